@@ -0,0 +1,459 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/backoff"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/protoregistry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// OperationType classifies Gitaly RPCs by the way they interact with repositories. The
+// classification is the same one Praefect uses to route and replicate requests.
+type OperationType int
+
+const (
+	// OperationUnknown is the type of RPCs which are not classified, e.g. RPCs which are not
+	// part of Gitaly's protocol. These RPCs are neither retried nor hedged.
+	OperationUnknown OperationType = iota
+	// OperationAccessor is the type of RPCs which only read from repositories.
+	OperationAccessor
+	// OperationMutator is the type of RPCs which modify repositories. These RPCs are never
+	// retried nor hedged because a failed attempt may have already been applied to some of the
+	// replicas.
+	OperationMutator
+	// OperationMaintenance is the type of RPCs which perform maintenance tasks that don't
+	// change the observable state of repositories.
+	OperationMaintenance
+)
+
+// String returns the name of the operation type.
+func (o OperationType) String() string {
+	switch o {
+	case OperationAccessor:
+		return "accessor"
+	case OperationMutator:
+		return "mutator"
+	case OperationMaintenance:
+		return "maintenance"
+	default:
+		return "unknown"
+	}
+}
+
+// OperationTypeForMethod returns the operation type of the given full method name, e.g.
+// "/gitaly.RepositoryService/RepositoryExists". Methods which are not known to Gitaly's protocol
+// are of type OperationUnknown.
+func OperationTypeForMethod(fullMethodName string) OperationType {
+	methodInfo, err := protoregistry.GitalyProtoPreregistered.LookupMethod(fullMethodName)
+	if err != nil {
+		return OperationUnknown
+	}
+
+	switch methodInfo.Operation {
+	case protoregistry.OpAccessor:
+		return OperationAccessor
+	case protoregistry.OpMutator:
+		return OperationMutator
+	case protoregistry.OpMaintenance:
+		return OperationMaintenance
+	default:
+		return OperationUnknown
+	}
+}
+
+// RetryPolicy configures how failed RPCs are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the initial one. Values smaller
+	// than two disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the upper limit of the delay between two attempts.
+	MaxBackoff time.Duration
+	// BackoffMultiplier is the factor the delay is multiplied with after each retry.
+	BackoffMultiplier float64
+	// Jitter is the maximum randomized duration added to each delay.
+	Jitter time.Duration
+	// RetryableCodes are the status codes on which the RPC is retried.
+	RetryableCodes []codes.Code
+}
+
+// HedgingPolicy configures hedged requests. When the initial attempt of an RPC didn't complete
+// after Delay, an additional attempt is sent. The first attempt to succeed wins and all other
+// attempts are cancelled.
+type HedgingPolicy struct {
+	// MaxAttempts is the maximum number of concurrent attempts including the initial one.
+	// Values smaller than two disable hedging.
+	MaxAttempts int
+	// Delay is the time to wait for an attempt to complete before sending the next one.
+	Delay time.Duration
+	// NonFatalCodes are the status codes which cause the next attempt to be sent immediately
+	// instead of failing the RPC. Any other error fails the RPC.
+	NonFatalCodes []codes.Code
+}
+
+// CircuitBreakerPolicy configures the circuit breakers kept for each address. After
+// FailureThreshold consecutive failures, the circuit is opened and RPCs to the address fail fast
+// with an Unavailable error for OpenDuration. Afterwards, a single RPC is let through to probe
+// whether the address has recovered.
+type CircuitBreakerPolicy struct {
+	// FailureThreshold is the number of consecutive failures that open the circuit. Values
+	// smaller than one disable circuit breaking.
+	FailureThreshold int
+	// OpenDuration is the time the circuit stays open before it is probed again.
+	OpenDuration time.Duration
+	// FailureCodes are the status codes that are counted as failures.
+	FailureCodes []codes.Code
+}
+
+// CallPolicy applies retries, hedging, default deadlines and circuit breaking to RPCs. The
+// behaviour depends on the operation type of the invoked RPC: only accessors and maintenance RPCs
+// are ever retried, and only accessors are hedged. The policy should be shared by all connections
+// of a process so that circuit breakers are kept per address.
+type CallPolicy struct {
+	retryPolicies   map[OperationType]RetryPolicy
+	hedgingPolicy   HedgingPolicy
+	breakerPolicy   CircuitBreakerPolicy
+	defaultTimeouts map[OperationType]time.Duration
+	now             func() time.Time
+
+	breakersMutex sync.Mutex
+	breakers      map[string]*circuitBreaker
+}
+
+// CallPolicyOption is an option for NewCallPolicy.
+type CallPolicyOption func(*CallPolicy)
+
+// WithRetryPolicy sets the retry policy for RPCs of the given operation type. The policy is
+// ignored for mutators and unknown RPCs as these are never retried.
+func WithRetryPolicy(operation OperationType, policy RetryPolicy) CallPolicyOption {
+	return func(p *CallPolicy) {
+		if operation != OperationAccessor && operation != OperationMaintenance {
+			return
+		}
+		p.retryPolicies[operation] = policy
+	}
+}
+
+// WithHedgingPolicy enables hedged requests for unary accessors. Accessors which are hedged are
+// not retried in addition.
+func WithHedgingPolicy(policy HedgingPolicy) CallPolicyOption {
+	return func(p *CallPolicy) {
+		p.hedgingPolicy = policy
+	}
+}
+
+// WithCircuitBreakerPolicy sets the policy of the circuit breakers kept for each address.
+func WithCircuitBreakerPolicy(policy CircuitBreakerPolicy) CallPolicyOption {
+	return func(p *CallPolicy) {
+		p.breakerPolicy = policy
+	}
+}
+
+// WithDefaultTimeout sets the deadline applied to RPCs of the given operation type whose context
+// doesn't have a deadline yet. Deadlines of the caller are always propagated unchanged.
+func WithDefaultTimeout(operation OperationType, timeout time.Duration) CallPolicyOption {
+	return func(p *CallPolicy) {
+		p.defaultTimeouts[operation] = timeout
+	}
+}
+
+// NewCallPolicy creates a new CallPolicy. By default, accessors are retried up to three times if
+// they fail with Unavailable, and the circuit to an address is opened for ten seconds after five
+// consecutive Unavailable errors. Hedging and default timeouts are disabled.
+func NewCallPolicy(opts ...CallPolicyOption) *CallPolicy {
+	p := &CallPolicy{
+		retryPolicies: map[OperationType]RetryPolicy{
+			OperationAccessor: {
+				MaxAttempts:       3,
+				InitialBackoff:    100 * time.Millisecond,
+				MaxBackoff:        time.Second,
+				BackoffMultiplier: 2,
+				Jitter:            50 * time.Millisecond,
+				RetryableCodes:    []codes.Code{codes.Unavailable},
+			},
+		},
+		breakerPolicy: CircuitBreakerPolicy{
+			FailureThreshold: 5,
+			OpenDuration:     10 * time.Second,
+			FailureCodes:     []codes.Code{codes.Unavailable},
+		},
+		defaultTimeouts: map[OperationType]time.Duration{},
+		now:             time.Now,
+		breakers:        map[string]*circuitBreaker{},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// DialOptions returns the dial options which install the policy's interceptors on a connection.
+// They can be passed to Dial or to a Pool via WithDialOptions.
+func (p *CallPolicy) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(p.UnaryInterceptor()),
+		grpc.WithChainStreamInterceptor(p.StreamInterceptor()),
+	}
+}
+
+// UnaryInterceptor returns the interceptor applying the policy to unary RPCs.
+func (p *CallPolicy) UnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		operation := OperationTypeForMethod(method)
+
+		ctx, cancel := p.withDefaultTimeout(ctx, operation)
+		defer cancel()
+
+		breaker := p.circuitBreaker(cc.Target())
+		attempt := func(ctx context.Context, reply interface{}, opts []grpc.CallOption) error {
+			if err := breaker.allow(); err != nil {
+				return err
+			}
+
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			breaker.record(ctx, err)
+			return err
+		}
+
+		if operation == OperationAccessor && p.hedgingPolicy.MaxAttempts > 1 {
+			return hedge(ctx, p.hedgingPolicy, reply, opts, attempt)
+		}
+
+		return retry(ctx, p.retryPolicy(operation), func() error {
+			return attempt(ctx, reply, opts)
+		})
+	}
+}
+
+// retryPolicy returns the retry policy of the given operation type. The zero policy disables
+// retries.
+func (p *CallPolicy) retryPolicy(operation OperationType) RetryPolicy {
+	return p.retryPolicies[operation]
+}
+
+// withDefaultTimeout sets the configured default timeout of the operation type on the context in
+// case it doesn't have a deadline yet.
+func (p *CallPolicy) withDefaultTimeout(ctx context.Context, operation OperationType) (context.Context, context.CancelFunc) {
+	timeout, ok := p.defaultTimeouts[operation]
+	if !ok || timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// circuitBreaker returns the circuit breaker of the given address.
+func (p *CallPolicy) circuitBreaker(address string) *circuitBreaker {
+	p.breakersMutex.Lock()
+	defer p.breakersMutex.Unlock()
+
+	breaker, ok := p.breakers[address]
+	if !ok {
+		breaker = newCircuitBreaker(address, p.breakerPolicy, p.now)
+		p.breakers[address] = breaker
+	}
+
+	return breaker
+}
+
+// retry invokes call until it succeeds, fails with an error that is not retryable or the attempts
+// of the policy are exhausted. It doesn't wait for a retry if the delay would exceed the deadline
+// of the context.
+func retry(ctx context.Context, policy RetryPolicy, call func() error) error {
+	var strategy backoff.Strategy
+
+	for attempt := 1; ; attempt++ {
+		err := call()
+		if err == nil || attempt >= policy.MaxAttempts || !policy.retryable(err) {
+			return err
+		}
+
+		if strategy == nil {
+			strategy = policy.backoffStrategy()
+		}
+
+		if !waitBackoff(ctx, strategy.Backoff(uint(attempt-1))) {
+			return err
+		}
+	}
+}
+
+// waitBackoff waits for the given delay. It returns false without waiting if the delay would
+// exceed the deadline of the context, or as soon as the context is done.
+func waitBackoff(ctx context.Context, delay time.Duration) bool {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return false
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// retryable determines whether the error may be retried. Errors caused by an open circuit are
+// never retried as the circuit stays open for longer than any reasonable backoff.
+func (policy RetryPolicy) retryable(err error) bool {
+	var circuitErr *CircuitOpenError
+	if errors.As(err, &circuitErr) {
+		return false
+	}
+
+	return containsCode(policy.RetryableCodes, status.Code(err))
+}
+
+func (policy RetryPolicy) backoffStrategy() backoff.Strategy {
+	//nolint:gosec // The random source is only used to jitter delays.
+	strategy := backoff.NewDefaultExponential(rand.New(rand.NewSource(time.Now().UnixNano())))
+	strategy.BaseDelay = policy.InitialBackoff
+	strategy.MaxDelay = policy.MaxBackoff
+	strategy.Multiplier = policy.BackoffMultiplier
+	strategy.Jitter = policy.Jitter
+	return strategy
+}
+
+// hedge invokes call concurrently according to the hedging policy and stores the reply of the
+// first successful attempt in reply. Replies which are no protobuf messages cannot be cloned for
+// concurrent attempts, so the call is invoked once only. Each attempt is invoked with its own
+// copies of the call options, and only the results of the attempt whose outcome is returned are
+// stored in the caller's call options.
+func hedge(ctx context.Context, policy HedgingPolicy, reply interface{}, opts []grpc.CallOption, call func(context.Context, interface{}, []grpc.CallOption) error) error {
+	message, ok := reply.(proto.Message)
+	if !ok {
+		return call(ctx, reply, opts)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		reply  proto.Message
+		err    error
+		commit func()
+	}
+
+	// The channel is buffered so that attempts which are still running when we return don't
+	// block forever.
+	results := make(chan result, policy.MaxAttempts)
+
+	var launched, inFlight int
+	hedgeTimer := time.NewTimer(policy.Delay)
+	defer hedgeTimer.Stop()
+
+	launch := func() {
+		launched++
+		inFlight++
+
+		attemptReply := message.ProtoReflect().New().Interface()
+		attemptOpts, commit := isolateCallOptions(opts)
+		go func() {
+			err := call(ctx, attemptReply, attemptOpts)
+			results <- result{reply: attemptReply, err: err, commit: commit}
+		}()
+
+		if !hedgeTimer.Stop() {
+			select {
+			case <-hedgeTimer.C:
+			default:
+			}
+		}
+		if launched < policy.MaxAttempts {
+			hedgeTimer.Reset(policy.Delay)
+		}
+	}
+
+	launch()
+
+	for {
+		select {
+		case <-hedgeTimer.C:
+			launch()
+		case res := <-results:
+			inFlight--
+
+			if res.err == nil {
+				proto.Reset(message)
+				proto.Merge(message, res.reply)
+				res.commit()
+				return nil
+			}
+
+			if !containsCode(policy.NonFatalCodes, status.Code(res.err)) {
+				res.commit()
+				return res.err
+			}
+
+			if launched < policy.MaxAttempts {
+				launch()
+			} else if inFlight == 0 {
+				res.commit()
+				return res.err
+			}
+		}
+	}
+}
+
+// isolateCallOptions returns the call options for a single hedged attempt. Call options which
+// receive results of the RPC, namely its header, trailer and peer, are replaced so that concurrent
+// attempts don't write to the caller's values. The returned function stores the results of the
+// attempt in the caller's values once the attempt has finished.
+func isolateCallOptions(opts []grpc.CallOption) ([]grpc.CallOption, func()) {
+	isolated := make([]grpc.CallOption, 0, len(opts))
+	var commits []func()
+
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case grpc.HeaderCallOption:
+			header := new(metadata.MD)
+			isolated = append(isolated, grpc.Header(header))
+			commits = append(commits, func() { *opt.HeaderAddr = *header })
+		case grpc.TrailerCallOption:
+			trailer := new(metadata.MD)
+			isolated = append(isolated, grpc.Trailer(trailer))
+			commits = append(commits, func() { *opt.TrailerAddr = *trailer })
+		case grpc.PeerCallOption:
+			p := new(peer.Peer)
+			isolated = append(isolated, grpc.Peer(p))
+			commits = append(commits, func() { *opt.PeerAddr = *p })
+		default:
+			isolated = append(isolated, opt)
+		}
+	}
+
+	return isolated, func() {
+		for _, commit := range commits {
+			commit()
+		}
+	}
+}
+
+func containsCode(candidates []codes.Code, code codes.Code) bool {
+	for _, c := range candidates {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
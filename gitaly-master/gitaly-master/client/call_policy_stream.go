@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/backoff"
+	"google.golang.org/grpc"
+)
+
+// StreamInterceptor returns the interceptor applying the policy to streaming RPCs. Streaming RPCs
+// are never hedged. Server-streaming accessors and maintenance RPCs are retried according to
+// their retry policy as long as no response has been received yet. Once a response has been
+// received, errors are returned to the caller as-is.
+func (p *CallPolicy) StreamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		operation := OperationTypeForMethod(method)
+
+		ctx, cancel := p.withDefaultTimeout(ctx, operation)
+
+		breaker := p.circuitBreaker(cc.Target())
+		newStream := func() (grpc.ClientStream, error) {
+			if err := breaker.allow(); err != nil {
+				return nil, err
+			}
+
+			stream, err := streamer(ctx, desc, cc, method, opts...)
+			if err != nil {
+				breaker.record(ctx, err)
+				return nil, err
+			}
+
+			return stream, nil
+		}
+
+		stream, err := newStream()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		policy := p.retryPolicy(operation)
+		if desc.ClientStreams {
+			// Requests of client-streaming RPCs cannot be replayed without buffering all of them.
+			policy = RetryPolicy{}
+		}
+
+		return &policyStream{
+			ClientStream: stream,
+			ctx:          ctx,
+			policy:       policy,
+			newStream:    newStream,
+			breaker:      breaker,
+			cancel:       cancel,
+			attempts:     1,
+		}, nil
+	}
+}
+
+// policyStream records the outcome of a stream with the circuit breaker and releases the
+// stream's context once it has finished. If the retry policy allows it, the stream is
+// transparently recreated when it fails before the first response has been received. To this
+// end, the single request of the server-streaming RPC is kept so that it can be sent again.
+type policyStream struct {
+	grpc.ClientStream
+
+	ctx       context.Context
+	policy    RetryPolicy
+	strategy  backoff.Strategy
+	newStream func() (grpc.ClientStream, error)
+	breaker   *circuitBreaker
+	cancel    context.CancelFunc
+
+	request    interface{}
+	closedSend bool
+	received   bool
+	finished   bool
+	attempts   int
+}
+
+// SendMsg sends the message and keeps it in case the stream needs to be recreated.
+func (s *policyStream) SendMsg(m interface{}) error {
+	if s.policy.MaxAttempts > 1 {
+		s.request = m
+	}
+
+	return s.ClientStream.SendMsg(m)
+}
+
+// CloseSend closes the sending side of the stream.
+func (s *policyStream) CloseSend() error {
+	s.closedSend = true
+	return s.ClientStream.CloseSend()
+}
+
+// RecvMsg receives the next message. Failures before the first message are retried if the retry
+// policy allows it. The first message proves that the address is reachable, so it is recorded as
+// success with the circuit breaker right away. Otherwise, a probe stream whose caller stops reading
+// would never record its outcome.
+func (s *policyStream) RecvMsg(m interface{}) error {
+	for {
+		err := s.ClientStream.RecvMsg(m)
+		if err == nil {
+			if !s.received {
+				s.received = true
+				s.breaker.record(s.ctx, nil)
+			}
+			return nil
+		}
+
+		if s.retry(err) {
+			continue
+		}
+
+		s.finish(err)
+		return err
+	}
+}
+
+// retry recreates the stream after it has failed with the given error and replays the request.
+// It returns false if the stream must not or cannot be retried, in which case the error should be
+// returned to the caller.
+func (s *policyStream) retry(err error) bool {
+	if s.received || s.finished || errors.Is(err, io.EOF) || s.request == nil || !s.closedSend {
+		return false
+	}
+
+	if s.attempts >= s.policy.MaxAttempts || !s.policy.retryable(err) {
+		return false
+	}
+
+	// The failed stream has been allowed by the circuit breaker, so its outcome needs to be
+	// recorded before a new stream is created.
+	s.breaker.record(s.ctx, err)
+
+	if s.strategy == nil {
+		s.strategy = s.policy.backoffStrategy()
+	}
+
+	if !waitBackoff(s.ctx, s.strategy.Backoff(uint(s.attempts-1))) {
+		s.finish(nil)
+		return false
+	}
+
+	s.attempts++
+
+	stream, err := s.newStream()
+	if err != nil {
+		// The outcome of the new stream has already been recorded by newStream, so we only
+		// need to release the context.
+		s.finish(nil)
+		s.ClientStream = failedStream{ClientStream: s.ClientStream, err: err}
+		return true
+	}
+
+	// Errors when sending the request are surfaced by RecvMsg, so we don't need to handle them
+	// here.
+	_ = stream.SendMsg(s.request)
+	_ = stream.CloseSend()
+
+	s.ClientStream = stream
+	return true
+}
+
+// finish records the final outcome of the stream and releases its context. A nil error doesn't
+// record any outcome, and neither does any error after a message has been received given that
+// the stream's success has already been recorded.
+func (s *policyStream) finish(err error) {
+	if s.finished {
+		return
+	}
+	s.finished = true
+
+	if err != nil && !s.received {
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+		s.breaker.record(s.ctx, err)
+	}
+
+	s.cancel()
+}
+
+// failedStream is a stream whose messages can't be received because it couldn't be recreated.
+type failedStream struct {
+	grpc.ClientStream
+	err error
+}
+
+// RecvMsg returns the error which prevented the stream from being recreated.
+func (s failedStream) RecvMsg(interface{}) error {
+	return s.err
+}
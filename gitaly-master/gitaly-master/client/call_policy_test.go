@@ -0,0 +1,710 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestOperationTypeForMethod(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		method   string
+		expected OperationType
+	}{
+		{method: "/gitaly.RepositoryService/RepositoryExists", expected: OperationAccessor},
+		{method: "/gitaly.RepositoryService/WriteRef", expected: OperationMutator},
+		{method: "/gitaly.RepositoryService/OptimizeRepository", expected: OperationMaintenance},
+		{method: "/grpc.health.v1.Health/Check", expected: OperationUnknown},
+	} {
+		require.Equal(t, tc.expected, OperationTypeForMethod(tc.method), tc.method)
+	}
+}
+
+func TestCallPolicy_retries(t *testing.T) {
+	t.Parallel()
+
+	retryPolicy := RetryPolicy{
+		MaxAttempts:       3,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        time.Millisecond,
+		BackoffMultiplier: 1,
+		RetryableCodes:    []codes.Code{codes.Unavailable},
+	}
+
+	for _, tc := range []struct {
+		desc          string
+		opts          []CallPolicyOption
+		call          func(context.Context, gitalypb.RepositoryServiceClient) error
+		errs          []error
+		expectedCalls int32
+		expectedErr   error
+	}{
+		{
+			desc: "accessor is retried on Unavailable",
+			call: repositoryExists,
+			errs: []error{
+				status.Error(codes.Unavailable, "unavailable"),
+			},
+			expectedCalls: 2,
+		},
+		{
+			desc: "accessor is retried until attempts are exhausted",
+			opts: []CallPolicyOption{WithRetryPolicy(OperationAccessor, retryPolicy)},
+			call: repositoryExists,
+			errs: []error{
+				status.Error(codes.Unavailable, "first"),
+				status.Error(codes.Unavailable, "second"),
+				status.Error(codes.Unavailable, "third"),
+			},
+			expectedCalls: 3,
+			expectedErr:   status.Error(codes.Unavailable, "third"),
+		},
+		{
+			desc: "accessor is not retried on other errors",
+			call: repositoryExists,
+			errs: []error{
+				status.Error(codes.NotFound, "not found"),
+			},
+			expectedCalls: 1,
+			expectedErr:   status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "mutator is never retried",
+			opts: []CallPolicyOption{WithRetryPolicy(OperationMutator, retryPolicy)},
+			call: writeRef,
+			errs: []error{
+				status.Error(codes.Unavailable, "unavailable"),
+			},
+			expectedCalls: 1,
+			expectedErr:   status.Error(codes.Unavailable, "unavailable"),
+		},
+		{
+			desc: "maintenance is not retried by default",
+			call: optimizeRepository,
+			errs: []error{
+				status.Error(codes.Unavailable, "unavailable"),
+			},
+			expectedCalls: 1,
+			expectedErr:   status.Error(codes.Unavailable, "unavailable"),
+		},
+		{
+			desc: "maintenance is retried with retry policy",
+			opts: []CallPolicyOption{WithRetryPolicy(OperationMaintenance, retryPolicy)},
+			call: optimizeRepository,
+			errs: []error{
+				status.Error(codes.Unavailable, "unavailable"),
+			},
+			expectedCalls: 2,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+			server := &fakeRepositoryServer{
+				handle: func(context.Context) error {
+					call := atomic.AddInt32(&calls, 1)
+					if int(call) <= len(tc.errs) {
+						return tc.errs[call-1]
+					}
+					return nil
+				},
+			}
+
+			opts := append([]CallPolicyOption{
+				WithRetryPolicy(OperationAccessor, RetryPolicy{
+					MaxAttempts:       2,
+					InitialBackoff:    time.Millisecond,
+					MaxBackoff:        time.Millisecond,
+					BackoffMultiplier: 1,
+					RetryableCodes:    []codes.Code{codes.Unavailable},
+				}),
+			}, tc.opts...)
+
+			client := newCallPolicyClient(t, server, NewCallPolicy(opts...))
+
+			err := tc.call(testhelper.Context(t), client)
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedCalls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestCallPolicy_retryRespectsDeadline(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	server := &fakeRepositoryServer{
+		handle: func(context.Context) error {
+			atomic.AddInt32(&calls, 1)
+			return status.Error(codes.Unavailable, "unavailable")
+		},
+	}
+
+	client := newCallPolicyClient(t, server, NewCallPolicy(
+		WithRetryPolicy(OperationAccessor, RetryPolicy{
+			MaxAttempts:       3,
+			InitialBackoff:    time.Hour,
+			MaxBackoff:        time.Hour,
+			BackoffMultiplier: 1,
+			RetryableCodes:    []codes.Code{codes.Unavailable},
+		}),
+		WithDefaultTimeout(OperationAccessor, time.Minute),
+	))
+
+	// The backoff would exceed the deadline, so the error is returned right away instead of
+	// waiting for the deadline to expire.
+	err := repositoryExists(testhelper.Context(t), client)
+	testhelper.RequireGrpcError(t, status.Error(codes.Unavailable, "unavailable"), err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestCallPolicy_hedging(t *testing.T) {
+	t.Parallel()
+
+	t.Run("slow attempt is hedged", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		firstCancelled := make(chan struct{})
+		server := &fakeRepositoryServer{
+			handle: func(ctx context.Context) error {
+				if atomic.AddInt32(&calls, 1) == 1 {
+					<-ctx.Done()
+					close(firstCancelled)
+					return ctx.Err()
+				}
+				return nil
+			},
+		}
+
+		client := newCallPolicyClient(t, server, NewCallPolicy(
+			WithHedgingPolicy(HedgingPolicy{
+				MaxAttempts: 2,
+				Delay:       10 * time.Millisecond,
+			}),
+		))
+
+		ctx := testhelper.Context(t)
+
+		response, err := client.RepositoryExists(ctx, &gitalypb.RepositoryExistsRequest{})
+		require.NoError(t, err)
+		require.True(t, response.GetExists())
+		require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+		// The losing attempt is cancelled once the hedged attempt has succeeded.
+		<-firstCancelled
+	})
+
+	t.Run("non-fatal error triggers hedged attempt immediately", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := &fakeRepositoryServer{
+			handle: func(ctx context.Context) error {
+				if atomic.AddInt32(&calls, 1) == 1 {
+					return status.Error(codes.Unavailable, "unavailable")
+				}
+				return nil
+			},
+		}
+
+		client := newCallPolicyClient(t, server, NewCallPolicy(
+			WithHedgingPolicy(HedgingPolicy{
+				MaxAttempts:   2,
+				Delay:         time.Hour,
+				NonFatalCodes: []codes.Code{codes.Unavailable},
+			}),
+		))
+
+		response, err := client.RepositoryExists(testhelper.Context(t), &gitalypb.RepositoryExistsRequest{})
+		require.NoError(t, err)
+		require.True(t, response.GetExists())
+		require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("fatal error fails the call", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := &fakeRepositoryServer{
+			handle: func(ctx context.Context) error {
+				atomic.AddInt32(&calls, 1)
+				return status.Error(codes.NotFound, "not found")
+			},
+		}
+
+		client := newCallPolicyClient(t, server, NewCallPolicy(
+			WithHedgingPolicy(HedgingPolicy{
+				MaxAttempts:   3,
+				Delay:         time.Hour,
+				NonFatalCodes: []codes.Code{codes.Unavailable},
+			}),
+		))
+
+		err := repositoryExists(testhelper.Context(t), client)
+		testhelper.RequireGrpcError(t, status.Error(codes.NotFound, "not found"), err)
+		require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("mutators are not hedged", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		server := &fakeRepositoryServer{
+			handle: func(ctx context.Context) error {
+				atomic.AddInt32(&calls, 1)
+				return status.Error(codes.Unavailable, "unavailable")
+			},
+		}
+
+		client := newCallPolicyClient(t, server, NewCallPolicy(
+			WithHedgingPolicy(HedgingPolicy{
+				MaxAttempts:   3,
+				Delay:         time.Millisecond,
+				NonFatalCodes: []codes.Code{codes.Unavailable},
+			}),
+		))
+
+		err := writeRef(testhelper.Context(t), client)
+		testhelper.RequireGrpcError(t, status.Error(codes.Unavailable, "unavailable"), err)
+		require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("call options receive results of the winning attempt", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int32
+		firstCancelled := make(chan struct{})
+		server := &fakeRepositoryServer{
+			handle: func(ctx context.Context) error {
+				call := atomic.AddInt32(&calls, 1)
+				if err := grpc.SendHeader(ctx, metadata.Pairs("attempt", fmt.Sprint(call))); err != nil {
+					return err
+				}
+				if err := grpc.SetTrailer(ctx, metadata.Pairs("attempt", fmt.Sprint(call))); err != nil {
+					return err
+				}
+
+				if call == 1 {
+					<-ctx.Done()
+					close(firstCancelled)
+					return ctx.Err()
+				}
+				return nil
+			},
+		}
+
+		client := newCallPolicyClient(t, server, NewCallPolicy(
+			WithHedgingPolicy(HedgingPolicy{
+				MaxAttempts: 2,
+				Delay:       10 * time.Millisecond,
+			}),
+		))
+
+		var header, trailer metadata.MD
+		var p peer.Peer
+		_, err := client.RepositoryExists(
+			testhelper.Context(t),
+			&gitalypb.RepositoryExistsRequest{},
+			grpc.Header(&header),
+			grpc.Trailer(&trailer),
+			grpc.Peer(&p),
+		)
+		require.NoError(t, err)
+
+		// The losing attempt must not write to the caller's values, neither while the winning
+		// attempt is in flight nor after it has been cancelled.
+		<-firstCancelled
+		require.Equal(t, []string{"2"}, header.Get("attempt"))
+		require.Equal(t, []string{"2"}, trailer.Get("attempt"))
+		require.NotNil(t, p.Addr)
+	})
+}
+
+func TestCallPolicy_defaultTimeout(t *testing.T) {
+	t.Parallel()
+
+	deadlines := make(chan time.Time, 1)
+	server := &fakeRepositoryServer{
+		handle: func(ctx context.Context) error {
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return status.Error(codes.FailedPrecondition, "missing deadline")
+			}
+			deadlines <- deadline
+			return nil
+		},
+	}
+
+	client := newCallPolicyClient(t, server, NewCallPolicy(
+		WithDefaultTimeout(OperationAccessor, time.Minute),
+	))
+
+	ctx := testhelper.Context(t)
+
+	t.Run("default timeout is applied", func(t *testing.T) {
+		start := time.Now()
+		require.NoError(t, repositoryExists(ctx, client))
+		deadline := <-deadlines
+		require.True(t, deadline.After(start))
+		require.True(t, deadline.Before(start.Add(time.Minute+time.Second)))
+	})
+
+	t.Run("caller deadline is propagated", func(t *testing.T) {
+		ctx := deadlineContext{Context: ctx, deadline: time.Now().Add(time.Hour)}
+
+		require.NoError(t, repositoryExists(ctx, client))
+		require.True(t, (<-deadlines).After(time.Now().Add(time.Minute)))
+	})
+
+	t.Run("other operation types have no default timeout", func(t *testing.T) {
+		testhelper.RequireGrpcError(t, status.Error(codes.FailedPrecondition, "missing deadline"), writeRef(ctx, client))
+	})
+}
+
+func TestCallPolicy_circuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	var healthy int32
+	streamReceived := make(chan struct{})
+	server := &fakeRepositoryServer{
+		handle: func(context.Context) error {
+			atomic.AddInt32(&calls, 1)
+			if atomic.LoadInt32(&healthy) == 0 {
+				return status.Error(codes.Unavailable, "unavailable")
+			}
+			return nil
+		},
+		handleStream: func(stream gitalypb.RepositoryService_GetInfoAttributesServer) error {
+			if err := stream.Send(&gitalypb.GetInfoAttributesResponse{Attributes: []byte("a")}); err != nil {
+				return err
+			}
+
+			// Keep the stream open so that its outcome can only be recorded via the
+			// first received message.
+			<-streamReceived
+			return nil
+		},
+	}
+
+	var nowMutex sync.Mutex
+	now := time.Now()
+	advanceClock := func(d time.Duration) {
+		nowMutex.Lock()
+		defer nowMutex.Unlock()
+		now = now.Add(d)
+	}
+
+	policy := NewCallPolicy(
+		WithRetryPolicy(OperationAccessor, RetryPolicy{}),
+		WithCircuitBreakerPolicy(CircuitBreakerPolicy{
+			FailureThreshold: 2,
+			OpenDuration:     time.Minute,
+			FailureCodes:     []codes.Code{codes.Unavailable},
+		}),
+	)
+	policy.now = func() time.Time {
+		nowMutex.Lock()
+		defer nowMutex.Unlock()
+		return now
+	}
+
+	client := newCallPolicyClient(t, server, policy)
+	ctx := testhelper.Context(t)
+
+	openCircuit := func(t *testing.T) {
+		t.Helper()
+
+		atomic.StoreInt32(&healthy, 0)
+		atomic.StoreInt32(&calls, 0)
+
+		for i := 0; i < 2; i++ {
+			testhelper.RequireGrpcError(t, status.Error(codes.Unavailable, "unavailable"), repositoryExists(ctx, client))
+		}
+		require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+		// The circuit is open now, so calls fail fast without reaching the server.
+		err := repositoryExists(ctx, client)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		var circuitErr *CircuitOpenError
+		require.ErrorAs(t, err, &circuitErr)
+		require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+		atomic.StoreInt32(&healthy, 1)
+	}
+
+	// Once the open duration has passed, a probe is let through and closes the circuit on
+	// success.
+	openCircuit(t)
+	advanceClock(time.Minute)
+	require.NoError(t, repositoryExists(ctx, client))
+	require.NoError(t, repositoryExists(ctx, client))
+	require.Equal(t, int32(4), atomic.LoadInt32(&calls))
+
+	// A probe stream closes the circuit once its first message has been received, even if the
+	// caller doesn't read the stream until its end.
+	openCircuit(t)
+	advanceClock(time.Minute)
+
+	stream, err := client.GetInfoAttributes(ctx, &gitalypb.GetInfoAttributesRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	require.NoError(t, repositoryExists(ctx, client))
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	close(streamReceived)
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestCallPolicy_circuitBreakerIgnoresLocalCancellation(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	blockedCallReceived := make(chan struct{})
+	server := &fakeRepositoryServer{
+		handle: func(ctx context.Context) error {
+			if atomic.AddInt32(&calls, 1) == 2 {
+				close(blockedCallReceived)
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			return status.Error(codes.Unavailable, "unavailable")
+		},
+	}
+
+	client := newCallPolicyClient(t, server, NewCallPolicy(
+		WithRetryPolicy(OperationAccessor, RetryPolicy{}),
+		WithCircuitBreakerPolicy(CircuitBreakerPolicy{
+			FailureThreshold: 2,
+			OpenDuration:     time.Minute,
+			FailureCodes:     []codes.Code{codes.Unavailable},
+		}),
+	))
+	ctx := testhelper.Context(t)
+
+	testhelper.RequireGrpcError(t, status.Error(codes.Unavailable, "unavailable"), repositoryExists(ctx, client))
+
+	// The caller going away says nothing about the health of the server, so the call must not
+	// reset the count of consecutive failures.
+	cancelCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		<-blockedCallReceived
+		cancel()
+	}()
+	require.Equal(t, codes.Canceled, status.Code(repositoryExists(cancelCtx, client)))
+
+	testhelper.RequireGrpcError(t, status.Error(codes.Unavailable, "unavailable"), repositoryExists(ctx, client))
+
+	var circuitErr *CircuitOpenError
+	require.ErrorAs(t, repositoryExists(ctx, client), &circuitErr)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestCallPolicy_streamRetries(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc              string
+		errs              []error
+		failAfterResponse bool
+		expectedCalls     int32
+		expectedResponses int
+		expectedErr       error
+	}{
+		{
+			desc:              "stream succeeds",
+			expectedCalls:     1,
+			expectedResponses: 2,
+		},
+		{
+			desc: "stream is retried before first response",
+			errs: []error{
+				status.Error(codes.Unavailable, "unavailable"),
+			},
+			expectedCalls:     2,
+			expectedResponses: 2,
+		},
+		{
+			desc: "stream is retried until attempts are exhausted",
+			errs: []error{
+				status.Error(codes.Unavailable, "first"),
+				status.Error(codes.Unavailable, "second"),
+			},
+			expectedCalls: 2,
+			expectedErr:   status.Error(codes.Unavailable, "second"),
+		},
+		{
+			desc: "stream is not retried on other errors",
+			errs: []error{
+				status.Error(codes.NotFound, "not found"),
+			},
+			expectedCalls: 1,
+			expectedErr:   status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:              "stream is not retried after first response",
+			failAfterResponse: true,
+			expectedCalls:     1,
+			expectedResponses: 1,
+			expectedErr:       status.Error(codes.Unavailable, "broken stream"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int32
+			server := &fakeRepositoryServer{
+				handleStream: func(stream gitalypb.RepositoryService_GetInfoAttributesServer) error {
+					call := atomic.AddInt32(&calls, 1)
+					if int(call) <= len(tc.errs) {
+						return tc.errs[call-1]
+					}
+
+					if err := stream.Send(&gitalypb.GetInfoAttributesResponse{Attributes: []byte("a")}); err != nil {
+						return err
+					}
+
+					if tc.failAfterResponse {
+						return status.Error(codes.Unavailable, "broken stream")
+					}
+
+					return stream.Send(&gitalypb.GetInfoAttributesResponse{Attributes: []byte("b")})
+				},
+			}
+
+			client := newCallPolicyClient(t, server, NewCallPolicy(
+				WithRetryPolicy(OperationAccessor, RetryPolicy{
+					MaxAttempts:       2,
+					InitialBackoff:    time.Millisecond,
+					MaxBackoff:        time.Millisecond,
+					BackoffMultiplier: 1,
+					RetryableCodes:    []codes.Code{codes.Unavailable},
+				}),
+			))
+
+			stream, err := client.GetInfoAttributes(testhelper.Context(t), &gitalypb.GetInfoAttributesRequest{})
+			require.NoError(t, err)
+
+			var responses int
+			for {
+				_, err = stream.Recv()
+				if err != nil {
+					break
+				}
+				responses++
+			}
+
+			if tc.expectedErr == nil {
+				require.Equal(t, io.EOF, err)
+			} else {
+				testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			}
+			require.Equal(t, tc.expectedResponses, responses)
+			require.Equal(t, tc.expectedCalls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+// deadlineContext is a context which has a deadline that never expires. It allows testing how
+// deadlines are propagated without depending on timers.
+type deadlineContext struct {
+	context.Context
+	deadline time.Time
+}
+
+// Deadline returns the context's deadline.
+func (c deadlineContext) Deadline() (time.Time, bool) {
+	return c.deadline, true
+}
+
+type fakeRepositoryServer struct {
+	gitalypb.UnimplementedRepositoryServiceServer
+	handle       func(context.Context) error
+	handleStream func(gitalypb.RepositoryService_GetInfoAttributesServer) error
+}
+
+func (s *fakeRepositoryServer) RepositoryExists(ctx context.Context, _ *gitalypb.RepositoryExistsRequest) (*gitalypb.RepositoryExistsResponse, error) {
+	if err := s.handle(ctx); err != nil {
+		return nil, err
+	}
+	return &gitalypb.RepositoryExistsResponse{Exists: true}, nil
+}
+
+func (s *fakeRepositoryServer) WriteRef(ctx context.Context, _ *gitalypb.WriteRefRequest) (*gitalypb.WriteRefResponse, error) {
+	if err := s.handle(ctx); err != nil {
+		return nil, err
+	}
+	return &gitalypb.WriteRefResponse{}, nil
+}
+
+func (s *fakeRepositoryServer) OptimizeRepository(ctx context.Context, _ *gitalypb.OptimizeRepositoryRequest) (*gitalypb.OptimizeRepositoryResponse, error) {
+	if err := s.handle(ctx); err != nil {
+		return nil, err
+	}
+	return &gitalypb.OptimizeRepositoryResponse{}, nil
+}
+
+func (s *fakeRepositoryServer) GetInfoAttributes(_ *gitalypb.GetInfoAttributesRequest, stream gitalypb.RepositoryService_GetInfoAttributesServer) error {
+	return s.handleStream(stream)
+}
+
+func newCallPolicyClient(t *testing.T, server gitalypb.RepositoryServiceServer, policy *CallPolicy) gitalypb.RepositoryServiceClient {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	gitalypb.RegisterRepositoryServiceServer(srv, server)
+	go testhelper.MustServe(t, srv, listener)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, policy.DialOptions()...)...)
+	require.NoError(t, err)
+	t.Cleanup(func() { testhelper.MustClose(t, conn) })
+
+	return gitalypb.NewRepositoryServiceClient(conn)
+}
+
+func repositoryExists(ctx context.Context, client gitalypb.RepositoryServiceClient) error {
+	_, err := client.RepositoryExists(ctx, &gitalypb.RepositoryExistsRequest{})
+	return err
+}
+
+func writeRef(ctx context.Context, client gitalypb.RepositoryServiceClient) error {
+	_, err := client.WriteRef(ctx, &gitalypb.WriteRefRequest{})
+	return err
+}
+
+func optimizeRepository(ctx context.Context, client gitalypb.RepositoryServiceClient) error {
+	_, err := client.OptimizeRepository(ctx, &gitalypb.OptimizeRepositoryRequest{})
+	return err
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CircuitOpenError is returned when an RPC is rejected because the circuit to its address is open.
+type CircuitOpenError struct {
+	// Address is the address whose circuit is open.
+	Address string
+}
+
+// Error returns the error message.
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open for %q", e.Address)
+}
+
+// GRPCStatus returns the gRPC status of the error so that callers can treat it like any other
+// Unavailable error.
+func (e *CircuitOpenError) GRPCStatus() *status.Status {
+	return status.New(codes.Unavailable, e.Error())
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker tracks consecutive failures of RPCs to a single address.
+type circuitBreaker struct {
+	address string
+	policy  CircuitBreakerPolicy
+	now     func() time.Time
+
+	mutex     sync.Mutex
+	state     circuitState
+	failures  int
+	openUntil time.Time
+}
+
+func newCircuitBreaker(address string, policy CircuitBreakerPolicy, now func() time.Time) *circuitBreaker {
+	return &circuitBreaker{
+		address: address,
+		policy:  policy,
+		now:     now,
+	}
+}
+
+// allow returns an error if the RPC must be rejected because the circuit is open. Once the open
+// duration has passed, a single RPC is allowed to probe the address. If the outcome of the probe
+// hasn't been recorded within another open duration, e.g. because its caller has abandoned it,
+// the probe is considered lost and another one is allowed.
+func (b *circuitBreaker) allow() error {
+	if b.policy.FailureThreshold < 1 {
+		return nil
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case circuitOpen, circuitHalfOpen:
+		if b.now().Before(b.openUntil) {
+			return &CircuitOpenError{Address: b.address}
+		}
+		b.state = circuitHalfOpen
+		b.openUntil = b.now().Add(b.policy.OpenDuration)
+		return nil
+	default:
+		return nil
+	}
+}
+
+// record records the outcome of an RPC which has been allowed. RPCs which have been aborted by
+// their own context, e.g. because the caller went away or because a competing hedged attempt has
+// won, say nothing about the health of the address and are neither recorded as success nor as
+// failure. If such an RPC was the probe, another probe is allowed right away.
+func (b *circuitBreaker) record(ctx context.Context, err error) {
+	if b.policy.FailureThreshold < 1 {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if abortedLocally(ctx, err) {
+		if b.state == circuitHalfOpen {
+			b.openUntil = b.now()
+		}
+		return
+	}
+
+	if !containsCode(b.policy.FailureCodes, status.Code(err)) {
+		b.state = circuitClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= b.policy.FailureThreshold {
+		b.state = circuitOpen
+		b.openUntil = b.now().Add(b.policy.OpenDuration)
+	}
+}
+
+// abortedLocally determines whether the RPC failed because its context has been canceled or its
+// deadline has been exceeded on the client side.
+func abortedLocally(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Canceled, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	now := time.Now()
+	breaker := newCircuitBreaker("address", CircuitBreakerPolicy{
+		FailureThreshold: 2,
+		OpenDuration:     time.Minute,
+		FailureCodes:     []codes.Code{codes.Unavailable},
+	}, func() time.Time { return now })
+
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	circuitOpen := &CircuitOpenError{Address: "address"}
+
+	// Errors which are not failures reset the count of consecutive failures.
+	require.NoError(t, breaker.allow())
+	breaker.record(ctx, unavailable)
+	require.NoError(t, breaker.allow())
+	breaker.record(ctx, status.Error(codes.NotFound, "not found"))
+	require.NoError(t, breaker.allow())
+	breaker.record(ctx, unavailable)
+
+	// The second consecutive failure opens the circuit.
+	require.NoError(t, breaker.allow())
+	breaker.record(ctx, unavailable)
+	require.Equal(t, circuitOpen, breaker.allow())
+	require.Equal(t, codes.Unavailable, status.Code(breaker.allow()))
+
+	// After the open duration, only a single probe is allowed.
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.allow())
+	require.Equal(t, circuitOpen, breaker.allow())
+
+	// A failed probe opens the circuit again right away.
+	breaker.record(ctx, unavailable)
+	require.Equal(t, circuitOpen, breaker.allow())
+
+	// A probe whose outcome is not recorded within the open duration is considered lost, so
+	// another probe is allowed.
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.allow())
+	require.Equal(t, circuitOpen, breaker.allow())
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.allow())
+	require.Equal(t, circuitOpen, breaker.allow())
+
+	// A probe which has been aborted by its own context is not recorded, but another probe is
+	// allowed right away.
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	breaker.record(canceledCtx, status.Error(codes.Canceled, "canceled"))
+	require.NoError(t, breaker.allow())
+	require.Equal(t, circuitOpen, breaker.allow())
+
+	// A successful probe closes the circuit.
+	breaker.record(ctx, nil)
+	require.NoError(t, breaker.allow())
+	require.NoError(t, breaker.allow())
+}
+
+func TestCircuitBreaker_disabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	breaker := newCircuitBreaker("address", CircuitBreakerPolicy{}, time.Now)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, breaker.allow())
+		breaker.record(ctx, errors.New("failure"))
+	}
+}
+
+func TestCircuitBreaker_abortedLocally(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	breaker := newCircuitBreaker("address", CircuitBreakerPolicy{
+		FailureThreshold: 2,
+		OpenDuration:     time.Minute,
+		FailureCodes:     []codes.Code{codes.Unavailable, codes.DeadlineExceeded},
+	}, time.Now)
+
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	// RPCs aborted by their own context neither reset the count of consecutive failures nor
+	// count as failures themselves.
+	require.NoError(t, breaker.allow())
+	breaker.record(ctx, unavailable)
+	require.NoError(t, breaker.allow())
+	breaker.record(canceledCtx, status.Error(codes.Canceled, "canceled"))
+	require.NoError(t, breaker.allow())
+	breaker.record(canceledCtx, status.Error(codes.DeadlineExceeded, "deadline exceeded"))
+	require.NoError(t, breaker.allow())
+	breaker.record(canceledCtx, context.Canceled)
+	require.NoError(t, breaker.allow())
+
+	// A deadline exceeded by the server while the client's context is still alive is a failure.
+	breaker.record(ctx, status.Error(codes.DeadlineExceeded, "deadline exceeded"))
+	require.Equal(t, &CircuitOpenError{Address: "address"}, breaker.allow())
+}
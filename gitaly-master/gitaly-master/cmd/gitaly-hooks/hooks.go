@@ -12,11 +12,13 @@ import (
 	gitalyauth "gitlab.com/gitlab-org/gitaly/v15/auth"
 	"gitlab.com/gitlab-org/gitaly/v15/client"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	internalclient "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/client"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/hook"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/env"
 	gitalylog "gitlab.com/gitlab-org/gitaly/v15/internal/log"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/stream"
+	gitalytracing "gitlab.com/gitlab-org/gitaly/v15/internal/tracing"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 	"gitlab.com/gitlab-org/gitaly/v15/streamio"
 	"gitlab.com/gitlab-org/labkit/tracing"
@@ -113,6 +115,10 @@ func executeHook(cmd hookCommand, args []string) error {
 	ctx, finished := tracing.ExtractFromEnv(ctx)
 	defer finished()
 
+	// Join the OpenTelemetry trace of the Git command executing the hook so that the hook RPCs
+	// become part of the same trace as the RPC that spawned Git.
+	ctx = gitalytracing.ExtractFromEnv(ctx, os.Environ())
+
 	payload, err := git.HooksPayloadFromEnv(os.Environ())
 	if err != nil {
 		return fmt.Errorf("error when getting hooks payload: %v", err)
@@ -146,7 +152,9 @@ func executeHook(cmd hookCommand, args []string) error {
 func noopSender(c chan error) {}
 
 func dialGitaly(payload git.HooksPayload) (*grpc.ClientConn, error) {
-	dialOpts := client.DefaultDialOpts
+	// The interceptors propagate the correlation ID and trace context of the Git command into
+	// the hook RPCs.
+	dialOpts := append(client.DefaultDialOpts, internalclient.UnaryInterceptor(), internalclient.StreamInterceptor())
 	if payload.InternalSocketToken != "" {
 		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(gitalyauth.RPCCredentialsV2(payload.InternalSocketToken)))
 	}
@@ -5,7 +5,9 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"runtime/debug"
+	"syscall"
 	"time"
 
 	"github.com/go-enry/go-license-detector/v4/licensedb"
@@ -26,11 +28,18 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/repository"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/updateref"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git2go"
+	internalgitaly "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/audit"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/bundleuri"
 	internalclient "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/client"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/sentry"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/hook"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/maintenance"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/partialclone"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushevents"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/refbarrier"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/reload"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/rubyserver"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/server"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
@@ -41,7 +50,9 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/env"
 	glog "gitlab.com/gitlab-org/gitaly/v15/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/middleware/audithandler"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/middleware/limithandler"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/protoregistry"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/streamcache"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/tempdir"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/tracing"
@@ -108,7 +119,7 @@ func main() {
 		log.Fatal(err)
 	}
 
-	if err := run(cfg); err != nil {
+	if err := run(flag.Arg(0), cfg); err != nil {
 		log.WithError(err).Error("Gitaly shutdown")
 		os.Exit(1)
 	}
@@ -142,10 +153,27 @@ func preloadLicenseDatabase() {
 	log.Info("License database preloaded")
 }
 
-func run(cfg config.Cfg) error {
+func run(configPath string, cfg config.Cfg) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	// The configuration is reloaded from disk, so changes are detected by comparing against
+	// the configuration as it has been loaded and not against the runtime configuration.
+	configReloader := reload.NewReloader(glog.Default(), cfg, func() (config.Cfg, error) {
+		return loadConfig(configPath)
+	})
+	prometheus.MustRegister(configReloader)
+
+	shutdownTelemetry, err := tracing.Initialize(ctx, "gitaly", version.GetVersion(), cfg.Telemetry)
+	if err != nil {
+		return fmt.Errorf("initialize telemetry: %w", err)
+	}
+	defer func() {
+		if err := shutdownTelemetry(context.Background()); err != nil {
+			log.WithError(err).Warn("failed to flush telemetry")
+		}
+	}()
+
 	bootstrapSpan, ctx := tracing.StartSpan(ctx, "gitaly-bootstrap", nil)
 	defer bootstrapSpan.Finish()
 
@@ -235,6 +263,21 @@ func run(cfg config.Cfg) error {
 
 	prometheus.MustRegister(gitCmdFactory)
 
+	var auditLogger *audit.Logger
+	if cfg.Audit.Path != "" {
+		auditLogger, err = audit.NewLogger(cfg.Audit, log.StandardLogger())
+		if err != nil {
+			return fmt.Errorf("creating audit logger: %w", err)
+		}
+		defer func() {
+			if err := auditLogger.Close(); err != nil {
+				log.WithError(err).Warn("closing audit log")
+			}
+		}()
+	}
+
+	refBarrier := refbarrier.New()
+
 	if skipHooks {
 		log.Warn("skipping GitLab API client creation since hooks are bypassed via GITALY_TESTING_NO_GIT_HOOKS")
 	} else {
@@ -244,7 +287,7 @@ func run(cfg config.Cfg) error {
 		}
 		prometheus.MustRegister(gitlabClient)
 
-		hm := hook.NewManager(cfg, locator, gitCmdFactory, transactionManager, gitlabClient)
+		hm := hook.NewManager(cfg, locator, gitCmdFactory, transactionManager, gitlabClient, auditLogger, refBarrier)
 
 		hookManager = hm
 	}
@@ -285,19 +328,11 @@ func run(cfg config.Cfg) error {
 		string(cfg.PackObjectsLimiting.Key),
 		cfg.Prometheus.GRPCLatencyBuckets,
 	)
-	packObjectsConcurrencyLimit := cfg.PackObjectsLimiting.MaxConcurrency
-	if packObjectsConcurrencyLimit == 0 {
-		// TODO: remove this default setting when we remove the feature
-		// flags PackObjectsLimitingRepo and PackObjectsLimitingUser
-		// feature flag issue:  https://gitlab.com/gitlab-org/gitaly/-/issues/4413
-		packObjectsConcurrencyLimit = 200
-	}
+	packObjectsConcurrencyLimit, packObjectsQueueTicker := packObjectsLimits(cfg.PackObjectsLimiting)
 	packObjectsLimiter := limithandler.NewConcurrencyLimiter(
 		packObjectsConcurrencyLimit,
 		0,
-		func() helper.Ticker {
-			return helper.NewTimerTicker(cfg.PackObjectsLimiting.MaxQueueWait.Duration())
-		},
+		packObjectsQueueTicker,
 		packObjectsMonitor,
 	)
 
@@ -323,10 +358,40 @@ func run(cfg config.Cfg) error {
 	}
 	defer rubySrv.Stop()
 
-	streamCache := streamcache.New(cfg.PackObjectsCache, glog.Default())
+	streamCache := streamcache.NewReloadable(cfg.PackObjectsCache, glog.Default())
 	concurrencyTracker := hook.NewConcurrencyTracker()
 	prometheus.MustRegister(concurrencyTracker)
 
+	partialCloneEnforcer := partialclone.NewEnforcer(cfg.PartialClone, locator)
+	prometheus.MustRegister(partialCloneEnforcer)
+
+	var bundleURIManager *bundleuri.Manager
+	if cfg.BundleURI.BaseURL != "" {
+		bundleURIManager = bundleuri.NewManager(cfg.BundleURI, locator, gitCmdFactory, catfileCache)
+	}
+
+	var partitionManager *internalgitaly.PartitionManager
+	if cfg.Transactions.Enabled {
+		partitionManager, err = internalgitaly.NewPartitionManager(
+			cfg.Storages,
+			locator,
+			gitCmdFactory,
+			catfileCache,
+			glog.Default(),
+			internalgitaly.WithLogRetention(cfg.Transactions.LogRetention),
+		)
+		if err != nil {
+			return fmt.Errorf("create partition manager: %w", err)
+		}
+		defer partitionManager.Stop()
+
+		// Apply the reference updates which have been logged but not yet applied before the
+		// repositories are served.
+		if err := partitionManager.Recover(ctx); err != nil {
+			return fmt.Errorf("recover write-ahead logs: %w", err)
+		}
+	}
+
 	for _, c := range []starter.Config{
 		{Name: starter.Unix, Addr: cfg.SocketPath, HandoverOnUpgrade: true},
 		{Name: starter.Unix, Addr: cfg.InternalSocketPath(), HandoverOnUpgrade: false},
@@ -339,7 +404,15 @@ func run(cfg config.Cfg) error {
 
 		var srv *grpc.Server
 		if c.HandoverOnUpgrade {
-			srv, err = gitalyServerFactory.CreateExternal(c.IsSecure())
+			var opts []server.Option
+			if auditLogger != nil {
+				opts = append(opts,
+					server.WithUnaryInterceptor(audithandler.UnaryInterceptor(auditLogger, protoregistry.GitalyProtoPreregistered)),
+					server.WithStreamInterceptor(audithandler.StreamInterceptor(auditLogger, protoregistry.GitalyProtoPreregistered)),
+				)
+			}
+
+			srv, err = gitalyServerFactory.CreateExternal(c.IsSecure(), opts...)
 			if err != nil {
 				return fmt.Errorf("create external gRPC server: %w", err)
 			}
@@ -366,6 +439,11 @@ func run(cfg config.Cfg) error {
 			Git2goExecutor:                git2goExecutor,
 			UpdaterWithHooks:              updaterWithHooks,
 			HousekeepingManager:           housekeepingManager,
+			BundleURIManager:              bundleURIManager,
+			PartialCloneEnforcer:          partialCloneEnforcer,
+			ConfigReloader:                configReloader,
+			RefBarrier:                    refBarrier,
+			PartitionManager:              partitionManager,
 		})
 		b.RegisterStarter(starter.New(c, srv))
 	}
@@ -412,13 +490,83 @@ func run(cfg config.Cfg) error {
 	}
 	bootstrapSpan.Finish()
 
-	shutdownWorkers, err := maintenance.StartWorkers(
-		ctx,
-		glog.Default(),
-		maintenance.DailyOptimizationWorker(cfg, maintenance.OptimizerFunc(func(ctx context.Context, repo repository.GitRepo) error {
+	dailyMaintenanceSchedule := maintenance.NewDailySchedule(cfg.DailyMaintenance)
+
+	configReloader.Register(reload.KeyConcurrency, func(cfg config.Cfg) error {
+		concurrencyLimitHandler.Reload(cfg)
+		return nil
+	})
+	configReloader.Register(reload.KeyRateLimiting, func(cfg config.Cfg) error {
+		rateLimitHandler.Reload(cfg)
+		return nil
+	})
+	configReloader.Register(reload.KeyPackObjectsLimiting, func(cfg config.Cfg) error {
+		limit, queueTicker := packObjectsLimits(cfg.PackObjectsLimiting)
+		packObjectsLimiter.SetLimits(limit, 0, queueTicker)
+		return nil
+	})
+	configReloader.Register(reload.KeyLogging, func(cfg config.Cfg) error {
+		switch cfg.Logging.Format {
+		case "", "text", "json":
+		default:
+			return fmt.Errorf("invalid log format %q", cfg.Logging.Format)
+		}
+
+		glog.Configure(glog.Loggers, cfg.Logging.Format, cfg.Logging.Level)
+		return nil
+	})
+	configReloader.Register(reload.KeyPackObjectsCache, func(cfg config.Cfg) error {
+		streamCache.Reload(cfg.PackObjectsCache)
+		return nil
+	})
+	configReloader.Register(reload.KeyDailyMaintenance, func(cfg config.Cfg) error {
+		dailyMaintenanceSchedule.Update(cfg.DailyMaintenance)
+		return nil
+	})
+	configReloader.Register(reload.KeyCgroups, func(cfg config.Cfg) error {
+		return cgroupMgr.UpdateLimits(cfg.Cgroups)
+	})
+
+	// When graceful upgrades are enabled, SIGHUP is used to trigger an upgrade which restarts
+	// Gitaly with the new configuration. Otherwise, SIGHUP reloads the configuration in place.
+	if upgradesEnabled, _ := env.GetBool(bootstrap.EnvUpgradesEnabled, false); !upgradesEnabled {
+		reloadSignal := make(chan os.Signal, 1)
+		signal.Notify(reloadSignal, syscall.SIGHUP)
+		defer signal.Stop(reloadSignal)
+
+		go func() {
+			for {
+				select {
+				case <-reloadSignal:
+					// Errors are logged by the reloader already.
+					_, _ = configReloader.Reload()
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	workers := []maintenance.WorkerFunc{
+		maintenance.DailyOptimizationWorker(cfg, dailyMaintenanceSchedule, maintenance.OptimizerFunc(func(ctx context.Context, repo repository.GitRepo) error {
 			return housekeepingManager.OptimizeRepository(ctx, localrepo.New(locator, gitCmdFactory, catfileCache, repo))
 		})),
-	)
+	}
+
+	if cfg.Hooks.Events.Sink != "" {
+		publisher, err := pushevents.NewPublisher(cfg.Hooks.Events)
+		if err != nil {
+			return fmt.Errorf("creating push events publisher: %w", err)
+		}
+
+		workers = append(workers, publisher.Run)
+	}
+
+	if bundleURIManager != nil {
+		workers = append(workers, bundleURIManager.Run)
+	}
+
+	shutdownWorkers, err := maintenance.StartWorkers(ctx, glog.Default(), workers...)
 	if err != nil {
 		return fmt.Errorf("initialize auxiliary workers: %v", err)
 	}
@@ -429,3 +577,19 @@ func run(cfg config.Cfg) error {
 
 	return b.Wait(gracefulStopTicker, gitalyServerFactory.GracefulStop)
 }
+
+// packObjectsLimits returns the concurrency limit and the queue ticker of the pack-objects
+// limiter.
+func packObjectsLimits(cfg config.PackObjectsLimiting) (int, limithandler.QueueTickerCreator) {
+	concurrencyLimit := cfg.MaxConcurrency
+	if concurrencyLimit == 0 {
+		// TODO: remove this default setting when we remove the feature
+		// flags PackObjectsLimitingRepo and PackObjectsLimitingUser
+		// feature flag issue:  https://gitlab.com/gitlab-org/gitaly/-/issues/4413
+		concurrencyLimit = 200
+	}
+
+	return concurrencyLimit, func() helper.Ticker {
+		return helper.NewTimerTicker(cfg.MaxQueueWait.Duration())
+	}
+}
@@ -93,6 +93,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/service/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/transactions"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/sidechannel"
+	gitalytracing "gitlab.com/gitlab-org/gitaly/v15/internal/tracing"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/version"
 	"gitlab.com/gitlab-org/labkit/monitoring"
 	"gitlab.com/gitlab-org/labkit/tracing"
@@ -235,6 +236,16 @@ func run(
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	shutdownTelemetry, err := gitalytracing.Initialize(ctx, progname, version.GetVersion(), conf.Telemetry)
+	if err != nil {
+		return fmt.Errorf("initialize telemetry: %w", err)
+	}
+	defer func() {
+		if err := shutdownTelemetry(context.Background()); err != nil {
+			logger.WithError(err).Warn("failed to flush telemetry")
+		}
+	}()
+
 	var db *sql.DB
 	if conf.NeedsSQL() {
 		logger.Infof("establishing database connection to %s:%d ...", conf.DB.Host, conf.DB.Port)
@@ -29,6 +29,9 @@ type authInfoWrapper struct {
 func (w authInfoWrapper) peerID() ID                   { return w.id }
 func (w authInfoWrapper) yamuxSession() *yamux.Session { return w.session }
 
+// Unwrap returns the AuthInfo of the underlying transport credentials.
+func (w authInfoWrapper) Unwrap() credentials.AuthInfo { return w.AuthInfo }
+
 // GetPeerID gets the ID of the current peer connection.
 func GetPeerID(ctx context.Context) (ID, error) {
 	peerInfo, ok := peer.FromContext(ctx)
@@ -1,6 +1,7 @@
 package backup
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
@@ -239,7 +240,17 @@ func (mgr *Manager) Restore(ctx context.Context, req *RestoreRequest) error {
 		return fmt.Errorf("manager: %w", err)
 	}
 
-	if err := mgr.createRepository(ctx, req.Server, req.Repository); err != nil {
+	// The repository needs to be created with the object format of the backed up repository,
+	// otherwise fetching the bundles into it would fail.
+	objectFormat := gitalypb.ObjectFormat_OBJECT_FORMAT_UNSPECIFIED
+	if len(backup.Steps) > 0 {
+		objectFormat, err = mgr.bundleObjectFormat(ctx, backup.Steps[0].BundlePath)
+		if err != nil && !errors.Is(err, ErrDoesntExist) {
+			return fmt.Errorf("manager: %w", err)
+		}
+	}
+
+	if err := mgr.createRepository(ctx, req.Server, req.Repository, objectFormat); err != nil {
 		return fmt.Errorf("manager: %w", err)
 	}
 
@@ -311,12 +322,15 @@ func (mgr *Manager) removeRepository(ctx context.Context, server storage.ServerI
 	return nil
 }
 
-func (mgr *Manager) createRepository(ctx context.Context, server storage.ServerInfo, repo *gitalypb.Repository) error {
+func (mgr *Manager) createRepository(ctx context.Context, server storage.ServerInfo, repo *gitalypb.Repository, objectFormat gitalypb.ObjectFormat) error {
 	repoClient, err := mgr.newRepoClient(ctx, server)
 	if err != nil {
 		return fmt.Errorf("create repository: %w", err)
 	}
-	if _, err := repoClient.CreateRepository(ctx, &gitalypb.CreateRepositoryRequest{Repository: repo}); err != nil {
+	if _, err := repoClient.CreateRepository(ctx, &gitalypb.CreateRepositoryRequest{
+		Repository:   repo,
+		ObjectFormat: objectFormat,
+	}); err != nil {
 		return fmt.Errorf("create repository: %w", err)
 	}
 	return nil
@@ -425,6 +439,67 @@ func (s *createBundleFromRefListSender) Send() error {
 	return s.stream.Send(&s.chunk)
 }
 
+// bundleObjectFormat determines the object format of the objects stored in the bundle.
+func (mgr *Manager) bundleObjectFormat(ctx context.Context, path string) (gitalypb.ObjectFormat, error) {
+	reader, err := mgr.sink.GetReader(ctx, path)
+	if err != nil {
+		return gitalypb.ObjectFormat_OBJECT_FORMAT_UNSPECIFIED, fmt.Errorf("bundle object format: %w", err)
+	}
+	defer reader.Close()
+
+	objectFormat, err := parseBundleObjectFormat(reader)
+	if err != nil {
+		return gitalypb.ObjectFormat_OBJECT_FORMAT_UNSPECIFIED, fmt.Errorf("bundle object format: %q: %w", path, err)
+	}
+
+	return objectFormat, nil
+}
+
+// parseBundleObjectFormat parses the object format from the bundle header. Version 2 bundles can
+// only contain SHA1 objects, whereas version 3 bundles announce the object format via the
+// "object-format" capability and default to SHA1 if it is missing.
+func parseBundleObjectFormat(r io.Reader) (gitalypb.ObjectFormat, error) {
+	scanner := bufio.NewScanner(r)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return gitalypb.ObjectFormat_OBJECT_FORMAT_UNSPECIFIED, fmt.Errorf("reading signature: %w", err)
+		}
+		return gitalypb.ObjectFormat_OBJECT_FORMAT_UNSPECIFIED, errEmptyBundle
+	}
+
+	switch signature := scanner.Text(); signature {
+	case "# v2 git bundle":
+		return gitalypb.ObjectFormat_OBJECT_FORMAT_SHA1, nil
+	case "# v3 git bundle":
+	default:
+		return gitalypb.ObjectFormat_OBJECT_FORMAT_UNSPECIFIED, fmt.Errorf("unsupported bundle signature: %q", signature)
+	}
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "@") {
+			break
+		}
+
+		if !strings.HasPrefix(line, "@object-format=") {
+			continue
+		}
+
+		objectHash, err := git.ObjectHashByFormat(strings.TrimPrefix(line, "@object-format="))
+		if err != nil {
+			return gitalypb.ObjectFormat_OBJECT_FORMAT_UNSPECIFIED, err
+		}
+
+		return objectHash.ProtoFormat, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return gitalypb.ObjectFormat_OBJECT_FORMAT_UNSPECIFIED, fmt.Errorf("reading capabilities: %w", err)
+	}
+
+	return gitalypb.ObjectFormat_OBJECT_FORMAT_SHA1, nil
+}
+
 func (mgr *Manager) restoreBundle(ctx context.Context, path string, server storage.ServerInfo, repo *gitalypb.Repository) error {
 	reader, err := mgr.sink.GetReader(ctx, path)
 	if err != nil {
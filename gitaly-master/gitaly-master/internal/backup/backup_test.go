@@ -3,6 +3,7 @@
 package backup
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -18,6 +19,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service/setup"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
@@ -568,6 +570,125 @@ func TestManager_CreateRestore_contextServerInfo(t *testing.T) {
 	}))
 }
 
+func TestManager_CreateRestore_objectFormat(t *testing.T) {
+	t.Parallel()
+
+	cfg := testcfg.Build(t)
+	testcfg.BuildGitalyHooks(t, cfg)
+	cfg.SocketPath = testserver.RunGitalyServer(t, cfg, nil, setup.RegisterAll)
+
+	ctx := testhelper.Context(t)
+
+	cc, err := client.Dial(cfg.SocketPath, nil)
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, cc)
+
+	repoClient := gitalypb.NewRepositoryServiceClient(cc)
+
+	for _, objectHash := range []git.ObjectHash{
+		git.ObjectHashSHA1,
+		git.ObjectHashSHA256,
+	} {
+		t.Run(objectHash.Format, func(t *testing.T) {
+			repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+				ObjectFormat: objectHash.Format,
+			})
+
+			// gittest.WriteCommit always uses the default object hash, so we need to
+			// write the commit manually.
+			treeID := text.ChompBytes(gittest.ExecOpts(t, cfg, gittest.ExecConfig{Stdin: &bytes.Buffer{}},
+				"-C", repoPath, "mktree",
+			))
+			commitID := text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "commit-tree", treeID, "-m", "message"))
+			gittest.Exec(t, cfg, "-C", repoPath, "update-ref", "refs/heads/main", commitID)
+			expectedChecksum := gittest.ChecksumRepo(t, cfg, repoPath)
+
+			pool := client.NewPool()
+			defer testhelper.MustClose(t, pool)
+
+			sink := NewFilesystemSink(testhelper.TempDir(t))
+			locator, err := ResolveLocator("pointer", sink)
+			require.NoError(t, err)
+
+			fsBackup := NewManager(sink, locator, pool, "backup-id")
+			server := storage.ServerInfo{Address: cfg.SocketPath, Token: cfg.Auth.Token}
+
+			require.NoError(t, fsBackup.Create(ctx, &CreateRequest{
+				Server:     server,
+				Repository: repo,
+			}))
+			require.NoError(t, fsBackup.Restore(ctx, &RestoreRequest{
+				Server:     server,
+				Repository: repo,
+			}))
+
+			objectFormat, err := repoClient.ObjectFormat(ctx, &gitalypb.ObjectFormatRequest{
+				Repository: repo,
+			})
+			require.NoError(t, err)
+			require.Equal(t, objectHash.ProtoFormat, objectFormat.GetFormat())
+
+			checksum, err := repoClient.CalculateChecksum(ctx, &gitalypb.CalculateChecksumRequest{
+				Repository: repo,
+			})
+			require.NoError(t, err)
+			require.Equal(t, expectedChecksum.String(), checksum.GetChecksum())
+		})
+	}
+}
+
+func TestParseBundleObjectFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc                 string
+		header               string
+		expectedObjectFormat gitalypb.ObjectFormat
+		expectedErr          error
+	}{
+		{
+			desc:                 "v2 bundle",
+			header:               "# v2 git bundle\n" + strings.Repeat("1", 40) + " refs/heads/main\n\n",
+			expectedObjectFormat: gitalypb.ObjectFormat_OBJECT_FORMAT_SHA1,
+		},
+		{
+			desc:                 "v3 bundle without capabilities",
+			header:               "# v3 git bundle\n" + strings.Repeat("1", 40) + " refs/heads/main\n\n",
+			expectedObjectFormat: gitalypb.ObjectFormat_OBJECT_FORMAT_SHA1,
+		},
+		{
+			desc:                 "v3 bundle with SHA256",
+			header:               "# v3 git bundle\n@filter=blob:none\n@object-format=sha256\n" + strings.Repeat("1", 64) + " refs/heads/main\n\n",
+			expectedObjectFormat: gitalypb.ObjectFormat_OBJECT_FORMAT_SHA256,
+		},
+		{
+			desc:        "v3 bundle with unknown object format",
+			header:      "# v3 git bundle\n@object-format=blake2b\n\n",
+			expectedErr: fmt.Errorf("unknown object format: %q", "blake2b"),
+		},
+		{
+			desc:        "unknown signature",
+			header:      "# v4 git bundle\n\n",
+			expectedErr: fmt.Errorf("unsupported bundle signature: %q", "# v4 git bundle"),
+		},
+		{
+			desc:        "empty bundle",
+			header:      "",
+			expectedErr: errEmptyBundle,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			objectFormat, err := parseBundleObjectFormat(strings.NewReader(tc.header))
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedObjectFormat, objectFormat)
+		})
+	}
+}
+
 func TestResolveSink(t *testing.T) {
 	ctx := testhelper.Context(t)
 
@@ -9,50 +9,60 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	gitalyauth "gitlab.com/gitlab-org/gitaly/v15/auth"
+	"gitlab.com/gitlab-org/gitaly/v15/client"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 	"gitlab.com/gitlab-org/labkit/monitoring"
+	"google.golang.org/grpc"
 )
 
-type httpReferenceDiscoveryMetrics struct {
+type referenceDiscoveryStats interface {
+	FirstGitPacket() time.Duration
+	ResponseBody() time.Duration
+	Refs() []stats.Reference
+}
+
+type referenceDiscoveryMetrics struct {
 	firstPacket    *prometheus.GaugeVec
 	totalTime      *prometheus.GaugeVec
 	advertisedRefs *prometheus.GaugeVec
 }
 
-func (m httpReferenceDiscoveryMetrics) measure(probeName string, rd stats.HTTPReferenceDiscovery) {
+func (m referenceDiscoveryMetrics) measure(probeName string, rd referenceDiscoveryStats) {
 	m.firstPacket.WithLabelValues(probeName).Set(rd.FirstGitPacket().Seconds())
 	m.totalTime.WithLabelValues(probeName).Set(rd.ResponseBody().Seconds())
 	m.advertisedRefs.WithLabelValues(probeName).Set(float64(len(rd.Refs())))
 }
 
 // Describe is used to describe Prometheus metrics.
-func (m httpReferenceDiscoveryMetrics) Describe(descs chan<- *prometheus.Desc) {
+func (m referenceDiscoveryMetrics) Describe(descs chan<- *prometheus.Desc) {
 	prometheus.DescribeByCollect(m, descs)
 }
 
 // Collect is used to collect Prometheus metrics.
-func (m httpReferenceDiscoveryMetrics) Collect(metrics chan<- prometheus.Metric) {
+func (m referenceDiscoveryMetrics) Collect(metrics chan<- prometheus.Metric) {
 	m.firstPacket.Collect(metrics)
 	m.totalTime.Collect(metrics)
 	m.advertisedRefs.Collect(metrics)
 }
 
-type httpPostStats interface {
+type packStats interface {
 	ResponseBody() time.Duration
 	BandFirstPacket(b string) time.Duration
 	BandPayloadSize(b string) int64
 }
 
-type httpPostMetrics struct {
+type packMetrics struct {
 	totalTime           *prometheus.GaugeVec
 	firstProgressPacket *prometheus.GaugeVec
 	firstPackPacket     *prometheus.GaugeVec
 	packBytes           *prometheus.GaugeVec
 }
 
-func (m httpPostMetrics) measure(probeName string, stats httpPostStats) {
+func (m packMetrics) measure(probeName string, stats packStats) {
 	m.totalTime.WithLabelValues(probeName).Set(stats.ResponseBody().Seconds())
 	m.firstProgressPacket.WithLabelValues(probeName).Set(stats.BandFirstPacket("progress").Seconds())
 	m.firstPackPacket.WithLabelValues(probeName).Set(stats.BandFirstPacket("pack").Seconds())
@@ -60,12 +70,12 @@ func (m httpPostMetrics) measure(probeName string, stats httpPostStats) {
 }
 
 // Describe is used to describe Prometheus metrics.
-func (m httpPostMetrics) Describe(descs chan<- *prometheus.Desc) {
+func (m packMetrics) Describe(descs chan<- *prometheus.Desc) {
 	prometheus.DescribeByCollect(m, descs)
 }
 
 // Collect is used to collect Prometheus metrics.
-func (m httpPostMetrics) Collect(metrics chan<- prometheus.Metric) {
+func (m packMetrics) Collect(metrics chan<- prometheus.Metric) {
 	m.totalTime.Collect(metrics)
 	m.firstProgressPacket.Collect(metrics)
 	m.firstPackPacket.Collect(metrics)
@@ -76,35 +86,69 @@ func (m httpPostMetrics) Collect(metrics chan<- prometheus.Metric) {
 type Blackbox struct {
 	cfg Config
 
-	fetchReferenceDiscoveryMetrics httpReferenceDiscoveryMetrics
-	httpPostMetrics                httpPostMetrics
+	fetchReferenceDiscoveryMetrics referenceDiscoveryMetrics
+	httpPostMetrics                packMetrics
 	wantedRefs                     *prometheus.GaugeVec
+
+	sshReferenceDiscoveryMetrics referenceDiscoveryMetrics
+	sshPackMetrics               packMetrics
+	sshWantedRefs                *prometheus.GaugeVec
+
+	grpcFindCommit                *prometheus.GaugeVec
+	grpcReferenceDiscoveryMetrics referenceDiscoveryMetrics
+	grpcUploadPackMetrics         packMetrics
+	grpcWantedRefs                *prometheus.GaugeVec
 }
 
 // New creates a new Blackbox structure.
 func New(cfg Config) Blackbox {
 	return Blackbox{
 		cfg: cfg,
-		fetchReferenceDiscoveryMetrics: httpReferenceDiscoveryMetrics{
-			firstPacket:    newGauge("get_first_packet_seconds", "Time to first Git packet in GET /info/refs response"),
-			totalTime:      newGauge("get_total_time_seconds", "Time to receive entire GET /info/refs response"),
-			advertisedRefs: newGauge("get_advertised_refs", "Number of Git refs advertised in GET /info/refs"),
+		fetchReferenceDiscoveryMetrics: referenceDiscoveryMetrics{
+			firstPacket:    newGauge("git_http", "get_first_packet_seconds", "Time to first Git packet in GET /info/refs response"),
+			totalTime:      newGauge("git_http", "get_total_time_seconds", "Time to receive entire GET /info/refs response"),
+			advertisedRefs: newGauge("git_http", "get_advertised_refs", "Number of Git refs advertised in GET /info/refs"),
+		},
+		httpPostMetrics: packMetrics{
+			totalTime:           newGauge("git_http", "post_total_time_seconds", "Time to receive entire POST /upload-pack response"),
+			firstProgressPacket: newGauge("git_http", "post_first_progress_packet_seconds", "Time to first progress band Git packet in POST /upload-pack response"),
+			firstPackPacket:     newGauge("git_http", "post_first_pack_packet_seconds", "Time to first pack band Git packet in POST /upload-pack response"),
+			packBytes:           newGauge("git_http", "post_pack_bytes", "Number of pack band bytes in POST /upload-pack response"),
+		},
+		wantedRefs: newGauge("git_http", "wanted_refs", "Number of Git refs selected for (fake) Git clone (branches + tags)"),
+		sshReferenceDiscoveryMetrics: referenceDiscoveryMetrics{
+			firstPacket:    newGauge("git_ssh", "advertisement_first_packet_seconds", "Time from connecting to first Git packet in reference advertisement"),
+			totalTime:      newGauge("git_ssh", "advertisement_total_time_seconds", "Time from connecting to receiving the entire reference advertisement"),
+			advertisedRefs: newGauge("git_ssh", "advertised_refs", "Number of Git refs advertised in reference advertisement"),
 		},
-		httpPostMetrics: httpPostMetrics{
-			totalTime:           newGauge("post_total_time_seconds", "Time to receive entire POST /upload-pack response"),
-			firstProgressPacket: newGauge("post_first_progress_packet_seconds", "Time to first progress band Git packet in POST /upload-pack response"),
-			firstPackPacket:     newGauge("post_first_pack_packet_seconds", "Time to first pack band Git packet in POST /upload-pack response"),
-			packBytes:           newGauge("post_pack_bytes", "Number of pack band bytes in POST /upload-pack response"),
+		sshPackMetrics: packMetrics{
+			totalTime:           newGauge("git_ssh", "pack_total_time_seconds", "Time to receive entire response after sending the request"),
+			firstProgressPacket: newGauge("git_ssh", "pack_first_progress_packet_seconds", "Time to first progress band Git packet after sending the request"),
+			firstPackPacket:     newGauge("git_ssh", "pack_first_pack_packet_seconds", "Time to first pack band Git packet after sending the request"),
+			packBytes:           newGauge("git_ssh", "pack_bytes", "Number of pack band bytes in response"),
 		},
-		wantedRefs: newGauge("wanted_refs", "Number of Git refs selected for (fake) Git clone (branches + tags)"),
+		sshWantedRefs:  newGauge("git_ssh", "wanted_refs", "Number of Git refs selected for (fake) Git clone (branches + tags)"),
+		grpcFindCommit: newGauge("grpc", "find_commit_seconds", "Time to receive FindCommit response"),
+		grpcReferenceDiscoveryMetrics: referenceDiscoveryMetrics{
+			firstPacket:    newGauge("grpc", "info_refs_first_packet_seconds", "Time to first Git packet in InfoRefsUploadPack response"),
+			totalTime:      newGauge("grpc", "info_refs_total_time_seconds", "Time to receive entire InfoRefsUploadPack response"),
+			advertisedRefs: newGauge("grpc", "info_refs_advertised_refs", "Number of Git refs advertised in InfoRefsUploadPack response"),
+		},
+		grpcUploadPackMetrics: packMetrics{
+			totalTime:           newGauge("grpc", "upload_pack_total_time_seconds", "Time to receive entire PostUploadPackWithSidechannel response"),
+			firstProgressPacket: newGauge("grpc", "upload_pack_first_progress_packet_seconds", "Time to first progress band Git packet in PostUploadPackWithSidechannel response"),
+			firstPackPacket:     newGauge("grpc", "upload_pack_first_pack_packet_seconds", "Time to first pack band Git packet in PostUploadPackWithSidechannel response"),
+			packBytes:           newGauge("grpc", "upload_pack_bytes", "Number of pack band bytes in PostUploadPackWithSidechannel response"),
+		},
+		grpcWantedRefs: newGauge("grpc", "wanted_refs", "Number of Git refs selected for (fake) Git clone (branches + tags)"),
 	}
 }
 
-func newGauge(name string, help string) *prometheus.GaugeVec {
+func newGauge(subsystem string, name string, help string) *prometheus.GaugeVec {
 	return prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "gitaly_blackbox",
-			Subsystem: "git_http",
+			Subsystem: subsystem,
 			Name:      name,
 			Help:      help,
 		},
@@ -122,6 +166,13 @@ func (b Blackbox) Collect(metrics chan<- prometheus.Metric) {
 	b.fetchReferenceDiscoveryMetrics.Collect(metrics)
 	b.httpPostMetrics.Collect(metrics)
 	b.wantedRefs.Collect(metrics)
+	b.sshReferenceDiscoveryMetrics.Collect(metrics)
+	b.sshPackMetrics.Collect(metrics)
+	b.sshWantedRefs.Collect(metrics)
+	b.grpcFindCommit.Collect(metrics)
+	b.grpcReferenceDiscoveryMetrics.Collect(metrics)
+	b.grpcUploadPackMetrics.Collect(metrics)
+	b.grpcWantedRefs.Collect(metrics)
 }
 
 // Run starts the blackbox. It sets up and serves the Prometheus listener and starts a Goroutine
@@ -153,6 +204,12 @@ func (b Blackbox) runProbes() {
 				err = b.fetch(probe)
 			case Push:
 				err = b.push(probe)
+			case SSHFetch:
+				err = b.sshFetch(probe)
+			case SSHPush:
+				err = b.sshPush(probe)
+			case GRPC:
+				err = b.grpcFetch(probe)
 			default:
 				err = fmt.Errorf("unsupported probe type: %q", probe.Type)
 			}
@@ -201,33 +258,51 @@ func (b Blackbox) push(probe Probe) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	objectFormat := probe.Push.ObjectFormat
-	if objectFormat == "" {
-		objectFormat = git.ObjectHashSHA1.Format
+	objectHash, commands, err := pushCommands(probe)
+	if err != nil {
+		return err
 	}
 
-	objectHash, err := git.ObjectHashByFormat(objectFormat)
+	packfile, err := os.Open(probe.Push.Packfile)
 	if err != nil {
-		return fmt.Errorf("looking up object format: %w", err)
+		return fmt.Errorf("opening packfile for probe %q: %w", probe.Name, err)
 	}
+	defer packfile.Close()
 
-	commands := make([]stats.PushCommand, len(probe.Push.Commands))
-	for i, command := range probe.Push.Commands {
-		oldOID, err := git.ObjectHashSHA1.FromHex(command.OldOID)
-		if err != nil {
-			return fmt.Errorf("invalid old object ID for probe %q: %w", probe.Name, err)
-		}
+	clone, err := stats.PerformHTTPPush(
+		ctx, probe.URL, probe.User, probe.Password, objectHash, commands, packfile, false)
+	if err != nil {
+		return err
+	}
 
-		newOID, err := git.ObjectHashSHA1.FromHex(command.NewOID)
-		if err != nil {
-			return fmt.Errorf("invalid new object ID for probe %q: %w", probe.Name, err)
-		}
+	b.httpPostMetrics.measure(probe.Name, &clone.SendPack)
 
-		commands[i] = stats.PushCommand{
-			Reference: git.ReferenceName(command.Reference),
-			OldOID:    oldOID,
-			NewOID:    newOID,
-		}
+	return nil
+}
+
+func (b Blackbox) sshFetch(probe Probe) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clone, err := stats.PerformSSHClone(ctx, probe.URL, probe.User, sshOptions(probe), false)
+	if err != nil {
+		return err
+	}
+
+	b.sshReferenceDiscoveryMetrics.measure(probe.Name, clone.ReferenceDiscovery)
+	b.sshPackMetrics.measure(probe.Name, &clone.FetchPack)
+	b.sshWantedRefs.WithLabelValues(probe.Name).Set(float64(clone.FetchPack.RefsWanted()))
+
+	return nil
+}
+
+func (b Blackbox) sshPush(probe Probe) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	objectHash, commands, err := pushCommands(probe)
+	if err != nil {
+		return err
 	}
 
 	packfile, err := os.Open(probe.Push.Packfile)
@@ -236,13 +311,98 @@ func (b Blackbox) push(probe Probe) error {
 	}
 	defer packfile.Close()
 
-	clone, err := stats.PerformHTTPPush(
-		ctx, probe.URL, probe.User, probe.Password, objectHash, commands, packfile, false)
+	push, err := stats.PerformSSHPush(
+		ctx, probe.URL, probe.User, sshOptions(probe), objectHash, commands, packfile, false)
 	if err != nil {
 		return err
 	}
 
-	b.httpPostMetrics.measure(probe.Name, &clone.SendPack)
+	b.sshReferenceDiscoveryMetrics.measure(probe.Name, push.ReferenceDiscovery)
+	b.sshPackMetrics.measure(probe.Name, &push.SendPack)
 
 	return nil
 }
+
+func (b Blackbox) grpcFetch(probe Probe) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	registry := client.NewSidechannelRegistry(log.Default())
+	conn, err := client.DialSidechannel(ctx, probe.URL, registry, []grpc.DialOption{
+		grpc.WithPerRPCCredentials(gitalyauth.RPCCredentialsV2(probe.GRPC.Token)),
+	})
+	if err != nil {
+		return fmt.Errorf("dialing %q: %w", probe.URL, err)
+	}
+	defer conn.Close()
+
+	repo := &gitalypb.Repository{
+		StorageName:  probe.GRPC.StorageName,
+		RelativePath: probe.GRPC.RelativePath,
+	}
+
+	revision := probe.GRPC.Revision
+	if revision == "" {
+		revision = "HEAD"
+	}
+
+	start := time.Now()
+	if _, err := gitalypb.NewCommitServiceClient(conn).FindCommit(ctx, &gitalypb.FindCommitRequest{
+		Repository: repo,
+		Revision:   []byte(revision),
+	}); err != nil {
+		return fmt.Errorf("finding commit: %w", err)
+	}
+	b.grpcFindCommit.WithLabelValues(probe.Name).Set(time.Since(start).Seconds())
+
+	clone, err := stats.PerformGRPCClone(ctx, conn, registry, repo, false)
+	if err != nil {
+		return err
+	}
+
+	b.grpcReferenceDiscoveryMetrics.measure(probe.Name, clone.ReferenceDiscovery)
+	b.grpcUploadPackMetrics.measure(probe.Name, &clone.FetchPack)
+	b.grpcWantedRefs.WithLabelValues(probe.Name).Set(float64(clone.FetchPack.RefsWanted()))
+
+	return nil
+}
+
+func sshOptions(probe Probe) stats.SSHOptions {
+	if probe.SSH == nil {
+		return stats.SSHOptions{}
+	}
+
+	return stats.SSHOptions{
+		Command:        probe.SSH.Command,
+		IdentityFile:   probe.SSH.IdentityFile,
+		KnownHostsFile: probe.SSH.KnownHostsFile,
+	}
+}
+
+func pushCommands(probe Probe) (git.ObjectHash, []stats.PushCommand, error) {
+	objectHash, err := git.ObjectHashByFormat(probe.Push.ObjectFormat)
+	if err != nil {
+		return git.ObjectHash{}, nil, fmt.Errorf("looking up object format: %w", err)
+	}
+
+	commands := make([]stats.PushCommand, len(probe.Push.Commands))
+	for i, command := range probe.Push.Commands {
+		oldOID, err := objectHash.FromHex(command.OldOID)
+		if err != nil {
+			return git.ObjectHash{}, nil, fmt.Errorf("invalid old object ID for probe %q: %w", probe.Name, err)
+		}
+
+		newOID, err := objectHash.FromHex(command.NewOID)
+		if err != nil {
+			return git.ObjectHash{}, nil, fmt.Errorf("invalid new object ID for probe %q: %w", probe.Name, err)
+		}
+
+		commands[i] = stats.PushCommand{
+			Reference: git.ReferenceName(command.Reference),
+			OldOID:    oldOID,
+			NewOID:    newOID,
+		}
+	}
+
+	return objectHash, commands, nil
+}
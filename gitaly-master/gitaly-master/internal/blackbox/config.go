@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/pelletier/go-toml/v2"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	logconfig "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/log"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
 )
@@ -35,8 +36,27 @@ const (
 	// Push exercises the equivalent of git-push(1) with measurements for packfile negotiation
 	// and sending the packfile.
 	Push = ProbeType("push")
+	// SSHFetch exercises the equivalent of git-fetch(1) via the SSH transport with
+	// measurements for the reference advertisement, packfile negotiation and receiving the
+	// packfile.
+	SSHFetch = ProbeType("ssh_fetch")
+	// SSHPush exercises the equivalent of git-push(1) via the SSH transport with measurements
+	// for the reference advertisement, packfile negotiation and sending the packfile.
+	SSHPush = ProbeType("ssh_push")
+	// GRPC exercises Gitaly or Praefect directly by looking up a commit and performing a clone
+	// via the SmartHTTPService, with measurements for each of the RPCs.
+	GRPC = ProbeType("grpc")
 )
 
+// supportedURLSchemes maps each probe type to the URL schemes it supports.
+var supportedURLSchemes = map[ProbeType][]string{
+	Fetch:    {"http", "https"},
+	Push:     {"http", "https"},
+	SSHFetch: {"ssh"},
+	SSHPush:  {"ssh"},
+	GRPC:     {"tcp", "tls", "unix"},
+}
+
 // PushCommand describes a command performed as part of the push.
 type PushCommand struct {
 	// OldOID is the old state of the reference that should be updated.
@@ -58,6 +78,29 @@ type PushConfig struct {
 	Packfile string `toml:"packfile"`
 }
 
+// SSHConfig is the configuration for SSH-type probes.
+type SSHConfig struct {
+	// Command is the SSH executable used to connect to the remote. Defaults to "ssh".
+	Command string `toml:"command"`
+	// IdentityFile is the path to the private key used for authentication.
+	IdentityFile string `toml:"identity_file"`
+	// KnownHostsFile is the path to the known hosts file used to verify the remote's host
+	// key. If unset, the SSH executable's defaults are used.
+	KnownHostsFile string `toml:"known_hosts_file"`
+}
+
+// GRPCConfig is the configuration for a GRPC-type probe.
+type GRPCConfig struct {
+	// Token is the token used to authenticate against Gitaly or Praefect.
+	Token string `toml:"token"`
+	// StorageName is the name of the storage the probed repository is located in.
+	StorageName string `toml:"storage_name"`
+	// RelativePath is the relative path of the probed repository.
+	RelativePath string `toml:"relative_path"`
+	// Revision is the revision that is looked up via FindCommit. Defaults to "HEAD".
+	Revision string `toml:"revision"`
+}
+
 // Probe is the configuration for a specific endpoint whose clone performance should be exercised.
 type Probe struct {
 	// Name is the name of the probe. This is used both for logging and for exported
@@ -66,8 +109,9 @@ type Probe struct {
 	// Type is the type of the probe. See ProbeType for the supported types. Defaults to `Fetch`
 	// if no type was given.
 	Type ProbeType `toml:"type"`
-	// URL is the URL of the Git repository that should be probed. For now, only the
-	// HTTP transport is supported.
+	// URL is the URL of the Git repository that should be probed. Fetch- and Push-type
+	// probes require an HTTP or HTTPS URL, SSH-type probes require an SSH URL and GRPC-type
+	// probes require the address of the Gitaly or Praefect node, e.g. "tcp://gitaly:8075".
 	URL string `toml:"url"`
 	// User is the user to authenticate as when connecting to the repository.
 	User string `toml:"user"`
@@ -76,6 +120,10 @@ type Probe struct {
 	Password string `toml:"password"`
 	// Push contains the configuration of a Push-type probe.
 	Push *PushConfig `toml:"push"`
+	// SSH contains the configuration of SSH-type probes.
+	SSH *SSHConfig `toml:"ssh"`
+	// GRPC contains the configuration of a GRPC-type probe.
+	GRPC *GRPCConfig `toml:"grpc"`
 }
 
 // ParseConfig parses the provided TOML-formatted configuration string and either returns the
@@ -102,18 +150,23 @@ func ParseConfig(raw string) (Config, error) {
 		return Config{}, fmt.Errorf("must define at least one probe")
 	}
 
-	for _, probe := range config.Probes {
+	for i := range config.Probes {
+		probe := &config.Probes[i]
+
 		if len(probe.Name) == 0 {
 			return Config{}, fmt.Errorf("all probes must have a 'name' attribute")
 		}
 
-		switch probe.Type {
-		case "", Fetch:
+		if probe.Type == "" {
 			probe.Type = Fetch
+		}
+
+		switch probe.Type {
+		case Fetch, SSHFetch:
 			if probe.Push != nil {
 				return Config{}, fmt.Errorf("fetch probe %q cannot have push configuration", probe.Name)
 			}
-		case Push:
+		case Push, SSHPush:
 			if probe.Push == nil {
 				return Config{}, fmt.Errorf("push probe %q must have push configuration", probe.Name)
 			}
@@ -121,19 +174,55 @@ func ParseConfig(raw string) (Config, error) {
 			if len(probe.Push.Commands) == 0 {
 				return Config{}, fmt.Errorf("push probe %q must have at least one command", probe.Name)
 			}
+
+			if probe.Push.ObjectFormat == "" {
+				probe.Push.ObjectFormat = git.ObjectHashSHA1.Format
+			}
+
+			if _, err := git.ObjectHashByFormat(probe.Push.ObjectFormat); err != nil {
+				return Config{}, fmt.Errorf("push probe %q has invalid object format: %w", probe.Name, err)
+			}
+		case GRPC:
+			if probe.GRPC == nil {
+				return Config{}, fmt.Errorf("grpc probe %q must have grpc configuration", probe.Name)
+			}
+
+			if probe.GRPC.StorageName == "" || probe.GRPC.RelativePath == "" {
+				return Config{}, fmt.Errorf("grpc probe %q must have storage name and relative path", probe.Name)
+			}
+
+			if probe.Push != nil {
+				return Config{}, fmt.Errorf("grpc probe %q cannot have push configuration", probe.Name)
+			}
 		default:
 			return Config{}, fmt.Errorf("unsupported probe type: %q", probe.Type)
 		}
 
+		if probe.SSH != nil && probe.Type != SSHFetch && probe.Type != SSHPush {
+			return Config{}, fmt.Errorf("%s probe %q cannot have ssh configuration", probe.Type, probe.Name)
+		}
+		if probe.GRPC != nil && probe.Type != GRPC {
+			return Config{}, fmt.Errorf("%s probe %q cannot have grpc configuration", probe.Type, probe.Name)
+		}
+
 		parsedURL, err := url.Parse(probe.URL)
 		if err != nil {
 			return Config{}, err
 		}
 
-		if s := parsedURL.Scheme; s != "http" && s != "https" {
+		if !isSupportedScheme(parsedURL.Scheme, supportedURLSchemes[probe.Type]) {
 			return Config{}, fmt.Errorf("unsupported probe URL scheme: %v", probe.URL)
 		}
 	}
 
 	return config, nil
 }
+
+func isSupportedScheme(scheme string, supportedSchemes []string) bool {
+	for _, supportedScheme := range supportedSchemes {
+		if scheme == supportedScheme {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package blackbox
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
 	"testing"
 	"time"
@@ -54,6 +55,48 @@ func TestConfigParseFailures(t *testing.T) {
 			in:          "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='http://foo/bar'\ntype='foo'",
 			expectedErr: errors.New("unsupported probe type: \"foo\""),
 		},
+		{
+			desc:        "ssh probe with HTTP url",
+			in:          "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='http://foo/bar'\ntype='ssh_fetch'",
+			expectedErr: errors.New("unsupported probe URL scheme: http://foo/bar"),
+		},
+		{
+			desc:        "fetch probe with ssh configuration",
+			in:          "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='http://foo/bar'\n[probe.ssh]\nidentity_file='/key'",
+			expectedErr: errors.New("fetch probe \"foo\" cannot have ssh configuration"),
+		},
+		{
+			desc:        "grpc probe without grpc configuration",
+			in:          "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='tcp://gitaly:8075'\ntype='grpc'",
+			expectedErr: errors.New("grpc probe \"foo\" must have grpc configuration"),
+		},
+		{
+			desc:        "grpc probe without relative path",
+			in:          "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='tcp://gitaly:8075'\ntype='grpc'\n[probe.grpc]\nstorage_name='default'",
+			expectedErr: errors.New("grpc probe \"foo\" must have storage name and relative path"),
+		},
+		{
+			desc:        "grpc probe with HTTP url",
+			in:          "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='http://foo/bar'\ntype='grpc'\n[probe.grpc]\nstorage_name='default'\nrelative_path='repo.git'",
+			expectedErr: errors.New("unsupported probe URL scheme: http://foo/bar"),
+		},
+		{
+			desc:        "push probe with invalid object format",
+			in:          "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='http://foo/bar'\ntype='push'\n[probe.push]\nobject_format='blake2b'\n[[probe.push.commands]]\nreference='refs/heads/main'",
+			expectedErr: fmt.Errorf("push probe \"foo\" has invalid object format: %w", fmt.Errorf("unknown object format: %q", "blake2b")),
+		},
+		{
+			desc: "valid push configuration with SHA256",
+			in:   "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='http://foo/bar'\ntype='push'\n[probe.push]\nobject_format='sha256'\n[[probe.push.commands]]\nreference='refs/heads/main'",
+		},
+		{
+			desc: "valid ssh configuration",
+			in:   "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='ssh://git@foo/bar.git'\ntype='ssh_fetch'\n[probe.ssh]\nidentity_file='/key'",
+		},
+		{
+			desc: "valid grpc configuration",
+			in:   "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='unix:/gitaly.socket'\ntype='grpc'\n[probe.grpc]\nstorage_name='default'\nrelative_path='repo.git'",
+		},
 		{
 			desc: "valid configuration",
 			in:   "prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='http://foo/bar'\n",
@@ -104,3 +147,9 @@ url = 'http://foo/bar'
 		})
 	}
 }
+
+func TestConfigDefaultProbeType(t *testing.T) {
+	cfg, err := ParseConfig("prometheus_listen_addr = 'foo'\n[[probe]]\nname='foo'\nurl='http://foo/bar'\n")
+	require.NoError(t, err)
+	require.Equal(t, Fetch, cfg.Probes[0].Type)
+}
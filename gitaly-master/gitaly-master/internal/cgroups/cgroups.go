@@ -1,8 +1,13 @@
 package cgroups
 
 import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
@@ -12,6 +17,7 @@ import (
 
 type addCommandCfg struct {
 	cgroupKey string
+	class     string
 }
 
 // AddCommandOption is an option that can be passed to AddCommand.
@@ -25,6 +31,14 @@ func WithCgroupKey(cgroupKey string) AddCommandOption {
 	}
 }
 
+// WithCommandClass puts the command into the cgroups of the given command class. If the class is
+// not configured, then the command is put into the default repository cgroups.
+func WithCommandClass(class string) AddCommandOption {
+	return func(cfg *addCommandCfg) {
+		cfg.class = class
+	}
+}
+
 // Manager supplies an interface for interacting with cgroups
 type Manager interface {
 	// Setup creates cgroups and assigns configured limitations.
@@ -33,6 +47,9 @@ type Manager interface {
 	Setup() error
 	// AddCommand adds a Cmd to a cgroup.
 	AddCommand(*exec.Cmd, ...AddCommandOption) (string, error)
+	// UpdateLimits updates the limits of the cgroups created in Setup. The layout of the
+	// cgroup hierarchy cannot be changed without a restart.
+	UpdateLimits(cgroups.Config) error
 	// Cleanup cleans up cgroups created in Setup.
 	// It is expected to be called once at Gitaly shutdown from any
 	// instance of the Manager.
@@ -41,21 +58,46 @@ type Manager interface {
 	Collect(ch chan<- prometheus.Metric)
 }
 
-// NewManager returns the appropriate Cgroups manager
+// NewManager returns the appropriate Cgroups manager. The cgroups version is detected by
+// inspecting the cgroup filesystem mounted at the configured mountpoint.
 func NewManager(cfg cgroups.Config, pid int) Manager {
 	if cfg.Repositories.Count > 0 {
+		if isUnifiedHierarchy(cfg.Mountpoint) {
+			return newV2Manager(cfg, pid)
+		}
+
 		return newV1Manager(cfg, pid)
 	}
 
 	return &NoopManager{}
 }
 
-// PruneOldCgroups prunes old cgroups for both the memory and cpu subsystems
+// isUnifiedHierarchy determines whether the cgroup filesystem mounted at the given mountpoint is the
+// unified cgroups v2 hierarchy. Only the root of the unified hierarchy contains the
+// cgroup.controllers file, whereas cgroups v1 mounts a separate hierarchy per subsystem.
+func isUnifiedHierarchy(mountpoint string) bool {
+	_, err := os.Stat(filepath.Join(mountpoint, "cgroup.controllers"))
+	return err == nil
+}
+
+// PruneOldCgroups prunes old cgroups for both the memory and cpu subsystems, or of the unified
+// hierarchy if cgroups v2 is in use.
 func PruneOldCgroups(cfg cgroups.Config, logger log.FieldLogger) {
 	if cfg.HierarchyRoot == "" {
 		return
 	}
 
+	if isUnifiedHierarchy(cfg.Mountpoint) {
+		if err := config.PruneOldGitalyProcessDirectories(
+			logger,
+			filepath.Join(cfg.Mountpoint, cfg.HierarchyRoot),
+		); err != nil {
+			logger.WithError(err).Error("failed to clean up cgroups")
+		}
+
+		return
+	}
+
 	if err := config.PruneOldGitalyProcessDirectories(
 		logger,
 		filepath.Join(cfg.Mountpoint, "memory",
@@ -72,3 +114,84 @@ func PruneOldCgroups(cfg cgroups.Config, logger log.FieldLogger) {
 		logger.WithError(err).Error("failed to clean up cpu cgroups")
 	}
 }
+
+// validateLimitsUpdate verifies that the updated configuration only changes limits, but not the
+// layout of the cgroup hierarchy. Changing the layout requires a restart given that the cgroups
+// are set up once at startup.
+func validateLimitsUpdate(current, updated cgroups.Config) error {
+	if current.Mountpoint != updated.Mountpoint ||
+		current.HierarchyRoot != updated.HierarchyRoot ||
+		current.Repositories.Count != updated.Repositories.Count {
+		return errors.New("cgroup hierarchy cannot be changed without a restart")
+	}
+
+	if len(current.Classes) != len(updated.Classes) {
+		return errors.New("cgroup classes cannot be changed without a restart")
+	}
+	for i := range current.Classes {
+		if current.Classes[i].Name != updated.Classes[i].Name {
+			return errors.New("cgroup classes cannot be changed without a restart")
+		}
+	}
+
+	return nil
+}
+
+// commandCgroupPath determines the path of the repository cgroup the command shall be added to,
+// relative to the mountpoint. The cgroup is determined by hashing the cgroup key and is nested in
+// the cgroup of the command's class, if configured.
+func commandCgroupPath(cfg cgroups.Config, processCgroup string, cmd *exec.Cmd, opts []AddCommandOption) string {
+	var cmdCfg addCommandCfg
+	for _, opt := range opts {
+		opt(&cmdCfg)
+	}
+
+	key := cmdCfg.cgroupKey
+	if key == "" {
+		key = strings.Join(cmd.Args, "/")
+	}
+
+	checksum := crc32.ChecksumIEEE(
+		[]byte(key),
+	)
+
+	parent := processCgroup
+	for _, class := range cfg.Classes {
+		if class.Name == cmdCfg.class {
+			parent = classCgroupPath(processCgroup, class.Name)
+			break
+		}
+	}
+
+	groupID := uint(checksum) % cfg.Repositories.Count
+	return repositoryCgroupPath(parent, int(groupID))
+}
+
+// classCgroupPath returns the path of the parent cgroup of the given command class.
+func classCgroupPath(processCgroup, class string) string {
+	return filepath.Join(processCgroup, "class-"+class)
+}
+
+// repositoryCgroupPath returns the path of the repository cgroup with the given ID nested in the
+// given parent cgroup.
+func repositoryCgroupPath(parent string, groupID int) string {
+	return filepath.Join(parent, fmt.Sprintf("repos-%d", groupID))
+}
+
+// repositoryCgroupPaths returns the paths of all repository cgroups, including those nested in
+// the cgroups of command classes.
+func repositoryCgroupPaths(cfg cgroups.Config, processCgroup string) []string {
+	parents := []string{processCgroup}
+	for _, class := range cfg.Classes {
+		parents = append(parents, classCgroupPath(processCgroup, class.Name))
+	}
+
+	paths := make([]string, 0, len(parents)*int(cfg.Repositories.Count))
+	for _, parent := range parents {
+		for i := 0; i < int(cfg.Repositories.Count); i++ {
+			paths = append(paths, repositoryCgroupPath(parent, i))
+		}
+	}
+
+	return paths
+}
@@ -115,3 +115,42 @@ func (m *mockCgroup) setupMockCgroupFiles(
 		}
 	}
 }
+
+type mockCgroupV2 struct {
+	root string
+}
+
+// newMockV2 sets up a fake unified cgroup filesystem. In contrast to the real cgroup filesystem,
+// interface files are not created automatically by the kernel, so tests need to set up the files
+// they want to read via setupMockCgroupFiles.
+func newMockV2(t *testing.T, hierarchyRoot string) *mockCgroupV2 {
+	t.Helper()
+
+	root := testhelper.TempDir(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "cgroup.controllers"), []byte("cpu io memory pids"), perm.SharedFile))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, hierarchyRoot), perm.SharedDir))
+
+	return &mockCgroupV2{
+		root: root,
+	}
+}
+
+func (m *mockCgroupV2) setupMockCgroupFiles(
+	t *testing.T,
+	manager *CGroupV2Manager,
+	memMaxEvents int,
+) {
+	for shard := uint(0); shard < manager.cfg.Repositories.Count; shard++ {
+		shardPath := filepath.Join(m.root, repositoryCgroupPath(manager.currentProcessCgroup(), int(shard)))
+		require.NoError(t, os.MkdirAll(shardPath, perm.SharedDir))
+
+		for filename, content := range map[string]string{
+			"cgroup.procs":  "",
+			"cpu.stat":      "usage_usec 300\nuser_usec 100\nsystem_usec 200\n",
+			"memory.events": fmt.Sprintf("low 0\nhigh 0\nmax %d\noom 0\noom_kill 0\n", memMaxEvents),
+		} {
+			require.NoError(t, os.WriteFile(filepath.Join(shardPath, filename), []byte(content), perm.SharedFile))
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"os/exec"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
 )
 
 // NoopManager is a cgroups manager that does nothing
@@ -19,6 +20,11 @@ func (cg *NoopManager) AddCommand(*exec.Cmd, ...AddCommandOption) (string, error
 	return "", nil
 }
 
+// UpdateLimits does nothing
+func (cg *NoopManager) UpdateLimits(cgroups.Config) error {
+	return nil
+}
+
 //nolint:revive // This is unintentionally missing documentation.
 func (cg *NoopManager) Cleanup() error {
 	return nil
@@ -2,9 +2,7 @@ package cgroups
 
 import (
 	"fmt"
-	"hash/crc32"
 	"os/exec"
-	"path/filepath"
 	"strings"
 
 	"github.com/containerd/cgroups"
@@ -57,15 +55,24 @@ func newV1Manager(cfg cgroupscfg.Config, pid int) *CGroupV1Manager {
 
 //nolint:revive // This is unintentionally missing documentation.
 func (cg *CGroupV1Manager) Setup() error {
-	var parentResources specs.LinuxResources
+	return cg.applyLimits(cg.cfg, false)
+}
 
-	if cg.cfg.CPUShares > 0 {
-		parentResources.CPU = &specs.LinuxCPU{Shares: &cg.cfg.CPUShares}
+// UpdateLimits updates the limits of the cgroups created by Setup. Limits which have been removed
+// are reset to the kernel's defaults.
+func (cg *CGroupV1Manager) UpdateLimits(cfg cgroupscfg.Config) error {
+	if err := validateLimitsUpdate(cg.cfg, cfg); err != nil {
+		return err
 	}
 
-	if cg.cfg.MemoryBytes > 0 {
-		parentResources.Memory = &specs.LinuxMemory{Limit: &cg.cfg.MemoryBytes}
-	}
+	return cg.applyLimits(cfg, true)
+}
+
+// applyLimits creates the cgroups if they don't exist yet and applies the configured limits to
+// them. If reset is set, then unset limits are reset to the kernel's defaults instead of being
+// left untouched.
+func (cg *CGroupV1Manager) applyLimits(cfg cgroupscfg.Config, reset bool) error {
+	parentResources := v1Resources(cfg.MemoryBytes, cfg.CPUShares, reset)
 
 	if _, err := cgroups.New(
 		cg.hierarchy,
@@ -75,20 +82,24 @@ func (cg *CGroupV1Manager) Setup() error {
 		return fmt.Errorf("failed creating parent cgroup: %w", err)
 	}
 
-	var reposResources specs.LinuxResources
+	reposResources := v1Resources(cfg.Repositories.MemoryBytes, cfg.Repositories.CPUShares, reset)
 
-	if cg.cfg.Repositories.CPUShares > 0 {
-		reposResources.CPU = &specs.LinuxCPU{Shares: &cg.cfg.Repositories.CPUShares}
-	}
+	for _, class := range cfg.Classes {
+		classResources := v1Resources(class.MemoryBytes, class.CPUShares, reset)
 
-	if cg.cfg.Repositories.MemoryBytes > 0 {
-		reposResources.Memory = &specs.LinuxMemory{Limit: &cg.cfg.Repositories.MemoryBytes}
+		if _, err := cgroups.New(
+			cg.hierarchy,
+			cgroups.StaticPath(classCgroupPath(cg.currentProcessCgroup(), class.Name)),
+			&classResources,
+		); err != nil {
+			return fmt.Errorf("failed creating class cgroup: %w", err)
+		}
 	}
 
-	for i := 0; i < int(cg.cfg.Repositories.Count); i++ {
+	for _, repoPath := range repositoryCgroupPaths(cfg, cg.currentProcessCgroup()) {
 		if _, err := cgroups.New(
 			cg.hierarchy,
-			cgroups.StaticPath(cg.repoPath(i)),
+			cgroups.StaticPath(repoPath),
 			&reposResources,
 		); err != nil {
 			return fmt.Errorf("failed creating repository cgroup: %w", err)
@@ -98,6 +109,28 @@ func (cg *CGroupV1Manager) Setup() error {
 	return nil
 }
 
+// v1Resources converts the given limits into resources. A limit of 0 means that no limit is
+// applied. If reset is set, then unset limits are set to the kernel's defaults.
+func v1Resources(memoryBytes int64, cpuShares uint64, reset bool) specs.LinuxResources {
+	var resources specs.LinuxResources
+
+	if cpuShares > 0 {
+		resources.CPU = &specs.LinuxCPU{Shares: &cpuShares}
+	} else if reset {
+		defaultShares := uint64(1024)
+		resources.CPU = &specs.LinuxCPU{Shares: &defaultShares}
+	}
+
+	if memoryBytes > 0 {
+		resources.Memory = &specs.LinuxMemory{Limit: &memoryBytes}
+	} else if reset {
+		unlimited := int64(-1)
+		resources.Memory = &specs.LinuxMemory{Limit: &unlimited}
+	}
+
+	return resources
+}
+
 // AddCommand adds the given command to one of the CGroup's buckets. The bucket used for the command
 // is determined by hashing the repository storage and path. No error is returned if the command has already
 // exited.
@@ -105,26 +138,11 @@ func (cg *CGroupV1Manager) AddCommand(
 	cmd *exec.Cmd,
 	opts ...AddCommandOption,
 ) (string, error) {
-	var cfg addCommandCfg
-	for _, opt := range opts {
-		opt(&cfg)
-	}
-
-	key := cfg.cgroupKey
-	if key == "" {
-		key = strings.Join(cmd.Args, "/")
-	}
-
-	checksum := crc32.ChecksumIEEE(
-		[]byte(key),
-	)
-
 	if cmd.Process == nil {
 		return "", fmt.Errorf("cannot add command that has not yet been started")
 	}
 
-	groupID := uint(checksum) % cg.cfg.Repositories.Count
-	cgroupPath := cg.repoPath(int(groupID))
+	cgroupPath := commandCgroupPath(cg.cfg, cg.currentProcessCgroup(), cmd, opts)
 
 	return cgroupPath, cg.addToCgroup(cmd.Process.Pid, cgroupPath)
 }
@@ -153,8 +171,7 @@ func (cg *CGroupV1Manager) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 
-	for i := 0; i < int(cg.cfg.Repositories.Count); i++ {
-		repoPath := cg.repoPath(i)
+	for _, repoPath := range repositoryCgroupPaths(cg.cfg, cg.currentProcessCgroup()) {
 		logger := log.Default().WithField("cgroup_path", repoPath)
 		control, err := cgroups.Load(
 			cg.hierarchy,
@@ -222,10 +239,6 @@ func (cg *CGroupV1Manager) Cleanup() error {
 	return nil
 }
 
-func (cg *CGroupV1Manager) repoPath(groupID int) string {
-	return filepath.Join(cg.currentProcessCgroup(), fmt.Sprintf("repos-%d", groupID))
-}
-
 func (cg *CGroupV1Manager) currentProcessCgroup() string {
 	return config.GetGitalyProcessTempDir(cg.cfg.HierarchyRoot, cg.pid)
 }
@@ -2,6 +2,7 @@ package cgroups
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"hash/crc32"
 	"os"
@@ -58,6 +59,44 @@ func TestSetup(t *testing.T) {
 	}
 }
 
+func TestSetup_classes(t *testing.T) {
+	mock := newMock(t)
+
+	cfg := defaultCgroupsConfig()
+	cfg.Classes = []cgroups.Class{
+		{Name: cgroups.ClassPackObjects, MemoryBytes: 4096000, CPUShares: 512},
+	}
+
+	pid := 1
+	v1Manager := &CGroupV1Manager{
+		cfg:       cfg,
+		hierarchy: mock.hierarchy,
+		pid:       pid,
+	}
+	require.NoError(t, v1Manager.Setup())
+
+	classPath := filepath.Join("gitaly", fmt.Sprintf("gitaly-%d", pid), "class-pack-objects")
+	require.Equal(t, "4096000", string(readCgroupFile(t, filepath.Join(mock.root, "memory", classPath, "memory.limit_in_bytes"))))
+	require.Equal(t, "512", string(readCgroupFile(t, filepath.Join(mock.root, "cpu", classPath, "cpu.shares"))))
+
+	for i := 0; i < 3; i++ {
+		repoPath := filepath.Join(classPath, fmt.Sprintf("repos-%d", i))
+		require.Equal(t, "1024000", string(readCgroupFile(t, filepath.Join(mock.root, "memory", repoPath, "memory.limit_in_bytes"))))
+		require.Equal(t, "256", string(readCgroupFile(t, filepath.Join(mock.root, "cpu", repoPath, "cpu.shares"))))
+	}
+
+	ctx := testhelper.Context(t)
+
+	cmd := exec.CommandContext(ctx, "ls", "-hal", ".")
+	require.NoError(t, cmd.Run())
+
+	cgroupPath, err := v1Manager.AddCommand(cmd, WithCgroupKey("foobar"), WithCommandClass(cgroups.ClassPackObjects))
+	require.NoError(t, err)
+
+	groupID := crc32.ChecksumIEEE([]byte("foobar")) % 3
+	require.Equal(t, filepath.Join(classPath, fmt.Sprintf("repos-%d", groupID)), cgroupPath)
+}
+
 func TestAddCommand(t *testing.T) {
 	mock := newMock(t)
 
@@ -123,6 +162,36 @@ func TestAddCommand(t *testing.T) {
 	})
 }
 
+func TestUpdateLimits(t *testing.T) {
+	mock := newMock(t)
+
+	pid := 1
+	v1Manager := &CGroupV1Manager{
+		cfg:       defaultCgroupsConfig(),
+		hierarchy: mock.hierarchy,
+		pid:       pid,
+	}
+	require.NoError(t, v1Manager.Setup())
+
+	updatedCfg := defaultCgroupsConfig()
+	updatedCfg.Repositories.MemoryBytes = 2048000
+	updatedCfg.Repositories.CPUShares = 0
+	require.NoError(t, v1Manager.UpdateLimits(updatedCfg))
+
+	for i := 0; i < 3; i++ {
+		repoPath := filepath.Join("gitaly", fmt.Sprintf("gitaly-%d", pid), fmt.Sprintf("repos-%d", i))
+		require.Equal(t, "2048000", string(readCgroupFile(t, filepath.Join(mock.root, "memory", repoPath, "memory.limit_in_bytes"))))
+		require.Equal(t, "1024", string(readCgroupFile(t, filepath.Join(mock.root, "cpu", repoPath, "cpu.shares"))))
+	}
+
+	updatedCfg.Repositories.Count = 5
+	require.Equal(t, errors.New("cgroup hierarchy cannot be changed without a restart"), v1Manager.UpdateLimits(updatedCfg))
+
+	updatedCfg = defaultCgroupsConfig()
+	updatedCfg.Classes = []cgroups.Class{{Name: cgroups.ClassHousekeeping}}
+	require.Equal(t, errors.New("cgroup classes cannot be changed without a restart"), v1Manager.UpdateLimits(updatedCfg))
+}
+
 func TestCleanup(t *testing.T) {
 	mock := newMock(t)
 
@@ -0,0 +1,12 @@
+//go:build !linux
+
+package cgroups
+
+import (
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
+)
+
+// For systems other than Linux, we return a noop manager if cgroups was enabled.
+func newV2Manager(cfg cgroups.Config, pid int) *NoopManager {
+	return &NoopManager{}
+}
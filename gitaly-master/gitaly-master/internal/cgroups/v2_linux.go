@@ -0,0 +1,438 @@
+package cgroups
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	cgroupscfg "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/log"
+)
+
+// CGroupV2Manager is the manager for cgroups v2. In contrast to cgroups v1, there is only a single
+// unified hierarchy where all controllers are attached to, so every cgroup is represented by a
+// single directory.
+type CGroupV2Manager struct {
+	cfg                                  cgroupscfg.Config
+	memoryReclaimAttemptsTotal, cpuUsage *prometheus.GaugeVec
+	procs                                *prometheus.GaugeVec
+	pid                                  int
+}
+
+func newV2Manager(cfg cgroupscfg.Config, pid int) *CGroupV2Manager {
+	return &CGroupV2Manager{
+		cfg: cfg,
+		pid: pid,
+		memoryReclaimAttemptsTotal: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "gitaly_cgroup_memory_reclaim_attempts_total",
+				Help: "Number of memory usage hits limits",
+			},
+			[]string{"path"},
+		),
+		cpuUsage: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "gitaly_cgroup_cpu_usage_total",
+				Help: "CPU Usage of Cgroup",
+			},
+			[]string{"path", "type"},
+		),
+		procs: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "gitaly_cgroup_procs_total",
+				Help: "Total number of procs",
+			},
+			[]string{"path", "subsystem"},
+		),
+	}
+}
+
+// v2Resources are the limits applied to a single cgroup.
+type v2Resources struct {
+	memoryBytes int64
+	cpuShares   uint64
+	pidsLimit   int64
+	ioWeight    uint64
+	// reset causes unset limits to be reset to the kernel's defaults. Limits are only reset
+	// if the interface file exists, that is if the respective controller is enabled.
+	reset bool
+}
+
+// controllers returns the controllers required to apply the limits.
+func (r v2Resources) controllers() []string {
+	var controllers []string
+	if r.cpuShares > 0 {
+		controllers = append(controllers, "cpu")
+	}
+	if r.ioWeight > 0 {
+		controllers = append(controllers, "io")
+	}
+	if r.memoryBytes > 0 {
+		controllers = append(controllers, "memory")
+	}
+	if r.pidsLimit > 0 {
+		controllers = append(controllers, "pids")
+	}
+	return controllers
+}
+
+// files returns the contents of the interface files that need to be written to apply the limits.
+func (r v2Resources) files() map[string]string {
+	files := map[string]string{}
+	if r.cpuShares > 0 {
+		files["cpu.weight"] = strconv.FormatUint(cpuSharesToWeight(r.cpuShares), 10)
+	}
+	if r.ioWeight > 0 {
+		files["io.weight"] = fmt.Sprintf("default %d", r.ioWeight)
+	}
+	if r.memoryBytes > 0 {
+		files["memory.max"] = strconv.FormatInt(r.memoryBytes, 10)
+	}
+	if r.pidsLimit > 0 {
+		files["pids.max"] = strconv.FormatInt(r.pidsLimit, 10)
+	}
+	return files
+}
+
+// defaultFiles returns the contents of the interface files that need to be written to reset the
+// limits which are not set to the kernel's defaults.
+func (r v2Resources) defaultFiles() map[string]string {
+	files := map[string]string{}
+	if r.cpuShares == 0 {
+		files["cpu.weight"] = "100"
+	}
+	if r.ioWeight == 0 {
+		files["io.weight"] = "default 100"
+	}
+	if r.memoryBytes <= 0 {
+		files["memory.max"] = "max"
+	}
+	if r.pidsLimit <= 0 {
+		files["pids.max"] = "max"
+	}
+	return files
+}
+
+// cpuSharesToWeight converts CPU shares as used by cgroups v1, which range from 2 to 262144, to a
+// CPU weight as used by cgroups v2, which ranges from 1 to 10000. This is the same conversion as
+// used by runc so that the configuration behaves the same with both versions.
+func cpuSharesToWeight(shares uint64) uint64 {
+	if shares < 2 {
+		shares = 2
+	} else if shares > 262144 {
+		shares = 262144
+	}
+
+	return 1 + ((shares-2)*9999)/262142
+}
+
+//nolint:revive // This is unintentionally missing documentation.
+func (cg *CGroupV2Manager) Setup() error {
+	return cg.applyLimits(cg.cfg, false)
+}
+
+// UpdateLimits updates the limits of the cgroups created by Setup. Limits which have been removed
+// are reset to the kernel's defaults.
+func (cg *CGroupV2Manager) UpdateLimits(cfg cgroupscfg.Config) error {
+	if err := validateLimitsUpdate(cg.cfg, cfg); err != nil {
+		return err
+	}
+
+	return cg.applyLimits(cfg, true)
+}
+
+// applyLimits creates the cgroups if they don't exist yet and applies the configured limits to
+// them. If reset is set, then unset limits are reset to the kernel's defaults instead of being
+// left untouched.
+func (cg *CGroupV2Manager) applyLimits(cfg cgroupscfg.Config, reset bool) error {
+	parentResources := v2Resources{
+		memoryBytes: cfg.MemoryBytes,
+		cpuShares:   cfg.CPUShares,
+		pidsLimit:   cfg.PidsLimit,
+		ioWeight:    cfg.IOWeight,
+		reset:       reset,
+	}
+
+	reposResources := v2Resources{
+		memoryBytes: cfg.Repositories.MemoryBytes,
+		cpuShares:   cfg.Repositories.CPUShares,
+		pidsLimit:   cfg.Repositories.PidsLimit,
+		ioWeight:    cfg.Repositories.IOWeight,
+		reset:       reset,
+	}
+
+	classResources := make(map[string]v2Resources, len(cfg.Classes))
+	var classControllers []string
+	for _, class := range cfg.Classes {
+		resources := v2Resources{
+			memoryBytes: class.MemoryBytes,
+			cpuShares:   class.CPUShares,
+			pidsLimit:   class.PidsLimit,
+			ioWeight:    class.IOWeight,
+			reset:       reset,
+		}
+
+		classResources[class.Name] = resources
+		classControllers = append(classControllers, resources.controllers()...)
+	}
+
+	// The hierarchy root may not exist yet, e.g. when Gitaly is started for the first time
+	// after booting the machine.
+	if err := os.MkdirAll(cg.absolutePath(cg.cfg.HierarchyRoot), perm.SharedDir); err != nil {
+		return fmt.Errorf("creating hierarchy root: %w", err)
+	}
+
+	// Limits can only be configured for a cgroup when the respective controllers have been
+	// enabled in its parent. Every cgroup must thus enable all controllers required by the
+	// cgroups nested in it.
+	if err := cg.enableControllers(
+		cg.cfg.HierarchyRoot,
+		append(append(parentResources.controllers(), classControllers...), reposResources.controllers()...),
+	); err != nil {
+		return fmt.Errorf("failed enabling controllers of hierarchy root: %w", err)
+	}
+
+	if err := cg.createCgroup(cg.currentProcessCgroup(), parentResources); err != nil {
+		return fmt.Errorf("failed creating parent cgroup: %w", err)
+	}
+
+	if err := cg.enableControllers(
+		cg.currentProcessCgroup(),
+		append(classControllers, reposResources.controllers()...),
+	); err != nil {
+		return fmt.Errorf("failed enabling controllers of parent cgroup: %w", err)
+	}
+
+	for _, class := range cfg.Classes {
+		classPath := classCgroupPath(cg.currentProcessCgroup(), class.Name)
+
+		if err := cg.createCgroup(classPath, classResources[class.Name]); err != nil {
+			return fmt.Errorf("failed creating class cgroup: %w", err)
+		}
+
+		if err := cg.enableControllers(classPath, reposResources.controllers()); err != nil {
+			return fmt.Errorf("failed enabling controllers of class cgroup: %w", err)
+		}
+	}
+
+	for _, repoPath := range repositoryCgroupPaths(cfg, cg.currentProcessCgroup()) {
+		if err := cg.createCgroup(repoPath, reposResources); err != nil {
+			return fmt.Errorf("failed creating repository cgroup: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (cg *CGroupV2Manager) createCgroup(cgroupPath string, resources v2Resources) error {
+	path := cg.absolutePath(cgroupPath)
+
+	if err := os.MkdirAll(path, perm.SharedDir); err != nil {
+		return fmt.Errorf("creating cgroup directory: %w", err)
+	}
+
+	for filename, content := range resources.files() {
+		if err := os.WriteFile(filepath.Join(path, filename), []byte(content), perm.SharedFile); err != nil {
+			return fmt.Errorf("writing %s: %w", filename, err)
+		}
+	}
+
+	if resources.reset {
+		for filename, content := range resources.defaultFiles() {
+			if _, err := os.Stat(filepath.Join(path, filename)); err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					continue
+				}
+				return fmt.Errorf("statting %s: %w", filename, err)
+			}
+
+			if err := os.WriteFile(filepath.Join(path, filename), []byte(content), perm.SharedFile); err != nil {
+				return fmt.Errorf("resetting %s: %w", filename, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (cg *CGroupV2Manager) enableControllers(cgroupPath string, controllers []string) error {
+	seen := make(map[string]bool, len(controllers))
+
+	var toggles []string
+	for _, controller := range controllers {
+		if seen[controller] {
+			continue
+		}
+		seen[controller] = true
+
+		toggles = append(toggles, "+"+controller)
+	}
+
+	if len(toggles) == 0 {
+		return nil
+	}
+
+	path := filepath.Join(cg.absolutePath(cgroupPath), "cgroup.subtree_control")
+	if err := os.WriteFile(path, []byte(strings.Join(toggles, " ")), perm.SharedFile); err != nil {
+		return fmt.Errorf("writing cgroup.subtree_control: %w", err)
+	}
+
+	return nil
+}
+
+// AddCommand adds the given command to one of the CGroup's buckets. The bucket used for the command
+// is determined by hashing the repository storage and path. No error is returned if the command has already
+// exited.
+func (cg *CGroupV2Manager) AddCommand(
+	cmd *exec.Cmd,
+	opts ...AddCommandOption,
+) (string, error) {
+	if cmd.Process == nil {
+		return "", fmt.Errorf("cannot add command that has not yet been started")
+	}
+
+	cgroupPath := commandCgroupPath(cg.cfg, cg.currentProcessCgroup(), cmd, opts)
+
+	return cgroupPath, cg.addToCgroup(cmd.Process.Pid, cgroupPath)
+}
+
+func (cg *CGroupV2Manager) addToCgroup(pid int, cgroupPath string) error {
+	path := filepath.Join(cg.absolutePath(cgroupPath), "cgroup.procs")
+
+	if err := os.WriteFile(path, []byte(strconv.Itoa(pid)), perm.SharedFile); err != nil {
+		// Command could finish so quickly before we can add it to a cgroup, so
+		// we don't consider it an error.
+		if errors.Is(err, syscall.ESRCH) {
+			return nil
+		}
+		return fmt.Errorf("failed adding process to cgroup: %w", err)
+	}
+
+	return nil
+}
+
+// Collect collects metrics from the cgroups controller
+func (cg *CGroupV2Manager) Collect(ch chan<- prometheus.Metric) {
+	if !cg.cfg.MetricsEnabled {
+		return
+	}
+
+	for _, repoPath := range repositoryCgroupPaths(cg.cfg, cg.currentProcessCgroup()) {
+		path := cg.absolutePath(repoPath)
+		logger := log.Default().WithField("cgroup_path", repoPath)
+
+		// The memory.events file is only present when the memory controller is enabled.
+		if events, err := readFlatKeyedFile(filepath.Join(path, "memory.events")); err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				logger.WithError(err).Warn("unable to get memory events")
+			}
+		} else {
+			memoryMetric := cg.memoryReclaimAttemptsTotal.WithLabelValues(repoPath)
+			memoryMetric.Set(float64(events["max"]))
+			ch <- memoryMetric
+		}
+
+		if stat, err := readFlatKeyedFile(filepath.Join(path, "cpu.stat")); err != nil {
+			logger.WithError(err).Warn("unable to get cpu stats")
+		} else {
+			// cgroups v2 reports the CPU usage in microseconds, whereas the metric has
+			// been reporting nanoseconds with cgroups v1.
+			cpuUserMetric := cg.cpuUsage.WithLabelValues(repoPath, "user")
+			cpuUserMetric.Set(float64(stat["user_usec"] * 1000))
+			ch <- cpuUserMetric
+
+			cpuKernelMetric := cg.cpuUsage.WithLabelValues(repoPath, "kernel")
+			cpuKernelMetric.Set(float64(stat["system_usec"] * 1000))
+			ch <- cpuKernelMetric
+		}
+
+		if processes, err := readCgroupProcs(filepath.Join(path, "cgroup.procs")); err != nil {
+			logger.WithError(err).Warn("unable to get process list")
+		} else {
+			// There are no separate hierarchies per subsystem with cgroups v2, so
+			// all processes are reported for the unified hierarchy.
+			procsMetric := cg.procs.WithLabelValues(repoPath, "unified")
+			procsMetric.Set(float64(processes))
+			ch <- procsMetric
+		}
+	}
+}
+
+// Describe describes the cgroup metrics that Collect provides
+func (cg *CGroupV2Manager) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(cg, ch)
+}
+
+//nolint:revive // This is unintentionally missing documentation.
+func (cg *CGroupV2Manager) Cleanup() error {
+	processCgroupPath := cg.currentProcessCgroup()
+
+	// The kernel refuses to unlink the interface files of a cgroup, but removes them when
+	// the cgroup directory itself is removed. os.RemoveAll handles this gracefully as it
+	// only returns an error when the directory itself could not be removed.
+	if err := os.RemoveAll(cg.absolutePath(processCgroupPath)); err != nil {
+		return fmt.Errorf("failed cleaning up cgroup %s: %w", processCgroupPath, err)
+	}
+
+	return nil
+}
+
+func (cg *CGroupV2Manager) currentProcessCgroup() string {
+	return config.GetGitalyProcessTempDir(cg.cfg.HierarchyRoot, cg.pid)
+}
+
+func (cg *CGroupV2Manager) absolutePath(cgroupPath string) string {
+	return filepath.Join(cg.cfg.Mountpoint, cgroupPath)
+}
+
+// readFlatKeyedFile reads a cgroup interface file which consists of lines of space-separated
+// keys and values, like for example cpu.stat or memory.events.
+func readFlatKeyedFile(path string) (map[string]uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	values := map[string]uint64{}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid line in %s: %q", filepath.Base(path), scanner.Text())
+		}
+
+		parsedValue, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing value of %q in %s: %w", key, filepath.Base(path), err)
+		}
+
+		values[key] = parsedValue
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", filepath.Base(path), err)
+	}
+
+	return values, nil
+}
+
+// readCgroupProcs returns the number of processes listed in the given cgroup.procs file.
+func readCgroupProcs(path string) (int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(strings.Fields(string(content))), nil
+}
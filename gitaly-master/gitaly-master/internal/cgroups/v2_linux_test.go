@@ -0,0 +1,292 @@
+package cgroups
+
+import (
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+)
+
+func defaultCgroupsV2Config(mountpoint string) cgroups.Config {
+	cfg := defaultCgroupsConfig()
+	cfg.Mountpoint = mountpoint
+	return cfg
+}
+
+func TestNewManager_unifiedHierarchy(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockV2(t, "gitaly")
+
+	require.IsType(t, &CGroupV2Manager{}, NewManager(defaultCgroupsV2Config(mock.root), 1))
+	require.IsType(t, &CGroupV1Manager{}, NewManager(defaultCgroupsV2Config(testhelper.TempDir(t)), 1))
+	require.IsType(t, &NoopManager{}, NewManager(cgroups.Config{Mountpoint: mock.root}, 1))
+}
+
+func TestCpuSharesToWeight(t *testing.T) {
+	t.Parallel()
+
+	for shares, expectedWeight := range map[uint64]uint64{
+		1:       1,
+		2:       1,
+		256:     10,
+		1024:    39,
+		262144:  10000,
+		1048576: 10000,
+	} {
+		require.Equal(t, expectedWeight, cpuSharesToWeight(shares), "shares: %d", shares)
+	}
+}
+
+func TestSetupV2(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockV2(t, "gitaly")
+
+	cfg := defaultCgroupsV2Config(mock.root)
+	cfg.MemoryBytes = 2048000
+	cfg.Repositories.PidsLimit = 100
+	cfg.Repositories.IOWeight = 50
+
+	pid := 1
+	v2Manager := newV2Manager(cfg, pid)
+	require.NoError(t, v2Manager.Setup())
+
+	require.Equal(t, "+memory +cpu +io +pids",
+		string(readCgroupFile(t, filepath.Join(mock.root, "gitaly", "cgroup.subtree_control"))))
+
+	processCgroupPath := filepath.Join(mock.root, "gitaly", fmt.Sprintf("gitaly-%d", pid))
+	require.Equal(t, "2048000", string(readCgroupFile(t, filepath.Join(processCgroupPath, "memory.max"))))
+	require.NoFileExists(t, filepath.Join(processCgroupPath, "cpu.weight"))
+	require.Equal(t, "+cpu +io +memory +pids",
+		string(readCgroupFile(t, filepath.Join(processCgroupPath, "cgroup.subtree_control"))))
+
+	for i := 0; i < 3; i++ {
+		repoCgroupPath := filepath.Join(processCgroupPath, fmt.Sprintf("repos-%d", i))
+
+		require.Equal(t, "1024000", string(readCgroupFile(t, filepath.Join(repoCgroupPath, "memory.max"))))
+		require.Equal(t, "10", string(readCgroupFile(t, filepath.Join(repoCgroupPath, "cpu.weight"))))
+		require.Equal(t, "100", string(readCgroupFile(t, filepath.Join(repoCgroupPath, "pids.max"))))
+		require.Equal(t, "default 50", string(readCgroupFile(t, filepath.Join(repoCgroupPath, "io.weight"))))
+	}
+}
+
+func TestSetupV2_missingHierarchyRoot(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockV2(t, "gitaly")
+	require.NoError(t, os.RemoveAll(filepath.Join(mock.root, "gitaly")))
+
+	cfg := defaultCgroupsV2Config(mock.root)
+	cfg.MemoryBytes = 2048000
+
+	pid := 1
+	v2Manager := newV2Manager(cfg, pid)
+	require.NoError(t, v2Manager.Setup())
+
+	require.Equal(t, "+memory +cpu",
+		string(readCgroupFile(t, filepath.Join(mock.root, "gitaly", "cgroup.subtree_control"))))
+	require.Equal(t, "2048000",
+		string(readCgroupFile(t, filepath.Join(mock.root, "gitaly", fmt.Sprintf("gitaly-%d", pid), "memory.max"))))
+}
+
+func TestSetupV2_classes(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockV2(t, "gitaly")
+
+	cfg := defaultCgroupsV2Config(mock.root)
+	cfg.Repositories.Count = 2
+	cfg.Repositories.CPUShares = 0
+	cfg.Classes = []cgroups.Class{
+		{Name: cgroups.ClassHousekeeping, CPUShares: 1024, PidsLimit: 50},
+	}
+
+	pid := 1
+	v2Manager := newV2Manager(cfg, pid)
+	require.NoError(t, v2Manager.Setup())
+
+	processCgroupPath := filepath.Join(mock.root, "gitaly", fmt.Sprintf("gitaly-%d", pid))
+	classCgroupPath := filepath.Join(processCgroupPath, "class-housekeeping")
+
+	require.Equal(t, "+cpu +pids +memory",
+		string(readCgroupFile(t, filepath.Join(mock.root, "gitaly", "cgroup.subtree_control"))))
+	require.Equal(t, "+cpu +pids +memory",
+		string(readCgroupFile(t, filepath.Join(processCgroupPath, "cgroup.subtree_control"))))
+	require.Equal(t, "+memory", string(readCgroupFile(t, filepath.Join(classCgroupPath, "cgroup.subtree_control"))))
+
+	require.Equal(t, "39", string(readCgroupFile(t, filepath.Join(classCgroupPath, "cpu.weight"))))
+	require.Equal(t, "50", string(readCgroupFile(t, filepath.Join(classCgroupPath, "pids.max"))))
+	require.NoFileExists(t, filepath.Join(classCgroupPath, "memory.max"))
+
+	for _, parent := range []string{processCgroupPath, classCgroupPath} {
+		for i := 0; i < 2; i++ {
+			repoCgroupPath := filepath.Join(parent, fmt.Sprintf("repos-%d", i))
+			require.Equal(t, "1024000", string(readCgroupFile(t, filepath.Join(repoCgroupPath, "memory.max"))))
+		}
+	}
+
+	ctx := testhelper.Context(t)
+
+	cmd := exec.CommandContext(ctx, "ls", "-hal", ".")
+	require.NoError(t, cmd.Run())
+
+	cgroupPath, err := v2Manager.AddCommand(cmd, WithCgroupKey("foobar"), WithCommandClass(cgroups.ClassHousekeeping))
+	require.NoError(t, err)
+
+	groupID := crc32.ChecksumIEEE([]byte("foobar")) % 2
+	require.Equal(t, filepath.Join("gitaly", fmt.Sprintf("gitaly-%d", pid), "class-housekeeping", fmt.Sprintf("repos-%d", groupID)), cgroupPath)
+}
+
+func TestAddCommandV2(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockV2(t, "gitaly")
+
+	cfg := defaultCgroupsV2Config(mock.root)
+	cfg.Repositories.Count = 10
+
+	pid := 1
+	v2Manager := newV2Manager(cfg, pid)
+	require.NoError(t, v2Manager.Setup())
+
+	ctx := testhelper.Context(t)
+
+	cmd := exec.CommandContext(ctx, "ls", "-hal", ".")
+	require.NoError(t, cmd.Run())
+
+	for _, tc := range []struct {
+		desc string
+		opts []AddCommandOption
+		key  string
+	}{
+		{
+			desc: "without overridden key",
+			key:  strings.Join(cmd.Args, "/"),
+		},
+		{
+			desc: "with overridden key",
+			opts: []AddCommandOption{WithCgroupKey("foobar")},
+			key:  "foobar",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cgroupPath, err := v2Manager.AddCommand(cmd, tc.opts...)
+			require.NoError(t, err)
+
+			groupID := uint(crc32.ChecksumIEEE([]byte(tc.key))) % cfg.Repositories.Count
+			expectedPath := filepath.Join("gitaly", fmt.Sprintf("gitaly-%d", pid), fmt.Sprintf("repos-%d", groupID))
+			require.Equal(t, expectedPath, cgroupPath)
+
+			content := readCgroupFile(t, filepath.Join(mock.root, expectedPath, "cgroup.procs"))
+			cmdPid, err := strconv.Atoi(string(content))
+			require.NoError(t, err)
+			require.Equal(t, cmd.Process.Pid, cmdPid)
+		})
+	}
+}
+
+func TestUpdateLimitsV2(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockV2(t, "gitaly")
+
+	cfg := defaultCgroupsV2Config(mock.root)
+	cfg.Repositories.PidsLimit = 100
+
+	pid := 1
+	v2Manager := newV2Manager(cfg, pid)
+	require.NoError(t, v2Manager.Setup())
+
+	updatedCfg := defaultCgroupsV2Config(mock.root)
+	updatedCfg.Repositories.MemoryBytes = 2048000
+	updatedCfg.Repositories.CPUShares = 1024
+	require.NoError(t, v2Manager.UpdateLimits(updatedCfg))
+
+	processCgroupPath := filepath.Join(mock.root, "gitaly", fmt.Sprintf("gitaly-%d", pid))
+	for i := 0; i < 3; i++ {
+		repoCgroupPath := filepath.Join(processCgroupPath, fmt.Sprintf("repos-%d", i))
+
+		require.Equal(t, "2048000", string(readCgroupFile(t, filepath.Join(repoCgroupPath, "memory.max"))))
+		require.Equal(t, "39", string(readCgroupFile(t, filepath.Join(repoCgroupPath, "cpu.weight"))))
+		// The pids limit has been removed, so it is reset to the default.
+		require.Equal(t, "max", string(readCgroupFile(t, filepath.Join(repoCgroupPath, "pids.max"))))
+		// The io controller has never been used, so its interface file is not written.
+		require.NoFileExists(t, filepath.Join(repoCgroupPath, "io.weight"))
+	}
+
+	updatedCfg.HierarchyRoot = "other"
+	require.EqualError(t, v2Manager.UpdateLimits(updatedCfg), "cgroup hierarchy cannot be changed without a restart")
+}
+
+func TestCleanupV2(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockV2(t, "gitaly")
+
+	pid := 1
+	v2Manager := newV2Manager(defaultCgroupsV2Config(mock.root), pid)
+	require.NoError(t, v2Manager.Setup())
+	require.NoError(t, v2Manager.Cleanup())
+
+	require.NoDirExists(t, filepath.Join(mock.root, "gitaly", fmt.Sprintf("gitaly-%d", pid)))
+	require.DirExists(t, filepath.Join(mock.root, "gitaly"))
+}
+
+func TestMetricsV2(t *testing.T) {
+	t.Parallel()
+
+	mock := newMockV2(t, "gitaly")
+
+	cfg := defaultCgroupsV2Config(mock.root)
+	cfg.Repositories.Count = 1
+
+	v2Manager := newV2Manager(cfg, 1)
+	require.NoError(t, v2Manager.Setup())
+	mock.setupMockCgroupFiles(t, v2Manager, 2)
+
+	ctx := testhelper.Context(t)
+
+	cmd := exec.CommandContext(ctx, "ls", "-hal", ".")
+	require.NoError(t, cmd.Start())
+	_, err := v2Manager.AddCommand(cmd)
+	require.NoError(t, err)
+	require.NoError(t, cmd.Wait())
+
+	repoCgroupPath := filepath.Join(v2Manager.currentProcessCgroup(), "repos-0")
+
+	expected := bytes.NewBufferString(fmt.Sprintf(`# HELP gitaly_cgroup_cpu_usage_total CPU Usage of Cgroup
+# TYPE gitaly_cgroup_cpu_usage_total gauge
+gitaly_cgroup_cpu_usage_total{path="%s",type="kernel"} 200000
+gitaly_cgroup_cpu_usage_total{path="%s",type="user"} 100000
+# HELP gitaly_cgroup_memory_reclaim_attempts_total Number of memory usage hits limits
+# TYPE gitaly_cgroup_memory_reclaim_attempts_total gauge
+gitaly_cgroup_memory_reclaim_attempts_total{path="%s"} 2
+# HELP gitaly_cgroup_procs_total Total number of procs
+# TYPE gitaly_cgroup_procs_total gauge
+gitaly_cgroup_procs_total{path="%s",subsystem="unified"} 1
+`, repoCgroupPath, repoCgroupPath, repoCgroupPath, repoCgroupPath))
+
+	for _, metricsEnabled := range []bool{true, false} {
+		t.Run(fmt.Sprintf("metrics enabled: %v", metricsEnabled), func(t *testing.T) {
+			v2Manager.cfg.MetricsEnabled = metricsEnabled
+
+			if metricsEnabled {
+				assert.NoError(t, testutil.CollectAndCompare(v2Manager, expected))
+			} else {
+				assert.NoError(t, testutil.CollectAndCompare(v2Manager, bytes.NewBufferString("")))
+			}
+		})
+	}
+}
@@ -23,6 +23,9 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/tracing"
 	labkittracing "gitlab.com/gitlab-org/labkit/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -144,6 +147,10 @@ type Command struct {
 	finalizer func(*Command)
 
 	span opentracing.Span
+	// otelSpan is the OpenTelemetry span of the command. Its trace context is passed to the
+	// command via the environment so that spans of the subprocess, e.g. gitaly-hooks, join the
+	// trace.
+	otelSpan trace.Span
 
 	metricsCmd    string
 	metricsSubCmd string
@@ -154,7 +161,7 @@ type Command struct {
 // New creates a Command from the given executable name and arguments On success, the Command
 // contains a running subprocess. When ctx is canceled the embedded process will be terminated and
 // reaped automatically.
-func New(ctx context.Context, nameAndArgs []string, opts ...Option) (*Command, error) {
+func New(ctx context.Context, nameAndArgs []string, opts ...Option) (_ *Command, returnedErr error) {
 	if ctx.Done() == nil {
 		panic("command spawned with context without Done() channel")
 	}
@@ -208,6 +215,21 @@ func New(ctx context.Context, nameAndArgs []string, opts ...Option) (*Command, e
 			"args": strings.Join(nameAndArgs[1:], " "),
 		},
 	)
+	ctx, otelSpan := tracing.StartChildSpan(ctx, spanName,
+		attribute.String("command.path", nameAndArgs[0]),
+		attribute.String("command.name", cfg.commandName),
+		attribute.String("command.subcommand", cfg.subcommandName),
+	)
+	// The span is ended when the command is reaped, which never happens when we fail to spawn
+	// it. We thus need to end it ourselves in that case.
+	defer func() {
+		if returnedErr != nil {
+			otelSpan.RecordError(returnedErr)
+			otelSpan.SetStatus(codes.Error, returnedErr.Error())
+			otelSpan.End()
+		}
+	}()
+
 	cmd := exec.Command(nameAndArgs[0], nameAndArgs[1:]...)
 
 	command := &Command{
@@ -215,6 +237,7 @@ func New(ctx context.Context, nameAndArgs []string, opts ...Option) (*Command, e
 		startTime:       time.Now(),
 		context:         ctx,
 		span:            span,
+		otelSpan:        otelSpan,
 		finalizer:       cfg.finalizer,
 		metricsCmd:      cfg.commandName,
 		metricsSubCmd:   cfg.subcommandName,
@@ -230,6 +253,7 @@ func New(ctx context.Context, nameAndArgs []string, opts ...Option) (*Command, e
 	cmd.Env = append(cmd.Env, cfg.environment...)
 	// And finally inject environment variables required for tracing into the command.
 	cmd.Env = envInjector(ctx, cmd.Env)
+	cmd.Env = tracing.InjectIntoEnv(ctx, cmd.Env)
 
 	// Start the command in its own process group (nice for signalling)
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
@@ -496,6 +520,20 @@ func (c *Command) logProcessComplete() {
 		c.span.SetTag("cgroup_path", c.cgroupPath)
 	}
 	c.span.Finish()
+
+	c.otelSpan.SetAttributes(
+		attribute.Int("command.pid", cmd.ProcessState.Pid()),
+		attribute.Int("command.exit_code", exitCode),
+		attribute.Int64("command.system_time_ms", systemTime.Milliseconds()),
+		attribute.Int64("command.user_time_ms", userTime.Milliseconds()),
+	)
+	if c.cgroupPath != "" {
+		c.otelSpan.SetAttributes(attribute.String("command.cgroup_path", c.cgroupPath))
+	}
+	if exitCode != 0 {
+		c.otelSpan.SetStatus(codes.Error, fmt.Sprintf("exit code %d", exitCode))
+	}
+	c.otelSpan.End()
 }
 
 // Args is an accessor for the command arguments
@@ -21,6 +21,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/cgroups"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestNew_environment(t *testing.T) {
@@ -518,3 +525,73 @@ func TestCommand_withFinalizer(t *testing.T) {
 		}
 	})
 }
+
+func TestNew_openTelemetrySpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(tracing.NewTracerProvider("gitaly", "1.0.0", 1, sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	t.Run("without parent span", func(t *testing.T) {
+		ctx := testhelper.Context(t)
+
+		var stdout bytes.Buffer
+		cmd, err := New(ctx, []string{"/usr/bin/env"}, WithStdout(&stdout))
+		require.NoError(t, err)
+		require.NoError(t, cmd.Wait())
+
+		require.NotContains(t, stdout.String(), "TRACEPARENT=")
+		require.Empty(t, recorder.Ended())
+	})
+
+	t.Run("with parent span", func(t *testing.T) {
+		ctx, parent := tracing.Tracer().Start(testhelper.Context(t), "parent")
+		defer parent.End()
+
+		var stdout bytes.Buffer
+		cmd, err := New(ctx, []string{"sh", "-c", `echo "$TRACEPARENT"; exit 3`},
+			WithStdout(&stdout),
+			WithCommandName("git", "rev-parse"),
+		)
+		require.NoError(t, err)
+		require.Error(t, cmd.Wait())
+
+		ended := recorder.Ended()
+		require.Len(t, ended, 1)
+
+		span := ended[0]
+		require.Equal(t, "git-rev-parse", span.Name())
+		require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		require.Equal(t, codes.Error, span.Status().Code)
+		require.Subset(t, span.Attributes(), []attribute.KeyValue{
+			attribute.String("command.name", "git"),
+			attribute.String("command.subcommand", "rev-parse"),
+			attribute.Int("command.exit_code", 3),
+		})
+
+		// The command's span is propagated to the subprocess so that its spans become
+		// children of the command.
+		require.Equal(t,
+			fmt.Sprintf("00-%s-%s-01\n", span.SpanContext().TraceID(), span.SpanContext().SpanID()),
+			stdout.String(),
+		)
+	})
+
+	t.Run("failure to spawn", func(t *testing.T) {
+		ctx, parent := tracing.Tracer().Start(testhelper.Context(t), "parent")
+		defer parent.End()
+
+		previouslyEnded := len(recorder.Ended())
+
+		_, err := New(ctx, []string{"/does/not/exist"})
+		require.Error(t, err)
+
+		ended := recorder.Ended()
+		require.Len(t, ended, previouslyEnded+1)
+
+		span := ended[len(ended)-1]
+		require.Equal(t, "exist", span.Name())
+		require.Equal(t, codes.Error, span.Status().Code)
+		require.Len(t, span.Events(), 1)
+		require.Equal(t, "exception", span.Events()[0].Name)
+	})
+}
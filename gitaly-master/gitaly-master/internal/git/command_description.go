@@ -84,6 +84,12 @@ var commandDescriptions = map[string]commandDescription{
 	"diff-tree": {
 		flags: scNoRefUpdates,
 	},
+	"fast-export": {
+		flags: scNoRefUpdates,
+	},
+	"fast-import": {
+		flags: scNoEndOfOptions,
+	},
 	"fetch": {
 		flags: 0,
 
@@ -438,6 +438,9 @@ func (cf *ExecCommandFactory) newCommand(ctx context.Context, repo repository.Gi
 			cgroups.WithCgroupKey(repo.GetStorageName() + "/" + repo.GetRelativePath()),
 		}
 	}
+	if config.cgroupClass != "" {
+		cgroupsAddCommandOpts = append(cgroupsAddCommandOpts, cgroups.WithCommandClass(config.cgroupClass))
+	}
 
 	command, err := command.New(ctx, append([]string{execEnv.BinaryPath}, args...), append(
 		config.commandOpts,
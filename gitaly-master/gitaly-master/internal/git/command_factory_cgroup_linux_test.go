@@ -0,0 +1,89 @@
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	cgroupscfg "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+)
+
+func TestNewCommandAddsToCgroupClass(t *testing.T) {
+	t.Parallel()
+
+	ctx := featureflag.IncomingCtxWithFeatureFlag(testhelper.Context(t), featureflag.RunCommandsInCGroup, true)
+	cfg := testcfg.Build(t)
+
+	repo, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	// Set up a fake unified cgroup hierarchy so that we can observe which cgroups the commands
+	// are added to.
+	mountpoint := testhelper.TempDir(t)
+	require.NoError(t, os.WriteFile(filepath.Join(mountpoint, "cgroup.controllers"), nil, perm.SharedFile))
+	require.NoError(t, os.Mkdir(filepath.Join(mountpoint, "gitaly"), perm.SharedDir))
+
+	cgroupsCfg := cgroupscfg.Config{
+		Mountpoint:    mountpoint,
+		HierarchyRoot: "gitaly",
+		Repositories: cgroupscfg.Repositories{
+			Count: 1,
+		},
+		Classes: []cgroupscfg.Class{
+			{Name: cgroupscfg.ClassHousekeeping},
+		},
+	}
+
+	manager := cgroups.NewManager(cgroupsCfg, 1)
+	require.NoError(t, manager.Setup())
+
+	gitCmdFactory := gittest.NewCommandFactory(t, cfg, git.WithCgroupsManager(manager))
+
+	processCgroup := filepath.Join(mountpoint, "gitaly", "gitaly-1")
+
+	for _, tc := range []struct {
+		desc         string
+		opts         []git.CmdOpt
+		expectedPath string
+	}{
+		{
+			desc:         "without class",
+			expectedPath: filepath.Join(processCgroup, "repos-0"),
+		},
+		{
+			desc:         "with configured class",
+			opts:         []git.CmdOpt{git.WithCgroupClass(cgroupscfg.ClassHousekeeping)},
+			expectedPath: filepath.Join(processCgroup, "class-housekeeping", "repos-0"),
+		},
+		{
+			desc:         "with unconfigured class",
+			opts:         []git.CmdOpt{git.WithCgroupClass(cgroupscfg.ClassPackObjects)},
+			expectedPath: filepath.Join(processCgroup, "repos-0"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			procsPath := filepath.Join(tc.expectedPath, "cgroup.procs")
+			require.NoError(t, os.RemoveAll(procsPath))
+
+			cmd, err := gitCmdFactory.New(ctx, repo, git.Command{
+				Name: "rev-parse",
+				Flags: []git.Option{
+					git.Flag{Name: "--is-bare-repository"},
+				},
+			}, tc.opts...)
+			require.NoError(t, err)
+			require.NoError(t, cmd.Wait())
+
+			require.FileExists(t, procsPath)
+		})
+	}
+}
@@ -134,6 +134,7 @@ type cmdCfg struct {
 	commandOpts     []command.Option
 	hooksConfigured bool
 	worktreePath    string
+	cgroupClass     string
 }
 
 // CmdOpt is an option for running a command
@@ -292,3 +293,13 @@ func WithWorktree(worktreePath string) CmdOpt {
 		return nil
 	}
 }
+
+// WithCgroupClass puts the Git command into the cgroups of the given command class so that it is
+// isolated from commands of other classes. Commands are put into the default repository cgroups
+// if the class has not been configured.
+func WithCgroupClass(class string) CmdOpt {
+	return func(_ context.Context, _ config.Cfg, _ CommandFactory, c *cmdCfg) error {
+		c.cgroupClass = class
+		return nil
+	}
+}
@@ -108,20 +108,23 @@ func (cc *cmdCfg) configureHooks(
 		return err
 	}
 
-	payload, err := NewHooksPayload(
+	payload := NewHooksPayload(
 		cfg,
 		repo,
 		transaction,
 		userDetails,
 		requestedHooks,
-		featureflag.FromContext(ctx)).Env()
+		featureflag.FromContext(ctx))
+	payload.SkipQuota = skipQuotaFromContext(ctx)
+
+	env, err := payload.Env()
 	if err != nil {
 		return err
 	}
 
 	cc.env = append(
 		cc.env,
-		payload,
+		env,
 		fmt.Sprintf("%s=%s", log.GitalyLogDirEnvKey, cfg.Logging.Dir),
 	)
 
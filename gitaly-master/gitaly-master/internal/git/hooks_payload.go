@@ -1,6 +1,7 @@
 package git
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -89,6 +90,11 @@ type HooksPayload struct {
 	// than the corresponding git2go binary. So, we need to keep the
 	// receive_hooks_payload key for one release before we can remove it.
 	ReceiveHooksPayload *UserDetails `json:"receive_hooks_payload"`
+
+	// SkipQuota indicates that the hooks must not enforce quotas. This is set when replicating
+	// repositories, where the target must end up with the same references as the source
+	// regardless of its size.
+	SkipQuota bool `json:"skip_quota,omitempty"`
 }
 
 // UserDetails contains all information which is required for hooks
@@ -104,6 +110,22 @@ type UserDetails struct {
 	Protocol string `json:"protocol"`
 }
 
+// skipQuotaKey is the context key used to disable quota enforcement in hooks.
+type skipQuotaKey struct{}
+
+// ContextWithoutQuota returns a context which causes hooks of Git commands spawned with it to skip
+// enforcing quotas.
+func ContextWithoutQuota(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipQuotaKey{}, true)
+}
+
+// skipQuotaFromContext determines whether quota enforcement has been disabled via
+// ContextWithoutQuota.
+func skipQuotaFromContext(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipQuotaKey{}).(bool)
+	return skip
+}
+
 // jsonHooksPayload wraps the HooksPayload such that we can manually encode the
 // repository protobuf message.
 type jsonHooksPayload struct {
@@ -79,6 +79,25 @@ func TestHooksPayload(t *testing.T) {
 		}, payload)
 	})
 
+	t.Run("roundtrip with skipped quota succeeds", func(t *testing.T) {
+		hooksPayload := git.NewHooksPayload(cfg, repo, nil, nil, git.ReferenceTransactionHook, nil)
+		hooksPayload.SkipQuota = true
+
+		env, err := hooksPayload.Env()
+		require.NoError(t, err)
+
+		payload, err := git.HooksPayloadFromEnv([]string{env})
+		require.NoError(t, err)
+
+		require.Equal(t, git.HooksPayload{
+			Repo:           repo,
+			RuntimeDir:     cfg.RuntimeDir,
+			InternalSocket: cfg.InternalSocketPath(),
+			RequestedHooks: git.ReferenceTransactionHook,
+			SkipQuota:      true,
+		}, payload)
+	})
+
 	t.Run("missing envvar", func(t *testing.T) {
 		_, err := git.HooksPayloadFromEnv([]string{"OTHER_ENV=foobar"})
 		require.Error(t, err)
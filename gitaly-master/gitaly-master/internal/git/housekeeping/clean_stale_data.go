@@ -31,6 +31,7 @@ const (
 	referenceLockfileGracePeriod     = 1 * time.Hour
 	packedRefsLockGracePeriod        = 1 * time.Hour
 	packedRefsNewGracePeriod         = 15 * time.Minute
+	reftableLockGracePeriod          = 1 * time.Hour
 )
 
 var lockfiles = []string{
@@ -79,6 +80,7 @@ func (m *RepositoryManager) CleanStaleData(ctx context.Context, repo *localrepo.
 		"reflocks":       findStaleReferenceLocks,
 		"packedrefslock": findPackedRefsLock,
 		"packedrefsnew":  findPackedRefsNew,
+		"reftablelock":   findReftableLock,
 		"serverinfo":     findServerInfo,
 	} {
 		staleFiles, err := staleFileFinder(ctx, repoPath)
@@ -425,6 +427,12 @@ func findPackedRefsNew(ctx context.Context, repoPath string) ([]string, error) {
 	return findStaleFiles(repoPath, packedRefsNewGracePeriod, "packed-refs.new")
 }
 
+// findReftableLock returns stale lockfiles for the list of tables of the reftable stack. A stale
+// lock would block all reference updates in repositories using the reftable backend.
+func findReftableLock(ctx context.Context, repoPath string) ([]string, error) {
+	return findStaleFiles(repoPath, reftableLockGracePeriod, "reftable/tables.list.lock")
+}
+
 // findServerInfo returns files generated by git-update-server-info(1). These files are only
 // required to serve Git fetches via the dumb HTTP protocol, which we don't serve at all. It's thus
 // safe to remove all of those files without a grace period.
@@ -143,6 +143,7 @@ type cleanStaleDataMetrics struct {
 	refsEmptyDir   int
 	packedRefsLock int
 	packedRefsNew  int
+	reftableLock   int
 	serverInfo     int
 }
 
@@ -165,6 +166,7 @@ func requireCleanStaleDataMetrics(t *testing.T, m *RepositoryManager, metrics cl
 		"reflocks":       metrics.reflocks,
 		"packedrefslock": metrics.packedRefsLock,
 		"packedrefsnew":  metrics.packedRefsNew,
+		"reftablelock":   metrics.reftableLock,
 		"refsemptydir":   metrics.refsEmptyDir,
 		"serverinfo":     metrics.serverInfo,
 	} {
@@ -639,6 +641,17 @@ func TestRepositoryManager_CleanStaleData_withSpecificFile(t *testing.T) {
 				packedRefsNew: 1,
 			},
 		},
+		{
+			desc: "locked reftable stack",
+			file: "tables.list.lock",
+			subdirs: []string{
+				"reftable",
+			},
+			finder: findReftableLock,
+			expectedMetrics: cleanStaleDataMetrics{
+				reftableLock: 1,
+			},
+		},
 	} {
 		tc := tc
 
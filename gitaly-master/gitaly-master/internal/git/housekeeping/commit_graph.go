@@ -7,6 +7,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
+	cgroupscfg "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 )
 
@@ -81,7 +82,7 @@ func WriteCommitGraph(ctx context.Context, repo *localrepo.Repo, cfg WriteCommit
 		Name:   "commit-graph",
 		Action: "write",
 		Flags:  flags,
-	}, git.WithStderr(&stderr)); err != nil {
+	}, git.WithStderr(&stderr), git.WithCgroupClass(cgroupscfg.ClassHousekeeping)); err != nil {
 		return structerr.NewInternal("writing commit-graph: %w: %v", err, stderr.String())
 	}
 
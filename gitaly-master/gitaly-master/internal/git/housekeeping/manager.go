@@ -18,6 +18,13 @@ type Manager interface {
 	// OptimizeRepository optimizes the repository's data structures such that it can be more
 	// efficiently served.
 	OptimizeRepository(context.Context, *localrepo.Repo, ...OptimizeRepositoryOption) error
+	// PreserveObjects prevents housekeeping from removing any objects of the repository until
+	// the returned function is called. Housekeeping which is already in progress is waited for.
+	// Multiple callers may preserve objects of the same repository at the same time.
+	PreserveObjects(context.Context, *localrepo.Repo) (func(), error)
+	// LockObjects waits until no caller preserves objects of the repository anymore and then
+	// locks them for housekeeping until the returned function is called.
+	LockObjects(context.Context, *localrepo.Repo) (func(), error)
 }
 
 // RepositoryManager is an implementation of the Manager interface.
@@ -32,6 +39,9 @@ type RepositoryManager struct {
 	dataStructureSize      *prometheus.HistogramVec
 	optimizeFunc           func(context.Context, *RepositoryManager, *localrepo.Repo, OptimizationStrategy) error
 	reposInProgress        sync.Map
+
+	objectLocksMutex sync.Mutex
+	objectLocks      map[objectLockKey]*objectLock
 }
 
 // NewManager creates a new RepositoryManager.
@@ -85,6 +95,7 @@ func NewManager(promCfg gitalycfgprom.Config, txManager transaction.Manager) *Re
 			[]string{"data_structure"},
 		),
 		optimizeFunc: optimizeRepository,
+		objectLocks:  map[objectLockKey]*objectLock{},
 	}
 }
 
@@ -0,0 +1,101 @@
+package housekeeping
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+)
+
+// objectLockKey identifies the repository an objectLock belongs to. The repository's path is not
+// used so that locking doesn't fail for repositories which don't exist.
+type objectLockKey struct {
+	storageName  string
+	relativePath string
+}
+
+// objectLock is a readers-writer lock guarding the objects of a single repository. Readers
+// preserve objects while writers perform housekeeping, which may remove objects.
+type objectLock struct {
+	readers int
+	writer  bool
+	// released is closed whenever the lock is released so that waiters can retry acquiring it.
+	released chan struct{}
+}
+
+// PreserveObjects prevents housekeeping from removing any objects of the repository until the
+// returned function is called.
+func (m *RepositoryManager) PreserveObjects(ctx context.Context, repo *localrepo.Repo) (func(), error) {
+	return m.lockObjects(ctx, repo, false)
+}
+
+// LockObjects locks objects of the repository for housekeeping until the returned function is
+// called.
+func (m *RepositoryManager) LockObjects(ctx context.Context, repo *localrepo.Repo) (func(), error) {
+	return m.lockObjects(ctx, repo, true)
+}
+
+func (m *RepositoryManager) lockObjects(ctx context.Context, repo *localrepo.Repo, exclusive bool) (func(), error) {
+	key := objectLockKey{
+		storageName:  repo.GetStorageName(),
+		relativePath: filepath.Clean(repo.GetRelativePath()),
+	}
+
+	for {
+		m.objectLocksMutex.Lock()
+
+		lock, ok := m.objectLocks[key]
+		if !ok {
+			lock = &objectLock{released: make(chan struct{})}
+			m.objectLocks[key] = lock
+		}
+
+		if !lock.writer && (!exclusive || lock.readers == 0) {
+			if exclusive {
+				lock.writer = true
+			} else {
+				lock.readers++
+			}
+
+			m.objectLocksMutex.Unlock()
+
+			var once sync.Once
+			return func() {
+				once.Do(func() {
+					m.unlockObjects(key, lock, exclusive)
+				})
+			}, nil
+		}
+
+		released := lock.released
+		m.objectLocksMutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for object lock: %w", ctx.Err())
+		case <-released:
+		}
+	}
+}
+
+func (m *RepositoryManager) unlockObjects(key objectLockKey, lock *objectLock, exclusive bool) {
+	m.objectLocksMutex.Lock()
+	defer m.objectLocksMutex.Unlock()
+
+	if exclusive {
+		lock.writer = false
+	} else {
+		lock.readers--
+	}
+
+	close(lock.released)
+
+	if lock.readers == 0 && !lock.writer {
+		delete(m.objectLocks, key)
+		return
+	}
+
+	lock.released = make(chan struct{})
+}
@@ -0,0 +1,105 @@
+package housekeeping
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+)
+
+func TestRepositoryManager_objectLocks(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	createRepo := func(t *testing.T) *localrepo.Repo {
+		repoProto, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+		return localrepo.NewTestRepo(t, cfg, repoProto)
+	}
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	t.Run("preserved objects block housekeeping", func(t *testing.T) {
+		manager := NewManager(cfg.Prometheus, nil)
+		repo := createRepo(t)
+
+		releaseA, err := manager.PreserveObjects(ctx, repo)
+		require.NoError(t, err)
+		releaseB, err := manager.PreserveObjects(ctx, repo)
+		require.NoError(t, err)
+
+		_, err = manager.LockObjects(cancelledCtx, repo)
+		require.ErrorIs(t, err, context.Canceled)
+
+		// Objects of other repositories are not preserved.
+		unlock, err := manager.LockObjects(ctx, createRepo(t))
+		require.NoError(t, err)
+		unlock()
+
+		// Releasing multiple times doesn't release the other caller's preservation.
+		releaseA()
+		releaseA()
+		_, err = manager.LockObjects(cancelledCtx, repo)
+		require.ErrorIs(t, err, context.Canceled)
+
+		releaseB()
+		unlock, err = manager.LockObjects(ctx, repo)
+		require.NoError(t, err)
+		unlock()
+
+		require.Empty(t, manager.objectLocks)
+	})
+
+	t.Run("housekeeping blocks preserving objects", func(t *testing.T) {
+		manager := NewManager(cfg.Prometheus, nil)
+		repo := createRepo(t)
+
+		unlock, err := manager.LockObjects(ctx, repo)
+		require.NoError(t, err)
+
+		_, err = manager.PreserveObjects(cancelledCtx, repo)
+		require.ErrorIs(t, err, context.Canceled)
+		_, err = manager.LockObjects(cancelledCtx, repo)
+		require.ErrorIs(t, err, context.Canceled)
+
+		type result struct {
+			release func()
+			err     error
+		}
+
+		preserved := make(chan result, 1)
+		go func() {
+			release, err := manager.PreserveObjects(ctx, repo)
+			preserved <- result{release: release, err: err}
+		}()
+
+		unlock()
+
+		preservation := <-preserved
+		require.NoError(t, preservation.err)
+		preservation.release()
+
+		require.Empty(t, manager.objectLocks)
+	})
+
+	t.Run("optimization waits for preserved objects", func(t *testing.T) {
+		manager := NewManager(cfg.Prometheus, nil)
+		repo := createRepo(t)
+
+		release, err := manager.PreserveObjects(ctx, repo)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, manager.OptimizeRepository(cancelledCtx, repo), context.Canceled)
+
+		release()
+		require.NoError(t, manager.OptimizeRepository(ctx, repo))
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/repository"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
+	cgroupscfg "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 )
 
@@ -56,7 +57,10 @@ func RepackObjects(ctx context.Context, repo *localrepo.Repo, cfg RepackObjectsC
 		Flags: append([]git.Option{
 			git.Flag{Name: "-d"},
 		}, options...),
-	}, git.WithConfig(GetRepackGitConfig(ctx, repo, cfg.WriteBitmap)...)); err != nil {
+	},
+		git.WithConfig(GetRepackGitConfig(ctx, repo, cfg.WriteBitmap)...),
+		git.WithCgroupClass(cgroupscfg.ClassHousekeeping),
+	); err != nil {
 		return err
 	}
 
@@ -4,9 +4,13 @@ import (
 	"context"
 	"math"
 
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
 )
 
+// maxReftableTables is the number of tables a reftable stack may consist of before we compact it.
+const maxReftableTables = 16
+
 // OptimizationStrategy is an interface to determine which parts of a repository should be
 // optimized.
 type OptimizationStrategy interface {
@@ -138,7 +142,8 @@ func (s HeuristicalOptimizationStrategy) ShouldWriteCommitGraph(ctx context.Cont
 	// If the repository doesn't have any references at all then there is no point in writing
 	// commit-graphs given that it would only contain reachable objects, of which there are
 	// none.
-	if s.info.References.LooseReferencesCount == 0 && s.info.References.PackedReferencesSize == 0 {
+	if s.info.References.LooseReferencesCount == 0 && s.info.References.PackedReferencesSize == 0 &&
+		s.info.References.ReftableSize == 0 {
 		return false, WriteCommitGraphConfig{}
 	}
 
@@ -194,6 +199,15 @@ func (s HeuristicalOptimizationStrategy) ShouldPruneObjects(context.Context) boo
 // on heuristics. The more references there are, the more loose referencos may exist until they are
 // packed again.
 func (s HeuristicalOptimizationStrategy) ShouldRepackReferences(context.Context) bool {
+	if s.info.References.ReferenceBackendName == git.ReferenceBackendReftable.Name {
+		// Git compacts the reftable stack on every write such that the table sizes form a
+		// geometric sequence, so the number of tables only grows logarithmically with the
+		// number of reference updates. We thus only need to compact the stack ourselves in
+		// case auto-compaction didn't happen, e.g. because it has failed due to concurrent
+		// writers holding the lock.
+		return s.info.References.ReftableTablesCount > maxReftableTables
+	}
+
 	// If there aren't any loose refs then there is nothing we need to do.
 	if s.info.References.LooseReferencesCount == 0 {
 		return false
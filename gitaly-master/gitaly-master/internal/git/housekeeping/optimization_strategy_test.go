@@ -400,6 +400,30 @@ func TestHeuristicalOptimizationStrategy_ShouldRepackReferences(t *testing.T) {
 	}
 }
 
+func TestHeuristicalOptimizationStrategy_ShouldRepackReferences_reftable(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	strategy := HeuristicalOptimizationStrategy{
+		info: stats.RepositoryInfo{
+			References: stats.ReferencesInfo{
+				ReferenceBackendName: "reftable",
+				// Loose references are irrelevant for the reftable backend.
+				LooseReferencesCount: 1000,
+				ReftableTablesCount:  16,
+				ReftableSize:         1024,
+			},
+		},
+	}
+
+	require.False(t, strategy.ShouldRepackReferences(ctx))
+
+	strategy.info.References.ReftableTablesCount++
+
+	require.True(t, strategy.ShouldRepackReferences(ctx))
+}
+
 func TestHeuristicalOptimizationStrategy_NeedsWriteCommitGraph(t *testing.T) {
 	t.Parallel()
 
@@ -438,6 +462,22 @@ func TestHeuristicalOptimizationStrategy_NeedsWriteCommitGraph(t *testing.T) {
 				ReplaceChain: true,
 			},
 		},
+		{
+			desc: "reftable repository without bloom filters",
+			strategy: HeuristicalOptimizationStrategy{
+				info: stats.RepositoryInfo{
+					References: stats.ReferencesInfo{
+						ReferenceBackendName: "reftable",
+						ReftableTablesCount:  1,
+						ReftableSize:         1024,
+					},
+				},
+			},
+			expectedNeeded: true,
+			expectedCfg: WriteCommitGraphConfig{
+				ReplaceChain: true,
+			},
+		},
 		{
 			desc: "repository without bloom filters with repack",
 			strategy: HeuristicalOptimizationStrategy{
@@ -11,6 +11,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
+	cgroupscfg "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/tracing"
 )
 
@@ -59,6 +60,12 @@ func (m *RepositoryManager) OptimizeRepository(
 		m.reposInProgress.Delete(path)
 	}()
 
+	unlock, err := m.LockObjects(ctx, repo)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	var cfg OptimizeRepositoryConfig
 	for _, opt := range opts {
 		opt(&cfg)
@@ -103,6 +110,7 @@ func (m *RepositoryManager) reportRepositoryInfo(ctx context.Context, info stats
 	m.reportDataStructureCount("packfiles_keep", info.Packfiles.KeepCount)
 	m.reportDataStructureCount("packfiles_reverse_indices", info.Packfiles.ReverseIndexCount)
 	m.reportDataStructureCount("loose_references", info.References.LooseReferencesCount)
+	m.reportDataStructureCount("reftables", info.References.ReftableTablesCount)
 
 	m.reportDataStructureSize("loose_objects_recent", info.LooseObjects.Size-info.LooseObjects.StaleSize)
 	m.reportDataStructureSize("loose_objects_stale", info.LooseObjects.StaleSize)
@@ -110,6 +118,7 @@ func (m *RepositoryManager) reportRepositoryInfo(ctx context.Context, info stats
 	m.reportDataStructureSize("packfiles_cruft", info.Packfiles.CruftSize)
 	m.reportDataStructureSize("packfiles_keep", info.Packfiles.KeepSize)
 	m.reportDataStructureSize("packed_references", info.References.PackedReferencesSize)
+	m.reportDataStructureSize("reftables", info.References.ReftableSize)
 }
 
 func (m *RepositoryManager) reportDataStructureExistence(dataStructure string, exists bool) {
@@ -267,7 +276,7 @@ func pruneIfNeeded(ctx context.Context, repo *localrepo.Repo, strategy Optimizat
 			// reachable. We thus use the same two-week grace period as git-gc(1) does.
 			git.ValueFlag{Name: "--expire", Value: "two.weeks.ago"},
 		},
-	}); err != nil {
+	}, git.WithCgroupClass(cgroupscfg.ClassHousekeeping)); err != nil {
 		return false, fmt.Errorf("pruning objects: %w", err)
 	}
 
@@ -285,7 +294,7 @@ func packRefsIfNeeded(ctx context.Context, repo *localrepo.Repo, strategy Optimi
 		Flags: []git.Option{
 			git.Flag{Name: "--all"},
 		},
-	}, git.WithStderr(&stderr)); err != nil {
+	}, git.WithStderr(&stderr), git.WithCgroupClass(cgroupscfg.ClassHousekeeping)); err != nil {
 		return false, fmt.Errorf("packing refs: %w, stderr: %q", err, stderr.String())
 	}
 
@@ -0,0 +1,63 @@
+package localrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+)
+
+// ErrEmptyBundle is returned when the bundle to be created would have been empty.
+var ErrEmptyBundle = errors.New("refusing to create empty bundle")
+
+// CreateBundleOpts are optional configurations used when creating a bundle.
+type CreateBundleOpts struct {
+	// Patterns contains all patterns which shall be bundled. Patterns should be in the format
+	// accepted by git-rev-list(1) and separated by newlines. If no patterns are given, all
+	// references are bundled.
+	Patterns io.Reader
+	// CgroupClass is the cgroup class git-bundle(1) is spawned with. Uses the default class if
+	// unset.
+	CgroupClass string
+}
+
+// CreateBundle creates a bundle that contains all refs matching the given patterns and writes it
+// to out. ErrEmptyBundle is returned if the bundle would not contain any references.
+func (repo *Repo) CreateBundle(ctx context.Context, out io.Writer, opts *CreateBundleOpts) error {
+	if opts == nil {
+		opts = &CreateBundleOpts{}
+	}
+
+	var stderr strings.Builder
+
+	gitOpts := []git.Option{git.OutputToStdout}
+	cmdOpts := []git.CmdOpt{git.WithStdout(out), git.WithStderr(&stderr)}
+
+	if opts.Patterns != nil {
+		gitOpts = append(gitOpts, git.Flag{Name: "--stdin"})
+		cmdOpts = append(cmdOpts, git.WithStdin(opts.Patterns))
+	} else {
+		gitOpts = append(gitOpts, git.Flag{Name: "--all"})
+	}
+
+	if opts.CgroupClass != "" {
+		cmdOpts = append(cmdOpts, git.WithCgroupClass(opts.CgroupClass))
+	}
+
+	if err := repo.ExecAndWait(ctx, git.Command{
+		Name:   "bundle",
+		Action: "create",
+		Flags:  gitOpts,
+	}, cmdOpts...); err != nil {
+		if isExitWithCode(err, 128) && strings.HasPrefix(stderr.String(), "fatal: Refusing to create empty bundle.") {
+			return fmt.Errorf("create bundle: %w", ErrEmptyBundle)
+		}
+
+		return fmt.Errorf("create bundle: %w: stderr: %q", err, stderr.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package localrepo
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+)
+
+func TestRepo_CreateBundle(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	t.Run("empty repository", func(t *testing.T) {
+		t.Parallel()
+
+		_, repo, _ := setupRepo(t)
+
+		var bundle bytes.Buffer
+		err := repo.CreateBundle(ctx, &bundle, nil)
+		require.ErrorIs(t, err, ErrEmptyBundle)
+	})
+
+	t.Run("all references", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, repo, repoPath := setupRepo(t)
+
+		commit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+		gittest.WriteRef(t, cfg, repoPath, "refs/keep-around/kept", commit)
+
+		var bundle bytes.Buffer
+		require.NoError(t, repo.CreateBundle(ctx, &bundle, nil))
+
+		bundlePath := filepath.Join(testhelper.TempDir(t), "repo.bundle")
+		require.NoError(t, os.WriteFile(bundlePath, bundle.Bytes(), perm.SharedFile))
+
+		require.Equal(t,
+			fmt.Sprintf("%[1]s refs/heads/main\n%[1]s refs/keep-around/kept", commit),
+			text.ChompBytes(gittest.Exec(t, cfg, "bundle", "list-heads", bundlePath)),
+		)
+	})
+
+	t.Run("patterns", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, repo, repoPath := setupRepo(t)
+
+		commit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+		gittest.WriteRef(t, cfg, repoPath, "refs/keep-around/kept", commit)
+
+		var bundle bytes.Buffer
+		require.NoError(t, repo.CreateBundle(ctx, &bundle, &CreateBundleOpts{
+			Patterns: strings.NewReader("refs/heads/main\n"),
+		}))
+
+		bundlePath := filepath.Join(testhelper.TempDir(t), "repo.bundle")
+		require.NoError(t, os.WriteFile(bundlePath, bundle.Bytes(), perm.SharedFile))
+
+		require.Equal(t,
+			fmt.Sprintf("%s refs/heads/main", commit),
+			text.ChompBytes(gittest.Exec(t, cfg, "bundle", "list-heads", bundlePath)),
+		)
+	})
+}
@@ -5,15 +5,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"gitlab.com/gitlab-org/gitaly/v15/internal/command"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 )
 
 type mergeTreeConfig struct {
 	allowUnrelatedHistories bool
+	mergeBase               git.Revision
+	conflictingFileInfo     bool
 }
 
 // MergeTreeOption is a function that sets a config in mergeTreeConfig.
@@ -27,6 +35,23 @@ func WithAllowUnrelatedHistories() MergeTreeOption {
 	}
 }
 
+// WithMergeBase lets MergeTree use the given tree-ish as merge base instead of computing the merge
+// base of both sides. This is required to implement operations like cherry-picks and reverts.
+func WithMergeBase(base git.Revision) MergeTreeOption {
+	return func(options *mergeTreeConfig) {
+		options.mergeBase = base
+	}
+}
+
+// WithConflictingFileInfo lets MergeTree report the object ID, mode and stage of each conflicting
+// file instead of only reporting the conflicting file names. The returned MergeTreeError will
+// then have its ConflictInfo and TreeOID set.
+func WithConflictingFileInfo() MergeTreeOption {
+	return func(options *mergeTreeConfig) {
+		options.conflictingFileInfo = true
+	}
+}
+
 // MergeTree calls git-merge-tree(1) with arguments, and parses the results from
 // stdout.
 func (repo *Repo) MergeTree(
@@ -42,15 +67,38 @@ func (repo *Repo) MergeTree(
 
 	flags := []git.Option{
 		git.Flag{Name: "--write-tree"},
-		git.Flag{Name: "--name-only"},
+	}
+
+	if config.conflictingFileInfo {
+		flags = append(flags, git.Flag{Name: "-z"})
+	} else {
+		flags = append(flags, git.Flag{Name: "--name-only"})
 	}
 
 	if config.allowUnrelatedHistories {
 		flags = append(flags, git.Flag{Name: "--allow-unrelated-histories"})
 	}
 
+	mergeRepo := repo
+	if config.mergeBase != "" {
+		scratchRepo, cleanup, err := repo.scratchObjectDirectory()
+		if err != nil {
+			return "", fmt.Errorf("creating scratch object directory: %w", err)
+		}
+		defer cleanup()
+
+		ours, theirs, err = scratchRepo.commitsWithMergeBase(ctx, config.mergeBase, ours, theirs)
+		if err != nil {
+			return "", fmt.Errorf("setting up merge base: %w", err)
+		}
+
+		// git-merge-tree(1) needs to see the synthetic commits, but the merged objects must
+		// be written into the repository's object directory so that callers can use them.
+		mergeRepo = repo.withAlternateObjectDirectory(scratchRepo.GetGitObjectDirectory())
+	}
+
 	var stdout, stderr bytes.Buffer
-	err := repo.ExecAndWait(
+	err := mergeRepo.ExecAndWait(
 		ctx,
 		git.Command{
 			Name:  "merge-tree",
@@ -75,6 +123,15 @@ func (repo *Repo) MergeTree(
 			return "", fmt.Errorf("merge-tree: %w", err)
 		}
 
+		if config.conflictingFileInfo {
+			objectHash, err := repo.ObjectHash(ctx)
+			if err != nil {
+				return "", fmt.Errorf("getting object hash %w", err)
+			}
+
+			return "", parseMergeTreeConflictInfo(objectHash, stdout.String())
+		}
+
 		return "", parseMergeTreeError(stdout.String())
 	}
 
@@ -83,7 +140,7 @@ func (repo *Repo) MergeTree(
 		return "", fmt.Errorf("getting object hash %w", err)
 	}
 
-	oid, err := objectHash.FromHex(text.ChompBytes(stdout.Bytes()))
+	oid, err := objectHash.FromHex(strings.TrimRight(stdout.String(), "\n\x00"))
 	if err != nil {
 		return "", fmt.Errorf("hex to oid: %w", err)
 	}
@@ -91,6 +148,112 @@ func (repo *Repo) MergeTree(
 	return oid, nil
 }
 
+// scratchObjectDirectory creates a temporary object directory which uses the repository's object
+// directories as alternates. Objects written via the returned repository end up in the temporary
+// object directory only, which is removed by the returned cleanup function.
+func (repo *Repo) scratchObjectDirectory() (*Repo, func(), error) {
+	repoPath, err := repo.Path()
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting repository path: %w", err)
+	}
+
+	tempDir, err := repo.locator.TempDir(repo.GetStorageName())
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting temporary directory: %w", err)
+	}
+
+	if err := os.MkdirAll(tempDir, perm.PrivateDir); err != nil {
+		return nil, nil, fmt.Errorf("creating temporary directory: %w", err)
+	}
+
+	scratchDir, err := os.MkdirTemp(tempDir, "merge-base-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating scratch directory: %w", err)
+	}
+	cleanup := func() { _ = os.RemoveAll(scratchDir) }
+
+	// All object directories are relative to the repository path.
+	relativeScratchDir, err := filepath.Rel(repoPath, scratchDir)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("computing relative scratch directory: %w", err)
+	}
+
+	alternates := []string{"objects"}
+	if repo.GetGitObjectDirectory() != "" {
+		alternates = append(alternates, repo.GetGitObjectDirectory())
+	}
+	alternates = append(alternates, repo.GetGitAlternateObjectDirectories()...)
+
+	scratchRepo := New(repo.locator, repo.gitCmdFactory, repo.catfileCache, &gitalypb.Repository{
+		StorageName:                   repo.GetStorageName(),
+		RelativePath:                  repo.GetRelativePath(),
+		GitObjectDirectory:            relativeScratchDir,
+		GitAlternateObjectDirectories: alternates,
+	})
+
+	return scratchRepo, cleanup, nil
+}
+
+// withAlternateObjectDirectory returns a copy of the repository which additionally uses the given
+// object directory, relative to the repository path, as alternate.
+func (repo *Repo) withAlternateObjectDirectory(objectDirectory string) *Repo {
+	alternates := append([]string{}, repo.GetGitAlternateObjectDirectories()...)
+	alternates = append(alternates, objectDirectory)
+
+	return New(repo.locator, repo.gitCmdFactory, repo.catfileCache, &gitalypb.Repository{
+		StorageName:                   repo.GetStorageName(),
+		RelativePath:                  repo.GetRelativePath(),
+		GitObjectDirectory:            repo.GetGitObjectDirectory(),
+		GitAlternateObjectDirectories: alternates,
+	})
+}
+
+// commitsWithMergeBase creates commits for both sides of the merge which have a synthetic commit
+// with the given merge base's tree as their only parent. git-merge-tree(1) only learned to accept
+// an explicit merge base via `--merge-base` in Git v2.40, so we instead make sure that it finds the
+// merge base we want it to use. The commits should be written into a scratch object directory so
+// that they don't end up in the repository.
+func (repo *Repo) commitsWithMergeBase(ctx context.Context, base git.Revision, ours, theirs string) (string, string, error) {
+	// We use fixed signatures and dates so that the synthetic commits are stable. They are only
+	// ever used to compute the merge and never referenced by anything.
+	writeCommit := func(treeish git.Revision, parents ...git.ObjectID) (git.ObjectID, error) {
+		treeOID, err := repo.ResolveRevision(ctx, treeish+"^{tree}")
+		if err != nil {
+			return "", fmt.Errorf("resolving tree of %q: %w", treeish, err)
+		}
+
+		return repo.WriteCommit(ctx, WriteCommitConfig{
+			TreeID:         treeOID,
+			Parents:        parents,
+			AuthorName:     "Gitaly",
+			AuthorEmail:    "gitaly@gitlab.com",
+			AuthorDate:     time.Unix(0, 0).UTC(),
+			CommitterName:  "Gitaly",
+			CommitterEmail: "gitaly@gitlab.com",
+			CommitterDate:  time.Unix(0, 0).UTC(),
+			Message:        "merge base",
+		})
+	}
+
+	baseCommit, err := writeCommit(base)
+	if err != nil {
+		return "", "", err
+	}
+
+	oursCommit, err := writeCommit(git.Revision(ours), baseCommit)
+	if err != nil {
+		return "", "", err
+	}
+
+	theirsCommit, err := writeCommit(git.Revision(theirs), baseCommit)
+	if err != nil {
+		return "", "", err
+	}
+
+	return oursCommit.String(), theirsCommit.String(), nil
+}
+
 // parseMergeTreeError parses the output from git-merge-tree(1)'s stdout into
 // a MergeTreeResult struct. The format for the output can be found at
 // https://git-scm.com/docs/git-merge-tree#OUTPUT.
@@ -116,11 +279,119 @@ func parseMergeTreeError(output string) error {
 	return &mergeTreeError
 }
 
+// parseMergeTreeConflictInfo parses the NUL-separated output from git-merge-tree(1)'s stdout when
+// it has been invoked with `-z` but without `--name-only`.
+func parseMergeTreeConflictInfo(objectHash git.ObjectHash, output string) error {
+	fields := strings.Split(output, "\x00")
+	if len(fields) < 2 {
+		return errors.New("error parsing merge tree result")
+	}
+
+	treeOID, err := objectHash.FromHex(fields[0])
+	if err != nil {
+		return fmt.Errorf("parsing tree ID: %w", err)
+	}
+
+	mergeTreeError := MergeTreeError{
+		TreeOID: treeOID,
+	}
+
+	// The conflicted file info section is terminated by an empty field. What follows are the
+	// informational messages, which we don't parse as their format is not meant to be
+	// machine-readable.
+	for _, field := range fields[1:] {
+		if field == "" {
+			break
+		}
+
+		info, err := parseConflictingFileInfo(objectHash, field)
+		if err != nil {
+			return err
+		}
+
+		if len(mergeTreeError.ConflictingFiles) == 0 ||
+			mergeTreeError.ConflictingFiles[len(mergeTreeError.ConflictingFiles)-1] != info.FileName {
+			mergeTreeError.ConflictingFiles = append(mergeTreeError.ConflictingFiles, info.FileName)
+		}
+
+		mergeTreeError.ConflictInfo = append(mergeTreeError.ConflictInfo, info)
+	}
+
+	return &mergeTreeError
+}
+
+// parseConflictingFileInfo parses a single line of conflicted file info, which has the format
+// `<mode> <object> <stage>\t<filename>`.
+func parseConflictingFileInfo(objectHash git.ObjectHash, line string) (ConflictingFileInfo, error) {
+	metadata, fileName, found := strings.Cut(line, "\t")
+	if !found {
+		return ConflictingFileInfo{}, fmt.Errorf("invalid conflicted file info: %q", line)
+	}
+
+	fields := strings.Split(metadata, " ")
+	if len(fields) != 3 {
+		return ConflictingFileInfo{}, fmt.Errorf("invalid conflicted file info: %q", line)
+	}
+
+	mode, err := strconv.ParseInt(fields[0], 8, 32)
+	if err != nil {
+		return ConflictingFileInfo{}, fmt.Errorf("parsing mode: %w", err)
+	}
+
+	oid, err := objectHash.FromHex(fields[1])
+	if err != nil {
+		return ConflictingFileInfo{}, fmt.Errorf("parsing object ID: %w", err)
+	}
+
+	stage, err := strconv.ParseUint(fields[2], 10, 8)
+	if err != nil || stage < uint64(MergeStageAncestor) || stage > uint64(MergeStageTheirs) {
+		return ConflictingFileInfo{}, fmt.Errorf("invalid stage: %q", fields[2])
+	}
+
+	return ConflictingFileInfo{
+		FileName: fileName,
+		OID:      oid,
+		Mode:     int32(mode),
+		Stage:    MergeStage(stage),
+	}, nil
+}
+
+// MergeStage denotes the side of a merge a conflicting file belongs to.
+type MergeStage uint
+
+const (
+	// MergeStageAncestor is the stage of the file in the merge base.
+	MergeStageAncestor MergeStage = 1
+	// MergeStageOurs is the stage of the file in our side of the merge.
+	MergeStageOurs MergeStage = 2
+	// MergeStageTheirs is the stage of the file in their side of the merge.
+	MergeStageTheirs MergeStage = 3
+)
+
+// ConflictingFileInfo contains information about one side of a conflicting file.
+type ConflictingFileInfo struct {
+	// FileName is the path of the file.
+	FileName string
+	// OID is the object ID of the file's blob.
+	OID git.ObjectID
+	// Mode is the file mode of the file.
+	Mode int32
+	// Stage is the side of the merge this entry belongs to.
+	Stage MergeStage
+}
+
 // MergeTreeError encapsulates any conflicting files and messages that occur
 // when a merge-tree(1) command fails.
 type MergeTreeError struct {
 	ConflictingFiles []string
 	InfoMessage      string
+	// ConflictInfo contains the detailed information about each side of every conflicting
+	// file. It is only set when WithConflictingFileInfo has been passed.
+	ConflictInfo []ConflictingFileInfo
+	// TreeOID is the object ID of the merged tree, which contains conflict markers for all
+	// files with content conflicts. It is only set when WithConflictingFileInfo has been
+	// passed.
+	TreeOID git.ObjectID
 }
 
 // Error returns the error string for a conflict error.
@@ -0,0 +1,138 @@
+package localrepo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/command"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+)
+
+// Conflict groups all sides of a single conflicting file. Sides which do not exist are nil, e.g.
+// when the file has been deleted on one side of the merge.
+type Conflict struct {
+	Ancestor *ConflictingFileInfo
+	Ours     *ConflictingFileInfo
+	Theirs   *ConflictingFileInfo
+}
+
+// Conflicts groups the detailed conflict information by file name. It requires the merge to have
+// been computed with WithConflictingFileInfo.
+func (c *MergeTreeError) Conflicts() []Conflict {
+	var conflicts []Conflict
+
+	for i := range c.ConflictInfo {
+		info := &c.ConflictInfo[i]
+
+		// git-merge-tree(1) prints all stages of a given file next to each other, so we
+		// only need to check whether the current entry belongs to the previous conflict.
+		if len(conflicts) == 0 || conflicts[len(conflicts)-1].path() != info.FileName {
+			conflicts = append(conflicts, Conflict{})
+		}
+
+		conflict := &conflicts[len(conflicts)-1]
+		switch info.Stage {
+		case MergeStageAncestor:
+			conflict.Ancestor = info
+		case MergeStageOurs:
+			conflict.Ours = info
+		case MergeStageTheirs:
+			conflict.Theirs = info
+		}
+	}
+
+	return conflicts
+}
+
+func (c Conflict) path() string {
+	for _, side := range []*ConflictingFileInfo{c.Ancestor, c.Ours, c.Theirs} {
+		if side != nil {
+			return side.FileName
+		}
+	}
+
+	return ""
+}
+
+// MergeFile performs a three-way merge of the sides of the given conflict via git-merge-file(1).
+// The returned contents contain conflict markers for all conflicting hunks, which are labelled with
+// the paths of the respective sides. Sides which do not exist are treated as empty files.
+func (repo *Repo) MergeFile(ctx context.Context, conflict Conflict) (returnedContents []byte, returnedErr error) {
+	// We cannot use the tempdir package here as it transitively depends on this package, so we
+	// create the directory in the storage's temporary directory ourselves. This makes sure that
+	// it is cleaned up by the storage's cleanup routines even if we fail to remove it.
+	storageTempDir, err := repo.locator.TempDir(repo.GetStorageName())
+	if err != nil {
+		return nil, fmt.Errorf("getting temporary directory: %w", err)
+	}
+
+	if err := os.MkdirAll(storageTempDir, perm.PrivateDir); err != nil {
+		return nil, fmt.Errorf("creating storage temporary directory: %w", err)
+	}
+
+	tmpDir, err := os.MkdirTemp(storageTempDir, "merge-file-")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(tmpDir); err != nil && returnedErr == nil {
+			returnedErr = fmt.Errorf("removing temporary directory: %w", err)
+		}
+	}()
+
+	// git-merge-file(1) expects the labels and files in the order ours, base, theirs.
+	var labels, files []string
+	for _, side := range []struct {
+		name string
+		info *ConflictingFileInfo
+	}{
+		{name: "ours", info: conflict.Ours},
+		{name: "base", info: conflict.Ancestor},
+		{name: "theirs", info: conflict.Theirs},
+	} {
+		var contents []byte
+		label := conflict.path()
+
+		if side.info != nil {
+			contents, err = repo.ReadObject(ctx, side.info.OID)
+			if err != nil {
+				return nil, fmt.Errorf("reading %s blob: %w", side.name, err)
+			}
+
+			label = side.info.FileName
+		}
+
+		path := filepath.Join(tmpDir, side.name)
+		if err := os.WriteFile(path, contents, perm.PrivateFile); err != nil {
+			return nil, fmt.Errorf("writing %s file: %w", side.name, err)
+		}
+
+		labels = append(labels, label)
+		files = append(files, path)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := repo.ExecAndWait(ctx, git.Command{
+		Name: "merge-file",
+		Flags: []git.Option{
+			git.Flag{Name: "--stdout"},
+			git.ValueFlag{Name: "-L", Value: labels[0]},
+			git.ValueFlag{Name: "-L", Value: labels[1]},
+			git.ValueFlag{Name: "-L", Value: labels[2]},
+		},
+		Args: files,
+	}, git.WithStdout(&stdout), git.WithStderr(&stderr)); err != nil {
+		// git-merge-file(1) exits with the number of conflicts, which is capped at 127.
+		// Errors are indicated by a negative exit code.
+		exitCode, ok := command.ExitStatus(err)
+		if !ok || exitCode < 0 || exitCode > 127 {
+			return nil, fmt.Errorf("merge-file: %w, stderr: %q", err, stderr.String())
+		}
+	}
+
+	return stdout.Bytes(), nil
+}
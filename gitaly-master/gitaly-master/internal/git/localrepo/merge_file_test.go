@@ -0,0 +1,91 @@
+package localrepo
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+)
+
+func TestRepo_MergeFile(t *testing.T) {
+	t.Parallel()
+
+	cfg := testcfg.Build(t)
+	ctx := testhelper.Context(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo := NewTestRepo(t, cfg, repoProto)
+
+	baseBlob := gittest.WriteBlob(t, cfg, repoPath, []byte("a\nb\nc\n"))
+	oursBlob := gittest.WriteBlob(t, cfg, repoPath, []byte("a\nours\nc\n"))
+	theirsBlob := gittest.WriteBlob(t, cfg, repoPath, []byte("a\ntheirs\nc\n"))
+	mergeableBlob := gittest.WriteBlob(t, cfg, repoPath, []byte("a\nb\nc\nd\n"))
+
+	// Temporary files are written into the storage's temporary directory and must be removed
+	// once all subtests have finished.
+	t.Cleanup(func() {
+		tempDir, err := repo.StorageTempDir()
+		require.NoError(t, err)
+
+		entries, err := os.ReadDir(tempDir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	for _, tc := range []struct {
+		desc             string
+		conflict         Conflict
+		expectedContents string
+	}{
+		{
+			desc: "content conflict",
+			conflict: Conflict{
+				Ancestor: &ConflictingFileInfo{FileName: "file", OID: baseBlob},
+				Ours:     &ConflictingFileInfo{FileName: "file", OID: oursBlob},
+				Theirs:   &ConflictingFileInfo{FileName: "file", OID: theirsBlob},
+			},
+			expectedContents: "a\n<<<<<<< file\nours\n=======\ntheirs\n>>>>>>> file\nc\n",
+		},
+		{
+			desc: "renamed file",
+			conflict: Conflict{
+				Ancestor: &ConflictingFileInfo{FileName: "base", OID: baseBlob},
+				Ours:     &ConflictingFileInfo{FileName: "ours", OID: oursBlob},
+				Theirs:   &ConflictingFileInfo{FileName: "theirs", OID: theirsBlob},
+			},
+			expectedContents: "a\n<<<<<<< ours\nours\n=======\ntheirs\n>>>>>>> theirs\nc\n",
+		},
+		{
+			desc: "missing ancestor",
+			conflict: Conflict{
+				Ours:   &ConflictingFileInfo{FileName: "file", OID: oursBlob},
+				Theirs: &ConflictingFileInfo{FileName: "file", OID: theirsBlob},
+			},
+			expectedContents: "a\n<<<<<<< file\nours\n=======\ntheirs\n>>>>>>> file\nc\n",
+		},
+		{
+			desc: "mergeable",
+			conflict: Conflict{
+				Ancestor: &ConflictingFileInfo{FileName: "file", OID: baseBlob},
+				Ours:     &ConflictingFileInfo{FileName: "file", OID: baseBlob},
+				Theirs:   &ConflictingFileInfo{FileName: "file", OID: mergeableBlob},
+			},
+			expectedContents: "a\nb\nc\nd\n",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			contents, err := repo.MergeFile(ctx, tc.conflict)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedContents, string(contents))
+		})
+	}
+}
@@ -2,11 +2,14 @@ package localrepo
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
 )
@@ -327,3 +330,181 @@ CONFLICT (content): Merge conflict in file1
 		})
 	}
 }
+
+func TestMergeTree_mergeBase(t *testing.T) {
+	t.Parallel()
+
+	cfg := testcfg.Build(t)
+	ctx := testhelper.Context(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo := NewTestRepo(t, cfg, repoProto)
+
+	base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "a\nb\nc\n"},
+	))
+	ours := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "a\nb\nc\n"},
+		gittest.TreeEntry{Mode: "100644", Path: "ours", Content: "ours"},
+	))
+	// The picked commit is based on a different history than ours and both introduces a new
+	// file and modifies an existing one.
+	pickedParent := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "a\nb\nc\n"},
+		gittest.TreeEntry{Mode: "100644", Path: "unrelated", Content: "unrelated"},
+	))
+	picked := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(pickedParent), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", Content: "a\nb\nc\nd\n"},
+		gittest.TreeEntry{Mode: "100644", Path: "unrelated", Content: "unrelated"},
+		gittest.TreeEntry{Mode: "100644", Path: "theirs", Content: "theirs"},
+	))
+
+	t.Run("cherry-pick", func(t *testing.T) {
+		treeOID, err := repo.MergeTree(ctx, ours.String(), picked.String(), WithMergeBase(pickedParent.Revision()))
+		require.NoError(t, err)
+
+		// Only the changes introduced by the picked commit must have been applied, so
+		// "unrelated" must not be part of the resulting tree.
+		gittest.RequireTree(t, cfg, repoPath, treeOID.String(), []gittest.TreeEntry{
+			{Mode: "100644", Path: "file", Content: "a\nb\nc\nd\n"},
+			{Mode: "100644", Path: "ours", Content: "ours"},
+			{Mode: "100644", Path: "theirs", Content: "theirs"},
+		})
+	})
+
+	t.Run("revert", func(t *testing.T) {
+		treeOID, err := repo.MergeTree(ctx, picked.String(), pickedParent.String(), WithMergeBase(picked.Revision()))
+		require.NoError(t, err)
+
+		gittest.RequireTree(t, cfg, repoPath, treeOID.String(), []gittest.TreeEntry{
+			{Mode: "100644", Path: "file", Content: "a\nb\nc\n"},
+			{Mode: "100644", Path: "unrelated", Content: "unrelated"},
+		})
+	})
+
+	t.Run("empty tree", func(t *testing.T) {
+		treeOID, err := repo.MergeTree(ctx, ours.String(), base.String(), WithMergeBase(gittest.DefaultObjectHash.EmptyTreeOID.Revision()))
+		require.NoError(t, err)
+
+		gittest.RequireTree(t, cfg, repoPath, treeOID.String(), []gittest.TreeEntry{
+			{Mode: "100644", Path: "file", Content: "a\nb\nc\n"},
+			{Mode: "100644", Path: "ours", Content: "ours"},
+		})
+	})
+
+	// The synthetic commits used to set up the merge base must not have been written into the
+	// repository.
+	objects := gittest.Exec(t, cfg, "-C", repoPath, "cat-file", "--batch-all-objects", "--batch-check=%(objecttype) %(objectname)")
+	var commits []string
+	for _, object := range strings.Split(text.ChompBytes(objects), "\n") {
+		if strings.HasPrefix(object, "commit ") {
+			commits = append(commits, strings.TrimPrefix(object, "commit "))
+		}
+	}
+	require.ElementsMatch(t, []string{base.String(), ours.String(), pickedParent.String(), picked.String()}, commits)
+}
+
+func TestMergeTree_conflictingFileInfo(t *testing.T) {
+	t.Parallel()
+
+	cfg := testcfg.Build(t)
+	ctx := testhelper.Context(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo := NewTestRepo(t, cfg, repoProto)
+
+	baseBlob := gittest.WriteBlob(t, cfg, repoPath, []byte("base\n"))
+	oursBlob := gittest.WriteBlob(t, cfg, repoPath, []byte("ours\n"))
+	theirsBlob := gittest.WriteBlob(t, cfg, repoPath, []byte("theirs\n"))
+
+	base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", OID: baseBlob},
+		gittest.TreeEntry{Mode: "100644", Path: "deleted", OID: baseBlob},
+	))
+	ours := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "file", OID: oursBlob},
+	))
+	theirs := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100755", Path: "file", OID: theirsBlob},
+		gittest.TreeEntry{Mode: "100644", Path: "deleted", OID: theirsBlob},
+	))
+
+	_, err := repo.MergeTree(ctx, ours.String(), theirs.String(), WithConflictingFileInfo())
+
+	var mergeTreeErr *MergeTreeError
+	require.ErrorAs(t, err, &mergeTreeErr)
+	require.NotEmpty(t, mergeTreeErr.TreeOID)
+	require.Equal(t, []string{"deleted", "file"}, mergeTreeErr.ConflictingFiles)
+	require.Equal(t, []ConflictingFileInfo{
+		{FileName: "deleted", OID: baseBlob, Mode: 0o100644, Stage: MergeStageAncestor},
+		{FileName: "deleted", OID: theirsBlob, Mode: 0o100644, Stage: MergeStageTheirs},
+		{FileName: "file", OID: baseBlob, Mode: 0o100644, Stage: MergeStageAncestor},
+		{FileName: "file", OID: oursBlob, Mode: 0o100644, Stage: MergeStageOurs},
+		{FileName: "file", OID: theirsBlob, Mode: 0o100755, Stage: MergeStageTheirs},
+	}, mergeTreeErr.ConflictInfo)
+
+	require.Equal(t, []Conflict{
+		{
+			Ancestor: &mergeTreeErr.ConflictInfo[0],
+			Theirs:   &mergeTreeErr.ConflictInfo[1],
+		},
+		{
+			Ancestor: &mergeTreeErr.ConflictInfo[2],
+			Ours:     &mergeTreeErr.ConflictInfo[3],
+			Theirs:   &mergeTreeErr.ConflictInfo[4],
+		},
+	}, mergeTreeErr.Conflicts())
+}
+
+func TestParseMergeTreeConflictInfo(t *testing.T) {
+	t.Parallel()
+
+	objectHash := gittest.DefaultObjectHash
+	blobID := objectHash.EmptyTreeOID.String()
+
+	for _, tc := range []struct {
+		desc        string
+		output      string
+		expectedErr error
+	}{
+		{
+			desc:   "single conflict",
+			output: objectHash.EmptyTreeOID.String() + "\x00100644 " + blobID + " 2\tfile\x00100644 " + blobID + " 3\tfile\x00\x00" + "1\x00file\x00Auto-merging\x00Auto-merging file\n\x00",
+			expectedErr: &MergeTreeError{
+				TreeOID:          objectHash.EmptyTreeOID,
+				ConflictingFiles: []string{"file"},
+				ConflictInfo: []ConflictingFileInfo{
+					{FileName: "file", OID: git.ObjectID(blobID), Mode: 0o100644, Stage: MergeStageOurs},
+					{FileName: "file", OID: git.ObjectID(blobID), Mode: 0o100644, Stage: MergeStageTheirs},
+				},
+			},
+		},
+		{
+			desc:        "invalid tree ID",
+			output:      "foobar\x00\x00",
+			expectedErr: fmt.Errorf("parsing tree ID: %w", git.InvalidObjectIDLengthError{OID: "foobar", CorrectLength: objectHash.EncodedLen(), Length: 6}),
+		},
+		{
+			desc:        "invalid stage",
+			output:      objectHash.EmptyTreeOID.String() + "\x00100644 " + blobID + " 4\tfile\x00\x00",
+			expectedErr: fmt.Errorf("invalid stage: %q", "4"),
+		},
+		{
+			desc:        "missing file name",
+			output:      objectHash.EmptyTreeOID.String() + "\x00100644 " + blobID + " 1\x00\x00",
+			expectedErr: fmt.Errorf("invalid conflicted file info: %q", "100644 "+blobID+" 1"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expectedErr, parseMergeTreeConflictInfo(objectHash, tc.output))
+		})
+	}
+}
@@ -549,7 +549,7 @@ type blockingManager struct {
 	ch chan struct{}
 }
 
-func (b *blockingManager) Vote(_ context.Context, _ txinfo.Transaction, _ voting.Vote, phase voting.Phase) error {
+func (b *blockingManager) Vote(_ context.Context, _ txinfo.Transaction, _ voting.Vote, phase voting.Phase, _ ...transaction.VoteOption) error {
 	// the purpose of this is to block SetDefaultBranch from completing, so just choose to block on
 	// a Prepared vote.
 	if phase == voting.Prepared {
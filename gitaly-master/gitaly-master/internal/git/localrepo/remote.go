@@ -1,6 +1,7 @@
 package localrepo
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
@@ -234,6 +235,47 @@ type PushOptions struct {
 
 // Push force pushes the refspecs to the remote.
 func (repo *Repo) Push(ctx context.Context, remote string, refspecs []string, options PushOptions) error {
+	return repo.push(ctx, remote, refspecs, options, nil)
+}
+
+// PushResult is the result of pushing a single reference.
+type PushResult struct {
+	// Reference is the name of the reference in the remote repository.
+	Reference git.ReferenceName
+	// Rejected is set if the remote repository rejected the update of the reference.
+	Rejected bool
+	// Summary is git-push(1)'s summary of the update, e.g. "[rejected] (non-fast-forward)".
+	Summary string
+}
+
+// PushWithResults pushes the refspecs to the remote and returns the result for each of the
+// pushed references. In contrast to Push, it does not return an error if the remote has only
+// rejected some of the references. The caller should thus check each result for rejections.
+func (repo *Repo) PushWithResults(ctx context.Context, remote string, refspecs []string, options PushOptions) ([]PushResult, error) {
+	var stdout bytes.Buffer
+	pushErr := repo.push(ctx, remote, refspecs, options, &stdout,
+		git.Flag{Name: "--porcelain"},
+	)
+
+	results, err := parsePushPorcelain(&stdout)
+	if err != nil {
+		return nil, fmt.Errorf("parse push output: %w", err)
+	}
+
+	if pushErr != nil {
+		for _, result := range results {
+			if result.Rejected {
+				return results, nil
+			}
+		}
+
+		return nil, pushErr
+	}
+
+	return results, nil
+}
+
+func (repo *Repo) push(ctx context.Context, remote string, refspecs []string, options PushOptions, stdout io.Writer, flags ...git.Option) error {
 	if len(refspecs) == 0 {
 		return errors.New("refspecs to push must be explicitly specified")
 	}
@@ -243,24 +285,67 @@ func (repo *Repo) Push(ctx context.Context, remote string, refspecs []string, op
 		env = append(env, envGitSSHCommand(options.SSHCommand))
 	}
 
-	var flags []git.Option
 	if options.Force {
 		flags = append(flags, git.Flag{Name: "--force"})
 	}
 
 	stderr := &bytes.Buffer{}
+	opts := []git.CmdOpt{
+		git.WithStderr(stderr),
+		git.WithEnv(env...),
+		git.WithConfigEnv(options.Config...),
+	}
+	if stdout != nil {
+		opts = append(opts, git.WithStdout(stdout))
+	}
+
 	if err := repo.ExecAndWait(ctx,
 		git.Command{
 			Name:  "push",
 			Flags: flags,
 			Args:  append([]string{remote}, refspecs...),
 		},
-		git.WithStderr(stderr),
-		git.WithEnv(env...),
-		git.WithConfigEnv(options.Config...),
+		opts...,
 	); err != nil {
 		return fmt.Errorf("git push: %w, stderr: %q", err, stderr)
 	}
 
 	return nil
 }
+
+// parsePushPorcelain parses the output of `git push --porcelain`. Each pushed reference is
+// printed as "<flag> TAB <from>:<to> TAB <summary> (<reason>)".
+func parsePushPorcelain(r io.Reader) ([]PushResult, error) {
+	var results []PushResult
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(line) < 2 || line[1] != '\t' {
+			// Lines like "To <url>" or "Done" don't describe any reference.
+			continue
+		}
+
+		fields := strings.SplitN(line[2:], "\t", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid line: %q", line)
+		}
+
+		colon := strings.LastIndexByte(fields[0], ':')
+		if colon < 0 {
+			return nil, fmt.Errorf("invalid refspec: %q", fields[0])
+		}
+
+		results = append(results, PushResult{
+			Reference: git.ReferenceName(fields[0][colon+1:]),
+			Rejected:  line[0] == '!',
+			Summary:   fields[1],
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -405,3 +406,73 @@ func TestRepo_Push(t *testing.T) {
 		})
 	}
 }
+
+func TestRepo_PushWithResults(t *testing.T) {
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	sourceRepoProto, sourceRepoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	sourceRepo := NewTestRepo(t, cfg, sourceRepoProto)
+	sourceMaster := gittest.WriteCommit(t, cfg, sourceRepoPath, gittest.WithBranch("master"))
+	gittest.WriteCommit(t, cfg, sourceRepoPath, gittest.WithBranch("feature"))
+
+	_, targetRepoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	require.NoError(t, sourceRepo.Push(ctx, targetRepoPath, []string{"refs/heads/master"}, PushOptions{}))
+	divergedMaster := gittest.WriteCommit(t, cfg, targetRepoPath,
+		gittest.WithBranch("master"),
+		gittest.WithParents(sourceMaster),
+		gittest.WithMessage("diverged"),
+	)
+	gittest.WriteCommit(t, cfg, targetRepoPath, gittest.WithBranch("stale"))
+
+	t.Run("partially rejected", func(t *testing.T) {
+		results, err := sourceRepo.PushWithResults(ctx, targetRepoPath, []string{
+			"refs/heads/master", "refs/heads/feature", ":refs/heads/stale",
+		}, PushOptions{})
+		require.NoError(t, err)
+
+		require.Len(t, results, 3)
+		resultsByRef := map[git.ReferenceName]PushResult{}
+		for _, result := range results {
+			resultsByRef[result.Reference] = result
+		}
+
+		require.True(t, resultsByRef["refs/heads/master"].Rejected)
+		require.Contains(t, resultsByRef["refs/heads/master"].Summary, "[rejected]")
+		require.Equal(t, PushResult{Reference: "refs/heads/feature", Summary: "[new branch]"}, resultsByRef["refs/heads/feature"])
+		require.Equal(t, PushResult{Reference: "refs/heads/stale", Summary: "[deleted]"}, resultsByRef["refs/heads/stale"])
+
+		require.Equal(t, divergedMaster, gittest.ResolveRevision(t, cfg, targetRepoPath, "refs/heads/master"))
+		gittest.Exec(t, cfg, "-C", targetRepoPath, "rev-parse", "--verify", "refs/heads/feature")
+	})
+
+	t.Run("invalid remote", func(t *testing.T) {
+		_, err := sourceRepo.PushWithResults(ctx, "", []string{"refs/heads/master"}, PushOptions{})
+		require.EqualError(t, err, `git push: exit status 128, stderr: "fatal: no path specified; see 'git help pull' for valid url syntax\n"`)
+	})
+}
+
+func TestParsePushPorcelain(t *testing.T) {
+	results, err := parsePushPorcelain(strings.NewReader(strings.Join([]string{
+		"To /path/to/remote.git",
+		"*\trefs/heads/feature:refs/heads/feature\t[new branch]",
+		"+\trefs/heads/master:refs/heads/master\t1234567...89abcde (forced update)",
+		"-\t:refs/heads/stale\t[deleted]",
+		"!\trefs/heads/protected:refs/heads/protected\t[remote rejected] (pre-receive hook declined)",
+		"Done",
+	}, "\n")))
+	require.NoError(t, err)
+	require.Equal(t, []PushResult{
+		{Reference: "refs/heads/feature", Summary: "[new branch]"},
+		{Reference: "refs/heads/master", Summary: "1234567...89abcde (forced update)"},
+		{Reference: "refs/heads/stale", Summary: "[deleted]"},
+		{Reference: "refs/heads/protected", Rejected: true, Summary: "[remote rejected] (pre-receive hook declined)"},
+	}, results)
+
+	_, err = parsePushPorcelain(strings.NewReader("*\trefs/heads/feature"))
+	require.EqualError(t, err, `invalid line: "*\trefs/heads/feature"`)
+}
@@ -234,3 +234,13 @@ func (repo *Repo) ObjectHash(ctx context.Context) (git.ObjectHash, error) {
 	})
 	return repo.objectHash, repo.objectHashErr
 }
+
+// ReferenceBackend detects the reference backend used by this particular repository.
+func (repo *Repo) ReferenceBackend(ctx context.Context) (git.ReferenceBackend, error) {
+	repoPath, err := repo.Path()
+	if err != nil {
+		return git.ReferenceBackend{}, err
+	}
+
+	return git.DetectReferenceBackend(repoPath)
+}
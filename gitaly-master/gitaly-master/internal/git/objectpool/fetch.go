@@ -34,6 +34,10 @@ func (o *ObjectPool) FetchFromOrigin(ctx context.Context, origin *localrepo.Repo
 		return fmt.Errorf("computing origin repo's path: %w", err)
 	}
 
+	if err := o.verifyObjectHash(ctx, origin); err != nil {
+		return err
+	}
+
 	if err := o.housekeepingManager.CleanStaleData(ctx, o.Repo); err != nil {
 		return fmt.Errorf("cleaning stale data: %w", err)
 	}
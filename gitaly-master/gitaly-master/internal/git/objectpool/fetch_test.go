@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
@@ -255,6 +256,25 @@ func TestFetchFromOrigin_missingPool(t *testing.T) {
 	require.False(t, pool.Exists())
 }
 
+func TestFetchFromOrigin_objectHashMismatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, pool, _ := setupObjectPool(t, ctx)
+
+	originProto, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+		ObjectFormat:           mismatchingObjectFormat(),
+	})
+	origin := localrepo.NewTestRepo(t, cfg, originProto)
+
+	require.Equal(t, structerr.NewFailedPrecondition("object pool and repository use different object hashes").
+		WithMetadata("object_pool_object_hash", gittest.DefaultObjectHash.Format).
+		WithMetadata("repository_object_hash", mismatchingObjectFormat()),
+		pool.FetchFromOrigin(ctx, origin),
+	)
+}
+
 func TestObjectPool_logStats(t *testing.T) {
 	t.Parallel()
 
@@ -276,6 +296,9 @@ func TestObjectPool_logStats(t *testing.T) {
 				"references.normal":   referencedObjectTypes{},
 				"repository_info": stats.RepositoryInfo{
 					IsObjectPool: true,
+					References: stats.ReferencesInfo{
+						ReferenceBackendName: "files",
+					},
 				},
 			},
 		},
@@ -298,6 +321,7 @@ func TestObjectPool_logStats(t *testing.T) {
 						Size:  hashDependentSize(142, 158),
 					},
 					References: stats.ReferencesInfo{
+						ReferenceBackendName: "files",
 						LooseReferencesCount: 1,
 					},
 				},
@@ -322,6 +346,7 @@ func TestObjectPool_logStats(t *testing.T) {
 						Size:  hashDependentSize(142, 158),
 					},
 					References: stats.ReferencesInfo{
+						ReferenceBackendName: "files",
 						LooseReferencesCount: 1,
 					},
 				},
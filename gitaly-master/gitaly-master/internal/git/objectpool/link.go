@@ -27,6 +27,10 @@ func (o *ObjectPool) Link(ctx context.Context, repo *localrepo.Repo) (returnedEr
 		return err
 	}
 
+	if err := o.verifyObjectHash(ctx, repo); err != nil {
+		return err
+	}
+
 	linked, err := o.LinkedToRepository(repo)
 	if err != nil {
 		return err
@@ -13,6 +13,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/transaction/txinfo"
 	"google.golang.org/grpc/peer"
@@ -99,6 +100,30 @@ func TestLink_removeBitmap(t *testing.T) {
 	gittest.Exec(t, cfg, "-C", repoPath, "fsck")
 }
 
+func TestLink_objectHashMismatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	cfg, pool, _ := setupObjectPool(t, ctx)
+
+	repoProto, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+		ObjectFormat:           mismatchingObjectFormat(),
+	})
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	require.Equal(t, structerr.NewFailedPrecondition("object pool and repository use different object hashes").
+		WithMetadata("object_pool_object_hash", gittest.DefaultObjectHash.Format).
+		WithMetadata("repository_object_hash", mismatchingObjectFormat()),
+		pool.Link(ctx, repo),
+	)
+
+	altPath, err := repo.InfoAlternatesPath()
+	require.NoError(t, err)
+	require.NoFileExists(t, altPath)
+}
+
 func requireHasBitmap(t *testing.T, repo *localrepo.Repo, expected bool) {
 	packfilesInfo, err := stats.PackfilesInfoForRepository(repo)
 	require.NoError(t, err)
@@ -18,6 +18,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 )
 
@@ -121,6 +122,28 @@ func (o *ObjectPool) IsValid() bool {
 	return storage.IsGitDirectory(path)
 }
 
+// verifyObjectHash verifies that the given repository uses the same object hash as the object
+// pool. Repositories with different object hashes cannot share objects with each other.
+func (o *ObjectPool) verifyObjectHash(ctx context.Context, repo *localrepo.Repo) error {
+	poolObjectHash, err := o.Repo.ObjectHash(ctx)
+	if err != nil {
+		return fmt.Errorf("detecting object pool object hash: %w", err)
+	}
+
+	repoObjectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return fmt.Errorf("detecting repository object hash: %w", err)
+	}
+
+	if poolObjectHash.Format != repoObjectHash.Format {
+		return structerr.NewFailedPrecondition("object pool and repository use different object hashes").
+			WithMetadata("object_pool_object_hash", poolObjectHash.Format).
+			WithMetadata("repository_object_hash", repoObjectHash.Format)
+	}
+
+	return nil
+}
+
 // Remove will remove the pool, and all its contents without preparing and/or
 // updating the repositories depending on this object pool
 // Subdirectories will remain to exist, and will never be cleaned up, even when
@@ -63,3 +63,11 @@ func hashDependentSize(sha1Size, sha256Size uint64) uint64 {
 	}
 	return sha1Size
 }
+
+// mismatchingObjectFormat returns the object format that is not used by default in tests.
+func mismatchingObjectFormat() string {
+	if gittest.ObjectHashIsSHA256() {
+		return git.ObjectHashSHA1.Format
+	}
+	return git.ObjectHashSHA256.Format
+}
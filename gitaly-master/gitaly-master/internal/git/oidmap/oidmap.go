@@ -0,0 +1,147 @@
+// Package oidmap implements the mapping from legacy object IDs to the object IDs of repositories
+// that have been converted to a different object format.
+//
+// The mapping is stored in a single file in the repository. Each line of that file maps a legacy
+// object ID to its current object ID, separated by a single space. Lines are sorted by the legacy
+// object ID. Because both object formats have a fixed length, all lines have the same width and
+// we can thus perform a binary search on the file without having to read it completely.
+package oidmap
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+)
+
+// Filename is the name of the file in the repository's directory that stores the mapping.
+const Filename = "gitaly-object-id-map"
+
+// Entry maps a legacy object ID to its current object ID.
+type Entry struct {
+	// LegacyOID is the object ID in the repository's previous object format.
+	LegacyOID git.ObjectID
+	// OID is the object ID in the repository's current object format.
+	OID git.ObjectID
+}
+
+// LegacyObjectHash returns the object hash that repositories using the given object hash have
+// been converted from.
+func LegacyObjectHash(objectHash git.ObjectHash) git.ObjectHash {
+	if objectHash.Format == git.ObjectHashSHA256.Format {
+		return git.ObjectHashSHA1
+	}
+	return git.ObjectHashSHA256
+}
+
+// Write writes the mapping into the repository at the given path. Any preexisting mapping will
+// be replaced. Note that the file is not written atomically, so this function should only be
+// called for repositories that are not yet visible to other processes.
+func Write(repoPath string, entries []Entry) (returnedErr error) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].LegacyOID < entries[j].LegacyOID
+	})
+
+	file, err := os.OpenFile(filepath.Join(repoPath, Filename), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm.SharedFile)
+	if err != nil {
+		return fmt.Errorf("creating mapping: %w", err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil && returnedErr == nil {
+			returnedErr = fmt.Errorf("closing mapping: %w", err)
+		}
+	}()
+
+	writer := bufio.NewWriter(file)
+	for _, entry := range entries {
+		if _, err := fmt.Fprintf(writer, "%s %s\n", entry.LegacyOID, entry.OID); err != nil {
+			return fmt.Errorf("writing mapping entry: %w", err)
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("flushing mapping: %w", err)
+	}
+
+	return nil
+}
+
+// Lookup looks up the current object ID for the given legacy object ID in the repository at the
+// given path. The object hash is the object hash currently used by the repository. Returns
+// `false` in case the repository has no mapping or in case the legacy object ID is not part of
+// it.
+func Lookup(repoPath string, objectHash git.ObjectHash, legacyOID string) (git.ObjectID, bool, error) {
+	legacyObjectHash := LegacyObjectHash(objectHash)
+	if err := legacyObjectHash.ValidateHex(legacyOID); err != nil {
+		return "", false, nil
+	}
+
+	file, err := os.Open(filepath.Join(repoPath, Filename))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("opening mapping: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", false, fmt.Errorf("statting mapping: %w", err)
+	}
+
+	lineWidth := int64(legacyObjectHash.EncodedLen() + 1 + objectHash.EncodedLen() + 1)
+	if info.Size()%lineWidth != 0 {
+		return "", false, fmt.Errorf("mapping has unexpected size %d", info.Size())
+	}
+
+	line := make([]byte, lineWidth)
+	readLine := func(i int64) error {
+		if _, err := file.ReadAt(line, i*lineWidth); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("reading mapping entry: %w", err)
+		}
+		return nil
+	}
+
+	var readErr error
+	entries := info.Size() / lineWidth
+	index := sort.Search(int(entries), func(i int) bool {
+		if readErr != nil {
+			return true
+		}
+
+		if readErr = readLine(int64(i)); readErr != nil {
+			return true
+		}
+
+		return string(line[:legacyObjectHash.EncodedLen()]) >= legacyOID
+	})
+	if readErr != nil {
+		return "", false, readErr
+	}
+
+	if int64(index) >= entries {
+		return "", false, nil
+	}
+
+	if err := readLine(int64(index)); err != nil {
+		return "", false, err
+	}
+
+	if string(line[:legacyObjectHash.EncodedLen()]) != legacyOID {
+		return "", false, nil
+	}
+
+	oid, err := objectHash.FromHex(string(line[legacyObjectHash.EncodedLen()+1 : lineWidth-1]))
+	if err != nil {
+		return "", false, fmt.Errorf("parsing mapped object ID: %w", err)
+	}
+
+	return oid, true, nil
+}
@@ -0,0 +1,113 @@
+package oidmap
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+)
+
+func TestLegacyObjectHash(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, git.ObjectHashSHA1.Format, LegacyObjectHash(git.ObjectHashSHA256).Format)
+	require.Equal(t, git.ObjectHashSHA256.Format, LegacyObjectHash(git.ObjectHashSHA1).Format)
+}
+
+func TestWriteAndLookup(t *testing.T) {
+	t.Parallel()
+
+	repoPath := testhelper.TempDir(t)
+
+	sha1OID := func(c string) git.ObjectID {
+		return git.ObjectID(strings.Repeat(c, git.ObjectHashSHA1.EncodedLen()))
+	}
+	sha256OID := func(c string) git.ObjectID {
+		return git.ObjectID(strings.Repeat(c, git.ObjectHashSHA256.EncodedLen()))
+	}
+
+	// Entries are intentionally unsorted to verify that Write sorts them.
+	require.NoError(t, Write(repoPath, []Entry{
+		{LegacyOID: sha1OID("c"), OID: sha256OID("3")},
+		{LegacyOID: sha1OID("a"), OID: sha256OID("1")},
+		{LegacyOID: sha1OID("e"), OID: sha256OID("5")},
+		{LegacyOID: sha1OID("b"), OID: sha256OID("2")},
+	}))
+
+	for _, tc := range []struct {
+		desc          string
+		legacyOID     string
+		expectedOID   git.ObjectID
+		expectedFound bool
+	}{
+		{
+			desc:          "first entry",
+			legacyOID:     sha1OID("a").String(),
+			expectedOID:   sha256OID("1"),
+			expectedFound: true,
+		},
+		{
+			desc:          "middle entry",
+			legacyOID:     sha1OID("b").String(),
+			expectedOID:   sha256OID("2"),
+			expectedFound: true,
+		},
+		{
+			desc:          "last entry",
+			legacyOID:     sha1OID("e").String(),
+			expectedOID:   sha256OID("5"),
+			expectedFound: true,
+		},
+		{
+			desc:      "missing entry in between",
+			legacyOID: sha1OID("d").String(),
+		},
+		{
+			desc:      "missing entry after last entry",
+			legacyOID: sha1OID("f").String(),
+		},
+		{
+			desc:      "current object ID",
+			legacyOID: sha256OID("1").String(),
+		},
+		{
+			desc:      "revision",
+			legacyOID: "refs/heads/main",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			oid, found, err := Lookup(repoPath, git.ObjectHashSHA256, tc.legacyOID)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFound, found)
+			require.Equal(t, tc.expectedOID, oid)
+		})
+	}
+}
+
+func TestLookup_missingMapping(t *testing.T) {
+	t.Parallel()
+
+	oid, found, err := Lookup(testhelper.TempDir(t), git.ObjectHashSHA256, strings.Repeat("a", 40))
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Empty(t, oid)
+}
+
+func TestLookup_corruptMapping(t *testing.T) {
+	t.Parallel()
+
+	repoPath := testhelper.TempDir(t)
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, Filename), []byte("garbage\n"), perm.SharedFile))
+
+	_, _, err := Lookup(repoPath, git.ObjectHashSHA256, strings.Repeat("a", 40))
+	require.EqualError(t, err, "mapping has unexpected size 8")
+}
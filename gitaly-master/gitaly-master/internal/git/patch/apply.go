@@ -0,0 +1,189 @@
+package patch
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// HunkError describes a single hunk which failed to apply.
+type HunkError struct {
+	// Index is the 0-based index of the hunk in the patch.
+	Index int
+	// Hunk is the hunk which failed to apply.
+	Hunk Hunk
+}
+
+// ApplyError is returned by Apply in case one or more hunks failed to apply.
+type ApplyError struct {
+	// FailedHunks are all hunks which failed to apply.
+	FailedHunks []HunkError
+	// TotalHunks is the number of hunks of the patch.
+	TotalHunks int
+}
+
+func (e *ApplyError) Error() string {
+	return fmt.Sprintf("%d out of %d hunks failed to apply", len(e.FailedHunks), e.TotalHunks)
+}
+
+// Apply applies the patch to the given content. Hunks are first tried at the position announced
+// by their header, adjusted by the offset of the previously applied hunk. If a hunk doesn't match
+// at that position, it is searched for in both directions. If it still doesn't match, up to
+// maxFuzz leading and trailing context lines of the hunk are ignored to find a match. All hunks
+// are tried so that an ApplyError reports all hunks which failed to apply.
+func Apply(content []byte, patch *Patch, maxFuzz uint) ([]byte, error) {
+	lines := splitLines(content)
+
+	var result [][]byte
+	var failedHunks []HunkError
+	cursor, offset := 0, 0
+
+	for i, hunk := range patch.Hunks {
+		position, expectedPosition, preimage, postimage, ok := locate(lines, cursor, offset, hunk, maxFuzz)
+		if !ok {
+			failedHunks = append(failedHunks, HunkError{Index: i, Hunk: hunk})
+			continue
+		}
+
+		result = append(result, lines[cursor:position]...)
+		result = append(result, postimage...)
+		cursor = position + len(preimage)
+		offset = position - expectedPosition
+	}
+
+	if len(failedHunks) > 0 {
+		return nil, &ApplyError{
+			FailedHunks: failedHunks,
+			TotalHunks:  len(patch.Hunks),
+		}
+	}
+
+	result = append(result, lines[cursor:]...)
+
+	return bytes.Join(result, nil), nil
+}
+
+// locate finds the position at which the hunk applies. Positions before minPosition are not
+// considered as they have been consumed by previous hunks already. It returns the position the
+// hunk applies at, the position it was expected at as well as the pre- and postimage of the hunk
+// with all fuzzed context lines removed.
+func locate(lines [][]byte, minPosition, offset int, hunk Hunk, maxFuzz uint) (int, int, [][]byte, [][]byte, bool) {
+	leadingContext, trailingContext := hunk.context()
+
+	for fuzz := 0; fuzz <= int(maxFuzz); fuzz++ {
+		trimLeading, trimTrailing := fuzz, fuzz
+		if trimLeading > leadingContext {
+			trimLeading = leadingContext
+		}
+		if trimTrailing > trailingContext {
+			trimTrailing = trailingContext
+		}
+		if fuzz > 0 && trimLeading < fuzz && trimTrailing < fuzz {
+			// There are no more context lines we could ignore.
+			break
+		}
+
+		preimage, postimage := hunk.images(trimLeading, trimTrailing)
+
+		// The start of a hunk which doesn't have any preimage lines denotes the line after
+		// which the postimage shall be inserted, whereas it otherwise denotes the first line
+		// of the preimage.
+		expectedPosition := hunk.OldStart + trimLeading + offset
+		if hunk.OldLines > 0 {
+			expectedPosition--
+		}
+
+		if position, ok := search(lines, minPosition, expectedPosition, preimage); ok {
+			return position, expectedPosition, preimage, postimage, true
+		}
+	}
+
+	return 0, 0, nil, nil, false
+}
+
+// search searches for the preimage in lines, starting at the expected position and moving outwards
+// in both directions.
+func search(lines [][]byte, minPosition, expectedPosition int, preimage [][]byte) (int, bool) {
+	maxPosition := len(lines) - len(preimage)
+
+	for delta := 0; expectedPosition+delta <= maxPosition || expectedPosition-delta >= minPosition; delta++ {
+		for _, position := range []int{expectedPosition + delta, expectedPosition - delta} {
+			if position < minPosition || position > maxPosition {
+				continue
+			}
+
+			if matches(lines[position:position+len(preimage)], preimage) {
+				return position, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func matches(lines, preimage [][]byte) bool {
+	for i := range preimage {
+		if !bytes.Equal(lines[i], preimage[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// context returns the number of leading and trailing context lines of the hunk.
+func (h Hunk) context() (int, int) {
+	leading := 0
+	for leading < len(h.Lines) && h.Lines[leading].Op == OpContext {
+		leading++
+	}
+
+	// A hunk consisting of context lines only doesn't have any trailing context.
+	if leading == len(h.Lines) {
+		return leading, 0
+	}
+
+	trailing := 0
+	for trailing < len(h.Lines) && h.Lines[len(h.Lines)-1-trailing].Op == OpContext {
+		trailing++
+	}
+
+	return leading, trailing
+}
+
+// images returns the pre- and postimage of the hunk with the given number of leading and trailing
+// context lines removed.
+func (h Hunk) images(trimLeading, trimTrailing int) ([][]byte, [][]byte) {
+	var preimage, postimage [][]byte
+
+	for _, line := range h.Lines[trimLeading : len(h.Lines)-trimTrailing] {
+		switch line.Op {
+		case OpContext:
+			preimage = append(preimage, line.Content)
+			postimage = append(postimage, line.Content)
+		case OpDelete:
+			preimage = append(preimage, line.Content)
+		case OpAdd:
+			postimage = append(postimage, line.Content)
+		}
+	}
+
+	return preimage, postimage
+}
+
+// splitLines splits the content into lines while retaining their trailing newlines.
+func splitLines(content []byte) [][]byte {
+	var lines [][]byte
+
+	for len(content) > 0 {
+		i := bytes.IndexByte(content, '\n')
+		if i < 0 {
+			lines = append(lines, content)
+			break
+		}
+
+		lines = append(lines, content[:i+1])
+		content = content[i+1:]
+	}
+
+	return lines
+}
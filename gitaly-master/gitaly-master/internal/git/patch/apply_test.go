@@ -0,0 +1,140 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	const content = "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n"
+
+	for _, tc := range []struct {
+		desc            string
+		content         string
+		patch           string
+		maxFuzz         uint
+		expectedContent string
+		expectedErr     func(*Patch) error
+	}{
+		{
+			desc:            "exact match",
+			content:         content,
+			patch:           "@@ -4,3 +4,3 @@\n 4\n-5\n+five\n 6\n",
+			expectedContent: "1\n2\n3\n4\nfive\n6\n7\n8\n9\n10\n",
+		},
+		{
+			desc:            "multiple hunks",
+			content:         content,
+			patch:           "@@ -1,2 +1,3 @@\n 1\n+1.5\n 2\n@@ -9,2 +10,1 @@\n 9\n-10\n",
+			expectedContent: "1\n1.5\n2\n3\n4\n5\n6\n7\n8\n9\n",
+		},
+		{
+			desc:            "offset",
+			content:         "0\n0\n0\n" + content,
+			patch:           "@@ -4,3 +4,3 @@\n 4\n-5\n+five\n 6\n",
+			expectedContent: "0\n0\n0\n1\n2\n3\n4\nfive\n6\n7\n8\n9\n10\n",
+		},
+		{
+			desc:            "negative offset",
+			content:         "4\n5\n6\n",
+			patch:           "@@ -4,3 +4,3 @@\n 4\n-5\n+five\n 6\n",
+			expectedContent: "4\nfive\n6\n",
+		},
+		{
+			desc:            "offset is carried over to subsequent hunks",
+			content:         "x\nx\n1\n2\n1\n2\n",
+			patch:           "@@ -1,2 +1,2 @@\n-1\n+one\n 2\n@@ -3,2 +3,2 @@\n-1\n+uno\n 2\n",
+			expectedContent: "x\nx\none\n2\nuno\n2\n",
+		},
+		{
+			desc:            "insertion",
+			content:         content,
+			patch:           "@@ -10,0 +11 @@\n+11\n",
+			expectedContent: content + "11\n",
+		},
+		{
+			desc:            "creation",
+			content:         "",
+			patch:           "--- /dev/null\n+++ b/file\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+			expectedContent: "a\nb\n",
+		},
+		{
+			desc:            "deletion",
+			content:         "a\nb\n",
+			patch:           "--- a/file\n+++ /dev/null\n@@ -1,2 +0,0 @@\n-a\n-b\n",
+			expectedContent: "",
+		},
+		{
+			desc:            "missing newline",
+			content:         "a\nb",
+			patch:           "@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+c\n",
+			expectedContent: "a\nc\n",
+		},
+		{
+			desc:            "fuzz",
+			content:         content,
+			patch:           "@@ -4,5 +4,5 @@\n changed\n 5\n-6\n+six\n 7\n changed\n",
+			maxFuzz:         1,
+			expectedContent: "1\n2\n3\n4\n5\nsix\n7\n8\n9\n10\n",
+		},
+		{
+			desc:    "insufficient fuzz",
+			content: content,
+			patch:   "@@ -4,5 +4,5 @@\n changed\n 5\n-6\n+six\n 7\n changed\n",
+			expectedErr: func(patch *Patch) error {
+				return &ApplyError{
+					FailedHunks: []HunkError{{Index: 0, Hunk: patch.Hunks[0]}},
+					TotalHunks:  1,
+				}
+			},
+		},
+		{
+			desc:    "partially failing",
+			content: content,
+			patch:   "@@ -1 +1 @@\n-one\n+1\n@@ -5 +5 @@\n-5\n+five\n@@ -9 +9 @@\n-nine\n+9\n",
+			maxFuzz: 3,
+			expectedErr: func(patch *Patch) error {
+				return &ApplyError{
+					FailedHunks: []HunkError{
+						{Index: 0, Hunk: patch.Hunks[0]},
+						{Index: 2, Hunk: patch.Hunks[2]},
+					},
+					TotalHunks: 3,
+				}
+			},
+		},
+		{
+			desc:    "overlapping hunks",
+			content: "a\nb\n",
+			patch:   "@@ -1,2 +1,2 @@\n a\n-b\n+c\n@@ -1,2 +1,2 @@\n a\n-b\n+d\n",
+			expectedErr: func(patch *Patch) error {
+				return &ApplyError{
+					FailedHunks: []HunkError{{Index: 1, Hunk: patch.Hunks[1]}},
+					TotalHunks:  2,
+				}
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			patch, err := Parse(strings.NewReader(tc.patch))
+			require.NoError(t, err)
+
+			content, err := Apply([]byte(tc.content), patch, tc.maxFuzz)
+			if tc.expectedErr != nil {
+				require.Equal(t, tc.expectedErr(patch), err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedContent, string(content))
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Package patch implements parsing of unified diffs of single files and applying them to file
+// contents. In contrast to git-apply(1), hunks which fail to apply are reported individually so
+// that callers can tell exactly which parts of a patch did not apply.
+package patch
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// devNull is the path used in the file headers of a patch to denote that the file does not exist
+// on one side of the patch.
+const devNull = "/dev/null"
+
+// ErrNoHunks is returned when the patch does not contain any hunks.
+var ErrNoHunks = errors.New("patch does not contain any hunks")
+
+var hunkHeaderRegex = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// ParseError is returned when the patch is malformed.
+type ParseError struct {
+	// Line is the 1-based line number at which the error occurred.
+	Line int
+	// Message describes the error.
+	Message string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("invalid patch at line %d: %s", e.Line, e.Message)
+}
+
+// LineOp is the operation performed by a single line of a hunk.
+type LineOp byte
+
+const (
+	// OpContext is a line which is unchanged.
+	OpContext = LineOp(' ')
+	// OpDelete is a line which is removed.
+	OpDelete = LineOp('-')
+	// OpAdd is a line which is added.
+	OpAdd = LineOp('+')
+)
+
+// Line is a single line of a hunk.
+type Line struct {
+	// Op is the operation performed by the line.
+	Op LineOp
+	// Content is the content of the line including its trailing newline, if any.
+	Content []byte
+}
+
+// Hunk is a single hunk of a patch.
+type Hunk struct {
+	// Header is the hunk header, e.g. `@@ -1,3 +1,4 @@ func main()`.
+	Header string
+	// OldStart is the 1-based line number the hunk starts at in the preimage.
+	OldStart int
+	// OldLines is the number of lines the hunk spans in the preimage.
+	OldLines int
+	// NewStart is the 1-based line number the hunk starts at in the postimage.
+	NewStart int
+	// NewLines is the number of lines the hunk spans in the postimage.
+	NewLines int
+	// Lines are the lines of the hunk.
+	Lines []Line
+}
+
+// Patch is a unified diff of a single file.
+type Patch struct {
+	// OldPath is the path of the file in the preimage as given by the `---` header. It is empty
+	// if the patch has no file headers.
+	OldPath string
+	// NewPath is the path of the file in the postimage as given by the `+++` header. It is
+	// empty if the patch has no file headers.
+	NewPath string
+	// Hunks are the hunks of the patch.
+	Hunks []Hunk
+}
+
+// IsCreation determines whether the patch creates a new file.
+func (p *Patch) IsCreation() bool {
+	return p.OldPath == devNull
+}
+
+// IsDeletion determines whether the patch deletes the file.
+func (p *Patch) IsDeletion() bool {
+	return p.NewPath == devNull
+}
+
+// Parse parses a unified diff of a single file. Any extended headers as written by git-diff(1)
+// before the first hunk are ignored.
+func Parse(r io.Reader) (*Patch, error) {
+	var patch Patch
+	var hunk *Hunk
+	var oldRemaining, newRemaining int
+
+	reader := bufio.NewReader(r)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("reading patch: %w", err)
+		}
+		if len(line) == 0 {
+			break
+		}
+
+		switch {
+		case hunk != nil && (oldRemaining > 0 || newRemaining > 0):
+			op := LineOp(line[0])
+			content := line[1:]
+
+			// Some tools strip the trailing whitespace of empty context lines, so we treat
+			// a completely empty line as an empty context line.
+			if bytes.Equal(line, []byte("\n")) {
+				op, content = OpContext, line
+			}
+
+			switch op {
+			case OpContext:
+				oldRemaining--
+				newRemaining--
+			case OpDelete:
+				oldRemaining--
+			case OpAdd:
+				newRemaining--
+			case '\\':
+				if err := stripTrailingNewline(hunk); err != nil {
+					return nil, ParseError{Line: lineNumber, Message: err.Error()}
+				}
+				continue
+			default:
+				return nil, ParseError{Line: lineNumber, Message: "unexpected line in hunk"}
+			}
+
+			if oldRemaining < 0 || newRemaining < 0 {
+				return nil, ParseError{Line: lineNumber, Message: "hunk is longer than announced by its header"}
+			}
+
+			hunk.Lines = append(hunk.Lines, Line{Op: op, Content: content})
+		case bytes.HasPrefix(line, []byte("@@ ")):
+			parsedHunk, err := parseHunkHeader(line)
+			if err != nil {
+				return nil, ParseError{Line: lineNumber, Message: err.Error()}
+			}
+
+			patch.Hunks = append(patch.Hunks, parsedHunk)
+			hunk = &patch.Hunks[len(patch.Hunks)-1]
+			oldRemaining, newRemaining = hunk.OldLines, hunk.NewLines
+		case hunk != nil && line[0] == '\\':
+			if err := stripTrailingNewline(hunk); err != nil {
+				return nil, ParseError{Line: lineNumber, Message: err.Error()}
+			}
+		case hunk != nil:
+			if len(bytes.TrimSpace(line)) != 0 {
+				return nil, ParseError{Line: lineNumber, Message: "unexpected line after hunk"}
+			}
+		case bytes.HasPrefix(line, []byte("--- ")):
+			patch.OldPath = parseFileHeader(line[4:], "a/")
+		case bytes.HasPrefix(line, []byte("+++ ")):
+			patch.NewPath = parseFileHeader(line[4:], "b/")
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+
+	if hunk == nil {
+		return nil, ErrNoHunks
+	}
+
+	if oldRemaining > 0 || newRemaining > 0 {
+		return nil, ParseError{Line: 0, Message: "last hunk is incomplete"}
+	}
+
+	return &patch, nil
+}
+
+func parseHunkHeader(line []byte) (Hunk, error) {
+	matches := hunkHeaderRegex.FindSubmatch(line)
+	if matches == nil {
+		return Hunk{}, errors.New("invalid hunk header")
+	}
+
+	numbers := make([]int, 4)
+	for i, match := range matches[1:] {
+		// The line count may be omitted, in which case it defaults to 1.
+		if len(match) == 0 {
+			numbers[i] = 1
+			continue
+		}
+
+		number, err := strconv.Atoi(string(match))
+		if err != nil {
+			return Hunk{}, fmt.Errorf("invalid hunk header: %w", err)
+		}
+		numbers[i] = number
+	}
+
+	return Hunk{
+		Header:   string(bytes.TrimRight(line, "\r\n")),
+		OldStart: numbers[0],
+		OldLines: numbers[1],
+		NewStart: numbers[2],
+		NewLines: numbers[3],
+	}, nil
+}
+
+func parseFileHeader(header []byte, prefix string) string {
+	path := strings.TrimRight(string(header), "\r\n")
+
+	// git-diff(1) separates the path from the timestamp via a tab, if any.
+	path, _, _ = strings.Cut(path, "\t")
+
+	if path == devNull {
+		return path
+	}
+
+	return strings.TrimPrefix(path, prefix)
+}
+
+// stripTrailingNewline handles the `\ No newline at end of file` marker by stripping the newline
+// of the line preceding it.
+func stripTrailingNewline(hunk *Hunk) error {
+	if len(hunk.Lines) == 0 {
+		return errors.New("missing line before newline marker")
+	}
+
+	lastLine := &hunk.Lines[len(hunk.Lines)-1]
+	lastLine.Content = bytes.TrimSuffix(lastLine.Content, []byte("\n"))
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package patch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc          string
+		patch         string
+		expectedPatch *Patch
+		expectedErr   error
+	}{
+		{
+			desc: "git-diff output",
+			patch: `diff --git a/file b/file
+index 3bd1f0e..86e041d 100644
+--- a/file
++++ b/file
+@@ -1,3 +1,3 @@ func main() {
+ foo
+-bar
++baz
+ qux
+`,
+			expectedPatch: &Patch{
+				OldPath: "file",
+				NewPath: "file",
+				Hunks: []Hunk{
+					{
+						Header:   "@@ -1,3 +1,3 @@ func main() {",
+						OldStart: 1, OldLines: 3, NewStart: 1, NewLines: 3,
+						Lines: []Line{
+							{Op: OpContext, Content: []byte("foo\n")},
+							{Op: OpDelete, Content: []byte("bar\n")},
+							{Op: OpAdd, Content: []byte("baz\n")},
+							{Op: OpContext, Content: []byte("qux\n")},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "multiple hunks without file headers",
+			patch: `@@ -1 +1 @@
+-a
++b
+@@ -10,0 +11,1 @@
++c
+`,
+			expectedPatch: &Patch{
+				Hunks: []Hunk{
+					{
+						Header:   "@@ -1 +1 @@",
+						OldStart: 1, OldLines: 1, NewStart: 1, NewLines: 1,
+						Lines: []Line{
+							{Op: OpDelete, Content: []byte("a\n")},
+							{Op: OpAdd, Content: []byte("b\n")},
+						},
+					},
+					{
+						Header:   "@@ -10,0 +11,1 @@",
+						OldStart: 10, OldLines: 0, NewStart: 11, NewLines: 1,
+						Lines: []Line{
+							{Op: OpAdd, Content: []byte("c\n")},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "file creation",
+			patch: `--- /dev/null
++++ b/new	2023-01-01 00:00:00.000000000 +0000
+@@ -0,0 +1 @@
++new
+\ No newline at end of file
+`,
+			expectedPatch: &Patch{
+				OldPath: "/dev/null",
+				NewPath: "new",
+				Hunks: []Hunk{
+					{
+						Header:   "@@ -0,0 +1 @@",
+						OldStart: 0, OldLines: 0, NewStart: 1, NewLines: 1,
+						Lines: []Line{
+							{Op: OpAdd, Content: []byte("new")},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc: "missing newline in the middle of a hunk",
+			patch: `@@ -1 +1 @@
+-old
+\ No newline at end of file
++new
+`,
+			expectedPatch: &Patch{
+				Hunks: []Hunk{
+					{
+						Header:   "@@ -1 +1 @@",
+						OldStart: 1, OldLines: 1, NewStart: 1, NewLines: 1,
+						Lines: []Line{
+							{Op: OpDelete, Content: []byte("old")},
+							{Op: OpAdd, Content: []byte("new\n")},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc:  "empty context line",
+			patch: "@@ -1,2 +1,2 @@\n\n-a\n+b\n",
+			expectedPatch: &Patch{
+				Hunks: []Hunk{
+					{
+						Header:   "@@ -1,2 +1,2 @@",
+						OldStart: 1, OldLines: 2, NewStart: 1, NewLines: 2,
+						Lines: []Line{
+							{Op: OpContext, Content: []byte("\n")},
+							{Op: OpDelete, Content: []byte("a\n")},
+							{Op: OpAdd, Content: []byte("b\n")},
+						},
+					},
+				},
+			},
+		},
+		{
+			desc:        "no hunks",
+			patch:       "--- a/file\n+++ b/file\n",
+			expectedErr: ErrNoHunks,
+		},
+		{
+			desc:        "invalid hunk header",
+			patch:       "@@ -a +b @@\n",
+			expectedErr: ParseError{Line: 1, Message: "invalid hunk header"},
+		},
+		{
+			desc:        "unexpected line in hunk",
+			patch:       "@@ -1,2 +1,2 @@\n-a\n*b\n",
+			expectedErr: ParseError{Line: 3, Message: "unexpected line in hunk"},
+		},
+		{
+			desc:        "hunk longer than announced",
+			patch:       "@@ -1 +1,2 @@\n a\n-b\n+c\n",
+			expectedErr: ParseError{Line: 3, Message: "hunk is longer than announced by its header"},
+		},
+		{
+			desc:        "incomplete hunk",
+			patch:       "@@ -1,3 +1,3 @@\n a\n",
+			expectedErr: ParseError{Line: 0, Message: "last hunk is incomplete"},
+		},
+		{
+			desc:        "multiple files",
+			patch:       "@@ -1 +1 @@\n-a\n+b\ndiff --git a/other b/other\n",
+			expectedErr: ParseError{Line: 4, Message: "unexpected line after hunk"},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			patch, err := Parse(strings.NewReader(tc.patch))
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedPatch, patch)
+		})
+	}
+}
+
+func TestPatch_IsCreationAndDeletion(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, (&Patch{OldPath: "/dev/null", NewPath: "file"}).IsCreation())
+	require.False(t, (&Patch{OldPath: "/dev/null", NewPath: "file"}).IsDeletion())
+	require.True(t, (&Patch{OldPath: "file", NewPath: "/dev/null"}).IsDeletion())
+	require.False(t, (&Patch{OldPath: "file", NewPath: "file"}).IsCreation())
+}
@@ -0,0 +1,62 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+var (
+	// ReferenceBackendFiles is the default reference backend of Git. It stores references as
+	// loose files and in the packed-refs file.
+	ReferenceBackendFiles = ReferenceBackend{
+		Name:         "files",
+		ProtoBackend: gitalypb.ReferenceBackend_REFERENCE_BACKEND_FILES,
+	}
+	// ReferenceBackendReftable stores references in a stack of reftables.
+	ReferenceBackendReftable = ReferenceBackend{
+		Name:         "reftable",
+		ProtoBackend: gitalypb.ReferenceBackend_REFERENCE_BACKEND_REFTABLE,
+	}
+)
+
+// ReferenceBackend is an abstraction for the backend a repository uses to store its references.
+type ReferenceBackend struct {
+	// Name is the name of the reference backend as understood by Git, e.g. via the
+	// `--ref-format` switch of git-init(1).
+	Name string
+	// ProtoBackend is the Protobuf representation of the reference backend.
+	ProtoBackend gitalypb.ReferenceBackend
+}
+
+// ReferenceBackendByProto looks up the ReferenceBackend by its Protobuf representation
+// `gitalypb.ReferenceBackend`. Returns an error in case the reference backend is not known.
+func ReferenceBackendByProto(backend gitalypb.ReferenceBackend) (ReferenceBackend, error) {
+	switch backend {
+	case gitalypb.ReferenceBackend_REFERENCE_BACKEND_UNSPECIFIED, gitalypb.ReferenceBackend_REFERENCE_BACKEND_FILES:
+		return ReferenceBackendFiles, nil
+	case gitalypb.ReferenceBackend_REFERENCE_BACKEND_REFTABLE:
+		return ReferenceBackendReftable, nil
+	default:
+		return ReferenceBackend{}, fmt.Errorf("unknown reference backend: %q", backend)
+	}
+}
+
+// DetectReferenceBackend detects the reference backend used by the repository at the given path.
+// Git always creates the `reftable/tables.list` file when initializing a repository with the
+// reftable backend, so we can detect the backend without spawning a Git process. This also works
+// with Git versions that do not know about the reftable backend.
+func DetectReferenceBackend(repoPath string) (ReferenceBackend, error) {
+	if _, err := os.Stat(filepath.Join(repoPath, "reftable", "tables.list")); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ReferenceBackendFiles, nil
+		}
+
+		return ReferenceBackend{}, fmt.Errorf("checking for reftables: %w", err)
+	}
+
+	return ReferenceBackendReftable, nil
+}
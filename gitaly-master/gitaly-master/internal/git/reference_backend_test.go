@@ -0,0 +1,76 @@
+package git_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func TestReferenceBackendByProto(t *testing.T) {
+	for _, tc := range []struct {
+		desc            string
+		backend         gitalypb.ReferenceBackend
+		expectedErr     error
+		expectedBackend git.ReferenceBackend
+	}{
+		{
+			desc:            "unspecified reference backend",
+			backend:         gitalypb.ReferenceBackend_REFERENCE_BACKEND_UNSPECIFIED,
+			expectedBackend: git.ReferenceBackendFiles,
+		},
+		{
+			desc:            "files reference backend",
+			backend:         gitalypb.ReferenceBackend_REFERENCE_BACKEND_FILES,
+			expectedBackend: git.ReferenceBackendFiles,
+		},
+		{
+			desc:            "reftable reference backend",
+			backend:         gitalypb.ReferenceBackend_REFERENCE_BACKEND_REFTABLE,
+			expectedBackend: git.ReferenceBackendReftable,
+		},
+		{
+			desc:        "invalid reference backend",
+			backend:     3,
+			expectedErr: fmt.Errorf("unknown reference backend: \"3\""),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			backend, err := git.ReferenceBackendByProto(tc.backend)
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedBackend, backend)
+		})
+	}
+}
+
+func TestDetectReferenceBackend(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	_, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	backend, err := git.DetectReferenceBackend(repoPath)
+	require.NoError(t, err)
+	require.Equal(t, git.ReferenceBackendFiles, backend)
+
+	// We cannot rely on the Git version used in tests to support the reftable backend, so we
+	// fake the on-disk layout of such a repository instead.
+	require.NoError(t, os.Mkdir(filepath.Join(repoPath, "reftable"), perm.SharedDir))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "reftable", "tables.list"), nil, perm.SharedFile))
+
+	backend, err = git.DetectReferenceBackend(repoPath)
+	require.NoError(t, err)
+	require.Equal(t, git.ReferenceBackendReftable, backend)
+}
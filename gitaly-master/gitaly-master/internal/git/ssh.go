@@ -3,20 +3,40 @@ package git
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
 )
 
-// BuildSSHInvocation builds a command line to invoke SSH with the provided key and known hosts.
-// Both are optional.
-func BuildSSHInvocation(ctx context.Context, sshKey, knownHosts string) (string, func(), error) {
+// hostKeyAliasRegex matches host names which can be safely used as host key alias. The alias is
+// part of GIT_SSH_COMMAND, which is interpreted by the shell, so it must not contain any
+// characters with special meaning.
+var hostKeyAliasRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// BuildSSHInvocation builds a command line to invoke SSH with the provided key, known hosts and
+// host key alias. All of them are optional. The host key alias is the name used to look up the
+// remote's host key in the known hosts, which is required when the remote URL's host has been
+// replaced with its resolved address.
+func BuildSSHInvocation(ctx context.Context, sshKey, knownHosts, hostKeyAlias string) (string, func(), error) {
 	const sshCommand = "ssh"
+
+	var aliasArgs []string
+	if hostKeyAlias != "" {
+		if !hostKeyAliasRegex.MatchString(hostKeyAlias) {
+			return "", func() {}, fmt.Errorf("invalid host key alias %q", hostKeyAlias)
+		}
+
+		aliasArgs = append(aliasArgs, "-oHostKeyAlias="+hostKeyAlias)
+	}
+
 	if sshKey == "" && knownHosts == "" {
-		return sshCommand, func() {}, nil
+		return strings.Join(append([]string{sshCommand}, aliasArgs...), " "), func() {}, nil
 	}
 
 	tmpDir, err := os.MkdirTemp("", "gitaly-ssh-invocation")
@@ -51,5 +71,47 @@ func BuildSSHInvocation(ctx context.Context, sshKey, knownHosts string) (string,
 		args = append(args, "-oStrictHostKeyChecking=yes", "-oCheckHostIP=no", "-oUserKnownHostsFile="+knownHostsFile)
 	}
 
+	args = append(args, aliasArgs...)
+
 	return strings.Join(args, " "), cleanup, nil
 }
+
+// SSHHostKeyAlias returns the host name that should be used to verify the host key of the given
+// remote URL when its host is replaced with the resolved address. It returns an empty string if
+// the remote URL is not accessed via SSH or if its host already is an IP address.
+func SSHHostKeyAlias(remoteURL string) (string, error) {
+	var host string
+	switch {
+	case strings.HasPrefix(remoteURL, "http://"), strings.HasPrefix(remoteURL, "https://"), strings.HasPrefix(remoteURL, "git://"):
+		return "", nil
+	case strings.HasPrefix(remoteURL, "ssh://"):
+		u, err := url.ParseRequestURI(remoteURL)
+		if err != nil {
+			return "", fmt.Errorf("couldn't parse remoteURL: %w", err)
+		}
+
+		host = u.Hostname()
+	default:
+		// SCP-like syntax, e.g. "git@example.com:group/project.git".
+		userAndHost, _, found := strings.Cut(remoteURL, ":")
+		if !found || strings.Contains(userAndHost, "/") {
+			return "", fmt.Errorf("invalid protocol/URL encountered: %s", remoteURL)
+		}
+
+		if _, h, found := strings.Cut(userAndHost, "@"); found {
+			host = h
+		} else {
+			host = userAndHost
+		}
+	}
+
+	if net.ParseIP(host) != nil {
+		return "", nil
+	}
+
+	if !hostKeyAliasRegex.MatchString(host) {
+		return "", fmt.Errorf("invalid host %q", host)
+	}
+
+	return host, nil
+}
@@ -1,6 +1,8 @@
 package git
 
 import (
+	"errors"
+	"fmt"
 	"path/filepath"
 	"regexp"
 	"testing"
@@ -16,9 +18,10 @@ func TestBuildSSHInvocation(t *testing.T) {
 	reTmpDir := regexp.MustCompile(tmpDirPattern)
 
 	for _, tc := range []struct {
-		desc       string
-		sshKey     string
-		knownHosts string
+		desc         string
+		sshKey       string
+		knownHosts   string
+		hostKeyAlias string
 	}{
 		{
 			desc: "no arguments",
@@ -36,9 +39,19 @@ func TestBuildSSHInvocation(t *testing.T) {
 			sshKey:     "ssh-key-content",
 			knownHosts: "known-hosts-content",
 		},
+		{
+			desc:         "host key alias given",
+			hostKeyAlias: "example.com",
+		},
+		{
+			desc:         "all given",
+			sshKey:       "ssh-key-content",
+			knownHosts:   "known-hosts-content",
+			hostKeyAlias: "example.com",
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			sshCommand, clean, err := BuildSSHInvocation(ctx, tc.sshKey, tc.knownHosts)
+			sshCommand, clean, err := BuildSSHInvocation(ctx, tc.sshKey, tc.knownHosts, tc.hostKeyAlias)
 			require.NoError(t, err)
 			defer clean()
 
@@ -72,6 +85,10 @@ func TestBuildSSHInvocation(t *testing.T) {
 				require.NoFileExists(t, knownHostsPath)
 			}
 
+			if tc.hostKeyAlias != "" {
+				expectedCommand += " -oHostKeyAlias=" + tc.hostKeyAlias
+			}
+
 			require.Equal(t, expectedCommand, sshCommand)
 
 			clean()
@@ -79,3 +96,64 @@ func TestBuildSSHInvocation(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildSSHInvocation_invalidHostKeyAlias(t *testing.T) {
+	ctx := testhelper.Context(t)
+
+	_, clean, err := BuildSSHInvocation(ctx, "ssh-key-content", "", "example.com; rm -rf /")
+	defer clean()
+	require.Equal(t, fmt.Errorf("invalid host key alias %q", "example.com; rm -rf /"), err)
+}
+
+func TestSSHHostKeyAlias(t *testing.T) {
+	for _, tc := range []struct {
+		desc          string
+		remoteURL     string
+		expectedAlias string
+		expectedErr   error
+	}{
+		{
+			desc:      "HTTP URL",
+			remoteURL: "https://example.com/group/project.git",
+		},
+		{
+			desc:      "Git URL",
+			remoteURL: "git://example.com/group/project.git",
+		},
+		{
+			desc:          "SSH URL",
+			remoteURL:     "ssh://git@example.com:2222/group/project.git",
+			expectedAlias: "example.com",
+		},
+		{
+			desc:      "SSH URL with IP address",
+			remoteURL: "ssh://git@192.0.2.1/group/project.git",
+		},
+		{
+			desc:          "SCP-like URL",
+			remoteURL:     "git@example.com:group/project.git",
+			expectedAlias: "example.com",
+		},
+		{
+			desc:          "SCP-like URL without user",
+			remoteURL:     "example.com:group/project.git",
+			expectedAlias: "example.com",
+		},
+		{
+			desc:        "SCP-like URL with slash before colon",
+			remoteURL:   "example.com/group:project.git",
+			expectedErr: errors.New("invalid protocol/URL encountered: example.com/group:project.git"),
+		},
+		{
+			desc:        "SCP-like URL with invalid host",
+			remoteURL:   "git@$(whoami):group/project.git",
+			expectedErr: fmt.Errorf("invalid host %q", "$(whoami)"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			alias, err := SSHHostKeyAlias(tc.remoteURL)
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedAlias, alias)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package stats
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v15/client"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"gitlab.com/gitlab-org/gitaly/v15/streamio"
+	"google.golang.org/grpc"
+)
+
+// GRPCClone hosts information about a clone performed directly against Gitaly or Praefect via the
+// SmartHTTPService.
+type GRPCClone struct {
+	// ReferenceDiscovery is the reference discovery performed via InfoRefsUploadPack.
+	ReferenceDiscovery TransportReferenceDiscovery
+	// FetchPack is the response to a git-fetch-pack(1) request performed via
+	// PostUploadPackWithSidechannel.
+	FetchPack TransportFetchPack
+}
+
+// PerformGRPCClone does a clone of the given repository via Gitaly's RPCs, discarding cloned data
+// to /dev/null. The connection must have been established with the given sidechannel registry.
+func PerformGRPCClone(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	registry *client.SidechannelRegistry,
+	repo *gitalypb.Repository,
+	interactive bool,
+) (GRPCClone, error) {
+	printInteractive := func(format string, a ...interface{}) {
+		if interactive {
+			// Ignore any errors returned by this given that we only use it as a
+			// debugging aid to write to stdout.
+			fmt.Printf(format, a...)
+		}
+	}
+
+	smartHTTPClient := gitalypb.NewSmartHTTPServiceClient(conn)
+
+	referenceDiscovery, err := performGRPCReferenceDiscovery(ctx, smartHTTPClient, repo, printInteractive)
+	if err != nil {
+		return GRPCClone{}, ctxErr(ctx, err)
+	}
+
+	fetchPack, err := performGRPCFetchPack(ctx, smartHTTPClient, registry, repo,
+		referenceDiscovery.Refs(), printInteractive)
+	if err != nil {
+		return GRPCClone{}, ctxErr(ctx, err)
+	}
+
+	return GRPCClone{
+		ReferenceDiscovery: referenceDiscovery,
+		FetchPack:          fetchPack,
+	}, nil
+}
+
+func performGRPCReferenceDiscovery(
+	ctx context.Context,
+	smartHTTPClient gitalypb.SmartHTTPServiceClient,
+	repo *gitalypb.Repository,
+	reportProgress func(string, ...interface{}),
+) (TransportReferenceDiscovery, error) {
+	referenceDiscovery := TransportReferenceDiscovery{
+		start: time.Now(),
+	}
+
+	reportProgress("---\n")
+	reportProgress("--- InfoRefsUploadPack %s\n", repo.GetRelativePath())
+	reportProgress("---\n")
+
+	stream, err := smartHTTPClient.InfoRefsUploadPack(ctx, &gitalypb.InfoRefsRequest{
+		Repository: repo,
+	})
+	if err != nil {
+		return TransportReferenceDiscovery{}, fmt.Errorf("starting reference discovery: %w", err)
+	}
+
+	if err := referenceDiscovery.stats.Parse(streamio.NewReader(func() ([]byte, error) {
+		response, err := stream.Recv()
+		return response.GetData(), err
+	})); err != nil {
+		return TransportReferenceDiscovery{}, fmt.Errorf("parsing reference discovery: %w", err)
+	}
+
+	return referenceDiscovery, nil
+}
+
+func performGRPCFetchPack(
+	ctx context.Context,
+	smartHTTPClient gitalypb.SmartHTTPServiceClient,
+	registry *client.SidechannelRegistry,
+	repo *gitalypb.Repository,
+	announcedRefs []Reference,
+	reportProgress func(string, ...interface{}),
+) (TransportFetchPack, error) {
+	fetchPack := TransportFetchPack{
+		stats: FetchPack{
+			ReportProgress: func(b []byte) { reportProgress("%s", string(b)) },
+		},
+	}
+
+	ctx, waiter := registry.Register(ctx, func(conn client.SidechannelConn) error {
+		writer := bufio.NewWriter(conn)
+
+		wants, err := writeFetchPackRequest(writer, announcedRefs)
+		if err != nil {
+			return fmt.Errorf("writing fetch-pack request: %w", err)
+		}
+		fetchPack.wantedRefs = wants
+
+		if err := writer.Flush(); err != nil {
+			return fmt.Errorf("flushing fetch-pack request: %w", err)
+		}
+
+		if err := conn.CloseWrite(); err != nil {
+			return fmt.Errorf("closing sidechannel: %w", err)
+		}
+
+		if err := fetchPack.stats.Parse(conn); err != nil {
+			return fmt.Errorf("parsing fetch-pack response: %w", err)
+		}
+
+		return nil
+	})
+	defer func() {
+		// The error is checked further down in case the RPC succeeded.
+		_ = waiter.Close()
+	}()
+
+	reportProgress("---\n")
+	reportProgress("--- PostUploadPackWithSidechannel %s\n", repo.GetRelativePath())
+	reportProgress("---\n")
+
+	fetchPack.start = time.Now()
+
+	if _, err := smartHTTPClient.PostUploadPackWithSidechannel(ctx, &gitalypb.PostUploadPackWithSidechannelRequest{
+		Repository: repo,
+	}); err != nil {
+		return TransportFetchPack{}, fmt.Errorf("fetching packfile: %w", err)
+	}
+
+	if err := waiter.Close(); err != nil {
+		return TransportFetchPack{}, err
+	}
+
+	reportProgress("\n")
+
+	return fetchPack, nil
+}
@@ -0,0 +1,57 @@
+package stats_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gitalyauth "gitlab.com/gitlab-org/gitaly/v15/auth"
+	"gitlab.com/gitlab-org/gitaly/v15/client"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service/setup"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testserver"
+	"google.golang.org/grpc"
+)
+
+func TestPerformGRPCClone(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+	testcfg.BuildGitalyHooks(t, cfg)
+	cfg.SocketPath = testserver.RunGitalyServer(t, cfg, nil, setup.RegisterAll)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+	gittest.WriteTag(t, cfg, repoPath, "some-tag", "refs/heads/main")
+
+	registry := client.NewSidechannelRegistry(testhelper.NewDiscardingLogEntry(t))
+	conn, err := client.DialSidechannel(ctx, cfg.SocketPath, registry, []grpc.DialOption{
+		grpc.WithPerRPCCredentials(gitalyauth.RPCCredentialsV2(cfg.Auth.Token)),
+	})
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, conn)
+
+	clone, err := stats.PerformGRPCClone(ctx, conn, registry, repo, false)
+	require.NoError(t, err)
+
+	require.Len(t, clone.ReferenceDiscovery.Refs(), 3)
+	require.Greater(t, len(clone.ReferenceDiscovery.Caps()), 10)
+	require.Equal(t, 2, clone.FetchPack.RefsWanted())
+	require.Greater(t, clone.FetchPack.BandPackets("pack"), 0)
+	require.Greater(t, clone.FetchPack.BandPayloadSize("pack"), int64(0))
+
+	for _, durations := range [][]time.Duration{
+		{clone.ReferenceDiscovery.FirstGitPacket(), clone.ReferenceDiscovery.ResponseBody()},
+		{clone.FetchPack.NAK(), clone.FetchPack.BandFirstPacket("pack"), clone.FetchPack.ResponseBody()},
+	} {
+		previousValue := time.Duration(0)
+		for _, duration := range durations {
+			require.GreaterOrEqual(t, duration, previousValue)
+			previousValue = duration
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -61,27 +62,10 @@ func buildFetchPackRequest(
 	url, user, password string,
 	announcedRefs []Reference,
 ) (*http.Request, []string, error) {
-	var wants []string
-	for _, ref := range announcedRefs {
-		if strings.HasPrefix(ref.Name, "refs/heads/") || strings.HasPrefix(ref.Name, "refs/tags/") {
-			wants = append(wants, ref.Oid)
-		}
-	}
-
 	reqBodyRaw := &bytes.Buffer{}
 	reqBodyGzip := gzip.NewWriter(reqBodyRaw)
-	for i, oid := range wants {
-		if i == 0 {
-			oid += " multi_ack_detailed no-done side-band-64k thin-pack ofs-delta deepen-since deepen-not agent=git/2.21.0"
-		}
-		if _, err := pktline.WriteString(reqBodyGzip, "want "+oid+"\n"); err != nil {
-			return nil, nil, err
-		}
-	}
-	if err := pktline.WriteFlush(reqBodyGzip); err != nil {
-		return nil, nil, err
-	}
-	if _, err := pktline.WriteString(reqBodyGzip, "done\n"); err != nil {
+	wants, err := writeFetchPackRequest(reqBodyGzip, announcedRefs)
+	if err != nil {
 		return nil, nil, err
 	}
 	if err := reqBodyGzip.Close(); err != nil {
@@ -154,3 +138,31 @@ func performFetchPack(
 
 	return fetchPack, nil
 }
+
+// writeFetchPackRequest writes a git-fetch-pack(1) request which wants all branches and tags of
+// the announced references to the writer. It returns the object IDs that have been wanted.
+func writeFetchPackRequest(w io.Writer, announcedRefs []Reference) ([]string, error) {
+	var wants []string
+	for _, ref := range announcedRefs {
+		if strings.HasPrefix(ref.Name, "refs/heads/") || strings.HasPrefix(ref.Name, "refs/tags/") {
+			wants = append(wants, ref.Oid)
+		}
+	}
+
+	for i, oid := range wants {
+		if i == 0 {
+			oid += " multi_ack_detailed no-done side-band-64k thin-pack ofs-delta deepen-since deepen-not agent=git/2.21.0"
+		}
+		if _, err := pktline.WriteString(w, "want "+oid+"\n"); err != nil {
+			return nil, err
+		}
+	}
+	if err := pktline.WriteFlush(w); err != nil {
+		return nil, err
+	}
+	if _, err := pktline.WriteString(w, "done\n"); err != nil {
+		return nil, err
+	}
+
+	return wants, nil
+}
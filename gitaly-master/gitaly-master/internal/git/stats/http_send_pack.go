@@ -41,25 +41,8 @@ func buildSendPackRequest(
 	var requestBuffer bytes.Buffer
 	zipper := gzip.NewWriter(&requestBuffer)
 
-	for i, command := range commands {
-		c := fmt.Sprintf("%s %s %s", command.OldOID, command.NewOID, command.Reference)
-		if i == 0 {
-			c += "\x00side-band-64k report-status delete-refs object-format=" + objectHash.Format
-		}
-
-		if _, err := pktline.WriteString(zipper, c); err != nil {
-			return nil, fmt.Errorf("writing command: %w", err)
-		}
-	}
-
-	if err := pktline.WriteFlush(zipper); err != nil {
-		return nil, fmt.Errorf("terminating command list: %w", err)
-	}
-
-	if packfile != nil {
-		if _, err := io.Copy(zipper, packfile); err != nil {
-			return nil, fmt.Errorf("sending packfile: %w", err)
-		}
+	if err := writeSendPackRequest(zipper, objectHash, commands, packfile); err != nil {
+		return nil, err
 	}
 
 	if err := zipper.Close(); err != nil {
@@ -140,3 +123,35 @@ func performHTTPSendPack(
 
 	return sendPack, nil
 }
+
+// writeSendPackRequest writes a git-send-pack(1) request consisting of the reference update
+// commands and the optional packfile to the writer.
+func writeSendPackRequest(
+	w io.Writer,
+	objectHash git.ObjectHash,
+	commands []PushCommand,
+	packfile io.Reader,
+) error {
+	for i, command := range commands {
+		c := fmt.Sprintf("%s %s %s", command.OldOID, command.NewOID, command.Reference)
+		if i == 0 {
+			c += "\x00side-band-64k report-status delete-refs object-format=" + objectHash.Format
+		}
+
+		if _, err := pktline.WriteString(w, c); err != nil {
+			return fmt.Errorf("writing command: %w", err)
+		}
+	}
+
+	if err := pktline.WriteFlush(w); err != nil {
+		return fmt.Errorf("terminating command list: %w", err)
+	}
+
+	if packfile != nil {
+		if _, err := io.Copy(w, packfile); err != nil {
+			return fmt.Errorf("sending packfile: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/pktline"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
 )
@@ -36,6 +37,18 @@ type ReferenceDiscovery struct {
 	Caps []string
 }
 
+// ObjectHash returns the object hash announced via the "object-format" capability. Remotes which
+// do not announce the capability use SHA1.
+func (d ReferenceDiscovery) ObjectHash() (git.ObjectHash, error) {
+	for _, capability := range d.Caps {
+		if strings.HasPrefix(capability, "object-format=") {
+			return git.ObjectHashByFormat(strings.TrimPrefix(capability, "object-format="))
+		}
+	}
+
+	return git.ObjectHashSHA1, nil
+}
+
 type referenceDiscoveryState int
 
 const (
@@ -66,17 +79,45 @@ func ParseReferenceDiscovery(body io.Reader) (ReferenceDiscovery, error) {
 // - ...
 // - FLUSH
 func (d *ReferenceDiscovery) Parse(body io.Reader) error {
-	state := referenceDiscoveryExpectService
+	return d.parse(body, referenceDiscoveryExpectService)
+}
+
+// ParseAdvertisement parses a reference advertisement as it is sent by git-upload-pack(1) or
+// git-receive-pack(1) when spawned directly, e.g. via SSH. In contrast to Parse, the
+// advertisement is not preceded by a service announcement. Parsing stops at the flush packet
+// terminating the advertisement so that the caller can continue to use the body for the
+// remaining protocol exchange.
+//
+// Expected protocol:
+// - "<OID> <ref>\x00<capabilities>\n"
+// - "<OID> <ref>\n"
+// - ...
+// - FLUSH
+func (d *ReferenceDiscovery) ParseAdvertisement(body io.Reader) error {
+	return d.parse(body, referenceDiscoveryExpectRefWithCaps)
+}
+
+func (d *ReferenceDiscovery) parse(body io.Reader, state referenceDiscoveryState) error {
+	// Without a service announcement the remote side is waiting for us to send our request
+	// after the advertisement, so we must not try to read past the terminating flush.
+	stopAtFlush := state != referenceDiscoveryExpectService
 	scanner := pktline.NewScanner(body)
 
-	for ; scanner.Scan(); d.Packets++ {
+	for ; state != referenceDiscoveryExpectEnd || !stopAtFlush; d.Packets++ {
+		if !scanner.Scan() {
+			break
+		}
+
 		pkt := scanner.Bytes()
 		data := text.ChompBytes(pktline.Data(pkt))
 		d.PayloadSize += int64(len(data))
 
+		if d.Packets == 0 {
+			d.FirstPacket = time.Now()
+		}
+
 		switch state {
 		case referenceDiscoveryExpectService:
-			d.FirstPacket = time.Now()
 			if data != "# service=git-upload-pack" {
 				return fmt.Errorf("unexpected header %q", data)
 			}
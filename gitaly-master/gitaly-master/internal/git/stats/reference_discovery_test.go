@@ -2,9 +2,11 @@ package stats
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
 )
 
@@ -35,6 +37,42 @@ func TestMultipleRefsAndCapsParse(t *testing.T) {
 	require.Equal(t, []Reference{{Oid: oid1, Name: "HEAD"}, {Oid: oid2, Name: "refs/heads/master"}}, d.Refs)
 }
 
+func TestReferenceDiscovery_ObjectHash(t *testing.T) {
+	for _, tc := range []struct {
+		desc               string
+		caps               []string
+		expectedObjectHash git.ObjectHash
+		expectedErr        error
+	}{
+		{
+			desc:               "no object format",
+			caps:               []string{"report-status"},
+			expectedObjectHash: git.ObjectHashSHA1,
+		},
+		{
+			desc:               "SHA1",
+			caps:               []string{"report-status", "object-format=sha1"},
+			expectedObjectHash: git.ObjectHashSHA1,
+		},
+		{
+			desc:               "SHA256",
+			caps:               []string{"report-status", "object-format=sha256"},
+			expectedObjectHash: git.ObjectHashSHA256,
+		},
+		{
+			desc:        "unknown object format",
+			caps:        []string{"object-format=blake2b"},
+			expectedErr: fmt.Errorf("unknown object format: %q", "blake2b"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			objectHash, err := ReferenceDiscovery{Caps: tc.caps}.ObjectHash()
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedObjectHash.Format, objectHash.Format)
+		})
+	}
+}
+
 func TestInvalidHeaderFails(t *testing.T) {
 	buf := &bytes.Buffer{}
 	gittest.WritePktlineString(t, buf, "# service=invalid\n")
@@ -77,3 +115,17 @@ func TestMissingTrailingFlushFails(t *testing.T) {
 	d := ReferenceDiscovery{}
 	require.Error(t, d.Parse(buf))
 }
+
+func TestAdvertisementParsesUntilFlush(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gittest.WritePktlineString(t, buf, oid1+" HEAD\x00first second")
+	gittest.WritePktlineString(t, buf, oid2+" refs/heads/master")
+	gittest.WritePktlineFlush(t, buf)
+
+	d := ReferenceDiscovery{}
+	require.NoError(t, d.ParseAdvertisement(buf))
+	require.Equal(t, []string{"first", "second"}, d.Caps)
+	require.Equal(t, []Reference{{Oid: oid1, Name: "HEAD"}, {Oid: oid2, Name: "refs/heads/master"}}, d.Refs)
+	require.Equal(t, 3, d.Packets)
+	require.False(t, d.FirstPacket.IsZero())
+}
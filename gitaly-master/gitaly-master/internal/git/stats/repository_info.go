@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
 )
@@ -121,6 +122,14 @@ type ReferencesInfo struct {
 	LooseReferencesCount uint64 `json:"loose_references_count"`
 	// PackedReferencesSize is the size of the packed-refs file in bytes.
 	PackedReferencesSize uint64 `json:"packed_references_size"`
+	// ReferenceBackendName is the name of the reference backend used by the repository.
+	ReferenceBackendName string `json:"reference_backend"`
+	// ReftableTablesCount is the number of tables in the reftable stack. This is only set for
+	// repositories using the reftable backend.
+	ReftableTablesCount uint64 `json:"reftable_tables_count"`
+	// ReftableSize is the accumulated size of all tables in the reftable stack in bytes. This is
+	// only set for repositories using the reftable backend.
+	ReftableSize uint64 `json:"reftable_size"`
 }
 
 // ReferencesInfoForRepository derives information about references in the repository.
@@ -129,9 +138,21 @@ func ReferencesInfoForRepository(repo *localrepo.Repo) (ReferencesInfo, error) {
 	if err != nil {
 		return ReferencesInfo{}, fmt.Errorf("getting repository path: %w", err)
 	}
+
+	backend, err := git.DetectReferenceBackend(repoPath)
+	if err != nil {
+		return ReferencesInfo{}, fmt.Errorf("detecting reference backend: %w", err)
+	}
+
+	if backend == git.ReferenceBackendReftable {
+		return reftableInfoForRepository(repoPath)
+	}
+
 	refsPath := filepath.Join(repoPath, "refs")
 
-	var info ReferencesInfo
+	info := ReferencesInfo{
+		ReferenceBackendName: backend.Name,
+	}
 	if err := filepath.WalkDir(refsPath, func(path string, entry fs.DirEntry, err error) error {
 		if err != nil {
 			return err
@@ -157,6 +178,44 @@ func ReferencesInfoForRepository(repo *localrepo.Repo) (ReferencesInfo, error) {
 	return info, nil
 }
 
+// reftableInfoForRepository derives information about the reftable stack of a repository. The
+// `refs/` directory of such repositories only contains stubs so that older Git versions still
+// recognize it as a repository, so we must not count its contents as loose references.
+func reftableInfoForRepository(repoPath string) (ReferencesInfo, error) {
+	reftablePath := filepath.Join(repoPath, "reftable")
+
+	tablesList, err := os.ReadFile(filepath.Join(reftablePath, "tables.list"))
+	if err != nil {
+		return ReferencesInfo{}, fmt.Errorf("reading tables list: %w", err)
+	}
+
+	info := ReferencesInfo{
+		ReferenceBackendName: git.ReferenceBackendReftable.Name,
+	}
+
+	for _, table := range strings.Split(text.ChompBytes(tablesList), "\n") {
+		if table == "" {
+			continue
+		}
+
+		stat, err := os.Stat(filepath.Join(reftablePath, table))
+		if err != nil {
+			// The stack may have been compacted concurrently, in which case the table
+			// doesn't exist anymore.
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return ReferencesInfo{}, fmt.Errorf("getting table size: %w", err)
+		}
+
+		info.ReftableTablesCount++
+		info.ReftableSize += uint64(stat.Size())
+	}
+
+	return info, nil
+}
+
 // LooseObjectsInfo contains information about loose objects.
 type LooseObjectsInfo struct {
 	// Count is the number of loose objects.
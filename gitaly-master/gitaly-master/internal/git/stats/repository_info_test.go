@@ -133,6 +133,7 @@ func TestLogObjectInfo(t *testing.T) {
 		repoInfo := requireRepositoryInfo(hook.AllEntries())
 		require.Equal(t, RepositoryInfo{
 			References: ReferencesInfo{
+				ReferenceBackendName: "files",
 				PackedReferencesSize: uint64(packedRefsStat.Size()),
 			},
 			Alternates: []string{
@@ -162,6 +163,7 @@ func TestLogObjectInfo(t *testing.T) {
 				Size:  hashDependentSize(142, 158),
 			},
 			References: ReferencesInfo{
+				ReferenceBackendName: "files",
 				LooseReferencesCount: 1,
 			},
 		}, objectsInfo)
@@ -189,6 +191,11 @@ func TestRepositoryInfoForRepository(t *testing.T) {
 			desc: "empty repository",
 			setup: func(*testing.T, string) {
 			},
+			expectedInfo: RepositoryInfo{
+				References: ReferencesInfo{
+					ReferenceBackendName: "files",
+				},
+			},
 		},
 		{
 			desc: "single blob",
@@ -196,6 +203,9 @@ func TestRepositoryInfoForRepository(t *testing.T) {
 				gittest.WriteBlob(t, cfg, repoPath, []byte("x"))
 			},
 			expectedInfo: RepositoryInfo{
+				References: ReferencesInfo{
+					ReferenceBackendName: "files",
+				},
 				LooseObjects: LooseObjectsInfo{
 					Count: 1,
 					Size:  16,
@@ -221,6 +231,7 @@ func TestRepositoryInfoForRepository(t *testing.T) {
 					},
 				},
 				References: ReferencesInfo{
+					ReferenceBackendName: "files",
 					LooseReferencesCount: 1,
 				},
 			},
@@ -249,6 +260,7 @@ func TestRepositoryInfoForRepository(t *testing.T) {
 					},
 				},
 				References: ReferencesInfo{
+					ReferenceBackendName: "files",
 					LooseReferencesCount: 1,
 				},
 			},
@@ -260,6 +272,9 @@ func TestRepositoryInfoForRepository(t *testing.T) {
 				require.NoError(t, os.WriteFile(garbagePath, []byte("x"), perm.PrivateFile))
 			},
 			expectedInfo: RepositoryInfo{
+				References: ReferencesInfo{
+					ReferenceBackendName: "files",
+				},
 				Packfiles: PackfilesInfo{
 					GarbageCount: 1,
 					GarbageSize:  1,
@@ -273,6 +288,9 @@ func TestRepositoryInfoForRepository(t *testing.T) {
 				require.NoError(t, os.WriteFile(infoAlternatesPath, []byte(alternatePath), perm.PrivateFile))
 			},
 			expectedInfo: RepositoryInfo{
+				References: ReferencesInfo{
+					ReferenceBackendName: "files",
+				},
 				Alternates: []string{
 					alternatePath,
 				},
@@ -293,6 +311,7 @@ func TestRepositoryInfoForRepository(t *testing.T) {
 					Size:  hashDependentSize(142, 158),
 				},
 				References: ReferencesInfo{
+					ReferenceBackendName: "files",
 					LooseReferencesCount: 1,
 				},
 				CommitGraph: CommitGraphInfo{
@@ -315,6 +334,7 @@ func TestRepositoryInfoForRepository(t *testing.T) {
 					Size:  hashDependentSize(142, 158),
 				},
 				References: ReferencesInfo{
+					ReferenceBackendName: "files",
 					LooseReferencesCount: 1,
 				},
 				CommitGraph: CommitGraphInfo{
@@ -342,6 +362,7 @@ func TestRepositoryInfoForRepository(t *testing.T) {
 					Size:  hashDependentSize(142, 158),
 				},
 				References: ReferencesInfo{
+					ReferenceBackendName: "files",
 					LooseReferencesCount: 1,
 				},
 				CommitGraph: CommitGraphInfo{
@@ -390,6 +411,7 @@ func TestRepositoryInfoForRepository(t *testing.T) {
 					},
 				},
 				References: ReferencesInfo{
+					ReferenceBackendName: "files",
 					LooseReferencesCount: 1,
 				},
 				Alternates: []string{
@@ -428,6 +450,9 @@ func TestReferencesInfoForRepository(t *testing.T) {
 			desc: "empty repository",
 			setup: func(*testing.T, *localrepo.Repo, string) {
 			},
+			expectedInfo: ReferencesInfo{
+				ReferenceBackendName: "files",
+			},
 		},
 		{
 			desc: "single unpacked reference",
@@ -435,6 +460,7 @@ func TestReferencesInfoForRepository(t *testing.T) {
 				gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
 			},
 			expectedInfo: ReferencesInfo{
+				ReferenceBackendName: "files",
 				LooseReferencesCount: 1,
 			},
 		},
@@ -447,6 +473,7 @@ func TestReferencesInfoForRepository(t *testing.T) {
 				require.NoError(t, os.WriteFile(filepath.Join(repoPath, "packed-refs"), []byte("content"), perm.SharedFile))
 			},
 			expectedInfo: ReferencesInfo{
+				ReferenceBackendName: "files",
 				PackedReferencesSize: 7,
 			},
 		},
@@ -467,10 +494,31 @@ func TestReferencesInfoForRepository(t *testing.T) {
 				require.NoError(t, os.WriteFile(filepath.Join(repoPath, "packed-refs"), []byte("content"), perm.SharedFile))
 			},
 			expectedInfo: ReferencesInfo{
+				ReferenceBackendName: "files",
 				LooseReferencesCount: 3,
 				PackedReferencesSize: 7,
 			},
 		},
+		{
+			desc: "reftable",
+			setup: func(t *testing.T, _ *localrepo.Repo, repoPath string) {
+				// We cannot rely on the Git version used in tests to support the reftable
+				// backend, so we fake the on-disk layout of such a repository instead. The
+				// loose reference must not be counted as it is not used by the backend.
+				gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+
+				reftablePath := filepath.Join(repoPath, "reftable")
+				require.NoError(t, os.Mkdir(reftablePath, perm.SharedDir))
+				require.NoError(t, os.WriteFile(filepath.Join(reftablePath, "0x01-0x02.ref"), []byte("first"), perm.SharedFile))
+				require.NoError(t, os.WriteFile(filepath.Join(reftablePath, "0x03-0x03.ref"), []byte("second"), perm.SharedFile))
+				require.NoError(t, os.WriteFile(filepath.Join(reftablePath, "tables.list"), []byte("0x01-0x02.ref\n0x03-0x03.ref\n0x04-0x04.ref\n"), perm.SharedFile))
+			},
+			expectedInfo: ReferencesInfo{
+				ReferenceBackendName: "reftable",
+				ReftableTablesCount:  2,
+				ReftableSize:         11,
+			},
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
@@ -0,0 +1,182 @@
+package stats
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// SSHOptions configures how the SSH connection to the remote repository is established.
+type SSHOptions struct {
+	// Command is the SSH executable that is used to connect to the remote. Defaults to "ssh".
+	Command string
+	// IdentityFile is the path to the private key used for authentication. If unset, the SSH
+	// executable's defaults are used.
+	IdentityFile string
+	// KnownHostsFile is the path to the known hosts file used to verify the remote's host key.
+	// If unset, the SSH executable's defaults are used.
+	KnownHostsFile string
+}
+
+// sshSession is a Git service spawned on the remote side via SSH.
+type sshSession struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout io.Reader
+	stderr bytes.Buffer
+	done   bool
+}
+
+// sshCommandArgs computes the arguments required to spawn the given Git service on the remote
+// identified by the URL. The user is only used in case the URL doesn't contain any user.
+func sshCommandArgs(rawURL, user string, opts SSHOptions, service string) ([]string, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing URL: %w", err)
+	}
+
+	if parsedURL.Scheme != "ssh" {
+		return nil, fmt.Errorf("unsupported URL scheme: %q", parsedURL.Scheme)
+	}
+	if parsedURL.Hostname() == "" {
+		return nil, errors.New("URL is missing host")
+	}
+	if parsedURL.Path == "" {
+		return nil, errors.New("URL is missing path")
+	}
+
+	args := []string{"-o", "BatchMode=yes"}
+	if opts.IdentityFile != "" {
+		args = append(args, "-i", opts.IdentityFile, "-o", "IdentitiesOnly=yes")
+	}
+	if opts.KnownHostsFile != "" {
+		args = append(args, "-o", "UserKnownHostsFile="+opts.KnownHostsFile, "-o", "StrictHostKeyChecking=yes")
+	}
+	if port := parsedURL.Port(); port != "" {
+		args = append(args, "-p", port)
+	}
+
+	if urlUser := parsedURL.User.Username(); urlUser != "" {
+		user = urlUser
+	}
+
+	destination := parsedURL.Hostname()
+	if user != "" {
+		destination = user + "@" + destination
+	}
+
+	return append(args, destination, service+" "+shellQuote(parsedURL.Path)), nil
+}
+
+// shellQuote quotes the string so that it is interpreted verbatim by a POSIX shell. This mirrors
+// the quoting Git itself applies to the path when spawning remote commands via SSH.
+func shellQuote(s string) string {
+	var builder strings.Builder
+	builder.WriteByte('\'')
+	for _, r := range s {
+		switch r {
+		case '\'', '!':
+			builder.WriteString(`'\` + string(r) + `'`)
+		default:
+			builder.WriteRune(r)
+		}
+	}
+	builder.WriteByte('\'')
+	return builder.String()
+}
+
+func startSSHSession(
+	ctx context.Context,
+	rawURL, user string,
+	opts SSHOptions,
+	service string,
+	reportProgress func(string, ...interface{}),
+) (*sshSession, error) {
+	args, err := sshCommandArgs(rawURL, user, opts, service)
+	if err != nil {
+		return nil, err
+	}
+
+	command := opts.Command
+	if command == "" {
+		command = "ssh"
+	}
+
+	reportProgress("---\n")
+	reportProgress("--- %s %s\n", command, strings.Join(args, " "))
+	reportProgress("---\n")
+
+	session := &sshSession{
+		cmd: exec.CommandContext(ctx, command, args...),
+	}
+	session.cmd.Stderr = &session.stderr
+
+	session.stdin, err = session.cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("creating stdin pipe: %w", err)
+	}
+
+	session.stdout, err = session.cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("creating stdout pipe: %w", err)
+	}
+
+	if err := session.cmd.Start(); err != nil {
+		return nil, fmt.Errorf("starting SSH command: %w", err)
+	}
+
+	return session, nil
+}
+
+// closeStdin signals to the remote command that we're done writing.
+func (s *sshSession) closeStdin() error {
+	if err := s.stdin.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+		return fmt.Errorf("closing stdin: %w", err)
+	}
+
+	return nil
+}
+
+// wait closes the session's standard input and waits for the remote command to exit.
+func (s *sshSession) wait() error {
+	if err := s.closeStdin(); err != nil {
+		return err
+	}
+
+	s.done = true
+	if err := s.cmd.Wait(); err != nil {
+		return fmt.Errorf("waiting for SSH command: %w, stderr: %q", err, s.stderr.String())
+	}
+
+	return nil
+}
+
+// abort terminates the session after the given error was encountered. The error is enriched
+// with the command's standard error, which typically explains why the session has failed.
+func (s *sshSession) abort(err error) error {
+	s.close()
+
+	if s.stderr.Len() > 0 {
+		return fmt.Errorf("%w, stderr: %q", err, s.stderr.String())
+	}
+
+	return err
+}
+
+// close kills the SSH command in case it hasn't been waited on yet.
+func (s *sshSession) close() {
+	if s.done {
+		return
+	}
+	s.done = true
+
+	// Errors are ignored given that we're only cleaning up after an earlier failure.
+	_ = s.cmd.Process.Kill()
+	_ = s.cmd.Wait()
+}
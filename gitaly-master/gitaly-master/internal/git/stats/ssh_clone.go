@@ -0,0 +1,75 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SSHClone hosts information about a typical SSH-based clone.
+type SSHClone struct {
+	// ReferenceDiscovery is the reference advertisement received as part of the clone.
+	ReferenceDiscovery TransportReferenceDiscovery
+	// FetchPack is the response to a git-fetch-pack(1) request which computes and transmits the
+	// packfile.
+	FetchPack TransportFetchPack
+}
+
+// PerformSSHClone does a Git SSH clone, discarding cloned data to /dev/null.
+func PerformSSHClone(ctx context.Context, url, user string, opts SSHOptions, interactive bool) (SSHClone, error) {
+	printInteractive := func(format string, a ...interface{}) {
+		if interactive {
+			// Ignore any errors returned by this given that we only use it as a
+			// debugging aid to write to stdout.
+			fmt.Printf(format, a...)
+		}
+	}
+
+	clone, err := performSSHClone(ctx, url, user, opts, printInteractive)
+	if err != nil {
+		return SSHClone{}, ctxErr(ctx, err)
+	}
+
+	return clone, nil
+}
+
+func performSSHClone(
+	ctx context.Context,
+	url, user string,
+	opts SSHOptions,
+	reportProgress func(string, ...interface{}),
+) (SSHClone, error) {
+	var clone SSHClone
+
+	clone.ReferenceDiscovery.start = time.Now()
+
+	session, err := startSSHSession(ctx, url, user, opts, "git-upload-pack", reportProgress)
+	if err != nil {
+		return SSHClone{}, err
+	}
+	defer session.close()
+
+	if err := clone.ReferenceDiscovery.stats.ParseAdvertisement(session.stdout); err != nil {
+		return SSHClone{}, session.abort(fmt.Errorf("parsing reference advertisement: %w", err))
+	}
+
+	clone.FetchPack.start = time.Now()
+
+	clone.FetchPack.wantedRefs, err = writeFetchPackRequest(session.stdin, clone.ReferenceDiscovery.Refs())
+	if err != nil {
+		return SSHClone{}, session.abort(fmt.Errorf("writing fetch-pack request: %w", err))
+	}
+
+	clone.FetchPack.stats.ReportProgress = func(b []byte) { reportProgress("%s", string(b)) }
+	if err := clone.FetchPack.stats.Parse(session.stdout); err != nil {
+		return SSHClone{}, session.abort(fmt.Errorf("parsing fetch-pack response: %w", err))
+	}
+
+	reportProgress("\n")
+
+	if err := session.wait(); err != nil {
+		return SSHClone{}, err
+	}
+
+	return clone, nil
+}
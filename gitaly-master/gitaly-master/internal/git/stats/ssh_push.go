@@ -0,0 +1,101 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+)
+
+// SSHPush hosts information about a typical SSH-based push.
+type SSHPush struct {
+	// ReferenceDiscovery is the reference advertisement received as part of the push.
+	ReferenceDiscovery TransportReferenceDiscovery
+	// SendPack is the upload of the packfile performed as part of the push.
+	SendPack TransportSendPack
+}
+
+// PerformSSHPush emulates a git-push(1) over the SSH protocol.
+func PerformSSHPush(
+	ctx context.Context,
+	url, user string,
+	opts SSHOptions,
+	objectHash git.ObjectHash,
+	commands []PushCommand,
+	packfile io.Reader,
+	interactive bool,
+) (SSHPush, error) {
+	printInteractive := func(format string, a ...interface{}) {
+		if interactive {
+			// Ignore any errors returned by this given that we only use it as a
+			// debugging aid to write to stdout.
+			fmt.Printf(format, a...)
+		}
+	}
+
+	push, err := performSSHPush(ctx, url, user, opts, objectHash, commands, packfile, printInteractive)
+	if err != nil {
+		return SSHPush{}, ctxErr(ctx, err)
+	}
+
+	return push, nil
+}
+
+func performSSHPush(
+	ctx context.Context,
+	url, user string,
+	opts SSHOptions,
+	objectHash git.ObjectHash,
+	commands []PushCommand,
+	packfile io.Reader,
+	reportProgress func(string, ...interface{}),
+) (SSHPush, error) {
+	var push SSHPush
+
+	push.ReferenceDiscovery.start = time.Now()
+
+	session, err := startSSHSession(ctx, url, user, opts, "git-receive-pack", reportProgress)
+	if err != nil {
+		return SSHPush{}, err
+	}
+	defer session.close()
+
+	if err := push.ReferenceDiscovery.stats.ParseAdvertisement(session.stdout); err != nil {
+		return SSHPush{}, session.abort(fmt.Errorf("parsing reference advertisement: %w", err))
+	}
+
+	// Sending object IDs of the wrong object format would only cause the remote to reject the
+	// push with a rather unhelpful error message, so we verify the object format up front.
+	remoteObjectHash, err := push.ReferenceDiscovery.stats.ObjectHash()
+	if err != nil {
+		return SSHPush{}, session.abort(fmt.Errorf("detecting remote object hash: %w", err))
+	}
+	if remoteObjectHash.Format != objectHash.Format {
+		return SSHPush{}, session.abort(fmt.Errorf("remote uses object format %q, but push uses %q",
+			remoteObjectHash.Format, objectHash.Format))
+	}
+
+	push.SendPack.start = time.Now()
+
+	if err := writeSendPackRequest(session.stdin, objectHash, commands, packfile); err != nil {
+		return SSHPush{}, session.abort(fmt.Errorf("writing send-pack request: %w", err))
+	}
+
+	// Signal to the remote side that the complete packfile has been sent.
+	if err := session.closeStdin(); err != nil {
+		return SSHPush{}, session.abort(err)
+	}
+
+	push.SendPack.stats.ReportProgress = func(b []byte) { reportProgress("%s", string(b)) }
+	if err := push.SendPack.stats.Parse(session.stdout); err != nil {
+		return SSHPush{}, session.abort(fmt.Errorf("parsing send-pack response: %w", err))
+	}
+
+	if err := session.wait(); err != nil {
+		return SSHPush{}, err
+	}
+
+	return push, nil
+}
@@ -0,0 +1,194 @@
+package stats
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+)
+
+// writeFakeSSH writes an executable which mimics ssh(1) by executing the remote command locally
+// via the Git binary configured for tests. The arguments it was invoked with are written to the
+// returned log file.
+func writeFakeSSH(t *testing.T, cfg config.Cfg) (string, string) {
+	t.Helper()
+
+	ctx := testhelper.Context(t)
+	execEnv := gittest.NewCommandFactory(t, cfg).GetExecutionEnvironment(ctx)
+
+	var env []string
+	for _, variable := range execEnv.EnvironmentVariables {
+		env = append(env, shellQuote(variable))
+	}
+
+	dir := testhelper.TempDir(t)
+	argsPath := filepath.Join(dir, "args")
+
+	sshPath := testhelper.WriteExecutable(t, filepath.Join(dir, "ssh"), []byte(fmt.Sprintf(`#!/bin/sh
+echo "$@" >%s
+for command; do :; done
+exec env %s sh -c "exec %s ${command#git-}"
+`, shellQuote(argsPath), strings.Join(env, " "), shellQuote(execEnv.BinaryPath))))
+
+	return sshPath, argsPath
+}
+
+func TestSSHCommandArgs(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc         string
+		url          string
+		user         string
+		opts         SSHOptions
+		expectedArgs []string
+		expectedErr  string
+	}{
+		{
+			desc:         "minimal URL",
+			url:          "ssh://example.com/group/repo.git",
+			expectedArgs: []string{"-o", "BatchMode=yes", "example.com", "git-upload-pack '/group/repo.git'"},
+		},
+		{
+			desc: "user and port in URL",
+			url:  "ssh://git@example.com:2222/group/repo.git",
+			user: "ignored",
+			expectedArgs: []string{
+				"-o", "BatchMode=yes", "-p", "2222", "git@example.com", "git-upload-pack '/group/repo.git'",
+			},
+		},
+		{
+			desc: "user and options",
+			url:  "ssh://example.com/it's!.git",
+			user: "git",
+			opts: SSHOptions{IdentityFile: "/key", KnownHostsFile: "/known_hosts"},
+			expectedArgs: []string{
+				"-o", "BatchMode=yes",
+				"-i", "/key", "-o", "IdentitiesOnly=yes",
+				"-o", "UserKnownHostsFile=/known_hosts", "-o", "StrictHostKeyChecking=yes",
+				"git@example.com", `git-upload-pack '/it'\''s'\!'.git'`,
+			},
+		},
+		{
+			desc:        "unsupported scheme",
+			url:         "https://example.com/repo.git",
+			expectedErr: `unsupported URL scheme: "https"`,
+		},
+		{
+			desc:        "missing path",
+			url:         "ssh://example.com",
+			expectedErr: "URL is missing path",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			args, err := sshCommandArgs(tc.url, tc.user, tc.opts, "git-upload-pack")
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedArgs, args)
+		})
+	}
+}
+
+func TestPerformSSHClone(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	_, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+	gittest.WriteTag(t, cfg, repoPath, "some-tag", "refs/heads/main")
+
+	sshPath, argsPath := writeFakeSSH(t, cfg)
+
+	clone, err := PerformSSHClone(ctx, "ssh://localhost"+repoPath, "git", SSHOptions{
+		Command:      sshPath,
+		IdentityFile: "/path/to/key",
+	}, false)
+	require.NoError(t, err)
+
+	args := text.ChompBytes(testhelper.MustReadFile(t, argsPath))
+	require.Equal(t, fmt.Sprintf("-o BatchMode=yes -i /path/to/key -o IdentitiesOnly=yes git@localhost git-upload-pack '%s'", repoPath), args)
+
+	require.Len(t, clone.ReferenceDiscovery.Refs(), 2)
+	require.Greater(t, len(clone.ReferenceDiscovery.Caps()), 10)
+	require.Equal(t, 2, clone.FetchPack.RefsWanted())
+	require.Greater(t, clone.FetchPack.BandPackets("pack"), 0)
+	require.Greater(t, clone.FetchPack.BandPayloadSize("pack"), int64(0))
+
+	for _, durations := range [][]time.Duration{
+		{clone.ReferenceDiscovery.FirstGitPacket(), clone.ReferenceDiscovery.ResponseBody()},
+		{clone.FetchPack.NAK(), clone.FetchPack.BandFirstPacket("pack"), clone.FetchPack.ResponseBody()},
+	} {
+		previousValue := time.Duration(0)
+		for _, duration := range durations {
+			require.GreaterOrEqual(t, duration, previousValue)
+			previousValue = duration
+		}
+	}
+}
+
+func TestPerformSSHClone_failingCommand(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	sshPath := testhelper.WriteExecutable(t, filepath.Join(testhelper.TempDir(t), "ssh"), []byte(`#!/bin/sh
+echo "Permission denied (publickey)." >&2
+exit 255
+`))
+
+	_, err := PerformSSHClone(ctx, "ssh://localhost/repo.git", "", SSHOptions{Command: sshPath}, false)
+	require.EqualError(t, err, "parsing reference advertisement: received no references, stderr: \"Permission denied (publickey).\\n\"")
+}
+
+func TestPerformSSHPush(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	_, sourceRepoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	_, targetRepoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	commit := gittest.WriteCommit(t, cfg, sourceRepoPath)
+	pack := gittest.ExecOpts(t, cfg, gittest.ExecConfig{Stdin: strings.NewReader(commit.String())},
+		"-C", sourceRepoPath, "pack-objects", "--stdout", "--revs", "--thin", "--delta-base-offset", "-q",
+	)
+
+	sshPath, _ := writeFakeSSH(t, cfg)
+
+	push, err := PerformSSHPush(ctx, "ssh://localhost"+targetRepoPath, "", SSHOptions{Command: sshPath},
+		gittest.DefaultObjectHash, []PushCommand{
+			{OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commit, Reference: "refs/heads/foobar"},
+		}, bytes.NewReader(pack), false)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, push.SendPack.stats.updatedRefs)
+	require.Greater(t, push.SendPack.BandPayloadSize("pack"), int64(0))
+	require.GreaterOrEqual(t, push.SendPack.ResponseBody(), push.SendPack.BandFirstPacket("pack"))
+	require.Equal(t, commit.String(), text.ChompBytes(gittest.Exec(t, cfg, "-C", targetRepoPath, "rev-parse", "refs/heads/foobar")))
+}
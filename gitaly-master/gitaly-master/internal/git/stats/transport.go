@@ -0,0 +1,90 @@
+package stats
+
+import (
+	"time"
+)
+
+// TransportReferenceDiscovery is a ReferenceDiscovery obtained via a transport other than HTTP,
+// like SSH or gRPC. It contains additional information about timings.
+type TransportReferenceDiscovery struct {
+	start time.Time
+	stats ReferenceDiscovery
+}
+
+// FirstGitPacket returns how long it took to receive the first Git packet.
+func (d TransportReferenceDiscovery) FirstGitPacket() time.Duration {
+	return d.stats.FirstPacket.Sub(d.start)
+}
+
+// ResponseBody returns how long it took to receive the complete reference advertisement.
+func (d TransportReferenceDiscovery) ResponseBody() time.Duration {
+	return d.stats.LastPacket.Sub(d.start)
+}
+
+// Refs returns all announced references.
+func (d TransportReferenceDiscovery) Refs() []Reference { return d.stats.Refs }
+
+// Packets returns the number of Git packets received.
+func (d TransportReferenceDiscovery) Packets() int { return d.stats.Packets }
+
+// PayloadSize returns the total size of all pktlines' data.
+func (d TransportReferenceDiscovery) PayloadSize() int64 { return d.stats.PayloadSize }
+
+// Caps returns all announced capabilities.
+func (d TransportReferenceDiscovery) Caps() []string { return d.stats.Caps }
+
+// TransportFetchPack is a FetchPack obtained via a transport other than HTTP, like SSH or gRPC. It
+// contains additional information about timings.
+type TransportFetchPack struct {
+	start      time.Time
+	stats      FetchPack
+	wantedRefs []string
+}
+
+// NAK returns how long it took to receive the NAK which signals that negotiation has concluded.
+func (p *TransportFetchPack) NAK() time.Duration { return p.stats.nak.Sub(p.start) }
+
+// ResponseBody returns how long it took to receive the complete response.
+func (p *TransportFetchPack) ResponseBody() time.Duration {
+	return p.stats.responseBody.Sub(p.start)
+}
+
+// Packets returns the number of Git packets received.
+func (p *TransportFetchPack) Packets() int { return p.stats.packets }
+
+// LargestPacketSize returns the largest packet size received.
+func (p *TransportFetchPack) LargestPacketSize() int { return p.stats.largestPacketSize }
+
+// RefsWanted returns the number of references sent to the remote repository as "want"s.
+func (p *TransportFetchPack) RefsWanted() int { return len(p.wantedRefs) }
+
+// BandPackets returns how many packets were received on a specific sideband.
+func (p *TransportFetchPack) BandPackets(b string) int { return p.stats.multiband[b].packets }
+
+// BandPayloadSize returns how many bytes were received on a specific sideband.
+func (p *TransportFetchPack) BandPayloadSize(b string) int64 { return p.stats.multiband[b].size }
+
+// BandFirstPacket returns how long it took to receive the first packet on a specific sideband.
+func (p *TransportFetchPack) BandFirstPacket(b string) time.Duration {
+	return p.stats.multiband[b].firstPacket.Sub(p.start)
+}
+
+// TransportSendPack is a SendPack obtained via a transport other than HTTP, like SSH. It contains
+// additional information about timings.
+type TransportSendPack struct {
+	start time.Time
+	stats SendPack
+}
+
+// ResponseBody returns how long it took to receive the complete response.
+func (p *TransportSendPack) ResponseBody() time.Duration {
+	return p.stats.responseBody.Sub(p.start)
+}
+
+// BandPayloadSize returns how many bytes were received on a specific sideband.
+func (p *TransportSendPack) BandPayloadSize(b string) int64 { return p.stats.multiband[b].size }
+
+// BandFirstPacket returns how long it took to receive the first packet on a specific sideband.
+func (p *TransportSendPack) BandFirstPacket(b string) time.Duration {
+	return p.stats.multiband[b].firstPacket.Sub(p.start)
+}
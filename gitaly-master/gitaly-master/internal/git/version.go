@@ -83,6 +83,25 @@ func (v Version) MidxDeletesRedundantBitmaps() bool {
 	})
 }
 
+// SupportsBundleURI detects whether the given Git version is able to advertise bundle URIs
+// configured via `bundle.*` to clients by setting `uploadpack.advertiseBundleURIs`. The server
+// side of the bundle-uri capability has been added via 0ff7ae4 (bundle-uri: serve URI
+// advertisement from bundle.* config, 2022-12-22), which is part of Git v2.40.0 and newer.
+func (v Version) SupportsBundleURI() bool {
+	return !v.LessThan(Version{
+		major: 2, minor: 40, patch: 0,
+	})
+}
+
+// SupportsReftable detects whether the given Git version is able to create and use repositories
+// with the reftable reference backend. The backend has been added via 57db2a094d (refs: introduce
+// reftable backend, 2024-02-07), which is part of Git v2.45.0 and newer.
+func (v Version) SupportsReftable() bool {
+	return !v.LessThan(Version{
+		major: 2, minor: 45, patch: 0,
+	})
+}
+
 // LessThan determines whether the version is older than another version.
 func (v Version) LessThan(other Version) bool {
 	switch {
@@ -158,3 +158,41 @@ func TestVersion_MidxDeletesRedundantBitmaps(t *testing.T) {
 		})
 	}
 }
+
+func TestVersion_SupportsBundleURI(t *testing.T) {
+	for _, tc := range []struct {
+		version string
+		expect  bool
+	}{
+		{"1.0.0", false},
+		{"2.39.2", false},
+		{"2.40.0-rc0", false},
+		{"2.40.0", true},
+		{"3.0.0", true},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			version, err := parseVersion(tc.version)
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, version.SupportsBundleURI())
+		})
+	}
+}
+
+func TestVersion_SupportsReftable(t *testing.T) {
+	for _, tc := range []struct {
+		version string
+		expect  bool
+	}{
+		{"1.0.0", false},
+		{"2.44.1", false},
+		{"2.45.0-rc0", false},
+		{"2.45.0", true},
+		{"3.0.0", true},
+	} {
+		t.Run(tc.version, func(t *testing.T) {
+			version, err := parseVersion(tc.version)
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, version.SupportsReftable())
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"golang.org/x/sys/unix"
 )
@@ -184,6 +185,30 @@ func (t *TarBuilder) VirtualFileWithContents(relPath string, contents *os.File)
 	return t.entry(fi, relPath, contents)
 }
 
+// VirtualFileWithBytes creates a regular file entry at relPath with the given contents. This can
+// be used to write files into the archive which do not exist on disk.
+func (t *TarBuilder) VirtualFileWithBytes(relPath string, contents []byte) error {
+	if t.err != nil {
+		return t.err
+	}
+
+	if err := t.tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     relPath,
+		Mode:     0o644,
+		Size:     int64(len(contents)),
+		ModTime:  time.Now(),
+	}); err != nil {
+		return t.setErr(err)
+	}
+
+	if _, err := t.tarWriter.Write(contents); err != nil {
+		return t.setErr(err)
+	}
+
+	return nil
+}
+
 // RecursiveDirIfExist is a helper for RecursiveDir that sets `mustExist` to
 // false.
 func (t *TarBuilder) RecursiveDirIfExist(rel string, patterns ...*regexp.Regexp) error {
@@ -0,0 +1,473 @@
+// Package audit implements a tamper-evident audit log of repository mutations. Entries are
+// appended to a file as JSON lines. Each entry records its sequence number, the hash of its
+// predecessor and its own hash so that removing, reordering or modifying entries breaks the hash
+// chain, which can be detected via Verify. Hashes are keyed with a secret so that the chain cannot
+// be recomputed by anyone who can write to the audit log.
+//
+// Removing entries from the end of the audit log cannot be detected by looking at the audit log
+// alone. The Logger thus reports the head of the chain to the application log whenever it appends
+// an entry, which anchors it outside of the audit log.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// EntryType is the type of an audit entry.
+type EntryType string
+
+const (
+	// EntryTypeRPC is the type of entries recording a mutator RPC.
+	EntryTypeRPC = EntryType("rpc")
+	// EntryTypeReferenceTransaction is the type of entries recording reference updates which
+	// have been committed by a reference transaction.
+	EntryTypeReferenceTransaction = EntryType("reference_transaction")
+)
+
+// ChangeType describes how a reference has been changed.
+type ChangeType string
+
+const (
+	// ChangeCreate is a reference which has been created. Updates of references whose old
+	// value has not been verified are recorded as creations, too.
+	ChangeCreate = ChangeType("create")
+	// ChangeUpdate is a reference which has been fast-forwarded.
+	ChangeUpdate = ChangeType("update")
+	// ChangeForceUpdate is a reference which has been updated to a commit that doesn't
+	// descend from its old value, e.g. by a force-push.
+	ChangeForceUpdate = ChangeType("force_update")
+	// ChangeDelete is a reference which has been deleted.
+	ChangeDelete = ChangeType("delete")
+)
+
+// Repository identifies the repository an entry has been recorded for.
+type Repository struct {
+	// StorageName is the name of the storage the repository is located in.
+	StorageName string `json:"storage_name"`
+	// RelativePath is the path of the repository relative to its storage.
+	RelativePath string `json:"relative_path,omitempty"`
+	// GlRepository is the GitLab identifier of the repository.
+	GlRepository string `json:"gl_repository,omitempty"`
+	// GlProjectPath is the GitLab path of the project the repository belongs to.
+	GlProjectPath string `json:"gl_project_path,omitempty"`
+}
+
+// NewRepository creates the Repository of an entry from the given repository.
+func NewRepository(repo *gitalypb.Repository) Repository {
+	return Repository{
+		StorageName:   repo.GetStorageName(),
+		RelativePath:  repo.GetRelativePath(),
+		GlRepository:  repo.GetGlRepository(),
+		GlProjectPath: repo.GetGlProjectPath(),
+	}
+}
+
+// ReferenceUpdate is a single reference update.
+type ReferenceUpdate struct {
+	// Reference is the fully-qualified name of the reference that has been updated.
+	Reference string `json:"reference"`
+	// OldOID is the object ID the reference pointed to before the update.
+	OldOID string `json:"old_oid"`
+	// NewOID is the object ID the reference points to after the update.
+	NewOID string `json:"new_oid"`
+	// Change describes how the reference has been changed.
+	Change ChangeType `json:"change"`
+}
+
+// Entry is a single entry of the audit log.
+type Entry struct {
+	// Time is the time at which the entry has been recorded. Set by the Logger if unset.
+	Time time.Time `json:"time"`
+	// Type is the type of the entry.
+	Type EntryType `json:"type"`
+	// Method is the full name of the RPC which has been invoked.
+	Method string `json:"method,omitempty"`
+	// CorrelationID is the correlation ID of the request. It links reference transactions
+	// to the RPC they have been performed by.
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// Client is the name of the client the request has been authenticated as, if any.
+	Client string `json:"client,omitempty"`
+	// Repository is the repository which has been mutated.
+	Repository Repository `json:"repository"`
+	// UserID is the GL_ID of the user who has caused the mutation.
+	UserID string `json:"user_id,omitempty"`
+	// Username is the name of the user who has caused the mutation.
+	Username string `json:"username,omitempty"`
+	// Protocol is the protocol via which the mutation has been performed.
+	Protocol string `json:"protocol,omitempty"`
+	// ReferenceUpdates are the reference updates of a reference transaction.
+	ReferenceUpdates []ReferenceUpdate `json:"reference_updates,omitempty"`
+	// Result is the gRPC status code the RPC has finished with.
+	Result string `json:"result,omitempty"`
+	// Error is the error message the RPC has failed with.
+	Error string `json:"error,omitempty"`
+	// Sequence is the position of the entry in the hash chain, starting at 1. Set by the
+	// Logger.
+	Sequence uint64 `json:"sequence"`
+	// PreviousHash is the hash of the preceding entry. It is empty for the first entry.
+	// Set by the Logger.
+	PreviousHash string `json:"previous_hash"`
+}
+
+// Head identifies the last entry of the hash chain. The zero value identifies the start of the
+// chain.
+type Head struct {
+	// Sequence is the sequence number of the last entry.
+	Sequence uint64
+	// Hash is the hash of the last entry.
+	Hash string
+}
+
+// hashField is the field that is appended to the encoded entry. The hash is computed over the
+// encoded entry without this field.
+const hashField = `,"hash":"`
+
+// Logger appends entries to the audit log. Writes are serialized via a lock file so that the hash
+// chain stays intact when multiple Gitaly processes write to the same audit log, e.g. during a
+// graceful upgrade.
+type Logger struct {
+	path         string
+	maxSizeBytes int64
+	key          []byte
+	logger       logrus.FieldLogger
+	now          func() time.Time
+
+	mutex    sync.Mutex
+	lockFile *os.File
+	file     *os.File
+	size     int64
+	head     Head
+}
+
+// NewLogger creates a new Logger writing to the audit log configured in cfg. The head of the hash
+// chain is reported to the given logger after every appended entry.
+func NewLogger(cfg config.Audit, logger logrus.FieldLogger) (*Logger, error) {
+	lockFile, err := os.OpenFile(cfg.Path+".lock", os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening lock file: %w", err)
+	}
+
+	return &Logger{
+		path:         cfg.Path,
+		maxSizeBytes: cfg.MaxSizeBytes,
+		key:          []byte(cfg.Secret),
+		logger:       logger,
+		now:          time.Now,
+		lockFile:     lockFile,
+	}, nil
+}
+
+// Log appends the entry to the audit log.
+func (l *Logger) Log(entry Entry) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if err := syscall.Flock(int(l.lockFile.Fd()), syscall.LOCK_EX); err != nil {
+		return fmt.Errorf("locking audit log: %w", err)
+	}
+	defer func() {
+		_ = syscall.Flock(int(l.lockFile.Fd()), syscall.LOCK_UN)
+	}()
+
+	if err := l.sync(); err != nil {
+		return err
+	}
+
+	if entry.Time.IsZero() {
+		entry.Time = l.now()
+	}
+	entry.Time = entry.Time.UTC()
+	entry.Sequence = l.head.Sequence + 1
+	entry.PreviousHash = l.head.Hash
+
+	line, hash, err := encodeEntry(l.key, entry)
+	if err != nil {
+		return err
+	}
+
+	if l.maxSizeBytes > 0 && l.size > 0 && l.size+int64(len(line)) > l.maxSizeBytes {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("writing audit entry: %w", err)
+	}
+
+	l.head = Head{Sequence: entry.Sequence, Hash: hash}
+
+	l.logger.WithFields(logrus.Fields{
+		"audit_sequence": l.head.Sequence,
+		"audit_hash":     l.head.Hash,
+	}).Info("audit entry recorded")
+
+	return nil
+}
+
+// Close closes the audit log.
+func (l *Logger) Close() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	var fileErr error
+	if l.file != nil {
+		fileErr = l.file.Close()
+		l.file = nil
+	}
+
+	if err := l.lockFile.Close(); err != nil {
+		return fmt.Errorf("closing lock file: %w", err)
+	}
+
+	if fileErr != nil {
+		return fmt.Errorf("closing audit log: %w", fileErr)
+	}
+
+	return nil
+}
+
+// sync makes sure the Logger writes to the current audit log and knows the head of its hash
+// chain. The audit log may have been appended to or rotated by another process since the last
+// write. Must be called with the lock held.
+func (l *Logger) sync() error {
+	pathInfo, err := os.Stat(l.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("stat audit log: %w", err)
+	}
+
+	if l.file != nil {
+		fileInfo, err := l.file.Stat()
+		if err != nil {
+			return fmt.Errorf("stat audit log: %w", err)
+		}
+
+		if pathInfo != nil && os.SameFile(pathInfo, fileInfo) {
+			if fileInfo.Size() != l.size {
+				return l.recoverHead()
+			}
+
+			return nil
+		}
+
+		// The audit log has been rotated by another process. Its last entry is the last
+		// entry of the rotated audit log, which we cannot know about. We thus need to read
+		// it from the audit log that we still have open.
+		if err := l.recoverHead(); err != nil {
+			return err
+		}
+
+		if err := l.file.Close(); err != nil {
+			return fmt.Errorf("closing rotated audit log: %w", err)
+		}
+		l.file = nil
+	}
+
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_RDWR, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	l.file = file
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("stat audit log: %w", err)
+	}
+
+	if fileInfo.Size() == 0 {
+		l.size = 0
+		return nil
+	}
+
+	return l.recoverHead()
+}
+
+// recoverHead reads the head of the hash chain from the last entry of the currently opened audit
+// log.
+func (l *Logger) recoverHead() error {
+	fileInfo, err := l.file.Stat()
+	if err != nil {
+		return fmt.Errorf("stat audit log: %w", err)
+	}
+	l.size = fileInfo.Size()
+
+	line, err := readLastLine(l.file, l.size)
+	if err != nil {
+		return fmt.Errorf("reading last audit entry: %w", err)
+	}
+
+	if len(line) == 0 {
+		return nil
+	}
+
+	encoded, hash, err := splitHash(line)
+	if err != nil {
+		return fmt.Errorf("reading last audit entry: %w", err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(encoded, &entry); err != nil {
+		return fmt.Errorf("decoding last audit entry: %w", err)
+	}
+	l.head = Head{Sequence: entry.Sequence, Hash: hash}
+
+	return nil
+}
+
+// rotate moves the current audit log aside and opens a new one. The hash chain continues in the
+// new audit log. Must be called with the lock held.
+func (l *Logger) rotate() error {
+	rotatedPath := fmt.Sprintf("%s.%s", l.path, l.now().UTC().Format("20060102T150405.000000000Z"))
+	if err := os.Rename(l.path, rotatedPath); err != nil {
+		return fmt.Errorf("rotating audit log: %w", err)
+	}
+
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("closing rotated audit log: %w", err)
+	}
+
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_EXCL|os.O_APPEND|os.O_RDWR, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	l.file = file
+	l.size = 0
+
+	return nil
+}
+
+// readLastLine reads the last line of the file of the given size without its trailing newline.
+func readLastLine(file io.ReaderAt, size int64) ([]byte, error) {
+	const chunkSize = 4096
+
+	var line []byte
+	for offset := size; offset > 0; {
+		readSize := int64(chunkSize)
+		if offset < readSize {
+			readSize = offset
+		}
+		offset -= readSize
+
+		chunk := make([]byte, readSize)
+		if _, err := file.ReadAt(chunk, offset); err != nil {
+			return nil, err
+		}
+		line = append(chunk, line...)
+
+		// Skip the trailing newline terminating the last line.
+		if idx := bytes.LastIndexByte(line[:len(line)-1], '\n'); idx >= 0 {
+			line = line[idx+1:]
+			break
+		}
+	}
+
+	return bytes.TrimSuffix(line, []byte("\n")), nil
+}
+
+// encodeEntry encodes the entry as a line of the audit log. It returns the line and the hash of
+// the entry.
+func encodeEntry(key []byte, entry Entry) ([]byte, string, error) {
+	encoded, err := json.Marshal(entry)
+	if err != nil {
+		return nil, "", fmt.Errorf("encoding audit entry: %w", err)
+	}
+
+	hash := computeHash(key, encoded)
+
+	line := make([]byte, 0, len(encoded)+len(hashField)+len(hash)+3)
+	line = append(line, encoded[:len(encoded)-1]...)
+	line = append(line, hashField...)
+	line = append(line, hash...)
+	line = append(line, "\"}\n"...)
+
+	return line, hash, nil
+}
+
+// computeHash computes the keyed hash of the encoded entry.
+func computeHash(key, encoded []byte) string {
+	mac := hmac.New(sha256.New, key)
+	// Writing into a hash never fails.
+	_, _ = mac.Write(encoded)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// splitHash splits a line of the audit log into the encoded entry and its recorded hash.
+func splitHash(line []byte) ([]byte, string, error) {
+	idx := bytes.LastIndex(line, []byte(hashField))
+	if idx < 0 || !bytes.HasSuffix(line, []byte(`"}`)) {
+		return nil, "", errors.New("entry has no hash")
+	}
+
+	encoded := append(line[:idx:idx], '}')
+	hash := string(line[idx+len(hashField) : len(line)-2])
+
+	return encoded, hash, nil
+}
+
+// Verify verifies the hash chain of the audit log read from r with the secret key the entries
+// have been hashed with. previous is the head of the chain preceding the audit log, e.g. the head
+// of the previously rotated audit log, or the zero value if the audit log starts the chain. It
+// returns the head of the chain so that the chain can be verified across rotated audit logs. The
+// returned head should be compared with the last head reported to the application log to detect
+// entries which have been removed from the end of the audit log.
+func Verify(r io.Reader, key []byte, previous Head) (Head, error) {
+	reader := bufio.NewReader(r)
+
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) && len(line) == 0 {
+			return previous, nil
+		} else if err != nil && !errors.Is(err, io.EOF) {
+			return Head{}, fmt.Errorf("reading audit log: %w", err)
+		}
+		line = bytes.TrimSuffix(line, []byte("\n"))
+
+		encoded, hash, err := splitHash(line)
+		if err != nil {
+			return Head{}, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		var entry Entry
+		if err := json.Unmarshal(encoded, &entry); err != nil {
+			return Head{}, fmt.Errorf("line %d: decoding entry: %w", lineNumber, err)
+		}
+
+		if entry.Sequence != previous.Sequence+1 {
+			return Head{}, fmt.Errorf("line %d: %w: expected sequence %d, got %d",
+				lineNumber, ErrBrokenChain, previous.Sequence+1, entry.Sequence)
+		}
+
+		if entry.PreviousHash != previous.Hash {
+			return Head{}, fmt.Errorf("line %d: %w: expected previous hash %q, got %q",
+				lineNumber, ErrBrokenChain, previous.Hash, entry.PreviousHash)
+		}
+
+		if computed := computeHash(key, encoded); !hmac.Equal([]byte(computed), []byte(hash)) {
+			return Head{}, fmt.Errorf("line %d: %w: expected hash %q, got %q",
+				lineNumber, ErrBrokenChain, computed, hash)
+		}
+
+		previous = Head{Sequence: entry.Sequence, Hash: hash}
+	}
+}
+
+// ErrBrokenChain is returned by Verify when the hash chain of the audit log is broken.
+var ErrBrokenChain = errors.New("hash chain broken")
@@ -0,0 +1,262 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+)
+
+var testKey = []byte("secret")
+
+func newTestLogger(t *testing.T, cfg config.Audit) *Logger {
+	t.Helper()
+
+	cfg.Secret = string(testKey)
+	logger, err := NewLogger(cfg, testhelper.NewDiscardingLogEntry(t))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, logger.Close()) })
+
+	return logger
+}
+
+func readEntries(t *testing.T, path string) []Entry {
+	t.Helper()
+
+	var entries []Entry
+	for _, line := range strings.Split(strings.TrimSuffix(string(testhelper.MustReadFile(t, path)), "\n"), "\n") {
+		var entry Entry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(testhelper.TempDir(t), "audit.log")
+	logger := newTestLogger(t, config.Audit{Path: path})
+	logger.now = func() time.Time { return time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	applicationLogger, hook := test.NewNullLogger()
+	logger.logger = applicationLogger
+
+	require.NoError(t, logger.Log(Entry{
+		Type:       EntryTypeRPC,
+		Method:     "/gitaly.OperationService/UserDeleteBranch",
+		Repository: Repository{StorageName: "default", RelativePath: "repo.git"},
+		UserID:     "user-1",
+		Result:     "OK",
+	}))
+	require.NoError(t, logger.Log(Entry{
+		Type:       EntryTypeReferenceTransaction,
+		Repository: Repository{StorageName: "default", RelativePath: "repo.git"},
+		ReferenceUpdates: []ReferenceUpdate{
+			{Reference: "refs/heads/main", OldOID: "1", NewOID: "0", Change: ChangeDelete},
+		},
+	}))
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	require.Equal(t, "", entries[0].PreviousHash)
+	require.Equal(t, uint64(1), entries[0].Sequence)
+	require.Equal(t, uint64(2), entries[1].Sequence)
+	require.Equal(t, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC), entries[0].Time)
+	require.Equal(t, "/gitaly.OperationService/UserDeleteBranch", entries[0].Method)
+	require.Equal(t, []ReferenceUpdate{
+		{Reference: "refs/heads/main", OldOID: "1", NewOID: "0", Change: ChangeDelete},
+	}, entries[1].ReferenceUpdates)
+
+	lines := bytes.Split(testhelper.MustReadFile(t, path), []byte("\n"))
+	_, firstHash, err := splitHash(lines[0])
+	require.NoError(t, err)
+	require.Equal(t, firstHash, entries[1].PreviousHash)
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, file)
+
+	head, err := Verify(file, testKey, Head{})
+	require.NoError(t, err)
+	require.Equal(t, logger.head, head)
+
+	// The head of the chain is reported to the application log so that removal of trailing
+	// entries can be detected.
+	lastLogEntry := hook.LastEntry()
+	require.Equal(t, "audit entry recorded", lastLogEntry.Message)
+	require.Equal(t, head.Sequence, lastLogEntry.Data["audit_sequence"])
+	require.Equal(t, head.Hash, lastLogEntry.Data["audit_hash"])
+}
+
+func TestLogger_resume(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(testhelper.TempDir(t), "audit.log")
+
+	// Multiple loggers may write to the same audit log, e.g. during graceful upgrades. They
+	// must continue the hash chain of each other.
+	first := newTestLogger(t, config.Audit{Path: path})
+	second := newTestLogger(t, config.Audit{Path: path})
+
+	require.NoError(t, first.Log(Entry{Type: EntryTypeRPC, Method: "first"}))
+	require.NoError(t, second.Log(Entry{Type: EntryTypeRPC, Method: "second"}))
+	require.NoError(t, first.Log(Entry{Type: EntryTypeRPC, Method: "third"}))
+
+	// A logger created after a restart continues the chain, too.
+	third := newTestLogger(t, config.Audit{Path: path})
+	require.NoError(t, third.Log(Entry{Type: EntryTypeRPC, Method: "fourth"}))
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 4)
+
+	head, err := Verify(bytes.NewReader(testhelper.MustReadFile(t, path)), testKey, Head{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), head.Sequence)
+}
+
+func TestLogger_rotation(t *testing.T) {
+	t.Parallel()
+
+	dir := testhelper.TempDir(t)
+	path := filepath.Join(dir, "audit.log")
+
+	logger := newTestLogger(t, config.Audit{Path: path, MaxSizeBytes: 300})
+	other := newTestLogger(t, config.Audit{Path: path, MaxSizeBytes: 300})
+
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, l := range []*Logger{logger, other} {
+		l.now = func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		}
+	}
+
+	for i := 0; i < 6; i++ {
+		l := logger
+		if i%2 == 1 {
+			l = other
+		}
+
+		require.NoError(t, l.Log(Entry{Type: EntryTypeRPC, Method: "/gitaly.RefService/DeleteRefs"}))
+	}
+
+	rotated, err := filepath.Glob(path + ".2*")
+	require.NoError(t, err)
+	require.NotEmpty(t, rotated)
+	sort.Strings(rotated)
+
+	// The hash chain continues across rotated audit logs.
+	var head Head
+	var count int
+	for _, p := range append(rotated, path) {
+		info, err := os.Stat(p)
+		require.NoError(t, err)
+		require.LessOrEqual(t, info.Size(), int64(300))
+
+		head, err = Verify(bytes.NewReader(testhelper.MustReadFile(t, p)), testKey, head)
+		require.NoError(t, err)
+
+		count += len(readEntries(t, p))
+	}
+	require.Equal(t, 6, count)
+	require.Equal(t, uint64(6), head.Sequence)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(testhelper.TempDir(t), "audit.log")
+	logger := newTestLogger(t, config.Audit{Path: path})
+
+	for _, method := range []string{"first", "second", "third"} {
+		require.NoError(t, logger.Log(Entry{Type: EntryTypeRPC, Method: method, UserID: "user-1"}))
+	}
+
+	content := testhelper.MustReadFile(t, path)
+	lines := bytes.SplitAfter(content, []byte("\n"))
+
+	for _, tc := range []struct {
+		desc          string
+		log           []byte
+		key           []byte
+		previous      Head
+		expectedError string
+	}{
+		{
+			desc: "valid",
+			log:  content,
+		},
+		{
+			desc: "empty",
+			log:  nil,
+		},
+		{
+			desc:          "unexpected previous hash",
+			log:           content,
+			previous:      Head{Hash: "abc"},
+			expectedError: "line 1: hash chain broken",
+		},
+		{
+			desc:          "unexpected previous sequence",
+			log:           content,
+			previous:      Head{Sequence: 1},
+			expectedError: "line 1: hash chain broken",
+		},
+		{
+			desc:          "wrong key",
+			log:           content,
+			key:           []byte("other secret"),
+			expectedError: "line 1: hash chain broken",
+		},
+		{
+			desc:          "modified entry",
+			log:           bytes.Replace(content, []byte(`"user_id":"user-1"`), []byte(`"user_id":"user-2"`), 1),
+			expectedError: "line 1: hash chain broken",
+		},
+		{
+			desc:          "removed entry",
+			log:           bytes.Join([][]byte{lines[0], lines[2]}, nil),
+			expectedError: "line 2: hash chain broken",
+		},
+		{
+			desc:          "reordered entries",
+			log:           bytes.Join([][]byte{lines[0], lines[2], lines[1]}, nil),
+			expectedError: "line 2: hash chain broken",
+		},
+		{
+			desc:          "missing hash",
+			log:           []byte(`{"type":"rpc"}` + "\n"),
+			expectedError: "line 1: entry has no hash",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			key := tc.key
+			if key == nil {
+				key = testKey
+			}
+
+			_, err := Verify(bytes.NewReader(tc.log), key, tc.previous)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
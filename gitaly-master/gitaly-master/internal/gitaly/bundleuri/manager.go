@@ -0,0 +1,323 @@
+package bundleuri
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/repository"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	cgroupscfg "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// queueSize is the number of repositories which may be waiting for their bundle to be generated.
+// Requests to refresh bundles are dropped while the queue is full and will be retried by the next
+// upload-pack for the same repository.
+const queueSize = 256
+
+// Manager generates per-repository bundles into the configured bundle directory and computes the
+// Git configuration required to advertise them to clients via the bundle-uri capability.
+//
+// Bundles contain the full history of a repository and are served without any authentication, so
+// their names must not be derivable by anyone who only knows the repository. Bundle names are thus
+// derived from the repository via an HMAC keyed with the configured secret. The refresh window is
+// part of the HMAC's input, so the name of a repository's bundle changes with every refresh
+// interval and bundle URLs stop working once the bundle has been pruned.
+type Manager struct {
+	locator         storage.Locator
+	gitCmdFactory   git.CommandFactory
+	catfileCache    catfile.Cache
+	baseURL         string
+	dir             string
+	secret          []byte
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	queue       chan *gitalypb.Repository
+	pendingLock sync.Mutex
+	pending     map[string]struct{}
+}
+
+// NewManager creates a new Manager from the given configuration.
+func NewManager(
+	cfg config.BundleURI,
+	locator storage.Locator,
+	gitCmdFactory git.CommandFactory,
+	catfileCache catfile.Cache,
+) *Manager {
+	return &Manager{
+		locator:         locator,
+		gitCmdFactory:   gitCmdFactory,
+		catfileCache:    catfileCache,
+		baseURL:         strings.TrimSuffix(cfg.BaseURL, "/"),
+		dir:             cfg.Dir,
+		secret:          []byte(cfg.Secret),
+		refreshInterval: cfg.RefreshInterval.Duration(),
+		now:             time.Now,
+		queue:           make(chan *gitalypb.Repository, queueSize),
+		pending:         make(map[string]struct{}),
+	}
+}
+
+// window returns the refresh window the given point in time falls into.
+func (m *Manager) window(t time.Time) int64 {
+	return t.UnixNano() / int64(m.refreshInterval)
+}
+
+// bundleFileName computes the file name of the bundle for the given repository in the given
+// refresh window.
+func (m *Manager) bundleFileName(repo repository.GitRepo, window int64) string {
+	mac := hmac.New(sha256.New, m.secret)
+	// The storage name and relative path are length-prefixed so that different repositories
+	// cannot map to the same input.
+	for _, field := range []string{repo.GetStorageName(), repo.GetRelativePath()} {
+		_ = binary.Write(mac, binary.BigEndian, uint64(len(field)))
+		mac.Write([]byte(field))
+	}
+	_ = binary.Write(mac, binary.BigEndian, window)
+
+	return hex.EncodeToString(mac.Sum(nil)) + ".bundle"
+}
+
+// bundlePath returns the path the bundle for the given repository in the given refresh window is
+// stored at.
+func (m *Manager) bundlePath(repo repository.GitRepo, window int64) string {
+	return filepath.Join(m.dir, repo.GetStorageName(), m.bundleFileName(repo, window))
+}
+
+// bundleURL returns the URL the bundle for the given repository in the given refresh window is
+// advertised with.
+func (m *Manager) bundleURL(repo repository.GitRepo, window int64) string {
+	return fmt.Sprintf("%s/%s/%s", m.baseURL, url.PathEscape(repo.GetStorageName()), m.bundleFileName(repo, window))
+}
+
+// BundlePath returns the path the bundle for the given repository is stored at in the current
+// refresh window.
+func (m *Manager) BundlePath(repo repository.GitRepo) string {
+	return m.bundlePath(repo, m.window(m.now()))
+}
+
+// BundleURL returns the URL the bundle for the given repository is advertised with in the current
+// refresh window.
+func (m *Manager) BundleURL(repo repository.GitRepo) string {
+	return m.bundleURL(repo, m.window(m.now()))
+}
+
+// UploadPackGitConfig returns the Git configuration that needs to be injected into
+// git-upload-pack(1) so that it advertises the repository's bundle to clients using protocol v2.
+// A refresh of the bundle is scheduled in case it doesn't exist for the current refresh window
+// yet. Until it has been generated, the bundle of the previous refresh window is advertised if it
+// exists. No configuration is returned if there is no bundle, if the Git version doesn't support
+// advertising bundle URIs or if the Manager is nil.
+func (m *Manager) UploadPackGitConfig(ctx context.Context, repo repository.GitRepo) []git.ConfigPair {
+	if m == nil {
+		return nil
+	}
+
+	version, err := m.gitCmdFactory.GitVersion(ctx)
+	if err != nil || !version.SupportsBundleURI() {
+		return nil
+	}
+
+	window := m.window(m.now())
+	if _, err := os.Stat(m.bundlePath(repo, window)); err != nil {
+		m.schedule(repo)
+
+		window--
+		if _, err := os.Stat(m.bundlePath(repo, window)); err != nil {
+			return nil
+		}
+	}
+
+	return []git.ConfigPair{
+		{Key: "uploadpack.advertiseBundleURIs", Value: "true"},
+		{Key: "bundle.version", Value: "1"},
+		{Key: "bundle.mode", Value: "all"},
+		{Key: "bundle.gitaly.uri", Value: m.bundleURL(repo, window)},
+	}
+}
+
+// pendingKey computes the key the repository is tracked with while its bundle is being generated.
+func pendingKey(repo repository.GitRepo) string {
+	return repo.GetStorageName() + "\x00" + repo.GetRelativePath()
+}
+
+// schedule enqueues the repository for generation of its bundle unless it is already pending.
+func (m *Manager) schedule(repo repository.GitRepo) {
+	name := pendingKey(repo)
+
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+
+	if _, ok := m.pending[name]; ok {
+		return
+	}
+
+	// Quarantine directories and the like must not leak into the background job, so we only
+	// retain the information required to locate the repository.
+	select {
+	case m.queue <- &gitalypb.Repository{
+		StorageName:  repo.GetStorageName(),
+		RelativePath: repo.GetRelativePath(),
+	}:
+		m.pending[name] = struct{}{}
+	default:
+	}
+}
+
+// Run generates bundles for scheduled repositories until the context is cancelled. Bundles which
+// are older than twice the refresh interval are pruned periodically, which removes bundles of past
+// refresh windows as well as bundles of repositories that have been deleted.
+func (m *Manager) Run(ctx context.Context, logger logrus.FieldLogger) error {
+	logger = logger.WithField("component", "bundle_uri_manager")
+
+	ticker := time.NewTicker(m.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := m.Prune(); err != nil {
+				logger.WithError(err).Error("pruning stale bundles")
+			}
+		case repo := <-m.queue:
+			if err := m.Generate(ctx, repo); err != nil {
+				logger.WithError(err).WithFields(logrus.Fields{
+					"storage":       repo.GetStorageName(),
+					"relative_path": repo.GetRelativePath(),
+				}).Error("generating bundle")
+			}
+
+			m.pendingLock.Lock()
+			delete(m.pending, pendingKey(repo))
+			m.pendingLock.Unlock()
+		}
+	}
+}
+
+// Generate writes a bundle containing all branches and tags of the repository into the bundle
+// directory, replacing any preexisting bundle of the current refresh window atomically.
+// Repositories without any branches or tags are skipped given that Git refuses to create empty
+// bundles.
+func (m *Manager) Generate(ctx context.Context, repo repository.GitRepo) error {
+	localRepo := localrepo.New(m.locator, m.gitCmdFactory, m.catfileCache, repo)
+
+	// Only branches and tags are included in the bundle given that these are the references
+	// a clone fetches. Including all references would otherwise expose hidden references via
+	// the static file server.
+	refs, err := localRepo.GetReferences(ctx, "refs/heads/", "refs/tags/")
+	if err != nil {
+		return fmt.Errorf("listing references: %w", err)
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	var patterns strings.Builder
+	for _, ref := range refs {
+		patterns.WriteString(ref.Name.String())
+		patterns.WriteString("\n")
+	}
+
+	bundlePath := m.BundlePath(repo)
+	if err := os.MkdirAll(filepath.Dir(bundlePath), perm.SharedDir); err != nil {
+		return fmt.Errorf("creating bundle directory: %w", err)
+	}
+
+	bundle, err := os.CreateTemp(filepath.Dir(bundlePath), filepath.Base(bundlePath)+".*")
+	if err != nil {
+		return fmt.Errorf("creating temporary bundle: %w", err)
+	}
+	defer func() {
+		// The file has either been renamed into place already or we've failed to generate
+		// it, so the errors are of no interest to us.
+		_ = bundle.Close()
+		_ = os.Remove(bundle.Name())
+	}()
+
+	if err := localRepo.CreateBundle(ctx, bundle, &localrepo.CreateBundleOpts{
+		Patterns:    strings.NewReader(patterns.String()),
+		CgroupClass: cgroupscfg.ClassHousekeeping,
+	}); err != nil {
+		if errors.Is(err, localrepo.ErrEmptyBundle) {
+			return nil
+		}
+
+		return err
+	}
+
+	if err := bundle.Chmod(perm.SharedFile); err != nil {
+		return fmt.Errorf("setting bundle permissions: %w", err)
+	}
+
+	if err := bundle.Close(); err != nil {
+		return fmt.Errorf("closing bundle: %w", err)
+	}
+
+	if err := os.Rename(bundle.Name(), bundlePath); err != nil {
+		return fmt.Errorf("moving bundle into place: %w", err)
+	}
+
+	return nil
+}
+
+// Prune removes all bundles which are older than twice the refresh interval. Such bundles belong
+// to refresh windows which are not advertised anymore.
+func (m *Manager) Prune() error {
+	threshold := m.now().Add(-2 * m.refreshInterval)
+
+	if err := filepath.WalkDir(m.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		if info.ModTime().After(threshold) {
+			return nil
+		}
+
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("walking bundle directory: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package bundleuri
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// newVersionedCommandFactory creates a command factory which pretends to be the given Git version
+// so that advertisement of bundles can be tested independent of the Git version in use.
+func newVersionedCommandFactory(t *testing.T, ctx context.Context, cfg config.Cfg, version string) git.CommandFactory {
+	return gittest.NewInterceptingCommandFactory(t, ctx, cfg, func(execEnv git.ExecutionEnvironment) string {
+		return fmt.Sprintf(`#!/usr/bin/env bash
+		if test "$1" = "version"
+		then
+			echo "git version %s"
+			exit 0
+		fi
+		exec %q "$@"
+		`, version, execEnv.BinaryPath)
+	}, gittest.WithInterceptedVersion())
+}
+
+func newManager(t *testing.T, cfg config.Cfg, gitCmdFactory git.CommandFactory) *Manager {
+	catfileCache := catfile.NewCache(cfg)
+	t.Cleanup(catfileCache.Stop)
+
+	return NewManager(config.BundleURI{
+		BaseURL:         "https://cdn.example.com/bundles/",
+		Dir:             testhelper.TempDir(t),
+		Secret:          "secret",
+		RefreshInterval: duration.Duration(time.Hour),
+	}, config.NewLocator(cfg), gitCmdFactory, catfileCache)
+}
+
+func TestManager_BundleURL(t *testing.T) {
+	t.Parallel()
+
+	cfg := testcfg.Build(t)
+	manager := newManager(t, cfg, nil)
+	repo := &gitalypb.Repository{StorageName: "default", RelativePath: "@hashed/aa/bb/repo.git"}
+
+	now := time.Date(2023, 1, 1, 0, 30, 0, 0, time.UTC)
+	manager.now = func() time.Time { return now }
+
+	bundleURL := manager.BundleURL(repo)
+	require.Equal(t,
+		"https://cdn.example.com/bundles/default/"+filepath.Base(manager.BundlePath(repo)),
+		bundleURL,
+	)
+	require.Equal(t, filepath.Join(manager.dir, "default"), filepath.Dir(manager.BundlePath(repo)))
+	require.NotContains(t, bundleURL, "repo.git")
+
+	// The name must not be derivable from the repository alone.
+	hash := sha256.Sum256([]byte(repo.GetRelativePath()))
+	require.NotContains(t, bundleURL, hex.EncodeToString(hash[:]))
+
+	// The name is stable within the refresh window.
+	now = now.Add(20 * time.Minute)
+	require.Equal(t, bundleURL, manager.BundleURL(repo))
+
+	// But it changes with the next refresh window.
+	now = now.Add(20 * time.Minute)
+	require.NotEqual(t, bundleURL, manager.BundleURL(repo))
+
+	// The name depends on the secret.
+	otherManager := newManager(t, cfg, nil)
+	otherManager.secret = []byte("other secret")
+	otherManager.now = manager.now
+	require.NotEqual(t, manager.BundleURL(repo), otherManager.BundleURL(repo))
+
+	// Different repositories map to different names.
+	require.NotEqual(t, manager.BundleURL(repo), manager.BundleURL(&gitalypb.Repository{
+		StorageName:  "default",
+		RelativePath: "@hashed/aa/bb/other.git",
+	}))
+}
+
+func TestManager_Generate(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+	manager := newManager(t, cfg, gittest.NewCommandFactory(t, cfg))
+
+	t.Run("empty repository", func(t *testing.T) {
+		repo, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+
+		require.NoError(t, manager.Generate(ctx, repo))
+		require.NoFileExists(t, manager.BundlePath(repo))
+	})
+
+	t.Run("repository with references", func(t *testing.T) {
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+		commit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+		gittest.WriteTag(t, cfg, repoPath, "v1.0.0", commit.Revision())
+		gittest.WriteRef(t, cfg, repoPath, "refs/keep-around/hidden", gittest.WriteCommit(t, cfg, repoPath))
+
+		require.NoError(t, manager.Generate(ctx, repo))
+
+		bundlePath := manager.BundlePath(repo)
+		info, err := os.Stat(bundlePath)
+		require.NoError(t, err)
+		require.Equal(t, perm.SharedFile, info.Mode().Perm())
+
+		heads := text.ChompBytes(gittest.Exec(t, cfg, "bundle", "list-heads", bundlePath))
+		require.Equal(t, fmt.Sprintf("%[1]s refs/heads/main\n%[1]s refs/tags/v1.0.0", commit), heads)
+
+		entries, err := os.ReadDir(filepath.Dir(bundlePath))
+		require.NoError(t, err)
+		require.Len(t, entries, 1, "temporary files must not be left behind")
+	})
+}
+
+func TestManager_UploadPackGitConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	t.Run("nil manager", func(t *testing.T) {
+		var manager *Manager
+		require.Nil(t, manager.UploadPackGitConfig(ctx, &gitalypb.Repository{}))
+	})
+
+	t.Run("unsupported Git version", func(t *testing.T) {
+		manager := newManager(t, cfg, newVersionedCommandFactory(t, ctx, cfg, "2.39.0"))
+
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+		gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+
+		require.Nil(t, manager.UploadPackGitConfig(ctx, repo))
+		require.Empty(t, manager.queue)
+	})
+
+	t.Run("missing bundle", func(t *testing.T) {
+		manager := newManager(t, cfg, newVersionedCommandFactory(t, ctx, cfg, "2.40.0"))
+
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+		gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+
+		require.Nil(t, manager.UploadPackGitConfig(ctx, repo))
+		// Repeatedly requesting the configuration must not schedule the repository twice.
+		require.Nil(t, manager.UploadPackGitConfig(ctx, repo))
+		require.Len(t, manager.queue, 1)
+	})
+
+	t.Run("existing bundle", func(t *testing.T) {
+		manager := newManager(t, cfg, newVersionedCommandFactory(t, ctx, cfg, "2.40.0"))
+
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+		gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+		require.NoError(t, manager.Generate(ctx, repo))
+
+		require.Equal(t, []git.ConfigPair{
+			{Key: "uploadpack.advertiseBundleURIs", Value: "true"},
+			{Key: "bundle.version", Value: "1"},
+			{Key: "bundle.mode", Value: "all"},
+			{Key: "bundle.gitaly.uri", Value: manager.BundleURL(repo)},
+		}, manager.UploadPackGitConfig(ctx, repo))
+		require.Empty(t, manager.queue)
+	})
+
+	t.Run("stale bundle", func(t *testing.T) {
+		manager := newManager(t, cfg, newVersionedCommandFactory(t, ctx, cfg, "2.40.0"))
+
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+		gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+
+		now := time.Now()
+		manager.now = func() time.Time { return now }
+		require.NoError(t, manager.Generate(ctx, repo))
+
+		previousURL := manager.BundleURL(repo)
+
+		// The bundle of the previous refresh window is still advertised while the bundle of
+		// the current refresh window is being generated given that clients will fetch the
+		// delta.
+		now = now.Add(time.Hour)
+		require.Equal(t, []git.ConfigPair{
+			{Key: "uploadpack.advertiseBundleURIs", Value: "true"},
+			{Key: "bundle.version", Value: "1"},
+			{Key: "bundle.mode", Value: "all"},
+			{Key: "bundle.gitaly.uri", Value: previousURL},
+		}, manager.UploadPackGitConfig(ctx, repo))
+		require.Len(t, manager.queue, 1)
+
+		// Bundles of older refresh windows are not advertised anymore.
+		now = now.Add(time.Hour)
+		require.Nil(t, manager.UploadPackGitConfig(ctx, repo))
+	})
+}
+
+func TestManager_Run(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+	manager := newManager(t, cfg, newVersionedCommandFactory(t, ctx, cfg, "2.40.0"))
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+
+	runCtx, cancel := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- manager.Run(runCtx, testhelper.NewDiscardingLogEntry(t))
+	}()
+
+	require.Nil(t, manager.UploadPackGitConfig(ctx, repo))
+	require.Eventually(t, func() bool {
+		return manager.UploadPackGitConfig(ctx, repo) != nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.Equal(t, context.Canceled, <-errCh)
+}
+
+func TestManager_Prune(t *testing.T) {
+	t.Parallel()
+
+	manager := newManager(t, testcfg.Build(t), nil)
+
+	freshBundle := filepath.Join(manager.dir, "default", "fresh.bundle")
+	staleBundle := filepath.Join(manager.dir, "default", "stale.bundle")
+	require.NoError(t, os.MkdirAll(filepath.Dir(freshBundle), perm.SharedDir))
+	require.NoError(t, os.WriteFile(freshBundle, nil, perm.SharedFile))
+	require.NoError(t, os.WriteFile(staleBundle, nil, perm.SharedFile))
+
+	staleTime := time.Now().Add(-3 * time.Hour)
+	require.NoError(t, os.Chtimes(staleBundle, staleTime, staleTime))
+
+	require.NoError(t, manager.Prune())
+	require.FileExists(t, freshBundle)
+	require.NoFileExists(t, staleBundle)
+
+	require.NoError(t, NewManager(config.BundleURI{
+		Dir:             filepath.Join(manager.dir, "missing"),
+		RefreshInterval: duration.Duration(time.Hour),
+	}, nil, nil, nil).Prune())
+}
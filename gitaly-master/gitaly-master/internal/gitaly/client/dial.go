@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"gitlab.com/gitlab-org/gitaly/v15/internal/dnsresolver"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/tracing"
 	gitalyx509 "gitlab.com/gitlab-org/gitaly/v15/internal/x509"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 	grpccorrelation "gitlab.com/gitlab-org/labkit/correlation/grpc"
@@ -182,6 +183,7 @@ func Dial(ctx context.Context, rawAddress string, connOpts []grpc.DialOption, ha
 func StreamInterceptor() grpc.DialOption {
 	return grpc.WithChainStreamInterceptor(
 		grpctracing.StreamClientTracingInterceptor(),
+		tracing.StreamClientInterceptor(),
 		grpccorrelation.StreamClientCorrelationInterceptor(),
 	)
 }
@@ -190,6 +192,7 @@ func StreamInterceptor() grpc.DialOption {
 func UnaryInterceptor() grpc.DialOption {
 	return grpc.WithChainUnaryInterceptor(
 		grpctracing.UnaryClientTracingInterceptor(),
+		tracing.UnaryClientInterceptor(),
 		grpccorrelation.UnaryClientCorrelationInterceptor(),
 	)
 }
@@ -4,4 +4,42 @@ package auth
 type Config struct {
 	Transitioning bool   `toml:"transitioning,omitempty"`
 	Token         string `toml:"token,omitempty"`
+	// Clients configures individual clients with their own credentials. In contrast to the
+	// shared token, which grants access to all RPCs, clients can be restricted to a subset of
+	// services, RPC categories and storages.
+	Clients []Client `toml:"clients,omitempty"`
+}
+
+const (
+	// CategoryReadOnly is the category of RPCs which only read data.
+	CategoryReadOnly = "read_only"
+	// CategoryMutator is the category of RPCs which modify data.
+	CategoryMutator = "mutator"
+	// CategoryMaintenance is the category of RPCs which perform repository maintenance.
+	CategoryMaintenance = "maintenance"
+)
+
+// Client configures the identity and permissions of a single client.
+type Client struct {
+	// Name identifies the client in logs and metrics.
+	Name string `toml:"name"`
+	// Token is the secret the client uses to authenticate itself. It is used the same way as
+	// the shared token.
+	Token string `toml:"token,omitempty"`
+	// CertificateIdentities are identities of the client's TLS certificate that identify this
+	// client. An identity matches if it is equal to any of the certificate's DNS, email or URI
+	// subject alternative names or to its subject common name. Client certificates are only
+	// verified when `tls.client_ca_path` is configured.
+	CertificateIdentities []string `toml:"certificate_identities,omitempty"`
+	// Services are the fully qualified names of the gRPC services the client may call, e.g.
+	// "gitaly.RepositoryService". All services may be called if unset.
+	Services []string `toml:"services,omitempty"`
+	// Categories are the categories of RPCs the client may call. Supported categories are
+	// "read_only", "mutator" and "maintenance". RPCs of all categories may be called if unset.
+	// RPCs which don't belong to any category can only be called by clients with category
+	// restrictions if their service has been explicitly allowed.
+	Categories []string `toml:"categories,omitempty"`
+	// Storages are the names of the storages the client may access. All storages may be
+	// accessed if unset.
+	Storages []string `toml:"storages,omitempty"`
 }
@@ -6,16 +6,30 @@ type Config struct {
 	Mountpoint string `toml:"mountpoint"`
 	// HierarchyRoot is the parent cgroup under which Gitaly creates <Count> of cgroups.
 	// A system administrator is expected to create such cgroup/directory under <Mountpoint>/memory
-	// and/or <Mountpoint>/cpu depending on which resource is enabled. HierarchyRoot is expected to
-	// be owned by the user and group Gitaly runs as.
+	// and/or <Mountpoint>/cpu depending on which resource is enabled. When the unified cgroups v2
+	// hierarchy is in use, the cgroup is expected directly under <Mountpoint> and the controllers
+	// to be enabled in its parent's cgroup.subtree_control. HierarchyRoot is expected to be owned
+	// by the user and group Gitaly runs as.
 	HierarchyRoot string       `toml:"hierarchy_root"`
 	Repositories  Repositories `toml:"repositories"`
 	// MemoryBytes is the memory limit for the parent cgroup. 0 implies no memory limit.
 	MemoryBytes int64 `toml:"memory_bytes"`
 	// CPUShares are the shares of CPU the parent cgroup is allowed to utilize. A value of 1024
 	// is full utilization of the CPU. 0 implies no CPU limit.
-	CPUShares      uint64 `toml:"cpu_shares"`
+	CPUShares uint64 `toml:"cpu_shares"`
+	// PidsLimit is the maximum number of processes in the parent cgroup. 0 implies no limit.
+	// Only supported with cgroups v2.
+	PidsLimit int64 `toml:"pids_limit"`
+	// IOWeight is the proportional weight of the parent cgroup when distributing IO, ranging
+	// from 1 to 10000. 0 implies the kernel's default weight. Only supported with cgroups v2.
+	IOWeight       uint64 `toml:"io_weight"`
 	MetricsEnabled bool   `toml:"metrics_enabled"`
+	// Classes configures separate parent cgroups for classes of commands so that they can be
+	// isolated from each other, e.g. to keep housekeeping from starving user-facing traffic.
+	// Each class contains its own set of repository cgroups as configured via Repositories.
+	// Commands which don't belong to any configured class are put into the repository cgroups
+	// directly below the parent cgroup.
+	Classes []Class `toml:"classes"`
 
 	// Deprecated: No longer supported after 15.0
 	Count  uint   `toml:"count"`
@@ -49,6 +63,41 @@ type Repositories struct {
 	// CPUShares are the shares of CPU that each cgroup is allowed to utilize. A value of 1024
 	// is full utilization of the CPU. 0 implies no CPU limit.
 	CPUShares uint64 `toml:"cpu_shares"`
+	// PidsLimit is the maximum number of processes in each cgroup. 0 implies no limit. Only
+	// supported with cgroups v2.
+	PidsLimit int64 `toml:"pids_limit"`
+	// IOWeight is the proportional weight of each cgroup when distributing IO, ranging from 1
+	// to 10000. 0 implies the kernel's default weight. Only supported with cgroups v2.
+	IOWeight uint64 `toml:"io_weight"`
+}
+
+const (
+	// ClassPackObjects is the class of git-pack-objects(1) processes spawned to serve fetches
+	// and clones.
+	ClassPackObjects = "pack-objects"
+	// ClassHousekeeping is the class of processes which perform repository maintenance, like
+	// for example repacking objects or writing commit-graphs.
+	ClassHousekeeping = "housekeeping"
+)
+
+// Classes are all known command classes.
+var Classes = []string{ClassPackObjects, ClassHousekeeping}
+
+// Class configures the parent cgroup of a class of commands.
+type Class struct {
+	// Name is the name of the class. It must be one of Classes.
+	Name string `toml:"name"`
+	// MemoryBytes is the memory limit for the class cgroup. 0 implies no memory limit.
+	MemoryBytes int64 `toml:"memory_bytes"`
+	// CPUShares are the shares of CPU the class cgroup is allowed to utilize. A value of 1024
+	// is full utilization of the CPU. 0 implies no CPU limit.
+	CPUShares uint64 `toml:"cpu_shares"`
+	// PidsLimit is the maximum number of processes in the class cgroup. 0 implies no limit.
+	// Only supported with cgroups v2.
+	PidsLimit int64 `toml:"pids_limit"`
+	// IOWeight is the proportional weight of the class cgroup when distributing IO, ranging
+	// from 1 to 10000. 0 implies the kernel's default weight. Only supported with cgroups v2.
+	IOWeight uint64 `toml:"io_weight"`
 }
 
 // Memory is a struct storing cgroups memory config
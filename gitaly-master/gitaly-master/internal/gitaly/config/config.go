@@ -1,11 +1,14 @@
 package config
 
 import (
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
 	"net"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"reflect"
 	"regexp"
@@ -20,6 +23,7 @@ import (
 	internallog "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/log"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/sentry"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/telemetry"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
 )
@@ -73,12 +77,24 @@ type Cfg struct {
 	Cgroups                cgroups.Config      `toml:"cgroups"`
 	PackObjectsCache       StreamCacheConfig   `toml:"pack_objects_cache"`
 	PackObjectsLimiting    PackObjectsLimiting `toml:"pack_objects_limiting"`
+	BundleURI              BundleURI           `toml:"bundle_uri"`
+	PartialClone           PartialClone        `toml:"partial_clone"`
+	Telemetry              telemetry.Config    `toml:"telemetry"`
+	Audit                  Audit               `toml:"audit"`
+	Quota                  Quota               `toml:"quota"`
+	Transactions           Transactions        `toml:"transactions"`
 }
 
 // TLS configuration
 type TLS struct {
 	CertPath string `toml:"certificate_path,omitempty" json:"cert_path"`
 	KeyPath  string `toml:"key_path,omitempty" json:"key_path"`
+	// ClientCAPath is the path to the certificate authorities used to verify client
+	// certificates. Client certificates are neither requested nor verified if unset.
+	ClientCAPath string `toml:"client_ca_path,omitempty" json:"client_ca_path"`
+	// RequireClientCertificate rejects connections of clients which don't present a valid
+	// client certificate. Requires ClientCAPath to be set.
+	RequireClientCertificate bool `toml:"require_client_certificate,omitempty" json:"require_client_certificate"`
 }
 
 // GitlabShell contains the settings required for executing `gitlab-shell`
@@ -96,9 +112,135 @@ type Gitlab struct {
 
 // Hooks contains the settings required for hooks
 type Hooks struct {
-	CustomHooksDir string `toml:"custom_hooks_dir" json:"custom_hooks_dir"`
+	CustomHooksDir string     `toml:"custom_hooks_dir" json:"custom_hooks_dir"`
+	Events         HookEvents `toml:"events" json:"events"`
 }
 
+// HookEvents configures publishing of reference-update events by the post-receive hook. Events
+// are first written into a spool directory and then delivered to the sink asynchronously so
+// that pushes are not blocked by a slow or unavailable sink.
+type HookEvents struct {
+	// Sink is the URL that events get published to. Supported schemes are "unix" to write
+	// events to a Unix socket, "file" to append events to a log file and "http" or "https" to
+	// post events to an HTTP endpoint. Publishing of events is disabled if no sink is set.
+	Sink string `toml:"sink" json:"sink"`
+	// Repositories is a list of glob patterns matched against the project path of the
+	// repository. Events are only published for repositories matching any of the patterns. If
+	// no patterns are given, events are published for all repositories.
+	Repositories []string `toml:"repositories" json:"repositories"`
+	// SpoolDir is the directory pending events are stored in until they have been delivered.
+	// Defaults to a directory in the first storage.
+	SpoolDir string `toml:"spool_dir" json:"spool_dir"`
+	// MaxAttempts is the number of times delivery of an event is attempted before it is
+	// dropped.
+	MaxAttempts uint `toml:"max_attempts" json:"max_attempts"`
+	// RetryInterval is the interval at which delivery of pending events is retried.
+	RetryInterval duration.Duration `toml:"retry_interval" json:"retry_interval"`
+	// Timeout is the timeout for delivering a single event to the sink.
+	Timeout duration.Duration `toml:"timeout" json:"timeout"`
+}
+
+// BundleURI configures generation of per-repository bundles which are advertised to clients via
+// the bundle-uri capability of Git's protocol v2. Clients then fetch the bulk of the objects from
+// a static file server serving the bundle directory and only negotiate the remaining delta with
+// Gitaly.
+type BundleURI struct {
+	// BaseURL is the URL the bundle directory is served from. Bundles are advertised with URLs
+	// relative to this base. Advertisement of bundles is disabled if no base URL is set.
+	BaseURL string `toml:"base_url" json:"base_url"`
+	// Dir is the directory bundles are written into. Defaults to a directory in the first
+	// storage.
+	Dir string `toml:"dir" json:"dir"`
+	// Secret is the key used to derive the names of bundles. Bundles are served without any
+	// authentication, so their names must not be guessable. Required if a base URL is set.
+	Secret string `toml:"secret" json:"secret"`
+	// RefreshInterval is the interval after which bundles are regenerated under a new name.
+	// Defaults to 24 hours.
+	RefreshInterval duration.Duration `toml:"refresh_interval" json:"refresh_interval"`
+}
+
+// PartialClone configures policies for the object filters clients may request via git-upload-pack(1)
+// in order to perform partial clones and fetches.
+type PartialClone struct {
+	// Policies is the list of filter policies. The first policy matching a repository applies.
+	// Clients may request any filter in case no policy matches.
+	Policies []PartialClonePolicy `toml:"policy" json:"policies"`
+}
+
+// PartialClonePolicy is a policy for the object filters requested by clients for a set of
+// repositories.
+type PartialClonePolicy struct {
+	// Storages is the list of storages the policy applies to. The policy applies to all
+	// storages if no storages are given.
+	Storages []string `toml:"storages" json:"storages"`
+	// Repositories is a list of glob patterns matched against the project path of the
+	// repository, or against its relative path in case the project path is unknown. The policy
+	// applies to all repositories if no patterns are given.
+	Repositories []string `toml:"repositories" json:"repositories"`
+	// AllowedFilters is the list of filter types clients may request. Supported filter types
+	// are "blob:none", "blob:limit", "tree", "sparse:oid" and "combine". All filter types are
+	// allowed if the list is empty.
+	AllowedFilters []string `toml:"allowed_filters" json:"allowed_filters"`
+	// MaxTreeDepth is the maximum depth clients may request via the "tree:<depth>" filter. The
+	// depth is unlimited if set to zero.
+	MaxTreeDepth uint `toml:"max_tree_depth" json:"max_tree_depth"`
+	// RequireFilterAboveBytes is the size of packfiles in bytes above which clients must
+	// request a filter when cloning the repository. Fetches which announce objects the client
+	// already has are not affected. No filter is required if set to zero.
+	RequireFilterAboveBytes uint64 `toml:"require_filter_above_bytes" json:"require_filter_above_bytes"`
+}
+
+// Audit configures the audit log. The audit log is a tamper-evident record of all mutator RPCs
+// and reference updates performed by Gitaly. Each entry contains the keyed hash of its
+// predecessor so that removal or modification of entries can be detected.
+type Audit struct {
+	// Path is the path of the file audit entries are appended to as JSON lines. Audit logging
+	// is disabled if no path is set.
+	Path string `toml:"path" json:"path"`
+	// MaxSizeBytes is the size after which the audit log is rotated. Rotated files are kept
+	// next to the audit log with the time of rotation appended to their name. Defaults to
+	// 100MiB.
+	MaxSizeBytes int64 `toml:"max_size_bytes" json:"max_size_bytes"`
+	// Secret is the key entries are hashed with. It must be kept separate from the audit log
+	// so that the hash chain cannot be recomputed after entries have been tampered with.
+	Secret string `toml:"secret" json:"secret"`
+}
+
+// Quota configures limits for the on-disk size of repositories and storages. Pushes which would
+// exceed any of the limits are rejected by the pre-receive hook, and reference updates other than
+// deletions are rejected by the reference-transaction hook once any of the limits is exceeded.
+type Quota struct {
+	// RepositoryMaxBytes is the maximum on-disk size of each repository. Repositories exceeding
+	// the limit become read-only: all pushes except for those which only delete references are
+	// rejected until the repository's size has been reduced. A value of zero disables the limit.
+	RepositoryMaxBytes int64 `toml:"repository_max_bytes" json:"repository_max_bytes"`
+	// StorageMaxBytes is the maximum number of bytes used on the filesystem hosting each
+	// storage. A value of zero disables the limit.
+	StorageMaxBytes int64 `toml:"storage_max_bytes" json:"storage_max_bytes"`
+	// CacheTTL is the duration for which the computed sizes of repositories are cached.
+	// Defaults to one minute.
+	CacheTTL duration.Duration `toml:"cache_ttl" json:"cache_ttl"`
+}
+
+// Enabled determines whether any quota is configured.
+func (q Quota) Enabled() bool {
+	return q.RepositoryMaxBytes > 0 || q.StorageMaxBytes > 0
+}
+
+// Transactions configures the write-ahead log of the repositories.
+type Transactions struct {
+	// Enabled enables the write-ahead log. If enabled, the write-ahead logs of the repositories are
+	// recovered on boot and they can be tailed via the TailLog RPC.
+	Enabled bool `toml:"enabled" json:"enabled"`
+	// LogRetention is the number of applied log entries retained in each repository's write-ahead
+	// log so that replicas lagging behind can still tail them. Applied log entries are pruned
+	// immediately by default.
+	LogRetention uint64 `toml:"log_retention" json:"log_retention"`
+}
+
+// PartialCloneFilterTypes are the filter types which can be allowed by a partial clone policy.
+var PartialCloneFilterTypes = []string{"blob:none", "blob:limit", "tree", "sparse:oid", "combine"}
+
 //nolint:revive // This is unintentionally missing documentation.
 type HTTPSettings struct {
 	ReadTimeout int    `toml:"read_timeout" json:"read_timeout"`
@@ -272,6 +414,7 @@ type StreamCacheConfig struct {
 func Load(file io.Reader) (Cfg, error) {
 	cfg := Cfg{
 		Prometheus: prometheus.DefaultConfig(),
+		Telemetry:  telemetry.DefaultConfig(),
 	}
 
 	if err := toml.NewDecoder(file).Decode(&cfg); err != nil {
@@ -295,6 +438,7 @@ func (cfg *Cfg) Validate() error {
 		cfg.validateListeners,
 		cfg.validateStorages,
 		cfg.validateToken,
+		cfg.validateAuthClients,
 		cfg.validateGit,
 		cfg.validateShell,
 		cfg.ConfigureRuby,
@@ -303,6 +447,12 @@ func (cfg *Cfg) Validate() error {
 		cfg.validateMaintenance,
 		cfg.validateCgroups,
 		cfg.configurePackObjectsCache,
+		cfg.configureHookEvents,
+		cfg.configureBundleURI,
+		cfg.validatePartialClone,
+		cfg.Telemetry.Validate,
+		cfg.configureAudit,
+		cfg.configureQuota,
 	} {
 		if err := run(); err != nil {
 			return err
@@ -522,6 +672,88 @@ func (cfg *Cfg) validateToken() error {
 	return nil
 }
 
+var (
+	errAuthClientsWithoutToken   = errors.New("auth.clients: requires auth.token to be set")
+	errAuthClientUnnamed         = errors.New("auth.clients: client must have a name")
+	errAuthClientDuplicate       = errors.New("auth.clients: duplicate client name")
+	errAuthClientWithoutIdentity = errors.New("auth.clients: client must have a token or certificate identities")
+	errAuthClientInvalidCategory = errors.New("auth.clients: invalid category")
+	errAuthClientUnknownStorage  = errors.New("auth.clients: unknown storage")
+	errAuthClientCertificateNoCA = errors.New("auth.clients: certificate identities require tls.client_ca_path")
+	errTLSClientCertificateNoCA  = errors.New("tls.require_client_certificate requires tls.client_ca_path")
+	errTLSClientCANotReadable    = errors.New("tls.client_ca_path: cannot read certificate authorities")
+	errTLSClientCANoCertificates = errors.New("tls.client_ca_path: no certificates found")
+)
+
+func (cfg *Cfg) validateAuthClients() error {
+	if cfg.TLS.RequireClientCertificate && cfg.TLS.ClientCAPath == "" {
+		return errTLSClientCertificateNoCA
+	}
+
+	if cfg.TLS.ClientCAPath != "" {
+		if _, err := cfg.TLS.ClientCertPool(); err != nil {
+			return err
+		}
+	}
+
+	// The shared token is still required by Gitaly's own components, like for example the
+	// hooks, and authentication would be disabled altogether without it.
+	if len(cfg.Auth.Clients) > 0 && cfg.Auth.Token == "" {
+		return errAuthClientsWithoutToken
+	}
+
+	names := make(map[string]struct{}, len(cfg.Auth.Clients))
+	for _, client := range cfg.Auth.Clients {
+		if client.Name == "" {
+			return errAuthClientUnnamed
+		}
+
+		if _, ok := names[client.Name]; ok {
+			return fmt.Errorf("%w %q", errAuthClientDuplicate, client.Name)
+		}
+		names[client.Name] = struct{}{}
+
+		if client.Token == "" && len(client.CertificateIdentities) == 0 {
+			return fmt.Errorf("%w: %q", errAuthClientWithoutIdentity, client.Name)
+		}
+
+		if len(client.CertificateIdentities) > 0 && cfg.TLS.ClientCAPath == "" {
+			return fmt.Errorf("%w: %q", errAuthClientCertificateNoCA, client.Name)
+		}
+
+		for _, category := range client.Categories {
+			switch category {
+			case auth.CategoryReadOnly, auth.CategoryMutator, auth.CategoryMaintenance:
+			default:
+				return fmt.Errorf("%w %q", errAuthClientInvalidCategory, category)
+			}
+		}
+
+		for _, storageName := range client.Storages {
+			if _, ok := cfg.StoragePath(storageName); !ok {
+				return fmt.Errorf("%w %q", errAuthClientUnknownStorage, storageName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ClientCertPool returns the pool of certificate authorities used to verify client certificates.
+func (t TLS) ClientCertPool() (*x509.CertPool, error) {
+	pem, err := os.ReadFile(t.ClientCAPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errTLSClientCANotReadable, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errTLSClientCANoCertificates
+	}
+
+	return pool, nil
+}
+
 // defaultMaintenanceWindow specifies a 10 minute job that runs daily at +1200
 // GMT time
 func defaultMaintenanceWindow(storages []Storage) DailyJob {
@@ -575,6 +807,43 @@ func (cfg *Cfg) validateCgroups() error {
 		return errors.New("cgroups.repositories: cpu shares cannot exceed parent")
 	}
 
+	if cg.PidsLimit > 0 && (cg.Repositories.PidsLimit > cg.PidsLimit) {
+		return errors.New("cgroups.repositories: pids limit cannot exceed parent")
+	}
+
+	if cg.IOWeight > 10000 || cg.Repositories.IOWeight > 10000 {
+		return errors.New("cgroups: io weight must not exceed 10000")
+	}
+
+	knownClasses := make(map[string]bool, len(cgroups.Classes))
+	for _, class := range cgroups.Classes {
+		knownClasses[class] = true
+	}
+
+	seenClasses := make(map[string]bool, len(cg.Classes))
+	for _, class := range cg.Classes {
+		if !knownClasses[class.Name] {
+			return fmt.Errorf("cgroups.classes: unknown class %q", class.Name)
+		}
+
+		if seenClasses[class.Name] {
+			return fmt.Errorf("cgroups.classes: class %q configured multiple times", class.Name)
+		}
+		seenClasses[class.Name] = true
+
+		if cg.MemoryBytes > 0 && (class.MemoryBytes > cg.MemoryBytes) {
+			return fmt.Errorf("cgroups.classes: memory limit of class %q cannot exceed parent", class.Name)
+		}
+
+		if cg.CPUShares > 0 && (class.CPUShares > cg.CPUShares) {
+			return fmt.Errorf("cgroups.classes: cpu shares of class %q cannot exceed parent", class.Name)
+		}
+
+		if class.IOWeight > 10000 {
+			return fmt.Errorf("cgroups.classes: io weight of class %q must not exceed 10000", class.Name)
+		}
+	}
+
 	return nil
 }
 
@@ -613,6 +882,222 @@ func (cfg *Cfg) configurePackObjectsCache() error {
 	return nil
 }
 
+var (
+	errHookEventsInvalidSink     = errors.New("hooks.events: sink must be a unix, file, http or https URL")
+	errHookEventsNoStorages      = errors.New("hooks.events: cannot pick default spool directory: no storages")
+	errHookEventsRelativeSpool   = errors.New("hooks.events: spool directory must be absolute path")
+	errHookEventsInvalidPattern  = errors.New("hooks.events: invalid repository pattern")
+	errHookEventsNegativeTimeout = errors.New("hooks.events: retry interval and timeout cannot be negative")
+)
+
+func (cfg *Cfg) configureHookEvents() error {
+	events := &cfg.Hooks.Events
+	if events.Sink == "" {
+		return nil
+	}
+
+	sink, err := url.Parse(events.Sink)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errHookEventsInvalidSink, err)
+	}
+
+	switch sink.Scheme {
+	case "unix", "file":
+		if sink.Path == "" {
+			return errHookEventsInvalidSink
+		}
+	case "http", "https":
+		if sink.Host == "" {
+			return errHookEventsInvalidSink
+		}
+	default:
+		return errHookEventsInvalidSink
+	}
+
+	for _, pattern := range events.Repositories {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("%w %q", errHookEventsInvalidPattern, pattern)
+		}
+	}
+
+	if events.RetryInterval < 0 || events.Timeout < 0 {
+		return errHookEventsNegativeTimeout
+	}
+
+	if events.SpoolDir == "" {
+		if len(cfg.Storages) == 0 {
+			return errHookEventsNoStorages
+		}
+
+		events.SpoolDir = filepath.Join(cfg.Storages[0].Path, GitalyDataPrefix, "HookEvents")
+	}
+
+	if !filepath.IsAbs(events.SpoolDir) {
+		return errHookEventsRelativeSpool
+	}
+
+	if events.MaxAttempts == 0 {
+		events.MaxAttempts = 10
+	}
+
+	if events.RetryInterval == 0 {
+		events.RetryInterval = duration.Duration(10 * time.Second)
+	}
+
+	if events.Timeout == 0 {
+		events.Timeout = duration.Duration(10 * time.Second)
+	}
+
+	return nil
+}
+
+var (
+	errBundleURIInvalidBaseURL   = errors.New("bundle_uri: base URL must be a http or https URL")
+	errBundleURINoStorages       = errors.New("bundle_uri: cannot pick default bundle directory: no storages")
+	errBundleURIRelativeDir      = errors.New("bundle_uri: bundle directory must be absolute path")
+	errBundleURINegativeInterval = errors.New("bundle_uri: refresh interval cannot be negative")
+	errBundleURIMissingSecret    = errors.New("bundle_uri: secret is required to derive bundle names")
+)
+
+func (cfg *Cfg) configureBundleURI() error {
+	bundleURI := &cfg.BundleURI
+	if bundleURI.BaseURL == "" {
+		return nil
+	}
+
+	baseURL, err := url.Parse(bundleURI.BaseURL)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errBundleURIInvalidBaseURL, err)
+	}
+
+	if (baseURL.Scheme != "http" && baseURL.Scheme != "https") || baseURL.Host == "" {
+		return errBundleURIInvalidBaseURL
+	}
+
+	if bundleURI.Secret == "" {
+		return errBundleURIMissingSecret
+	}
+
+	if bundleURI.RefreshInterval < 0 {
+		return errBundleURINegativeInterval
+	}
+
+	if bundleURI.Dir == "" {
+		if len(cfg.Storages) == 0 {
+			return errBundleURINoStorages
+		}
+
+		bundleURI.Dir = filepath.Join(cfg.Storages[0].Path, GitalyDataPrefix, "BundleURI")
+	}
+
+	if !filepath.IsAbs(bundleURI.Dir) {
+		return errBundleURIRelativeDir
+	}
+
+	if bundleURI.RefreshInterval == 0 {
+		bundleURI.RefreshInterval = duration.Duration(24 * time.Hour)
+	}
+
+	return nil
+}
+
+var (
+	errPartialCloneUnknownStorage = errors.New("partial_clone: policy refers to unknown storage")
+	errPartialCloneInvalidPattern = errors.New("partial_clone: invalid repository pattern")
+	errPartialCloneInvalidFilter  = errors.New("partial_clone: unsupported filter type")
+)
+
+func (cfg *Cfg) validatePartialClone() error {
+	for _, policy := range cfg.PartialClone.Policies {
+		for _, storageName := range policy.Storages {
+			if _, ok := cfg.StoragePath(storageName); !ok {
+				return fmt.Errorf("%w %q", errPartialCloneUnknownStorage, storageName)
+			}
+		}
+
+		for _, pattern := range policy.Repositories {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("%w %q", errPartialCloneInvalidPattern, pattern)
+			}
+		}
+
+		for _, filter := range policy.AllowedFilters {
+			supported := false
+			for _, filterType := range PartialCloneFilterTypes {
+				if filter == filterType {
+					supported = true
+					break
+				}
+			}
+
+			if !supported {
+				return fmt.Errorf("%w %q", errPartialCloneInvalidFilter, filter)
+			}
+		}
+	}
+
+	return nil
+}
+
+var (
+	errAuditRelativePath    = errors.New("audit: path must be absolute path")
+	errAuditNegativeMaxSize = errors.New("audit: max_size_bytes cannot be negative")
+	errAuditMissingSecret   = errors.New("audit: secret is required to hash entries")
+)
+
+func (cfg *Cfg) configureAudit() error {
+	audit := &cfg.Audit
+	if audit.Path == "" {
+		return nil
+	}
+
+	if !filepath.IsAbs(audit.Path) {
+		return errAuditRelativePath
+	}
+
+	if audit.MaxSizeBytes < 0 {
+		return errAuditNegativeMaxSize
+	}
+
+	if audit.Secret == "" {
+		return errAuditMissingSecret
+	}
+
+	if audit.MaxSizeBytes == 0 {
+		audit.MaxSizeBytes = 100 * 1024 * 1024
+	}
+
+	return nil
+}
+
+var (
+	errQuotaNegativeRepositoryMax = errors.New("quota: repository_max_bytes cannot be negative")
+	errQuotaNegativeStorageMax    = errors.New("quota: storage_max_bytes cannot be negative")
+	errQuotaNegativeCacheTTL      = errors.New("quota: cache_ttl cannot be negative")
+)
+
+func (cfg *Cfg) configureQuota() error {
+	quota := &cfg.Quota
+
+	if quota.RepositoryMaxBytes < 0 {
+		return errQuotaNegativeRepositoryMax
+	}
+
+	if quota.StorageMaxBytes < 0 {
+		return errQuotaNegativeStorageMax
+	}
+
+	if quota.CacheTTL < 0 {
+		return errQuotaNegativeCacheTTL
+	}
+
+	if quota.Enabled() && quota.CacheTTL == 0 {
+		quota.CacheTTL = duration.Duration(time.Minute)
+	}
+
+	return nil
+}
+
 // SetupRuntimeDirectory creates a new runtime directory. Runtime directory contains internal
 // runtime data generated by Gitaly such as the internal sockets. If cfg.RuntimeDir is set,
 // it's used as the parent directory for the runtime directory. Runtime directory owner process
@@ -15,6 +15,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/sentry"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/telemetry"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
@@ -43,6 +44,7 @@ func TestLoadEmptyConfig(t *testing.T) {
 
 	expectedCfg := Cfg{
 		Prometheus: prometheus.DefaultConfig(),
+		Telemetry:  telemetry.DefaultConfig(),
 	}
 	require.NoError(t, expectedCfg.setDefaults())
 
@@ -1067,6 +1069,155 @@ func TestValidateCgroups(t *testing.T) {
 				},
 				validateErr: errors.New("cgroups.repositories: cpu shares cannot exceed parent"),
 			},
+			{
+				name: "pids and io limits",
+				rawCfg: `[cgroups]
+				mountpoint = "/sys/fs/cgroup"
+				hierarchy_root = "gitaly"
+				pids_limit = 1000
+				io_weight = 500
+				[cgroups.repositories]
+				count = 10
+				pids_limit = 100
+				io_weight = 100
+				`,
+				expect: cgroups.Config{
+					Mountpoint:    "/sys/fs/cgroup",
+					HierarchyRoot: "gitaly",
+					PidsLimit:     1000,
+					IOWeight:      500,
+					Repositories: cgroups.Repositories{
+						Count:     10,
+						PidsLimit: 100,
+						IOWeight:  100,
+					},
+				},
+			},
+			{
+				name: "repositories pids limit exceeds parent",
+				rawCfg: `[cgroups]
+				mountpoint = "/sys/fs/cgroup"
+				hierarchy_root = "gitaly"
+				pids_limit = 100
+				[cgroups.repositories]
+				count = 10
+				pids_limit = 1000
+				`,
+				expect: cgroups.Config{
+					Mountpoint:    "/sys/fs/cgroup",
+					HierarchyRoot: "gitaly",
+					PidsLimit:     100,
+					Repositories: cgroups.Repositories{
+						Count:     10,
+						PidsLimit: 1000,
+					},
+				},
+				validateErr: errors.New("cgroups.repositories: pids limit cannot exceed parent"),
+			},
+			{
+				name: "io weight out of range",
+				rawCfg: `[cgroups]
+				mountpoint = "/sys/fs/cgroup"
+				hierarchy_root = "gitaly"
+				[cgroups.repositories]
+				count = 10
+				io_weight = 10001
+				`,
+				expect: cgroups.Config{
+					Mountpoint:    "/sys/fs/cgroup",
+					HierarchyRoot: "gitaly",
+					Repositories: cgroups.Repositories{
+						Count:    10,
+						IOWeight: 10001,
+					},
+				},
+				validateErr: errors.New("cgroups: io weight must not exceed 10000"),
+			},
+			{
+				name: "classes",
+				rawCfg: `[cgroups]
+				mountpoint = "/sys/fs/cgroup"
+				hierarchy_root = "gitaly"
+				[cgroups.repositories]
+				count = 10
+				[[cgroups.classes]]
+				name = "housekeeping"
+				memory_bytes = 1024
+				cpu_shares = 128
+				[[cgroups.classes]]
+				name = "pack-objects"
+				pids_limit = 100
+				io_weight = 1000
+				`,
+				expect: cgroups.Config{
+					Mountpoint:    "/sys/fs/cgroup",
+					HierarchyRoot: "gitaly",
+					Repositories: cgroups.Repositories{
+						Count: 10,
+					},
+					Classes: []cgroups.Class{
+						{Name: "housekeeping", MemoryBytes: 1024, CPUShares: 128},
+						{Name: "pack-objects", PidsLimit: 100, IOWeight: 1000},
+					},
+				},
+			},
+			{
+				name: "unknown class",
+				rawCfg: `[cgroups]
+				mountpoint = "/sys/fs/cgroup"
+				hierarchy_root = "gitaly"
+				[[cgroups.classes]]
+				name = "unknown"
+				`,
+				expect: cgroups.Config{
+					Mountpoint:    "/sys/fs/cgroup",
+					HierarchyRoot: "gitaly",
+					Classes: []cgroups.Class{
+						{Name: "unknown"},
+					},
+				},
+				validateErr: errors.New(`cgroups.classes: unknown class "unknown"`),
+			},
+			{
+				name: "duplicate class",
+				rawCfg: `[cgroups]
+				mountpoint = "/sys/fs/cgroup"
+				hierarchy_root = "gitaly"
+				[[cgroups.classes]]
+				name = "housekeeping"
+				[[cgroups.classes]]
+				name = "housekeeping"
+				`,
+				expect: cgroups.Config{
+					Mountpoint:    "/sys/fs/cgroup",
+					HierarchyRoot: "gitaly",
+					Classes: []cgroups.Class{
+						{Name: "housekeeping"},
+						{Name: "housekeeping"},
+					},
+				},
+				validateErr: errors.New(`cgroups.classes: class "housekeeping" configured multiple times`),
+			},
+			{
+				name: "class memory exceeds parent",
+				rawCfg: `[cgroups]
+				mountpoint = "/sys/fs/cgroup"
+				hierarchy_root = "gitaly"
+				memory_bytes = 1024
+				[[cgroups.classes]]
+				name = "housekeeping"
+				memory_bytes = 2048
+				`,
+				expect: cgroups.Config{
+					Mountpoint:    "/sys/fs/cgroup",
+					HierarchyRoot: "gitaly",
+					MemoryBytes:   1024,
+					Classes: []cgroups.Class{
+						{Name: "housekeeping", MemoryBytes: 2048},
+					},
+				},
+				validateErr: errors.New(`cgroups.classes: memory limit of class "housekeeping" cannot exceed parent`),
+			},
 			{
 				name: "metrics enabled",
 				rawCfg: `[cgroups]
@@ -1173,6 +1324,615 @@ dir = "foobar"
 	}
 }
 
+func TestConfigureHookEvents(t *testing.T) {
+	storageConfig := `[[storage]]
+name="default"
+path="/foobar"
+`
+
+	testCases := []struct {
+		desc        string
+		in          string
+		out         HookEvents
+		expectedErr error
+	}{
+		{desc: "empty"},
+		{
+			desc: "enabled",
+			in: storageConfig + `[hooks.events]
+sink = "unix:///run/events.sock"
+`,
+			out: HookEvents{
+				Sink:          "unix:///run/events.sock",
+				SpoolDir:      "/foobar/+gitaly/HookEvents",
+				MaxAttempts:   10,
+				RetryInterval: duration.Duration(10 * time.Second),
+				Timeout:       duration.Duration(10 * time.Second),
+			},
+		},
+		{
+			desc: "enabled with custom values",
+			in: storageConfig + `[hooks.events]
+sink = "https://example.com/events"
+repositories = ["group/*"]
+spool_dir = "/spool"
+max_attempts = 3
+retry_interval = "1m"
+timeout = "5s"
+`,
+			out: HookEvents{
+				Sink:          "https://example.com/events",
+				Repositories:  []string{"group/*"},
+				SpoolDir:      "/spool",
+				MaxAttempts:   3,
+				RetryInterval: duration.Duration(time.Minute),
+				Timeout:       duration.Duration(5 * time.Second),
+			},
+		},
+		{
+			desc: "unsupported sink",
+			in: storageConfig + `[hooks.events]
+sink = "ftp://example.com"
+`,
+			expectedErr: errHookEventsInvalidSink,
+		},
+		{
+			desc: "file sink without path",
+			in: storageConfig + `[hooks.events]
+sink = "file://"
+`,
+			expectedErr: errHookEventsInvalidSink,
+		},
+		{
+			desc: "invalid repository pattern",
+			in: storageConfig + `[hooks.events]
+sink = "file:///events.log"
+repositories = ["["]
+`,
+			expectedErr: fmt.Errorf("%w %q", errHookEventsInvalidPattern, "["),
+		},
+		{
+			desc: "negative timeout",
+			in: storageConfig + `[hooks.events]
+sink = "file:///events.log"
+timeout = "-1s"
+`,
+			expectedErr: errHookEventsNegativeTimeout,
+		},
+		{
+			desc: "enabled with 0 storages",
+			in: `[hooks.events]
+sink = "file:///events.log"
+`,
+			expectedErr: errHookEventsNoStorages,
+		},
+		{
+			desc: "enabled with relative spool directory",
+			in: `[hooks.events]
+sink = "file:///events.log"
+spool_dir = "spool"
+`,
+			expectedErr: errHookEventsRelativeSpool,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg, err := Load(strings.NewReader(tc.in))
+			require.NoError(t, err)
+
+			err = cfg.configureHookEvents()
+			if tc.expectedErr != nil {
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.out, cfg.Hooks.Events)
+		})
+	}
+}
+
+func TestConfigureBundleURI(t *testing.T) {
+	storageConfig := `[[storage]]
+name="default"
+path="/foobar"
+`
+
+	testCases := []struct {
+		desc        string
+		in          string
+		out         BundleURI
+		expectedErr error
+	}{
+		{desc: "empty"},
+		{
+			desc: "enabled",
+			in: storageConfig + `[bundle_uri]
+base_url = "https://cdn.example.com/bundles"
+secret = "secret"
+`,
+			out: BundleURI{
+				BaseURL:         "https://cdn.example.com/bundles",
+				Secret:          "secret",
+				Dir:             "/foobar/+gitaly/BundleURI",
+				RefreshInterval: duration.Duration(24 * time.Hour),
+			},
+		},
+		{
+			desc: "enabled with custom values",
+			in: storageConfig + `[bundle_uri]
+base_url = "http://cdn.example.com"
+secret = "secret"
+dir = "/bundles"
+refresh_interval = "1h"
+`,
+			out: BundleURI{
+				BaseURL:         "http://cdn.example.com",
+				Secret:          "secret",
+				Dir:             "/bundles",
+				RefreshInterval: duration.Duration(time.Hour),
+			},
+		},
+		{
+			desc: "unsupported scheme",
+			in: storageConfig + `[bundle_uri]
+base_url = "file:///bundles"
+`,
+			expectedErr: errBundleURIInvalidBaseURL,
+		},
+		{
+			desc: "missing host",
+			in: storageConfig + `[bundle_uri]
+base_url = "https:///bundles"
+`,
+			expectedErr: errBundleURIInvalidBaseURL,
+		},
+		{
+			desc: "missing secret",
+			in: storageConfig + `[bundle_uri]
+base_url = "https://cdn.example.com"
+`,
+			expectedErr: errBundleURIMissingSecret,
+		},
+		{
+			desc: "negative refresh interval",
+			in: storageConfig + `[bundle_uri]
+base_url = "https://cdn.example.com"
+secret = "secret"
+refresh_interval = "-1s"
+`,
+			expectedErr: errBundleURINegativeInterval,
+		},
+		{
+			desc: "enabled with 0 storages",
+			in: `[bundle_uri]
+base_url = "https://cdn.example.com"
+secret = "secret"
+`,
+			expectedErr: errBundleURINoStorages,
+		},
+		{
+			desc: "enabled with relative directory",
+			in: `[bundle_uri]
+base_url = "https://cdn.example.com"
+secret = "secret"
+dir = "bundles"
+`,
+			expectedErr: errBundleURIRelativeDir,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg, err := Load(strings.NewReader(tc.in))
+			require.NoError(t, err)
+
+			err = cfg.configureBundleURI()
+			if tc.expectedErr != nil {
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.out, cfg.BundleURI)
+		})
+	}
+}
+
+func TestValidatePartialClone(t *testing.T) {
+	storageConfig := `[[storage]]
+name="default"
+path="/foobar"
+`
+
+	testCases := []struct {
+		desc        string
+		in          string
+		out         PartialClone
+		expectedErr error
+	}{
+		{desc: "empty"},
+		{
+			desc: "valid policies",
+			in: storageConfig + `[[partial_clone.policy]]
+storages = ["default"]
+repositories = ["group/monorepo"]
+allowed_filters = ["blob:none", "tree"]
+max_tree_depth = 1
+require_filter_above_bytes = 1073741824
+
+[[partial_clone.policy]]
+allowed_filters = ["blob:none", "blob:limit", "sparse:oid", "combine"]
+`,
+			out: PartialClone{
+				Policies: []PartialClonePolicy{
+					{
+						Storages:                []string{"default"},
+						Repositories:            []string{"group/monorepo"},
+						AllowedFilters:          []string{"blob:none", "tree"},
+						MaxTreeDepth:            1,
+						RequireFilterAboveBytes: 1073741824,
+					},
+					{
+						AllowedFilters: []string{"blob:none", "blob:limit", "sparse:oid", "combine"},
+					},
+				},
+			},
+		},
+		{
+			desc: "unknown storage",
+			in: storageConfig + `[[partial_clone.policy]]
+storages = ["unknown"]
+`,
+			expectedErr: fmt.Errorf("%w %q", errPartialCloneUnknownStorage, "unknown"),
+		},
+		{
+			desc: "invalid repository pattern",
+			in: storageConfig + `[[partial_clone.policy]]
+repositories = ["["]
+`,
+			expectedErr: fmt.Errorf("%w %q", errPartialCloneInvalidPattern, "["),
+		},
+		{
+			desc: "unsupported filter",
+			in: storageConfig + `[[partial_clone.policy]]
+allowed_filters = ["tree:0"]
+`,
+			expectedErr: fmt.Errorf("%w %q", errPartialCloneInvalidFilter, "tree:0"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg, err := Load(strings.NewReader(tc.in))
+			require.NoError(t, err)
+
+			err = cfg.validatePartialClone()
+			if tc.expectedErr != nil {
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.out, cfg.PartialClone)
+		})
+	}
+}
+
+func TestConfigureAudit(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		in          string
+		out         Audit
+		expectedErr error
+	}{
+		{desc: "empty"},
+		{
+			desc: "enabled",
+			in: `[audit]
+path = "/var/log/gitaly/audit.log"
+secret = "secret"
+`,
+			out: Audit{Path: "/var/log/gitaly/audit.log", MaxSizeBytes: 100 * 1024 * 1024, Secret: "secret"},
+		},
+		{
+			desc: "enabled with custom max size",
+			in: `[audit]
+path = "/var/log/gitaly/audit.log"
+max_size_bytes = 1024
+secret = "secret"
+`,
+			out: Audit{Path: "/var/log/gitaly/audit.log", MaxSizeBytes: 1024, Secret: "secret"},
+		},
+		{
+			desc: "relative path",
+			in: `[audit]
+path = "audit.log"
+`,
+			expectedErr: errAuditRelativePath,
+		},
+		{
+			desc: "negative max size",
+			in: `[audit]
+path = "/var/log/gitaly/audit.log"
+max_size_bytes = -1
+`,
+			expectedErr: errAuditNegativeMaxSize,
+		},
+		{
+			desc: "missing secret",
+			in: `[audit]
+path = "/var/log/gitaly/audit.log"
+`,
+			expectedErr: errAuditMissingSecret,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg, err := Load(strings.NewReader(tc.in))
+			require.NoError(t, err)
+
+			err = cfg.configureAudit()
+			if tc.expectedErr != nil {
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.out, cfg.Audit)
+		})
+	}
+}
+
+func TestConfigureQuota(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		in          string
+		out         Quota
+		expectedErr error
+	}{
+		{desc: "empty"},
+		{
+			desc: "repository quota",
+			in: `[quota]
+repository_max_bytes = 1024
+`,
+			out: Quota{RepositoryMaxBytes: 1024, CacheTTL: duration.Duration(time.Minute)},
+		},
+		{
+			desc: "storage quota with custom cache TTL",
+			in: `[quota]
+storage_max_bytes = 2048
+cache_ttl = "10s"
+`,
+			out: Quota{StorageMaxBytes: 2048, CacheTTL: duration.Duration(10 * time.Second)},
+		},
+		{
+			desc: "negative repository quota",
+			in: `[quota]
+repository_max_bytes = -1
+`,
+			expectedErr: errQuotaNegativeRepositoryMax,
+		},
+		{
+			desc: "negative storage quota",
+			in: `[quota]
+storage_max_bytes = -1
+`,
+			expectedErr: errQuotaNegativeStorageMax,
+		},
+		{
+			desc: "negative cache TTL",
+			in: `[quota]
+repository_max_bytes = 1024
+cache_ttl = "-1s"
+`,
+			expectedErr: errQuotaNegativeCacheTTL,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg, err := Load(strings.NewReader(tc.in))
+			require.NoError(t, err)
+
+			err = cfg.configureQuota()
+			if tc.expectedErr != nil {
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.out, cfg.Quota)
+		})
+	}
+}
+
+func TestValidateTelemetry(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		in          string
+		out         telemetry.Config
+		expectedErr error
+	}{
+		{
+			desc: "empty",
+			out:  telemetry.DefaultConfig(),
+		},
+		{
+			desc: "collector endpoint",
+			in: `[telemetry]
+endpoint = "localhost:4317"
+insecure = true
+sample_ratio = 0.5
+`,
+			out: telemetry.Config{Endpoint: "localhost:4317", Insecure: true, SampleRatio: 0.5},
+		},
+		{
+			desc: "file",
+			in: `[telemetry]
+file = "/var/log/gitaly/traces.json"
+`,
+			out: telemetry.Config{File: "/var/log/gitaly/traces.json", SampleRatio: 1},
+		},
+		{
+			desc: "never sample",
+			in: `[telemetry]
+endpoint = "localhost:4317"
+sample_ratio = 0.0
+`,
+			out: telemetry.Config{Endpoint: "localhost:4317"},
+		},
+		{
+			desc: "endpoint and file",
+			in: `[telemetry]
+endpoint = "localhost:4317"
+file = "/var/log/gitaly/traces.json"
+`,
+			expectedErr: errors.New("telemetry: endpoint and file are mutually exclusive"),
+		},
+		{
+			desc: "invalid sample ratio",
+			in: `[telemetry]
+sample_ratio = 1.5
+`,
+			expectedErr: errors.New("telemetry: sample_ratio must be between 0 and 1 but is 1.5"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg, err := Load(strings.NewReader(tc.in))
+			require.NoError(t, err)
+
+			err = cfg.Telemetry.Validate()
+			if tc.expectedErr != nil {
+				require.Equal(t, tc.expectedErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.out, cfg.Telemetry)
+		})
+	}
+}
+
+func TestValidateAuthClients(t *testing.T) {
+	caPath, _ := testhelper.GenerateCerts(t)
+
+	emptyPath := filepath.Join(testhelper.TempDir(t), "empty.pem")
+	require.NoError(t, os.WriteFile(emptyPath, nil, perm.SharedFile))
+
+	storages := []Storage{{Name: "default", Path: "/foobar"}}
+
+	testCases := []struct {
+		desc        string
+		auth        auth.Config
+		tls         TLS
+		expectedErr error
+	}{
+		{desc: "empty"},
+		{
+			desc: "valid clients",
+			auth: auth.Config{
+				Token: "secret",
+				Clients: []auth.Client{
+					{
+						Name:       "analytics",
+						Token:      "analytics-secret",
+						Categories: []string{auth.CategoryReadOnly},
+						Storages:   []string{"default"},
+					},
+					{
+						Name:                  "backup",
+						CertificateIdentities: []string{"backup.example.com"},
+						Services:              []string{"gitaly.RepositoryService"},
+						Categories:            []string{auth.CategoryReadOnly, auth.CategoryMaintenance},
+					},
+				},
+			},
+			tls: TLS{ClientCAPath: caPath, RequireClientCertificate: true},
+		},
+		{
+			desc:        "required client certificate without certificate authority",
+			tls:         TLS{RequireClientCertificate: true},
+			expectedErr: errTLSClientCertificateNoCA,
+		},
+		{
+			desc:        "certificate authority without certificates",
+			tls:         TLS{ClientCAPath: emptyPath},
+			expectedErr: errTLSClientCANoCertificates,
+		},
+		{
+			desc: "clients without shared token",
+			auth: auth.Config{
+				Clients: []auth.Client{{Name: "analytics", Token: "analytics-secret"}},
+			},
+			expectedErr: errAuthClientsWithoutToken,
+		},
+		{
+			desc: "unnamed client",
+			auth: auth.Config{
+				Token:   "secret",
+				Clients: []auth.Client{{Token: "analytics-secret"}},
+			},
+			expectedErr: errAuthClientUnnamed,
+		},
+		{
+			desc: "duplicate client",
+			auth: auth.Config{
+				Token: "secret",
+				Clients: []auth.Client{
+					{Name: "analytics", Token: "first-secret"},
+					{Name: "analytics", Token: "second-secret"},
+				},
+			},
+			expectedErr: fmt.Errorf("%w %q", errAuthClientDuplicate, "analytics"),
+		},
+		{
+			desc: "client without identity",
+			auth: auth.Config{
+				Token:   "secret",
+				Clients: []auth.Client{{Name: "analytics"}},
+			},
+			expectedErr: fmt.Errorf("%w: %q", errAuthClientWithoutIdentity, "analytics"),
+		},
+		{
+			desc: "certificate identities without certificate authority",
+			auth: auth.Config{
+				Token:   "secret",
+				Clients: []auth.Client{{Name: "analytics", CertificateIdentities: []string{"analytics.example.com"}}},
+			},
+			expectedErr: fmt.Errorf("%w: %q", errAuthClientCertificateNoCA, "analytics"),
+		},
+		{
+			desc: "invalid category",
+			auth: auth.Config{
+				Token:   "secret",
+				Clients: []auth.Client{{Name: "analytics", Token: "analytics-secret", Categories: []string{"admin"}}},
+			},
+			expectedErr: fmt.Errorf("%w %q", errAuthClientInvalidCategory, "admin"),
+		},
+		{
+			desc: "unknown storage",
+			auth: auth.Config{
+				Token:   "secret",
+				Clients: []auth.Client{{Name: "analytics", Token: "analytics-secret", Storages: []string{"unknown"}}},
+			},
+			expectedErr: fmt.Errorf("%w %q", errAuthClientUnknownStorage, "unknown"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := Cfg{Auth: tc.auth, TLS: tc.tls, Storages: storages}
+			require.Equal(t, tc.expectedErr, cfg.validateAuthClients())
+		})
+	}
+}
+
 func TestValidateToken(t *testing.T) {
 	require.NoError(t, (&Cfg{Auth: auth.Config{}}).validateToken())
 	require.NoError(t, (&Cfg{Auth: auth.Config{Token: ""}}).validateToken())
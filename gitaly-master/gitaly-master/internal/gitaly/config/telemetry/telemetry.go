@@ -0,0 +1,49 @@
+package telemetry
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Config contains the configuration of the OpenTelemetry traces exported by the process.
+// Exporting is disabled if neither an endpoint nor a file is configured, but trace context
+// received from clients is still propagated to downstream services and subprocesses.
+type Config struct {
+	// Endpoint is the address of an OTLP collector accepting traces via gRPC, e.g.
+	// "localhost:4317".
+	Endpoint string `toml:"endpoint,omitempty" json:"endpoint"`
+	// Insecure disables transport security for the connection to the collector.
+	Insecure bool `toml:"insecure,omitempty" json:"insecure"`
+	// File is the path of a file traces are appended to as JSON-encoded OTLP messages, one
+	// export batch per line.
+	File string `toml:"file,omitempty" json:"file"`
+	// SampleRatio is the ratio of traces started by the process that are sampled, where 0
+	// never samples and 1 always samples. Traces continued from a client follow the sampling
+	// decision of the client. Defaults to 1.
+	SampleRatio float64 `toml:"sample_ratio" json:"sample_ratio"`
+}
+
+// DefaultConfig returns a new config with default values set.
+func DefaultConfig() Config {
+	return Config{
+		SampleRatio: 1,
+	}
+}
+
+// Enabled determines whether traces are exported.
+func (c Config) Enabled() bool {
+	return c.Endpoint != "" || c.File != ""
+}
+
+// Validate validates the telemetry configuration.
+func (c Config) Validate() error {
+	if c.Endpoint != "" && c.File != "" {
+		return errors.New("telemetry: endpoint and file are mutually exclusive")
+	}
+
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("telemetry: sample_ratio must be between 0 and 1 but is %v", c.SampleRatio)
+	}
+
+	return nil
+}
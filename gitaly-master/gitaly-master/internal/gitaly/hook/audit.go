@@ -0,0 +1,104 @@
+package hook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/audit"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushrules"
+	"gitlab.com/gitlab-org/labkit/correlation"
+)
+
+// recordReferenceTransaction records the reference updates committed by a reference transaction
+// in the audit log. Failing to record the updates does not cause the transaction to fail given
+// that the references have already been updated at this point.
+func (m *GitLabHookManager) recordReferenceTransaction(ctx context.Context, payload git.HooksPayload, changes []byte) {
+	if m.auditLogger == nil {
+		return
+	}
+
+	if err := m.auditReferenceTransaction(ctx, payload, changes); err != nil {
+		ctxlogrus.Extract(ctx).WithError(err).Error("recording audit entry")
+	}
+}
+
+func (m *GitLabHookManager) auditReferenceTransaction(ctx context.Context, payload git.HooksPayload, changes []byte) error {
+	repo := localrepo.New(m.locator, m.gitCmdFactory, nil, payload.Repo)
+
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	parsedChanges, err := pushrules.ParseChanges(objectHash, changes)
+	if err != nil {
+		return fmt.Errorf("parsing changes: %w", err)
+	}
+
+	entry := audit.Entry{
+		Type:             audit.EntryTypeReferenceTransaction,
+		CorrelationID:    correlation.ExtractFromContext(ctx),
+		Repository:       audit.NewRepository(payload.Repo),
+		ReferenceUpdates: make([]audit.ReferenceUpdate, 0, len(parsedChanges)),
+	}
+
+	if payload.UserDetails != nil {
+		entry.UserID = payload.UserDetails.UserID
+		entry.Username = payload.UserDetails.Username
+		entry.Protocol = payload.UserDetails.Protocol
+	}
+
+	for _, change := range parsedChanges {
+		changeType, err := classifyChange(ctx, repo, objectHash, change)
+		if err != nil {
+			return fmt.Errorf("classifying change of %q: %w", change.Reference, err)
+		}
+
+		entry.ReferenceUpdates = append(entry.ReferenceUpdates, audit.ReferenceUpdate{
+			Reference: change.Reference.String(),
+			OldOID:    change.OldOID.String(),
+			NewOID:    change.NewOID.String(),
+			Change:    changeType,
+		})
+	}
+
+	return m.auditLogger.Log(entry)
+}
+
+// classifyChange determines how the reference has been changed. Updates are force-updates if the
+// new object doesn't descend from the old object.
+func classifyChange(ctx context.Context, repo *localrepo.Repo, objectHash git.ObjectHash, change pushrules.Change) (audit.ChangeType, error) {
+	switch {
+	case objectHash.IsZeroOID(change.NewOID):
+		return audit.ChangeDelete, nil
+	case objectHash.IsZeroOID(change.OldOID):
+		return audit.ChangeCreate, nil
+	}
+
+	// Ancestry is only defined for commits, so updates of references pointing to other objects
+	// cannot be force-updates.
+	for _, oid := range []git.ObjectID{change.OldOID, change.NewOID} {
+		if _, err := repo.ResolveRevision(ctx, oid.Revision()+"^{commit}"); err != nil {
+			if errors.Is(err, git.ErrReferenceNotFound) {
+				return audit.ChangeUpdate, nil
+			}
+
+			return "", fmt.Errorf("peeling %q: %w", oid, err)
+		}
+	}
+
+	isAncestor, err := repo.IsAncestor(ctx, change.OldOID.Revision(), change.NewOID.Revision())
+	if err != nil {
+		return "", err
+	}
+
+	if !isAncestor {
+		return audit.ChangeForceUpdate, nil
+	}
+
+	return audit.ChangeUpdate, nil
+}
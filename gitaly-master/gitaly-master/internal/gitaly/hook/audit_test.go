@@ -0,0 +1,148 @@
+package hook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/audit"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitlab"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/transaction/txinfo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/transaction/voting"
+)
+
+func TestHookManager_auditReferenceTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	base := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("base"))
+	child := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(base), gittest.WithMessage("child"))
+	diverging := gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("diverging"))
+	blob := gittest.WriteBlob(t, cfg, repoPath, []byte("blob"))
+	otherBlob := gittest.WriteBlob(t, cfg, repoPath, []byte("other blob"))
+	zeroOID := gittest.DefaultObjectHash.ZeroOID
+
+	auditPath := filepath.Join(testhelper.TempDir(t), "audit.log")
+	auditLogger, err := audit.NewLogger(config.Audit{Path: auditPath, Secret: "secret"}, testhelper.NewDiscardingLogEntry(t))
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, auditLogger)
+
+	hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), &transaction.MockManager{}, gitlab.NewMockClient(
+		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
+	), auditLogger, nil)
+
+	hooksPayload, err := git.NewHooksPayload(
+		cfg,
+		repo,
+		nil,
+		&git.UserDetails{
+			UserID:   "1234",
+			Username: "user",
+			Protocol: "web",
+		},
+		git.ReferenceTransactionHook,
+		featureflag.FromContext(ctx),
+	).Env()
+	require.NoError(t, err)
+
+	changes := strings.Join([]string{
+		fmt.Sprintf("%s %s refs/heads/created", zeroOID, base),
+		fmt.Sprintf("%s %s refs/heads/updated", base, child),
+		fmt.Sprintf("%s %s refs/heads/force-updated", child, diverging),
+		fmt.Sprintf("%s %s refs/heads/deleted", base, zeroOID),
+		fmt.Sprintf("%s %s refs/tags/blob", blob, otherBlob),
+	}, "\n") + "\n"
+
+	// Only committed transactions are recorded.
+	require.NoError(t, hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionPrepared, []string{hooksPayload}, strings.NewReader(changes)))
+	require.NoError(t, hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionCommitted, []string{hooksPayload}, strings.NewReader(changes)))
+
+	lines := strings.Split(strings.TrimSpace(string(testhelper.MustReadFile(t, auditPath))), "\n")
+	require.Len(t, lines, 1)
+
+	var entry audit.Entry
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	require.Equal(t, audit.EntryTypeReferenceTransaction, entry.Type)
+	require.Equal(t, audit.NewRepository(repo), entry.Repository)
+	require.Equal(t, "1234", entry.UserID)
+	require.Equal(t, "user", entry.Username)
+	require.Equal(t, "web", entry.Protocol)
+	require.Equal(t, []audit.ReferenceUpdate{
+		{Reference: "refs/heads/created", OldOID: zeroOID.String(), NewOID: base.String(), Change: audit.ChangeCreate},
+		{Reference: "refs/heads/updated", OldOID: base.String(), NewOID: child.String(), Change: audit.ChangeUpdate},
+		{Reference: "refs/heads/force-updated", OldOID: child.String(), NewOID: diverging.String(), Change: audit.ChangeForceUpdate},
+		{Reference: "refs/heads/deleted", OldOID: base.String(), NewOID: zeroOID.String(), Change: audit.ChangeDelete},
+		{Reference: "refs/tags/blob", OldOID: blob.String(), NewOID: otherBlob.String(), Change: audit.ChangeUpdate},
+	}, entry.ReferenceUpdates)
+}
+
+func TestHookManager_auditReferenceTransactionWithFailedVote(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	commit := gittest.WriteCommit(t, cfg, repoPath)
+
+	auditPath := filepath.Join(testhelper.TempDir(t), "audit.log")
+	auditLogger, err := audit.NewLogger(config.Audit{Path: auditPath, Secret: "secret"}, testhelper.NewDiscardingLogEntry(t))
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, auditLogger)
+
+	hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), &transaction.MockManager{
+		VoteFn: func(context.Context, txinfo.Transaction, voting.Vote, voting.Phase) error {
+			return errors.New("vote failed")
+		},
+	}, gitlab.NewMockClient(
+		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
+	), auditLogger, nil)
+
+	hooksPayload, err := git.NewHooksPayload(
+		cfg,
+		repo,
+		&txinfo.Transaction{
+			ID: 1234, Node: "primary", Primary: true,
+		},
+		nil,
+		git.ReferenceTransactionHook,
+		featureflag.FromContext(ctx),
+	).Env()
+	require.NoError(t, err)
+
+	changes := fmt.Sprintf("%s %s refs/heads/main\n", gittest.DefaultObjectHash.ZeroOID, commit)
+
+	// The references have already been updated once the transaction is committed, so the
+	// updates must be recorded even if the vote fails.
+	err = hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionCommitted, []string{hooksPayload}, strings.NewReader(changes))
+	require.EqualError(t, err, "error voting on transaction: vote failed")
+
+	lines := strings.Split(strings.TrimSpace(string(testhelper.MustReadFile(t, auditPath))), "\n")
+	require.Len(t, lines, 1)
+
+	var entry audit.Entry
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	require.Equal(t, []audit.ReferenceUpdate{
+		{Reference: "refs/heads/main", OldOID: gittest.DefaultObjectHash.ZeroOID.String(), NewOID: commit.String(), Change: audit.ChangeCreate},
+	}, entry.ReferenceUpdates)
+}
@@ -5,7 +5,10 @@ import (
 	"io"
 
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/audit"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/quota"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/refbarrier"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitlab"
@@ -55,6 +58,9 @@ type GitLabHookManager struct {
 	gitCmdFactory git.CommandFactory
 	txManager     transaction.Manager
 	gitlabClient  gitlab.Client
+	auditLogger   *audit.Logger
+	quotaManager  *quota.Manager
+	refBarrier    *refbarrier.Barrier
 }
 
 // NewManager returns a new hook manager
@@ -64,6 +70,8 @@ func NewManager(
 	gitCmdFactory git.CommandFactory,
 	txManager transaction.Manager,
 	gitlabClient gitlab.Client,
+	auditLogger *audit.Logger,
+	refBarrier *refbarrier.Barrier,
 ) *GitLabHookManager {
 	return &GitLabHookManager{
 		cfg:           cfg,
@@ -71,5 +79,8 @@ func NewManager(
 		gitCmdFactory: gitCmdFactory,
 		txManager:     txManager,
 		gitlabClient:  gitlabClient,
+		auditLogger:   auditLogger,
+		quotaManager:  quota.NewManager(cfg.Quota, locator),
+		refBarrier:    refBarrier,
 	}
 }
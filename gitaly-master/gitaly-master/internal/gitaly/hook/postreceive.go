@@ -11,6 +11,7 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushevents"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitlab"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
@@ -149,6 +150,8 @@ func (m *GitLabHookManager) postReceiveHook(ctx context.Context, payload git.Hoo
 		return structerr.NewInternal("repository not set")
 	}
 
+	m.publishEvent(ctx, payload, repo, pushOptions, stdin)
+
 	ok, messages, err := m.gitlabClient.PostReceive(
 		ctx, repo.GetGlRepository(),
 		payload.UserDetails.UserID,
@@ -190,3 +193,23 @@ func (m *GitLabHookManager) postReceiveHook(ctx context.Context, payload git.Hoo
 
 	return nil
 }
+
+// publishEvent spools an event describing the reference updates so that it can be delivered to
+// the configured sink asynchronously. Failing to spool the event is logged, but it does not cause
+// the push to fail as the references have already been updated at this point.
+func (m *GitLabHookManager) publishEvent(ctx context.Context, payload git.HooksPayload, repo *gitalypb.Repository, pushOptions []string, changes []byte) {
+	cfg := m.cfg.Hooks.Events
+	if cfg.Sink == "" || !pushevents.MatchesRepository(cfg.Repositories, repo) {
+		return
+	}
+
+	event, err := pushevents.NewEvent(repo, payload.UserDetails, pushOptions, changes)
+	if err != nil {
+		ctxlogrus.Extract(ctx).WithError(err).Warn("creating push event")
+		return
+	}
+
+	if err := pushevents.NewSpool(cfg.SpoolDir).Enqueue(event); err != nil {
+		ctxlogrus.Extract(ctx).WithError(err).Warn("spooling push event")
+	}
+}
@@ -3,8 +3,10 @@ package hook
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -16,6 +18,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/quarantine"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushevents"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitlab"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
@@ -79,7 +82,7 @@ func TestPostReceive_customHook(t *testing.T) {
 
 	hookManager := NewManager(cfg, locator, gitCmdFactory, transaction.NewManager(cfg, backchannel.NewRegistry()), gitlab.NewMockClient(
 		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
-	))
+	), nil, nil)
 
 	receiveHooksPayload := &git.UserDetails{
 		UserID:   "1234",
@@ -342,7 +345,7 @@ func TestPostReceive_gitlab(t *testing.T) {
 				},
 			}
 
-			hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), transaction.NewManager(cfg, backchannel.NewRegistry()), &gitlabAPI)
+			hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), transaction.NewManager(cfg, backchannel.NewRegistry()), &gitlabAPI, nil, nil)
 
 			gittest.WriteCustomHook(t, repoPath, "post-receive", []byte("#!/bin/sh\necho hook called\n"))
 
@@ -378,7 +381,7 @@ func TestPostReceive_quarantine(t *testing.T) {
 
 	hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), nil, gitlab.NewMockClient(
 		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
-	))
+	), nil, nil)
 
 	gittest.WriteCustomHook(t, repoPath, "post-receive", []byte(fmt.Sprintf(
 		`#!/bin/sh
@@ -418,3 +421,89 @@ func TestPostReceive_quarantine(t *testing.T) {
 		})
 	}
 }
+
+func TestPostReceive_events(t *testing.T) {
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo.GlProjectPath = "gitlab-org/gitaly"
+
+	payload, err := git.NewHooksPayload(cfg, repo, nil, &git.UserDetails{
+		UserID:   "1234",
+		Username: "user",
+		Protocol: "web",
+	}, git.PostReceiveHook, nil).Env()
+	require.NoError(t, err)
+
+	oldOID := gittest.DefaultObjectHash.ZeroOID
+	newOID := gittest.DefaultObjectHash.EmptyTreeOID
+	changes := fmt.Sprintf("%s %s refs/heads/main\n", oldOID, newOID)
+
+	for _, tc := range []struct {
+		desc           string
+		repositories   []string
+		expectedEvents int
+	}{
+		{
+			desc:           "all repositories",
+			expectedEvents: 1,
+		},
+		{
+			desc:           "matching repository",
+			repositories:   []string{"gitlab-com/*", "gitlab-org/*"},
+			expectedEvents: 1,
+		},
+		{
+			desc:           "non-matching repository",
+			repositories:   []string{"gitlab-com/*"},
+			expectedEvents: 0,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := cfg
+			cfg.Hooks.Events = config.HookEvents{
+				Sink:         "file://" + filepath.Join(testhelper.TempDir(t), "events.log"),
+				Repositories: tc.repositories,
+				SpoolDir:     testhelper.TempDir(t),
+			}
+
+			hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), nil, gitlab.NewMockClient(
+				t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
+			), nil, nil)
+
+			var stdout, stderr bytes.Buffer
+			require.NoError(t, hookManager.PostReceiveHook(ctx, repo, []string{"ci.skip"}, []string{payload}, strings.NewReader(changes), &stdout, &stderr))
+
+			spool := pushevents.NewSpool(cfg.Hooks.Events.SpoolDir)
+			pending, err := spool.Pending()
+			require.NoError(t, err)
+			require.Len(t, pending, tc.expectedEvents)
+
+			if tc.expectedEvents == 0 {
+				return
+			}
+
+			data, err := spool.Read(pending[0])
+			require.NoError(t, err)
+
+			var event pushevents.Event
+			require.NoError(t, json.Unmarshal(data, &event))
+			require.Equal(t, pushevents.Repository{
+				StorageName:   repo.GetStorageName(),
+				RelativePath:  repo.GetRelativePath(),
+				GlRepository:  repo.GetGlRepository(),
+				GlProjectPath: "gitlab-org/gitaly",
+			}, event.Repository)
+			require.Equal(t, "1234", event.UserID)
+			require.Equal(t, "user", event.Username)
+			require.Equal(t, "web", event.Protocol)
+			require.Equal(t, []string{"ci.skip"}, event.PushOptions)
+			require.Equal(t, []pushevents.RefUpdate{
+				{Reference: "refs/heads/main", OldOID: oldOID.String(), NewOID: newOID.String()},
+			}, event.Changes)
+		})
+	}
+}
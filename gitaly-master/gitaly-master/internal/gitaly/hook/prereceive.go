@@ -11,6 +11,8 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushrules"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitlab"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/env"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
@@ -124,6 +126,22 @@ func (m *GitLabHookManager) preReceiveHook(ctx context.Context, payload git.Hook
 		return structerr.NewInternal("protocol not set")
 	}
 
+	// Push rules are evaluated before calling the GitLab API so that pushes which violate them
+	// get rejected without requiring a round-trip. This also keeps them enforced in case the API
+	// is unavailable.
+	if err := m.evaluatePushRules(ctx, payload, repo, repoPath, changes); err != nil {
+		return err
+	}
+
+	var quarantineDir string
+	if repo.GetGitObjectDirectory() != "" {
+		quarantineDir = filepath.Join(repoPath, repo.GetGitObjectDirectory())
+	}
+
+	if err := m.checkQuota(ctx, repo, quarantineDir, changes); err != nil {
+		return err
+	}
+
 	params := gitlab.AllowedParams{
 		RepoPath:                      repoPath,
 		GitObjectDirectory:            repo.GitObjectDirectory,
@@ -194,3 +212,51 @@ func (m *GitLabHookManager) preReceiveHook(ctx context.Context, payload git.Hook
 
 	return nil
 }
+
+// evaluatePushRules evaluates the push rules configured for the repository against the given
+// changes. The repository must be set up to see the quarantined objects of the push.
+func (m *GitLabHookManager) evaluatePushRules(ctx context.Context, payload git.HooksPayload, repo *gitalypb.Repository, repoPath string, changes []byte) error {
+	rules, err := pushrules.Read(repoPath)
+	if err != nil {
+		return structerr.NewInternal("reading push rules: %w", err)
+	}
+
+	if pushrules.IsEmpty(rules) {
+		return nil
+	}
+
+	evaluator, err := pushrules.NewEvaluator(rules)
+	if err != nil {
+		return structerr.NewInternal("creating push rule evaluator: %w", err)
+	}
+
+	quarantinedRepo := localrepo.New(m.locator, m.gitCmdFactory, nil, repo)
+
+	objectHash, err := quarantinedRepo.ObjectHash(ctx)
+	if err != nil {
+		return structerr.NewInternal("detecting object hash: %w", err)
+	}
+
+	parsedChanges, err := pushrules.ParseChanges(objectHash, changes)
+	if err != nil {
+		return structerr.NewInternal("parsing changes: %w", err)
+	}
+
+	if err := evaluator.Evaluate(ctx, quarantinedRepo, parsedChanges); err != nil {
+		// Violations are reported the same way as rejections by the GitLab API so that the
+		// calling RPCs report them as PermissionDenied instead of as an internal error.
+		var violationErr pushrules.ViolationError
+		if errors.As(err, &violationErr) {
+			return NotAllowedError{
+				Message:  violationErr.Error(),
+				UserID:   payload.UserDetails.UserID,
+				Protocol: payload.UserDetails.Protocol,
+				Changes:  changes,
+			}
+		}
+
+		return structerr.NewInternal("evaluating push rules: %w", err)
+	}
+
+	return nil
+}
@@ -5,8 +5,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/backchannel"
@@ -15,8 +18,13 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/quarantine"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushrules"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/quota"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/repoutil"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitlab"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
@@ -38,7 +46,7 @@ func TestPrereceive_customHooks(t *testing.T) {
 
 	hookManager := NewManager(cfg, locator, gitCmdFactory, transaction.NewManager(cfg, backchannel.NewRegistry()), gitlab.NewMockClient(
 		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
-	))
+	), nil, nil)
 
 	receiveHooksPayload := &git.UserDetails{
 		UserID:   "1234",
@@ -194,7 +202,7 @@ func TestPrereceive_quarantine(t *testing.T) {
 
 	hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), nil, gitlab.NewMockClient(
 		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
-	))
+	), nil, nil)
 
 	gittest.WriteCustomHook(t, repoPath, "pre-receive", []byte(fmt.Sprintf(
 		`#!/bin/sh
@@ -371,7 +379,7 @@ func TestPrereceive_gitlab(t *testing.T) {
 				},
 			}
 
-			hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), transaction.NewManager(cfg, backchannel.NewRegistry()), &gitlabAPI)
+			hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), transaction.NewManager(cfg, backchannel.NewRegistry()), &gitlabAPI, nil, nil)
 
 			gittest.WriteCustomHook(t, repoPath, "pre-receive", []byte("#!/bin/sh\necho called\n"))
 
@@ -393,3 +401,239 @@ func TestPrereceive_gitlab(t *testing.T) {
 		})
 	}
 }
+
+func TestPrereceive_pushRules(t *testing.T) {
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	quarantine, err := quarantine.New(ctx, repoProto, config.NewLocator(cfg))
+	require.NoError(t, err)
+	quarantinedRepo := quarantine.QuarantinedRepo()
+
+	commitID := gittest.WriteCommit(t, cfg, repoPath,
+		gittest.WithAlternateObjectDirectory(filepath.Join(repoPath, quarantinedRepo.GetGitObjectDirectory())),
+		gittest.WithMessage("fix bug"),
+	)
+
+	payload, err := git.NewHooksPayload(cfg, quarantinedRepo, nil, &git.UserDetails{
+		UserID:   "1234",
+		Username: "user",
+		Protocol: "web",
+	}, git.PreReceiveHook, featureflag.FromContext(ctx)).Env()
+	require.NoError(t, err)
+
+	changes := fmt.Sprintf("%s %s refs/heads/main\n", git.ObjectHashSHA1.ZeroOID, commitID)
+
+	for _, tc := range []struct {
+		desc          string
+		rules         *gitalypb.PushRules
+		expectAllowed bool
+		expectedErr   error
+	}{
+		{
+			desc:          "no push rules",
+			rules:         &gitalypb.PushRules{},
+			expectAllowed: true,
+		},
+		{
+			desc:          "satisfied push rules",
+			rules:         &gitalypb.PushRules{CommitMessageRegex: "^fix"},
+			expectAllowed: true,
+		},
+		{
+			desc:  "violated push rules",
+			rules: &gitalypb.PushRules{CommitMessageRegex: "^JIRA-"},
+			expectedErr: NotAllowedError{
+				Message: pushrules.ViolationError{
+					Rule:    "commit_message_regex",
+					Message: fmt.Sprintf("commit %s does not match the required commit message pattern", commitID),
+				}.Error(),
+				UserID:   "1234",
+				Protocol: "web",
+				Changes:  []byte(changes),
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			data, err := pushrules.Marshal(tc.rules)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(pushrules.Path(repoPath), data, perm.SharedFile))
+
+			var allowedCalled bool
+			gitlabAPI := prereceiveAPIMock{
+				allowed: func(context.Context, gitlab.AllowedParams) (bool, string, error) {
+					allowedCalled = true
+					return true, "", nil
+				},
+				prereceive: func(context.Context, string) (bool, error) {
+					return true, nil
+				},
+			}
+
+			hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), nil, &gitlabAPI, nil, nil)
+
+			var stdout, stderr bytes.Buffer
+			err = hookManager.PreReceiveHook(ctx, quarantinedRepo, nil, []string{payload}, strings.NewReader(changes), &stdout, &stderr)
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectAllowed, allowedCalled)
+		})
+	}
+}
+
+func TestPrereceive_quota(t *testing.T) {
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	quarantine, err := quarantine.New(ctx, repoProto, config.NewLocator(cfg))
+	require.NoError(t, err)
+	quarantinedRepo := quarantine.QuarantinedRepo()
+	quarantineDir := filepath.Join(repoPath, quarantinedRepo.GetGitObjectDirectory())
+
+	commitID := gittest.WriteCommit(t, cfg, repoPath,
+		gittest.WithAlternateObjectDirectory(quarantineDir),
+	)
+
+	repoSize, err := repoutil.DiskUsage(ctx, repoPath)
+	require.NoError(t, err)
+	quarantineSize, err := repoutil.DiskUsage(ctx, quarantineDir)
+	require.NoError(t, err)
+
+	payload, err := git.NewHooksPayload(cfg, quarantinedRepo, nil, &git.UserDetails{
+		UserID:   "1234",
+		Username: "user",
+		Protocol: "web",
+	}, git.PreReceiveHook, featureflag.FromContext(ctx)).Env()
+	require.NoError(t, err)
+
+	creation := fmt.Sprintf("%s %s refs/heads/main\n", git.ObjectHashSHA1.ZeroOID, commitID)
+	deletion := fmt.Sprintf("%s %s refs/heads/main\n", commitID, git.ObjectHashSHA1.ZeroOID)
+
+	for _, tc := range []struct {
+		desc          string
+		limit         int64
+		changes       string
+		expectAllowed bool
+		expectedErr   error
+	}{
+		{
+			desc:          "no quota",
+			changes:       creation,
+			expectAllowed: true,
+		},
+		{
+			desc:          "within quota",
+			limit:         repoSize + quarantineSize,
+			changes:       creation,
+			expectAllowed: true,
+		},
+		{
+			desc:    "exceeding quota",
+			limit:   repoSize + quarantineSize - 1,
+			changes: creation,
+			expectedErr: quota.ExceededError{
+				Scope:         gitalypb.QuotaExceededError_SCOPE_REPOSITORY,
+				LimitBytes:    repoSize + quarantineSize - 1,
+				UsedBytes:     repoSize,
+				IncomingBytes: quarantineSize,
+			}.StructuredError(),
+		},
+		{
+			desc:    "read-only repository",
+			limit:   repoSize - 1,
+			changes: creation,
+			expectedErr: quota.ExceededError{
+				Scope:         gitalypb.QuotaExceededError_SCOPE_REPOSITORY,
+				LimitBytes:    repoSize - 1,
+				UsedBytes:     repoSize,
+				IncomingBytes: quarantineSize,
+				ReadOnly:      true,
+			}.StructuredError(),
+		},
+		{
+			desc:          "deletion in read-only repository",
+			limit:         repoSize - 1,
+			changes:       deletion,
+			expectAllowed: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := cfg
+			cfg.Quota = config.Quota{
+				RepositoryMaxBytes: tc.limit,
+				CacheTTL:           duration.Duration(time.Minute),
+			}
+
+			var allowedCalled bool
+			gitlabAPI := prereceiveAPIMock{
+				allowed: func(context.Context, gitlab.AllowedParams) (bool, string, error) {
+					allowedCalled = true
+					return true, "", nil
+				},
+				prereceive: func(context.Context, string) (bool, error) {
+					return true, nil
+				},
+			}
+
+			hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), nil, &gitlabAPI, nil, nil)
+
+			var stdout, stderr bytes.Buffer
+			err = hookManager.PreReceiveHook(ctx, quarantinedRepo, nil, []string{payload}, strings.NewReader(tc.changes), &stdout, &stderr)
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectAllowed, allowedCalled)
+		})
+	}
+}
+
+func TestReferenceTransactionHook_quota(t *testing.T) {
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	commitID := gittest.WriteCommit(t, cfg, repoPath)
+
+	repoSize, err := repoutil.DiskUsage(ctx, repoPath)
+	require.NoError(t, err)
+
+	payload, err := git.NewHooksPayload(cfg, repoProto, nil, nil, git.ReferenceTransactionHook, featureflag.FromContext(ctx)).Env()
+	require.NoError(t, err)
+
+	// Writes which don't go through the pre-receive hook must not be able to grow a repository
+	// that exceeds its quota, either.
+	cfg.Quota = config.Quota{
+		RepositoryMaxBytes: repoSize - 1,
+		CacheTTL:           duration.Duration(time.Minute),
+	}
+	hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), &transaction.MockManager{}, gitlab.NewMockClient(
+		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
+	), nil, nil)
+
+	creation := fmt.Sprintf("%s %s refs/heads/main\n", gittest.DefaultObjectHash.ZeroOID, commitID)
+	err = hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionPrepared, []string{payload}, strings.NewReader(creation))
+	testhelper.RequireGrpcError(t, quota.ExceededError{
+		Scope:      gitalypb.QuotaExceededError_SCOPE_REPOSITORY,
+		LimitBytes: repoSize - 1,
+		UsedBytes:  repoSize,
+		ReadOnly:   true,
+	}.StructuredError(), err)
+
+	// Deletions are still accepted so that the repository can be cleaned up.
+	deletion := fmt.Sprintf("%s %s refs/heads/main\n", commitID, gittest.DefaultObjectHash.ZeroOID)
+	require.NoError(t, hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionPrepared, []string{payload}, strings.NewReader(deletion)))
+
+	// Replication must mirror the source repository and thus skips the quota.
+	replicationPayload := git.NewHooksPayload(cfg, repoProto, nil, nil, git.ReferenceTransactionHook, featureflag.FromContext(ctx))
+	replicationPayload.SkipQuota = true
+	replicationEnv, err := replicationPayload.Env()
+	require.NoError(t, err)
+	require.NoError(t, hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionPrepared, []string{replicationEnv}, strings.NewReader(creation)))
+}
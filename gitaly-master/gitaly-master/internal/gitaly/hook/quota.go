@@ -0,0 +1,48 @@
+package hook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/quota"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// checkQuota verifies that the objects in the quarantine directory do not exceed the quotas of the
+// repository and its storage. If no quarantine directory is given, it only verifies that the
+// repository is not read-only. Changes which only delete references are always accepted so that
+// repositories exceeding their quota can still be cleaned up.
+func (m *GitLabHookManager) checkQuota(ctx context.Context, repo *gitalypb.Repository, quarantineDir string, changes []byte) error {
+	if isDeletionsOnly(changes) {
+		return nil
+	}
+
+	if err := m.quotaManager.Check(ctx, repo, quarantineDir); err != nil {
+		var exceededErr quota.ExceededError
+		if errors.As(err, &exceededErr) {
+			return exceededErr.StructuredError()
+		}
+
+		return structerr.NewInternal("checking quota: %w", err)
+	}
+
+	return nil
+}
+
+// isDeletionsOnly determines whether the given changes only consist of deletions.
+func isDeletionsOnly(changes []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(changes))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || strings.Trim(fields[1], "0") != "" {
+			return false
+		}
+	}
+
+	return true
+}
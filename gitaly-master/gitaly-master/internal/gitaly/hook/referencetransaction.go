@@ -5,10 +5,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
 	"io"
 
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/transaction/voting"
 )
 
@@ -16,6 +18,11 @@ import (
 // reference without checking its current value.
 var forceDeletionPrefix = fmt.Sprintf("%[1]s %[1]s ", git.ObjectHashSHA1.ZeroOID.String())
 
+// maxReferenceUpdatesSize is the maximum size of reference updates sent along with committed votes.
+// Praefect discards updates exceeding the same limit, so we omit them right away instead of sending
+// huge vote requests for huge pushes.
+const maxReferenceUpdatesSize = 64 * 1024
+
 //nolint:revive // This is unintentionally missing documentation.
 func (m *GitLabHookManager) ReferenceTransactionHook(ctx context.Context, state ReferenceTransactionState, env []string, stdin io.Reader) error {
 	payload, err := git.HooksPayloadFromEnv(env)
@@ -28,22 +35,6 @@ func (m *GitLabHookManager) ReferenceTransactionHook(ctx context.Context, state
 		return fmt.Errorf("reading stdin from request: %w", err)
 	}
 
-	var phase voting.Phase
-	switch state {
-	// We're voting in prepared state as this is the only stage in Git's reference transaction
-	// which allows us to abort the transaction.
-	case ReferenceTransactionPrepared:
-		phase = voting.Prepared
-	// We're also voting in committed state to tell Praefect we've actually persisted the
-	// changes. This is necessary as some RPCs fail return errors in the response body rather
-	// than as an error code. Praefect can't tell if these RPCs have failed. Voting on committed
-	// ensure Praefect sees either a missing vote or that the RPC did commit the changes.
-	case ReferenceTransactionCommitted:
-		phase = voting.Committed
-	default:
-		return nil
-	}
-
 	// When deleting references, git has to delete them both in the packed-refs backend as well
 	// as any loose refs -- if only the loose ref was deleted, it would potentially unshadow the
 	// value contained in the packed-refs file and vice versa. As a result, git will create two
@@ -62,13 +53,76 @@ func (m *GitLabHookManager) ReferenceTransactionHook(ctx context.Context, state
 	// The workaround is thus clear: we simply do not cast a vote on any reference transaction
 	// which consists only of force-deletions -- the vote will instead only happen on the loose
 	// backend transaction, which contains the full record of all refs which are to be updated.
+	// For the same reason, these transactions don't pass the reference barrier either: they are
+	// nested into the loose backend transaction, which would otherwise deadlock with a
+	// concurrent freeze waiting for the packed-refs transaction to finish.
 	if isForceDeletionsOnly(bytes.NewReader(changes)) {
 		return nil
 	}
 
 	hash := sha1.Sum(changes)
+	transactionID := hex.EncodeToString(hash[:])
+
+	var phase voting.Phase
+	switch state {
+	// We're voting in prepared state as this is the only stage in Git's reference transaction
+	// which allows us to abort the transaction.
+	case ReferenceTransactionPrepared:
+		phase = voting.Prepared
+	// We're also voting in committed state to tell Praefect we've actually persisted the
+	// changes. This is necessary as some RPCs fail return errors in the response body rather
+	// than as an error code. Praefect can't tell if these RPCs have failed. Voting on committed
+	// ensure Praefect sees either a missing vote or that the RPC did commit the changes.
+	case ReferenceTransactionCommitted:
+		phase = voting.Committed
+	case ReferenceTransactionAborted:
+		m.refBarrier.Leave(payload.Repo, transactionID)
+		return nil
+	default:
+		return nil
+	}
+
+	// References of the repository may be frozen while a consistent snapshot of them is being
+	// captured. Given that the transaction can still be aborted in the prepared state, we block
+	// it until the repository is thawed so that the snapshot doesn't observe its changes. The
+	// transaction stays in flight until it has been committed or aborted so that freezes wait
+	// for it to finish.
+	if phase == voting.Prepared {
+		if err := m.refBarrier.Enter(ctx, payload.Repo, transactionID); err != nil {
+			return fmt.Errorf("waiting for reference barrier: %w", err)
+		}
+
+		// Not all writes go through the pre-receive hook, but all of them update references.
+		// We thus reject any updates except for deletions in repositories which exceed their
+		// quota so that they cannot grow any further. Incoming objects have already been
+		// checked by the pre-receive hook, if at all, so we only check for read-only
+		// repositories here. Replication must mirror the source repository though, so it
+		// is exempt from quotas.
+		if !payload.SkipQuota {
+			if err := m.checkQuota(ctx, payload.Repo, "", changes); err != nil {
+				return err
+			}
+		}
+	} else {
+		m.refBarrier.Leave(payload.Repo, transactionID)
+	}
+
+	// Committed changes are sent along with the vote so that Praefect can replicate them
+	// incrementally to replicas which have not been part of the transaction. Replicas fall back
+	// to full replication if the changes are too big to be sent.
+	var voteOpts []transaction.VoteOption
+	if phase == voting.Committed && len(changes) <= maxReferenceUpdatesSize {
+		voteOpts = append(voteOpts, transaction.WithReferenceUpdates(changes))
+	}
+
+	// References have already been updated once the transaction has been committed, so the
+	// updates are recorded before voting such that they end up in the audit log even if the
+	// vote fails.
+	if phase == voting.Committed {
+		m.recordReferenceTransaction(ctx, payload, changes)
+	}
 
-	if err := m.voteOnTransaction(ctx, hash, phase, payload); err != nil {
+	if err := m.voteOnTransaction(ctx, hash, phase, payload, voteOpts...); err != nil {
 		return fmt.Errorf("error voting on transaction: %w", err)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/transaction/txinfo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/transaction/voting"
 )
@@ -37,9 +38,10 @@ func (m *GitLabHookManager) voteOnTransaction(
 	vote voting.Vote,
 	phase voting.Phase,
 	payload git.HooksPayload,
+	opts ...transaction.VoteOption,
 ) error {
 	return m.runWithTransaction(ctx, payload, func(ctx context.Context, tx txinfo.Transaction) error {
-		return m.txManager.Vote(ctx, tx, vote, phase)
+		return m.txManager.Vote(ctx, tx, vote, phase, opts...)
 	})
 }
 
@@ -12,6 +12,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/refbarrier"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitlab"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
@@ -19,6 +20,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/transaction/txinfo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/transaction/voting"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 )
 
 func TestHookManager_stopCalled(t *testing.T) {
@@ -36,7 +38,7 @@ func TestHookManager_stopCalled(t *testing.T) {
 	var mockTxMgr transaction.MockManager
 	hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), &mockTxMgr, gitlab.NewMockClient(
 		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
-	))
+	), nil, nil)
 
 	hooksPayload, err := git.NewHooksPayload(
 		cfg,
@@ -139,7 +141,7 @@ func TestHookManager_contextCancellationCancelsVote(t *testing.T) {
 
 	hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), &mockTxMgr, gitlab.NewMockClient(
 		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
-	))
+	), nil, nil)
 
 	hooksPayload, err := git.NewHooksPayload(
 		cfg,
@@ -161,6 +163,168 @@ func TestHookManager_contextCancellationCancelsVote(t *testing.T) {
 	require.Equal(t, "error voting on transaction: mock error: context canceled", err.Error())
 }
 
+func TestHookManager_refBarrierBlocksPreparedVote(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	votes := make(chan voting.Phase, 2)
+	mockTxMgr := transaction.MockManager{
+		VoteFn: func(_ context.Context, _ txinfo.Transaction, _ voting.Vote, phase voting.Phase) error {
+			votes <- phase
+			return nil
+		},
+	}
+
+	barrier := refbarrier.New()
+	hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), &mockTxMgr, gitlab.NewMockClient(
+		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
+	), nil, barrier)
+
+	hooksPayload, err := git.NewHooksPayload(
+		cfg,
+		repo,
+		&txinfo.Transaction{
+			ID: 1234, Node: "primary", Primary: true,
+		},
+		nil,
+		git.ReferenceTransactionHook,
+		nil,
+	).Env()
+	require.NoError(t, err)
+
+	changes := fmt.Sprintf("%s %s refs/heads/main\n", git.ObjectHashSHA1.ZeroOID, strings.Repeat("1", 40))
+
+	thaw, err := barrier.Freeze(ctx, repo)
+	require.NoError(t, err)
+
+	// Committed transactions have already passed the barrier and are thus not blocked.
+	require.NoError(t, hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionCommitted, []string{hooksPayload}, strings.NewReader(changes)))
+	require.Equal(t, voting.Committed, <-votes)
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = hookManager.ReferenceTransactionHook(cancelledCtx, ReferenceTransactionPrepared, []string{hooksPayload}, strings.NewReader(changes))
+	require.Equal(t, "waiting for reference barrier: context canceled", err.Error())
+	require.Empty(t, votes)
+
+	thaw()
+
+	require.NoError(t, hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionPrepared, []string{hooksPayload}, strings.NewReader(changes)))
+	require.Equal(t, voting.Prepared, <-votes)
+
+	// The transaction is in flight until it has been committed, so freezes wait for it.
+	cancelledCtx, cancel = context.WithCancel(ctx)
+	cancel()
+	_, err = barrier.Freeze(cancelledCtx, repo)
+	require.Equal(t, context.Canceled, err)
+
+	require.NoError(t, hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionCommitted, []string{hooksPayload}, strings.NewReader(changes)))
+	require.Equal(t, voting.Committed, <-votes)
+
+	thaw, err = barrier.Freeze(ctx, repo)
+	require.NoError(t, err)
+	thaw()
+
+	// Aborted transactions are not in flight anymore either.
+	require.NoError(t, hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionPrepared, []string{hooksPayload}, strings.NewReader(changes)))
+	require.Equal(t, voting.Prepared, <-votes)
+	require.NoError(t, hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionAborted, []string{hooksPayload}, strings.NewReader(changes)))
+
+	thaw, err = barrier.Freeze(ctx, repo)
+	require.NoError(t, err)
+	thaw()
+	require.Empty(t, votes)
+}
+
+// requestRecordingManager is a transaction manager which records the requests that would be sent
+// to the transaction server.
+type requestRecordingManager struct {
+	transaction.MockManager
+	requests []*gitalypb.VoteTransactionRequest
+}
+
+func (m *requestRecordingManager) Vote(_ context.Context, _ txinfo.Transaction, vote voting.Vote, phase voting.Phase, opts ...transaction.VoteOption) error {
+	request := &gitalypb.VoteTransactionRequest{
+		ReferenceUpdatesHash: vote.Bytes(),
+		Phase:                phase.ToProto(),
+	}
+	for _, opt := range opts {
+		opt(request)
+	}
+
+	m.requests = append(m.requests, request)
+
+	return nil
+}
+
+func TestHookManager_committedVoteCarriesReferenceUpdates(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	txManager := &requestRecordingManager{}
+	hookManager := NewManager(cfg, config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), txManager, gitlab.NewMockClient(
+		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
+	), nil, nil)
+
+	hooksPayload, err := git.NewHooksPayload(
+		cfg,
+		repo,
+		&txinfo.Transaction{
+			ID: 1234, Node: "primary", Primary: true,
+		},
+		nil,
+		git.ReferenceTransactionHook,
+		nil,
+	).Env()
+	require.NoError(t, err)
+
+	changes := fmt.Sprintf("%s %s refs/heads/main\n", git.ObjectHashSHA1.ZeroOID, strings.Repeat("1", 40))
+	vote := voting.VoteFromData([]byte(changes))
+
+	for _, state := range []ReferenceTransactionState{ReferenceTransactionPrepared, ReferenceTransactionCommitted} {
+		require.NoError(t, hookManager.ReferenceTransactionHook(ctx, state, []string{hooksPayload}, strings.NewReader(changes)))
+	}
+
+	// Changes exceeding the maximum size are not sent along with the committed vote.
+	var hugeChanges strings.Builder
+	for i := 0; hugeChanges.Len() <= maxReferenceUpdatesSize; i++ {
+		fmt.Fprintf(&hugeChanges, "%s %s refs/heads/branch-%d\n", git.ObjectHashSHA1.ZeroOID, strings.Repeat("1", 40), i)
+	}
+	hugeVote := voting.VoteFromData([]byte(hugeChanges.String()))
+
+	require.NoError(t, hookManager.ReferenceTransactionHook(ctx, ReferenceTransactionCommitted, []string{hooksPayload}, strings.NewReader(hugeChanges.String())))
+
+	// Only the committed vote carries the reference updates given that the prepared changes
+	// may still be aborted.
+	testhelper.ProtoEqual(t, []*gitalypb.VoteTransactionRequest{
+		{
+			ReferenceUpdatesHash: vote.Bytes(),
+			Phase:                gitalypb.VoteTransactionRequest_PREPARED_PHASE,
+		},
+		{
+			ReferenceUpdatesHash: vote.Bytes(),
+			Phase:                gitalypb.VoteTransactionRequest_COMMITTED_PHASE,
+			ReferenceUpdates:     []byte(changes),
+		},
+		{
+			ReferenceUpdatesHash: hugeVote.Bytes(),
+			Phase:                gitalypb.VoteTransactionRequest_COMMITTED_PHASE,
+		},
+	}, txManager.requests)
+}
+
 func TestIsForceDeletionsOnly(t *testing.T) {
 	anyOID := strings.Repeat("1", 40)
 	zeroOID := git.ObjectHashSHA1.ZeroOID.String()
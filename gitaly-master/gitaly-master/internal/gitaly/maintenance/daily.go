@@ -2,6 +2,7 @@ package maintenance
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -38,14 +39,72 @@ func (dw DailyWorker) nextTime(hour, minute int) time.Time {
 	return next
 }
 
+// DailySchedule holds the schedule of a daily job. The schedule can be updated while the job is
+// running, in which case the job is rescheduled according to the new schedule.
+type DailySchedule struct {
+	m       sync.Mutex
+	job     config.DailyJob
+	updated chan struct{}
+}
+
+// NewDailySchedule returns a new schedule for the given daily job.
+func NewDailySchedule(job config.DailyJob) *DailySchedule {
+	return &DailySchedule{
+		job:     job,
+		updated: make(chan struct{}),
+	}
+}
+
+// Update replaces the schedule. Workers waiting for the next run are rescheduled, while a job
+// which is currently running is allowed to finish with its previous schedule.
+func (s *DailySchedule) Update(job config.DailyJob) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.job = job
+	close(s.updated)
+	s.updated = make(chan struct{})
+}
+
+// get returns the current schedule as well as a channel that is closed when the schedule is
+// updated the next time.
+func (s *DailySchedule) get() (config.DailyJob, <-chan struct{}) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.job, s.updated
+}
+
+func isDailyJobDisabled(schedule config.DailyJob) bool {
+	return schedule.Duration == 0 || len(schedule.Storages) == 0 || schedule.Disabled
+}
+
 // StartDaily will run the provided job every day at the specified time for the
 // specified duration. Only the specified storages wil be worked on.
 func (dw DailyWorker) StartDaily(ctx context.Context, l logrus.FieldLogger, schedule config.DailyJob, job StoragesJob) error {
-	if schedule.Duration == 0 || len(schedule.Storages) == 0 || schedule.Disabled {
+	if isDailyJobDisabled(schedule) {
 		return nil
 	}
 
+	return dw.StartDailySchedule(ctx, l, NewDailySchedule(schedule), job)
+}
+
+// StartDailySchedule is like StartDaily, except that the schedule may be updated while the worker
+// is running. While the schedule is disabled the worker waits for it to be updated.
+func (dw DailyWorker) StartDailySchedule(ctx context.Context, l logrus.FieldLogger, dailySchedule *DailySchedule, job StoragesJob) error {
 	for {
+		schedule, updated := dailySchedule.get()
+
+		if isDailyJobDisabled(schedule) {
+			l.Info("maintenance: daily disabled")
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-updated:
+				continue
+			}
+		}
+
 		nt := dw.nextTime(int(schedule.Hour), int(schedule.Minute))
 		l.WithField("scheduled", nt).Info("maintenance: daily scheduled")
 
@@ -54,6 +113,9 @@ func (dw DailyWorker) StartDaily(ctx context.Context, l logrus.FieldLogger, sche
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case <-updated:
+			l.Info("maintenance: daily rescheduled")
+			continue
 		case start = <-dw.timer(nt.Sub(dw.clock())):
 			l.WithField("max_duration", schedule.Duration).
 				Info("maintenance: daily starting")
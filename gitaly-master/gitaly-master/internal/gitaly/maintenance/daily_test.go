@@ -88,3 +88,57 @@ func TestStartDaily(t *testing.T) {
 	<-durationQ         // mock artifact; this value doesn't matter
 	require.Equal(t, context.Canceled, <-errQ)
 }
+
+func TestStartDailySchedule_update(t *testing.T) {
+	dw := NewDailyWorker()
+
+	startTime := time.Date(1999, 3, 31, 0, 0, 0, 0, time.Local)
+	dw.clock = func() time.Time {
+		return startTime
+	}
+
+	timerQ := make(chan time.Time)
+	durationQ := make(chan time.Duration)
+	dw.timer = func(d time.Duration) <-chan time.Time {
+		durationQ <- d
+		return timerQ
+	}
+
+	storagesQ := make(chan []string)
+	fn := func(_ context.Context, _ logrus.FieldLogger, s []string) error {
+		storagesQ <- s
+		return nil
+	}
+
+	schedule := NewDailySchedule(config.DailyJob{Disabled: true})
+
+	errQ := make(chan error)
+	ctx, cancel := context.WithCancel(testhelper.Context(t))
+	go func() { errQ <- dw.StartDailySchedule(ctx, testhelper.NewDiscardingLogEntry(t), schedule, fn) }()
+
+	// Enabling the schedule starts the worker.
+	schedule.Update(config.DailyJob{
+		Hour:     1,
+		Duration: duration.Duration(time.Hour),
+		Storages: []string{"meow"},
+	})
+	require.Equal(t, time.Hour, <-durationQ)
+
+	// Updating the schedule while waiting for the next run reschedules the job.
+	schedule.Update(config.DailyJob{
+		Hour:     2,
+		Duration: duration.Duration(time.Hour),
+		Storages: []string{"woof"},
+	})
+	require.Equal(t, 2*time.Hour, <-durationQ)
+
+	timerQ <- startTime.Add(2 * time.Hour)
+	require.Equal(t, []string{"woof"}, <-storagesQ)
+	<-durationQ
+
+	// Disabling the schedule stops the worker from scheduling further runs.
+	schedule.Update(config.DailyJob{Disabled: true})
+
+	cancel()
+	require.Equal(t, context.Canceled, <-errQ)
+}
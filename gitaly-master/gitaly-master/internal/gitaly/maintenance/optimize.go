@@ -80,13 +80,14 @@ func (o OptimizerFunc) OptimizeRepository(ctx context.Context, repo repository.G
 	return o(ctx, repo)
 }
 
-// DailyOptimizationWorker creates a worker that runs repository maintenance daily
-func DailyOptimizationWorker(cfg config.Cfg, optimizer Optimizer) WorkerFunc {
+// DailyOptimizationWorker creates a worker that runs repository maintenance daily according to the
+// given schedule.
+func DailyOptimizationWorker(cfg config.Cfg, schedule *DailySchedule, optimizer Optimizer) WorkerFunc {
 	return func(ctx context.Context, l logrus.FieldLogger) error {
-		return NewDailyWorker().StartDaily(
+		return NewDailyWorker().StartDailySchedule(
 			ctx,
 			l,
-			cfg.DailyMaintenance,
+			schedule,
 			OptimizeReposRandomly(
 				cfg.Storages,
 				optimizer,
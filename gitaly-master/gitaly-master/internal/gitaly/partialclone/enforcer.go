@@ -0,0 +1,219 @@
+package partialclone
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// ErrFilterRequired is returned when a client tries to clone a repository without requesting an
+// object filter even though the repository's policy requires one.
+var ErrFilterRequired = errors.New("repository is too large to be cloned without an object filter, use a partial clone via e.g. --filter=blob:none")
+
+// Enforcer enforces the partial clone policies for git-upload-pack(1) and collects metrics on the
+// object filters requested by clients.
+type Enforcer struct {
+	policies      []config.PartialClonePolicy
+	locator       storage.Locator
+	filtersTotal  *prometheus.CounterVec
+	rejectedTotal prometheus.Counter
+}
+
+// NewEnforcer creates a new Enforcer for the given configuration.
+func NewEnforcer(cfg config.PartialClone, locator storage.Locator) *Enforcer {
+	return &Enforcer{
+		policies: cfg.Policies,
+		locator:  locator,
+		filtersTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "gitaly_partial_clone_filters_total",
+				Help: "Number of packfile negotiations by the type of object filter requested by the client",
+			},
+			[]string{"filter"},
+		),
+		rejectedTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Name: "gitaly_partial_clone_rejected_total",
+				Help: "Number of clones rejected because the repository requires an object filter",
+			},
+		),
+	}
+}
+
+// Describe is used to describe Prometheus metrics.
+func (e *Enforcer) Describe(descs chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(e, descs)
+}
+
+// Collect is used to collect Prometheus metrics.
+func (e *Enforcer) Collect(metrics chan<- prometheus.Metric) {
+	e.filtersTotal.Collect(metrics)
+	e.rejectedTotal.Collect(metrics)
+}
+
+// policy returns the first policy which applies to the given repository, or nil if there is none.
+func (e *Enforcer) policy(repo *gitalypb.Repository) *config.PartialClonePolicy {
+	repoPath := repo.GetGlProjectPath()
+	if repoPath == "" {
+		repoPath = repo.GetRelativePath()
+	}
+
+	for i, policy := range e.policies {
+		if matches(policy.Storages, func(storageName string) bool {
+			return storageName == repo.GetStorageName()
+		}) && matches(policy.Repositories, func(pattern string) bool {
+			matched, _ := path.Match(pattern, repoPath)
+			return matched
+		}) {
+			return &e.policies[i]
+		}
+	}
+
+	return nil
+}
+
+// matches determines whether any of the values matches. An empty list of values always matches.
+func matches(values []string, match func(string) bool) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	for _, value := range values {
+		if match(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UploadPackGitConfig returns the Git configuration that needs to be injected into
+// git-upload-pack(1) so that it only accepts the filters allowed by the repository's policy. The
+// configuration requested by the client is passed in as requested. If a policy applies, any
+// `uploadpackfilter.*` options requested by the client are dropped and the policy's configuration
+// is appended so that clients cannot override it. The requested configuration is returned as-is if
+// no policy applies or if the Enforcer is nil.
+func (e *Enforcer) UploadPackGitConfig(repo *gitalypb.Repository, requested []git.ConfigPair) []git.ConfigPair {
+	if e == nil {
+		return requested
+	}
+
+	policy := e.policy(repo)
+	if policy == nil {
+		return requested
+	}
+
+	configPairs := make([]git.ConfigPair, 0, len(requested))
+	for _, configPair := range requested {
+		// Section names are case-insensitive in Git.
+		if strings.HasPrefix(strings.ToLower(configPair.Key), "uploadpackfilter.") {
+			continue
+		}
+
+		configPairs = append(configPairs, configPair)
+	}
+
+	if len(policy.AllowedFilters) > 0 {
+		configPairs = append(configPairs, git.ConfigPair{Key: "uploadpackfilter.allow", Value: "false"})
+		for _, filter := range policy.AllowedFilters {
+			configPairs = append(configPairs, git.ConfigPair{Key: "uploadpackfilter." + filter + ".allow", Value: "true"})
+		}
+	}
+
+	if policy.MaxTreeDepth > 0 {
+		configPairs = append(configPairs, git.ConfigPair{
+			Key:   "uploadpackfilter.tree.maxDepth",
+			Value: strconv.FormatUint(uint64(policy.MaxTreeDepth), 10),
+		})
+	}
+
+	return configPairs
+}
+
+// GuardNegotiation wraps the standard input of git-upload-pack(1) so that the packfile negotiation
+// sent by the client is inspected before it reaches Git. If the repository's policy requires an
+// object filter, clones without a filter are rejected before Git starts to compute the packfile.
+// The returned Guard can be used to check whether the negotiation has been rejected. The input is
+// returned as-is if the Enforcer is nil.
+func (e *Enforcer) GuardNegotiation(repo *gitalypb.Repository, stdin io.Reader) (io.Reader, *Guard) {
+	if e == nil {
+		return stdin, nil
+	}
+
+	guard := &Guard{
+		reader:   stdin,
+		enforcer: e,
+		repo:     repo,
+	}
+	if policy := e.policy(repo); policy != nil {
+		guard.requireFilterAboveBytes = policy.RequireFilterAboveBytes
+	}
+
+	return guard, guard
+}
+
+// packfilesSize computes the total size of all packfiles of the repository.
+func (e *Enforcer) packfilesSize(repo *gitalypb.Repository) (uint64, error) {
+	repoPath, err := e.locator.GetRepoPath(repo)
+	if err != nil {
+		return 0, fmt.Errorf("getting repository path: %w", err)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(repoPath, "objects", "pack"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("reading packfiles: %w", err)
+	}
+
+	var size uint64
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), ".pack") {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return 0, fmt.Errorf("getting packfile size: %w", err)
+		}
+
+		size += uint64(info.Size())
+	}
+
+	return size, nil
+}
+
+// filterType derives the type of the given filter-spec, e.g. "blob:limit" for "blob:limit=1m".
+func filterType(filter string) string {
+	switch {
+	case filter == "":
+		return "none"
+	case filter == "blob:none":
+		return "blob:none"
+	case strings.HasPrefix(filter, "blob:limit="):
+		return "blob:limit"
+	case strings.HasPrefix(filter, "tree:"):
+		return "tree"
+	case strings.HasPrefix(filter, "sparse:oid="):
+		return "sparse:oid"
+	case strings.HasPrefix(filter, "combine:"):
+		return "combine"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,287 @@
+package partialclone
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	promtest "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func TestEnforcer_UploadPackGitConfig(t *testing.T) {
+	t.Parallel()
+
+	enforcer := NewEnforcer(config.PartialClone{
+		Policies: []config.PartialClonePolicy{
+			{
+				Storages:       []string{"monorepos"},
+				AllowedFilters: []string{"blob:none", "tree"},
+				MaxTreeDepth:   2,
+			},
+			{
+				Repositories: []string{"group/*"},
+				MaxTreeDepth: 5,
+			},
+		},
+	}, nil)
+
+	for _, tc := range []struct {
+		desc           string
+		repo           *gitalypb.Repository
+		requested      []git.ConfigPair
+		expectedConfig []git.ConfigPair
+	}{
+		{
+			desc: "matching storage",
+			repo: &gitalypb.Repository{StorageName: "monorepos", RelativePath: "repo.git"},
+			expectedConfig: []git.ConfigPair{
+				{Key: "uploadpackfilter.allow", Value: "false"},
+				{Key: "uploadpackfilter.blob:none.allow", Value: "true"},
+				{Key: "uploadpackfilter.tree.allow", Value: "true"},
+				{Key: "uploadpackfilter.tree.maxDepth", Value: "2"},
+			},
+		},
+		{
+			desc: "matching project path",
+			repo: &gitalypb.Repository{StorageName: "default", RelativePath: "@hashed/aa/bb.git", GlProjectPath: "group/project"},
+			expectedConfig: []git.ConfigPair{
+				{Key: "uploadpackfilter.tree.maxDepth", Value: "5"},
+			},
+		},
+		{
+			desc: "matching relative path",
+			repo: &gitalypb.Repository{StorageName: "default", RelativePath: "group/project.git"},
+			expectedConfig: []git.ConfigPair{
+				{Key: "uploadpackfilter.tree.maxDepth", Value: "5"},
+			},
+		},
+		{
+			desc: "requested configuration cannot override policy",
+			repo: &gitalypb.Repository{StorageName: "monorepos", RelativePath: "repo.git"},
+			requested: []git.ConfigPair{
+				{Key: "uploadpack.allowFilter", Value: "true"},
+				{Key: "uploadpackfilter.allow", Value: "true"},
+				{Key: "UploadPackFilter.blob:limit.allow", Value: "true"},
+				{Key: "uploadpackfilter.tree.maxDepth", Value: "100"},
+			},
+			expectedConfig: []git.ConfigPair{
+				{Key: "uploadpack.allowFilter", Value: "true"},
+				{Key: "uploadpackfilter.allow", Value: "false"},
+				{Key: "uploadpackfilter.blob:none.allow", Value: "true"},
+				{Key: "uploadpackfilter.tree.allow", Value: "true"},
+				{Key: "uploadpackfilter.tree.maxDepth", Value: "2"},
+			},
+		},
+		{
+			desc: "no matching policy",
+			repo: &gitalypb.Repository{StorageName: "default", RelativePath: "@hashed/aa/bb.git", GlProjectPath: "other/project"},
+		},
+		{
+			desc: "no matching policy keeps requested configuration",
+			repo: &gitalypb.Repository{StorageName: "default", RelativePath: "@hashed/aa/bb.git", GlProjectPath: "other/project"},
+			requested: []git.ConfigPair{
+				{Key: "uploadpackfilter.allow", Value: "true"},
+			},
+			expectedConfig: []git.ConfigPair{
+				{Key: "uploadpackfilter.allow", Value: "true"},
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expectedConfig, enforcer.UploadPackGitConfig(tc.repo, tc.requested))
+		})
+	}
+
+	t.Run("nil enforcer", func(t *testing.T) {
+		var enforcer *Enforcer
+		require.Nil(t, enforcer.UploadPackGitConfig(&gitalypb.Repository{}, nil))
+
+		requested := []git.ConfigPair{{Key: "uploadpackfilter.allow", Value: "true"}}
+		require.Equal(t, requested, enforcer.UploadPackGitConfig(&gitalypb.Repository{}, requested))
+
+		stdin := strings.NewReader("data")
+		reader, guard := enforcer.GuardNegotiation(&gitalypb.Repository{}, stdin)
+		require.Equal(t, stdin, reader)
+		require.NoError(t, guard.Err())
+	})
+}
+
+func TestFilterType(t *testing.T) {
+	t.Parallel()
+
+	for filter, expectedType := range map[string]string{
+		"":                         "none",
+		"blob:none":                "blob:none",
+		"blob:limit=1m":            "blob:limit",
+		"tree:0":                   "tree",
+		"sparse:oid=main:.sparse":  "sparse:oid",
+		"combine:blob:none+tree:0": "combine",
+		"object:type=blob":         "unknown",
+	} {
+		require.Equal(t, expectedType, filterType(filter), filter)
+	}
+}
+
+func TestGuard(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	commit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+	gittest.Exec(t, cfg, "-C", repoPath, "repack", "-ad")
+
+	pktlines := func(lines ...string) []byte {
+		var buf bytes.Buffer
+		for _, line := range lines {
+			switch line {
+			case "flush":
+				gittest.WritePktlineFlush(t, &buf)
+			case "delim":
+				gittest.WritePktlineDelim(t, &buf)
+			default:
+				gittest.WritePktlineString(t, &buf, line+"\n")
+			}
+		}
+		return buf.Bytes()
+	}
+
+	cloneV0 := pktlines("want "+commit.String()+" multi_ack_detailed side-band-64k", "flush", "done")
+	filteredCloneV0 := pktlines("want "+commit.String()+" multi_ack_detailed side-band-64k", "filter blob:none", "flush", "done")
+	fetchV0 := pktlines("want "+commit.String()+" multi_ack_detailed side-band-64k", "flush", "have "+commit.String(), "done")
+	cloneV2 := pktlines("command=ls-refs", "delim", "peel", "flush", "command=fetch", "delim", "want "+commit.String(), "done", "flush")
+	filteredCloneV2 := pktlines("command=fetch", "delim", "want "+commit.String(), "filter tree:0", "done", "flush")
+
+	for _, tc := range []struct {
+		desc                    string
+		requireFilterAboveBytes uint64
+		input                   []byte
+		expectedErr             error
+		expectedFilter          string
+	}{
+		{
+			desc:           "no policy",
+			input:          cloneV0,
+			expectedFilter: "none",
+		},
+		{
+			desc:                    "unfiltered clone of small repository",
+			requireFilterAboveBytes: 1 << 30,
+			input:                   cloneV0,
+			expectedFilter:          "none",
+		},
+		{
+			desc:                    "unfiltered clone of large repository",
+			requireFilterAboveBytes: 1,
+			input:                   cloneV0,
+			expectedErr:             ErrFilterRequired,
+			expectedFilter:          "none",
+		},
+		{
+			desc:                    "filtered clone of large repository",
+			requireFilterAboveBytes: 1,
+			input:                   filteredCloneV0,
+			expectedFilter:          "blob:none",
+		},
+		{
+			desc:                    "fetch of large repository",
+			requireFilterAboveBytes: 1,
+			input:                   fetchV0,
+			expectedFilter:          "none",
+		},
+		{
+			desc:                    "unfiltered protocol v2 clone of large repository",
+			requireFilterAboveBytes: 1,
+			input:                   cloneV2,
+			expectedErr:             ErrFilterRequired,
+			expectedFilter:          "none",
+		},
+		{
+			desc:                    "filtered protocol v2 clone of large repository",
+			requireFilterAboveBytes: 1,
+			input:                   filteredCloneV2,
+			expectedFilter:          "tree",
+		},
+		{
+			desc:                    "non-packet data",
+			requireFilterAboveBytes: 1,
+			input:                   []byte("garbage"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var policies []config.PartialClonePolicy
+			if tc.requireFilterAboveBytes > 0 {
+				policies = append(policies, config.PartialClonePolicy{
+					RequireFilterAboveBytes: tc.requireFilterAboveBytes,
+				})
+			}
+			enforcer := NewEnforcer(config.PartialClone{Policies: policies}, config.NewLocator(cfg))
+
+			// Reading a single byte at a time verifies that packets split across reads
+			// are handled correctly.
+			reader, guard := enforcer.GuardNegotiation(repo, iotest.OneByteReader(bytes.NewReader(tc.input)))
+			data, err := io.ReadAll(reader)
+			require.Equal(t, tc.expectedErr, err)
+			require.Equal(t, tc.expectedErr, guard.Err())
+
+			if tc.expectedErr != nil {
+				// Git must never see the complete "done" packet.
+				require.True(t, bytes.HasPrefix(tc.input, data))
+				require.Less(t, len(data), bytes.LastIndex(tc.input, []byte("done\n"))+len("done\n"))
+				require.Equal(t, 1.0, promtest.ToFloat64(enforcer.rejectedTotal))
+			} else {
+				require.Equal(t, tc.input, data)
+				require.Equal(t, 0.0, promtest.ToFloat64(enforcer.rejectedTotal))
+			}
+
+			if tc.expectedFilter != "" {
+				require.Equal(t, 1.0, promtest.ToFloat64(enforcer.filtersTotal.WithLabelValues(tc.expectedFilter)))
+			} else {
+				require.Equal(t, 0, promtest.CollectAndCount(enforcer.filtersTotal))
+			}
+		})
+	}
+}
+
+func TestGuard_missingRepository(t *testing.T) {
+	t.Parallel()
+
+	cfg := testcfg.Build(t)
+	enforcer := NewEnforcer(config.PartialClone{
+		Policies: []config.PartialClonePolicy{{RequireFilterAboveBytes: 1}},
+	}, config.NewLocator(cfg))
+
+	var input bytes.Buffer
+	gittest.WritePktlineString(t, &input, fmt.Sprintf("want %s\n", gittest.DefaultObjectHash.EmptyTreeOID))
+	gittest.WritePktlineFlush(t, &input)
+	gittest.WritePktlineString(t, &input, "done\n")
+
+	reader, guard := enforcer.GuardNegotiation(&gitalypb.Repository{
+		StorageName:  cfg.Storages[0].Name,
+		RelativePath: "does-not-exist.git",
+	}, &input)
+	_, err := io.ReadAll(reader)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "computing repository size")
+	require.Equal(t, err, guard.Err())
+}
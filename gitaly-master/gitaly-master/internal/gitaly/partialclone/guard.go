@@ -0,0 +1,137 @@
+package partialclone
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// Guard inspects the packfile negotiation sent by the client to git-upload-pack(1). It supports
+// both protocol v0 and v2 and tracks the wants, haves and the filter announced by the client until
+// the "done" packet is seen, which tells Git to start computing the packfile.
+type Guard struct {
+	reader                  io.Reader
+	enforcer                *Enforcer
+	repo                    *gitalypb.Repository
+	requireFilterAboveBytes uint64
+
+	buf      []byte
+	disabled bool
+	err      error
+
+	wants  int
+	haves  int
+	filter string
+}
+
+// Read reads from the wrapped reader and inspects the data. An error is returned without
+// forwarding the "done" packet in case the negotiation violates the repository's policy.
+func (g *Guard) Read(p []byte) (int, error) {
+	if g.err != nil {
+		return 0, g.err
+	}
+
+	n, err := g.reader.Read(p)
+	if n > 0 && !g.disabled {
+		if inspectErr := g.inspect(p[:n]); inspectErr != nil {
+			g.err = inspectErr
+			return 0, inspectErr
+		}
+	}
+
+	return n, err
+}
+
+// Err returns the error in case the negotiation has been rejected. It is safe to call on a nil
+// Guard.
+func (g *Guard) Err() error {
+	if g == nil {
+		return nil
+	}
+	return g.err
+}
+
+// inspect splits the data into packets and handles each complete packet. Incomplete packets are
+// buffered until the remaining data has been read.
+func (g *Guard) inspect(data []byte) error {
+	g.buf = append(g.buf, data...)
+
+	for len(g.buf) >= 4 {
+		length, err := strconv.ParseUint(string(g.buf[:4]), 16, 16)
+		if err != nil {
+			// This is not a packet stream we understand, so we stop inspecting it and
+			// let Git handle the data.
+			g.disabled = true
+			g.buf = nil
+			return nil
+		}
+
+		// Flush, delimiter and response-end packets don't carry any data.
+		if length < 4 {
+			g.buf = g.buf[4:]
+			continue
+		}
+
+		if uint64(len(g.buf)) < length {
+			break
+		}
+
+		if err := g.handlePacket(strings.TrimSuffix(string(g.buf[4:length]), "\n")); err != nil {
+			return err
+		}
+		g.buf = g.buf[length:]
+	}
+
+	return nil
+}
+
+func (g *Guard) handlePacket(data string) error {
+	switch {
+	case strings.HasPrefix(data, "command="):
+		// Protocol v2 allows multiple commands per session, each of which starts a new
+		// negotiation.
+		g.reset()
+	case strings.HasPrefix(data, "want ") || strings.HasPrefix(data, "want-ref "):
+		g.wants++
+	case strings.HasPrefix(data, "have "):
+		g.haves++
+	case strings.HasPrefix(data, "filter "):
+		g.filter = strings.TrimPrefix(data, "filter ")
+	case data == "done":
+		defer g.reset()
+		return g.finish()
+	}
+
+	return nil
+}
+
+// finish is invoked when the client has finished the negotiation. Clones which don't request a
+// filter are rejected in case the repository's policy requires one.
+func (g *Guard) finish() error {
+	g.enforcer.filtersTotal.WithLabelValues(filterType(g.filter)).Inc()
+
+	if g.requireFilterAboveBytes == 0 || g.filter != "" || g.wants == 0 || g.haves > 0 {
+		return nil
+	}
+
+	size, err := g.enforcer.packfilesSize(g.repo)
+	if err != nil {
+		return fmt.Errorf("computing repository size: %w", err)
+	}
+
+	if size > g.requireFilterAboveBytes {
+		g.enforcer.rejectedTotal.Inc()
+		return ErrFilterRequired
+	}
+
+	return nil
+}
+
+func (g *Guard) reset() {
+	g.wants = 0
+	g.haves = 0
+	g.filter = ""
+}
@@ -0,0 +1,286 @@
+package gitaly
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// ErrPartitionManagerStopped is returned when the PartitionManager has been stopped.
+var ErrPartitionManagerStopped = errors.New("partition manager stopped")
+
+// PartitionManager is responsible for managing the lifecycle of the TransactionManagers. Each repository is a
+// partition with its own write-ahead log, which is processed by a TransactionManager. The write-ahead logs of the
+// repositories are stored in a database per storage, located at `<storage>/+gitaly/database`.
+//
+// TransactionManagers are started on demand and kept running until the PartitionManager is stopped.
+type PartitionManager struct {
+	// storages holds the per-storage state keyed by the name of the storage.
+	storages map[string]*storageManager
+	// locator is used to locate the repositories.
+	locator storage.Locator
+	// gitCmdFactory is used to run Git commands in the repositories.
+	gitCmdFactory git.CommandFactory
+	// catfileCache is used to read objects from the repositories.
+	catfileCache catfile.Cache
+	// logger is used to log errors of TransactionManagers that stop unexpectedly.
+	logger logrus.FieldLogger
+	// transactionManagerOpts are the options the TransactionManagers are created with.
+	transactionManagerOpts []TransactionManagerOption
+}
+
+// storageManager holds the database and the running TransactionManagers of a single storage.
+type storageManager struct {
+	// name is the name of the storage.
+	name string
+	// db is the database holding the write-ahead logs of the storage's repositories.
+	db *badger.DB
+
+	// mutex guards the fields below.
+	mutex sync.Mutex
+	// stopped is set when the storageManager has been stopped. No new TransactionManagers are
+	// started after that.
+	stopped bool
+	// transactionManagers holds the running TransactionManagers keyed by the relative path of
+	// the repository.
+	transactionManagers map[string]*TransactionManager
+	// transactionManagersWG is used to wait for all TransactionManagers to return from Run.
+	transactionManagersWG sync.WaitGroup
+}
+
+// NewPartitionManager returns a new PartitionManager for the given storages. It opens the database of each
+// storage. Stop must be called to close the databases.
+func NewPartitionManager(
+	storages []config.Storage,
+	locator storage.Locator,
+	gitCmdFactory git.CommandFactory,
+	catfileCache catfile.Cache,
+	logger logrus.FieldLogger,
+	opts ...TransactionManagerOption,
+) (*PartitionManager, error) {
+	pm := &PartitionManager{
+		storages:               make(map[string]*storageManager, len(storages)),
+		locator:                locator,
+		gitCmdFactory:          gitCmdFactory,
+		catfileCache:           catfileCache,
+		logger:                 logger,
+		transactionManagerOpts: opts,
+	}
+
+	for _, configuredStorage := range storages {
+		databaseDir := filepath.Join(configuredStorage.Path, config.GitalyDataPrefix, "database")
+		if err := os.MkdirAll(databaseDir, perm.PrivateDir); err != nil {
+			pm.Stop()
+			return nil, fmt.Errorf("create database directory: %w", err)
+		}
+
+		db, err := OpenDatabase(databaseDir)
+		if err != nil {
+			pm.Stop()
+			return nil, fmt.Errorf("open database for storage %q: %w", configuredStorage.Name, err)
+		}
+
+		pm.storages[configuredStorage.Name] = &storageManager{
+			name:                configuredStorage.Name,
+			db:                  db,
+			transactionManagers: make(map[string]*TransactionManager),
+		}
+	}
+
+	return pm, nil
+}
+
+// Recover starts a TransactionManager for every repository that has log entries in its write-ahead log. Recover
+// returns once the log entries that had not yet been applied have been applied to the repositories. Repositories
+// whose log fails to be recovered are logged and skipped so that they don't prevent serving the others.
+func (pm *PartitionManager) Recover(ctx context.Context) error {
+	for _, storageMgr := range pm.storages {
+		relativePaths, err := storageMgr.loggedRepositories()
+		if err != nil {
+			return fmt.Errorf("list logged repositories of storage %q: %w", storageMgr.name, err)
+		}
+
+		for _, relativePath := range relativePaths {
+			mgr, err := pm.transactionManager(storageMgr, relativePath)
+			if err != nil {
+				return fmt.Errorf("start transaction manager: %w", err)
+			}
+
+			select {
+			case <-mgr.recovered:
+			case <-mgr.runDone:
+				// The failure has already been logged by the goroutine running the
+				// TransactionManager.
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	return nil
+}
+
+// Propose proposes the transaction to the TransactionManager of the repository. Refer to
+// TransactionManager.Propose for details.
+func (pm *PartitionManager) Propose(ctx context.Context, repo *gitalypb.Repository, transaction Transaction) error {
+	mgr, err := pm.repositoryTransactionManager(repo)
+	if err != nil {
+		return err
+	}
+
+	return mgr.Propose(ctx, transaction)
+}
+
+// TailLog streams the write-ahead log of the repository to the handler. Refer to TransactionManager.TailLog for
+// details.
+func (pm *PartitionManager) TailLog(ctx context.Context, repo *gitalypb.Repository, startIndex LogIndex, handler func(LogIndex, *gitalypb.LogEntry) error) error {
+	mgr, err := pm.repositoryTransactionManager(repo)
+	if err != nil {
+		return err
+	}
+
+	return mgr.TailLog(ctx, startIndex, handler)
+}
+
+// Stop stops all TransactionManagers, waits for them to return and closes the databases. Stop may be
+// called multiple times.
+func (pm *PartitionManager) Stop() {
+	for _, storageMgr := range pm.storages {
+		storageMgr.stop(pm.logger)
+	}
+}
+
+// repositoryTransactionManager returns the running TransactionManager of the repository.
+func (pm *PartitionManager) repositoryTransactionManager(repo *gitalypb.Repository) (*TransactionManager, error) {
+	storageMgr, ok := pm.storages[repo.GetStorageName()]
+	if !ok {
+		return nil, fmt.Errorf("unknown storage: %q", repo.GetStorageName())
+	}
+
+	return pm.transactionManager(storageMgr, repo.GetRelativePath())
+}
+
+// transactionManager returns the running TransactionManager of the repository. A new TransactionManager is
+// started if there is none running yet.
+func (pm *PartitionManager) transactionManager(storageMgr *storageManager, relativePath string) (*TransactionManager, error) {
+	storageMgr.mutex.Lock()
+	defer storageMgr.mutex.Unlock()
+
+	if storageMgr.stopped {
+		return nil, ErrPartitionManagerStopped
+	}
+
+	if mgr, ok := storageMgr.transactionManagers[relativePath]; ok {
+		return mgr, nil
+	}
+
+	repo := localrepo.New(pm.locator, pm.gitCmdFactory, pm.catfileCache, &gitalypb.Repository{
+		StorageName:  storageMgr.name,
+		RelativePath: relativePath,
+	})
+
+	mgr := NewTransactionManager(storageMgr.db, repo, pm.transactionManagerOpts...)
+	storageMgr.transactionManagers[relativePath] = mgr
+
+	storageMgr.transactionManagersWG.Add(1)
+	go func() {
+		defer storageMgr.transactionManagersWG.Done()
+
+		if err := mgr.Run(); err != nil {
+			pm.logger.WithError(err).WithFields(logrus.Fields{
+				"storage":       storageMgr.name,
+				"relative_path": relativePath,
+			}).Error("transaction manager failed")
+		}
+
+		// Remove the stopped TransactionManager so that the next caller starts a new one.
+		storageMgr.mutex.Lock()
+		defer storageMgr.mutex.Unlock()
+		if storageMgr.transactionManagers[relativePath] == mgr {
+			delete(storageMgr.transactionManagers, relativePath)
+		}
+	}()
+
+	return mgr, nil
+}
+
+// loggedRepositories returns the relative paths of the repositories of the storage that have entries in their
+// write-ahead log.
+func (storageMgr *storageManager) loggedRepositories() ([]string, error) {
+	var relativePaths []string
+	if err := storageMgr.db.View(func(txn *badger.Txn) error {
+		prefix := []byte("repository/" + storageMgr.name + ":")
+
+		iterator := txn.NewIterator(badger.IteratorOptions{Prefix: prefix})
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			// Log entry keys are of the form `repository/<storage>:<relative_path>/log/entry/<log_index>`.
+			// The log index is a big endian encoded uint64, which is stripped before looking
+			// for the separator as its bytes could contain anything.
+			key := iterator.Item().Key()[len(prefix):]
+			if len(key) < 8 {
+				continue
+			}
+
+			relativePath, ok := cutSuffix(key[:len(key)-8], []byte("/log/entry/"))
+			if !ok {
+				continue
+			}
+
+			if len(relativePaths) == 0 || relativePaths[len(relativePaths)-1] != string(relativePath) {
+				relativePaths = append(relativePaths, string(relativePath))
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return relativePaths, nil
+}
+
+// stop stops the TransactionManagers of the storage, waits for them to return and closes the database.
+func (storageMgr *storageManager) stop(logger logrus.FieldLogger) {
+	storageMgr.mutex.Lock()
+	if storageMgr.stopped {
+		storageMgr.mutex.Unlock()
+		return
+	}
+
+	storageMgr.stopped = true
+	for _, mgr := range storageMgr.transactionManagers {
+		mgr.Stop()
+	}
+	storageMgr.mutex.Unlock()
+
+	storageMgr.transactionManagersWG.Wait()
+
+	if err := storageMgr.db.Close(); err != nil {
+		logger.WithError(err).WithField("storage", storageMgr.name).Error("failed closing database")
+	}
+}
+
+// cutSuffix returns s without the provided suffix and reports whether it found the suffix.
+func cutSuffix(s, suffix []byte) ([]byte, bool) {
+	if !bytes.HasSuffix(s, suffix) {
+		return s, false
+	}
+
+	return s[:len(s)-len(suffix)], true
+}
@@ -0,0 +1,101 @@
+package gitaly
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPartitionManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	commitOID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents())
+
+	locator := config.NewLocator(cfg)
+	gitCmdFactory := gittest.NewCommandFactory(t, cfg)
+	catfileCache := catfile.NewCache(cfg)
+	defer catfileCache.Stop()
+
+	localRepo := localrepo.New(locator, gitCmdFactory, catfileCache, repo)
+	logger := testhelper.NewDiscardingLogEntry(t)
+
+	// Log a reference update without applying it as if Gitaly was stopped right after
+	// appending it to the write-ahead log.
+	logEntry := &gitalypb.LogEntry{
+		ReferenceUpdates: []*gitalypb.LogEntry_ReferenceUpdate{
+			{ReferenceName: []byte("refs/heads/main"), NewOid: []byte(commitOID)},
+		},
+	}
+
+	db, err := OpenDatabase(filepath.Join(cfg.Storages[0].Path, config.GitalyDataPrefix, "database"))
+	require.NoError(t, err)
+	marshaledLogEntry, err := proto.Marshal(logEntry)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(txn *badger.Txn) error {
+		return txn.Set(keyLogEntry(getRepositoryID(localRepo), 1), marshaledLogEntry)
+	}))
+	require.NoError(t, db.Close())
+
+	partitionManager, err := NewPartitionManager(cfg.Storages, locator, gitCmdFactory, catfileCache, logger, WithLogRetention(1))
+	require.NoError(t, err)
+	defer partitionManager.Stop()
+
+	t.Run("recovers logged reference updates", func(t *testing.T) {
+		require.NoError(t, partitionManager.Recover(ctx))
+
+		RequireReferences(t, ctx, localRepo, []git.Reference{
+			{Name: "refs/heads/main", Target: commitOID.String()},
+		})
+		RequireDatabase(t, ctx, partitionManager.storages[cfg.Storages[0].Name].db, DatabaseState{
+			string(keyAppliedLogIndex(getRepositoryID(localRepo))): LogIndex(1).toProto(),
+			string(keyLogEntry(getRepositoryID(localRepo), 1)):     logEntry,
+		})
+	})
+
+	t.Run("tails the log", func(t *testing.T) {
+		errStop := errors.New("stop")
+
+		var actualEntries []*gitalypb.LogEntry
+		require.Equal(t, errStop, partitionManager.TailLog(ctx, repo, 1, func(logIndex LogIndex, logEntry *gitalypb.LogEntry) error {
+			require.Equal(t, LogIndex(1), logIndex)
+			actualEntries = append(actualEntries, logEntry)
+			return errStop
+		}))
+		testhelper.ProtoEqual(t, []*gitalypb.LogEntry{logEntry}, actualEntries)
+	})
+
+	t.Run("unknown storage", func(t *testing.T) {
+		require.EqualError(t, partitionManager.TailLog(ctx, &gitalypb.Repository{
+			StorageName:  "non-existent",
+			RelativePath: repo.GetRelativePath(),
+		}, 1, func(LogIndex, *gitalypb.LogEntry) error {
+			return nil
+		}), `unknown storage: "non-existent"`)
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		partitionManager.Stop()
+
+		require.Equal(t, ErrPartitionManagerStopped, partitionManager.TailLog(ctx, repo, 1, func(LogIndex, *gitalypb.LogEntry) error {
+			return nil
+		}))
+	})
+}
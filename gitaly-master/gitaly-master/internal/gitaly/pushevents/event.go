@@ -0,0 +1,114 @@
+// Package pushevents implements publishing of structured reference-update events. Events are
+// created by the post-receive hook, spooled to disk and then asynchronously delivered to a
+// configurable sink.
+package pushevents
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// Repository identifies the repository an event has been created for.
+type Repository struct {
+	// StorageName is the name of the storage the repository is located in.
+	StorageName string `json:"storage_name"`
+	// RelativePath is the path of the repository relative to its storage.
+	RelativePath string `json:"relative_path"`
+	// GlRepository is the GitLab identifier of the repository.
+	GlRepository string `json:"gl_repository"`
+	// GlProjectPath is the GitLab path of the project the repository belongs to.
+	GlProjectPath string `json:"gl_project_path"`
+}
+
+// RefUpdate is a single reference update that has been performed by a push.
+type RefUpdate struct {
+	// Reference is the fully-qualified name of the reference that has been updated.
+	Reference string `json:"reference"`
+	// OldOID is the object ID the reference pointed to before the update.
+	OldOID string `json:"old_oid"`
+	// NewOID is the object ID the reference points to after the update.
+	NewOID string `json:"new_oid"`
+}
+
+// Event is a structured event describing the reference updates of a single push.
+type Event struct {
+	// Repository is the repository that has been pushed into.
+	Repository Repository `json:"repository"`
+	// UserID is the GL_ID of the pusher.
+	UserID string `json:"user_id"`
+	// Username is the name of the pusher.
+	Username string `json:"username"`
+	// Protocol is the protocol used for the push.
+	Protocol string `json:"protocol"`
+	// Changes are the reference updates performed by the push.
+	Changes []RefUpdate `json:"changes"`
+	// PushOptions are the push options passed by the client.
+	PushOptions []string `json:"push_options"`
+	// Timestamp is the time at which the event has been created.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NewEvent creates a new event from the information passed to the post-receive hook. changes
+// must match the format specified in githooks(5).
+func NewEvent(repo *gitalypb.Repository, userDetails *git.UserDetails, pushOptions []string, changes []byte) (Event, error) {
+	event := Event{
+		Repository: Repository{
+			StorageName:   repo.GetStorageName(),
+			RelativePath:  repo.GetRelativePath(),
+			GlRepository:  repo.GetGlRepository(),
+			GlProjectPath: repo.GetGlProjectPath(),
+		},
+		PushOptions: pushOptions,
+		Timestamp:   time.Now().UTC(),
+	}
+
+	if userDetails != nil {
+		event.UserID = userDetails.UserID
+		event.Username = userDetails.Username
+		event.Protocol = userDetails.Protocol
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(changes))
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), " ", 3)
+		if len(fields) != 3 {
+			return Event{}, fmt.Errorf("invalid change line: %q", scanner.Text())
+		}
+
+		event.Changes = append(event.Changes, RefUpdate{
+			OldOID:    fields[0],
+			NewOID:    fields[1],
+			Reference: fields[2],
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Event{}, fmt.Errorf("scanning changes: %w", err)
+	}
+
+	return event, nil
+}
+
+// MatchesRepository determines whether events should be published for the given repository.
+// The project path of the repository is matched against each of the glob patterns. All
+// repositories match if no patterns are given.
+func MatchesRepository(patterns []string, repo *gitalypb.Repository) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, repo.GetGlProjectPath()); matched {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,96 @@
+package pushevents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func TestNewEvent(t *testing.T) {
+	t.Parallel()
+
+	repo := &gitalypb.Repository{
+		StorageName:   "default",
+		RelativePath:  "repo.git",
+		GlRepository:  "project-1",
+		GlProjectPath: "gitlab-org/gitaly",
+	}
+
+	t.Run("valid changes", func(t *testing.T) {
+		event, err := NewEvent(repo, &git.UserDetails{
+			UserID:   "user-1",
+			Username: "user",
+			Protocol: "ssh",
+		}, []string{"ci.skip"}, []byte("1111 2222 refs/heads/main\n3333 4444 refs/tags/v1.0.0\n"))
+		require.NoError(t, err)
+
+		require.False(t, event.Timestamp.IsZero())
+		event.Timestamp = event.Timestamp.UTC()
+		require.Equal(t, Event{
+			Repository: Repository{
+				StorageName:   "default",
+				RelativePath:  "repo.git",
+				GlRepository:  "project-1",
+				GlProjectPath: "gitlab-org/gitaly",
+			},
+			UserID:   "user-1",
+			Username: "user",
+			Protocol: "ssh",
+			Changes: []RefUpdate{
+				{Reference: "refs/heads/main", OldOID: "1111", NewOID: "2222"},
+				{Reference: "refs/tags/v1.0.0", OldOID: "3333", NewOID: "4444"},
+			},
+			PushOptions: []string{"ci.skip"},
+			Timestamp:   event.Timestamp,
+		}, event)
+	})
+
+	t.Run("invalid changes", func(t *testing.T) {
+		_, err := NewEvent(repo, nil, nil, []byte("garbage\n"))
+		require.EqualError(t, err, `invalid change line: "garbage"`)
+	})
+}
+
+func TestMatchesRepository(t *testing.T) {
+	t.Parallel()
+
+	repo := &gitalypb.Repository{GlProjectPath: "gitlab-org/gitaly"}
+
+	for _, tc := range []struct {
+		desc          string
+		patterns      []string
+		expectedMatch bool
+	}{
+		{
+			desc:          "no patterns",
+			expectedMatch: true,
+		},
+		{
+			desc:          "exact match",
+			patterns:      []string{"gitlab-org/gitaly"},
+			expectedMatch: true,
+		},
+		{
+			desc:          "glob match",
+			patterns:      []string{"gitlab-com/*", "gitlab-org/*"},
+			expectedMatch: true,
+		},
+		{
+			desc:     "glob does not cross path separators",
+			patterns: []string{"*"},
+		},
+		{
+			desc:     "no match",
+			patterns: []string{"gitlab-com/*"},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expectedMatch, MatchesRepository(tc.patterns, repo))
+		})
+	}
+}
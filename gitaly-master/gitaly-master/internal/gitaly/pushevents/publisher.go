@@ -0,0 +1,112 @@
+package pushevents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+)
+
+// Publisher delivers spooled events to the configured sink.
+type Publisher struct {
+	spool         *Spool
+	sink          Sink
+	maxAttempts   uint
+	retryInterval time.Duration
+	timeout       time.Duration
+	// attempts tracks the number of failed delivery attempts per spooled event.
+	attempts map[string]uint
+}
+
+// NewPublisher creates a new Publisher from the given configuration.
+func NewPublisher(cfg config.HookEvents) (*Publisher, error) {
+	sink, err := NewSink(cfg.Sink)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Publisher{
+		spool:         NewSpool(cfg.SpoolDir),
+		sink:          sink,
+		maxAttempts:   cfg.MaxAttempts,
+		retryInterval: cfg.RetryInterval.Duration(),
+		timeout:       cfg.Timeout.Duration(),
+		attempts:      make(map[string]uint),
+	}, nil
+}
+
+// Run periodically delivers all pending events until the context is cancelled. Events that could
+// not be delivered are retried on the next run until the maximum number of attempts has been
+// reached, at which point they are dropped.
+func (p *Publisher) Run(ctx context.Context, logger logrus.FieldLogger) error {
+	logger = logger.WithField("component", "push_events_publisher")
+
+	ticker := time.NewTicker(p.retryInterval)
+	defer ticker.Stop()
+
+	for {
+		p.Publish(ctx, logger)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Publish delivers all currently pending events in the order they have been enqueued in. Delivery
+// stops at the first event which fails to be delivered so that later events are never delivered
+// before earlier ones.
+func (p *Publisher) Publish(ctx context.Context, logger logrus.FieldLogger) {
+	names, err := p.spool.Pending()
+	if err != nil {
+		logger.WithError(err).Error("listing pending events")
+		return
+	}
+
+	for _, name := range names {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err := p.deliver(ctx, name); err != nil {
+			p.attempts[name]++
+
+			if p.attempts[name] < p.maxAttempts {
+				logger.WithError(err).WithField("event", name).Warn("delivering event failed, will retry")
+				return
+			}
+
+			logger.WithError(err).WithField("event", name).Error("delivering event failed, dropping it")
+		}
+
+		delete(p.attempts, name)
+		if err := p.spool.Remove(name); err != nil {
+			logger.WithError(err).WithField("event", name).Error("removing event from spool")
+		}
+	}
+}
+
+func (p *Publisher) deliver(ctx context.Context, name string) error {
+	event, err := p.spool.Read(name)
+	if err != nil {
+		return fmt.Errorf("reading event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if err := p.sink.Deliver(ctx, event); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("delivery timed out: %w", err)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package pushevents
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+)
+
+type sinkFunc func(context.Context, []byte) error
+
+func (f sinkFunc) Deliver(ctx context.Context, event []byte) error {
+	return f(ctx, event)
+}
+
+func enqueueEvents(t *testing.T, spool *Spool, userIDs ...string) {
+	t.Helper()
+
+	for _, userID := range userIDs {
+		require.NoError(t, spool.Enqueue(Event{UserID: userID}))
+	}
+}
+
+func TestSpool(t *testing.T) {
+	t.Parallel()
+
+	spool := NewSpool(filepath.Join(testhelper.TempDir(t), "spool"))
+
+	pending, err := spool.Pending()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+
+	enqueueEvents(t, spool, "first", "second", "third")
+
+	// Files which are not spooled events must be ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(spool.dir, "event.json.tmp123"), nil, 0o600))
+
+	pending, err = spool.Pending()
+	require.NoError(t, err)
+	require.Len(t, pending, 3)
+
+	data, err := spool.Read(pending[0])
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"user_id":"first"`)
+
+	require.NoError(t, spool.Remove(pending[0]))
+	require.NoError(t, spool.Remove(pending[0]))
+
+	pending, err = spool.Pending()
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+}
+
+func TestPublisher_Publish(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	logger, _ := test.NewNullLogger()
+
+	t.Run("successful delivery", func(t *testing.T) {
+		spool := NewSpool(testhelper.TempDir(t))
+		enqueueEvents(t, spool, "first", "second")
+
+		var delivered []string
+		publisher := &Publisher{
+			spool: spool,
+			sink: sinkFunc(func(ctx context.Context, event []byte) error {
+				delivered = append(delivered, string(event))
+				return nil
+			}),
+			maxAttempts: 3,
+			timeout:     time.Minute,
+			attempts:    make(map[string]uint),
+		}
+
+		publisher.Publish(ctx, logger)
+
+		require.Len(t, delivered, 2)
+		require.Contains(t, delivered[0], `"user_id":"first"`)
+		require.Contains(t, delivered[1], `"user_id":"second"`)
+
+		pending, err := spool.Pending()
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	})
+
+	t.Run("failed delivery is retried until max attempts", func(t *testing.T) {
+		spool := NewSpool(testhelper.TempDir(t))
+		enqueueEvents(t, spool, "first")
+
+		attempts := 0
+		publisher := &Publisher{
+			spool: spool,
+			sink: sinkFunc(func(ctx context.Context, event []byte) error {
+				attempts++
+				return errors.New("sink unavailable")
+			}),
+			maxAttempts: 3,
+			timeout:     time.Minute,
+			attempts:    make(map[string]uint),
+		}
+
+		for i := 1; i < 3; i++ {
+			publisher.Publish(ctx, logger)
+			require.Equal(t, i, attempts)
+
+			pending, err := spool.Pending()
+			require.NoError(t, err)
+			require.Len(t, pending, 1)
+		}
+
+		publisher.Publish(ctx, logger)
+		require.Equal(t, 3, attempts)
+
+		pending, err := spool.Pending()
+		require.NoError(t, err)
+		require.Empty(t, pending)
+		require.Empty(t, publisher.attempts)
+	})
+
+	t.Run("failed delivery blocks subsequent events", func(t *testing.T) {
+		spool := NewSpool(testhelper.TempDir(t))
+		enqueueEvents(t, spool, "first", "second")
+
+		var delivered []string
+		failing := true
+		publisher := &Publisher{
+			spool: spool,
+			sink: sinkFunc(func(ctx context.Context, event []byte) error {
+				if failing && strings.Contains(string(event), `"user_id":"first"`) {
+					return errors.New("sink unavailable")
+				}
+
+				delivered = append(delivered, string(event))
+				return nil
+			}),
+			maxAttempts: 3,
+			timeout:     time.Minute,
+			attempts:    make(map[string]uint),
+		}
+
+		// The second event would be delivered successfully, but it must not overtake the
+		// first one.
+		publisher.Publish(ctx, logger)
+		require.Empty(t, delivered)
+
+		pending, err := spool.Pending()
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+
+		failing = false
+		publisher.Publish(ctx, logger)
+
+		require.Len(t, delivered, 2)
+		require.Contains(t, delivered[0], `"user_id":"first"`)
+		require.Contains(t, delivered[1], `"user_id":"second"`)
+
+		pending, err = spool.Pending()
+		require.NoError(t, err)
+		require.Empty(t, pending)
+		require.Empty(t, publisher.attempts)
+	})
+}
+
+func TestPublisher_sinks(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	logger, _ := test.NewNullLogger()
+
+	publish := func(t *testing.T, sink string) {
+		t.Helper()
+
+		spoolDir := testhelper.TempDir(t)
+		enqueueEvents(t, NewSpool(spoolDir), "user")
+
+		publisher, err := NewPublisher(config.HookEvents{
+			Sink:          sink,
+			SpoolDir:      spoolDir,
+			MaxAttempts:   1,
+			RetryInterval: duration.Duration(time.Minute),
+			Timeout:       duration.Duration(time.Minute),
+		})
+		require.NoError(t, err)
+
+		publisher.Publish(ctx, logger)
+
+		pending, err := NewSpool(spoolDir).Pending()
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	}
+
+	t.Run("file", func(t *testing.T) {
+		logPath := filepath.Join(testhelper.TempDir(t), "events.log")
+
+		publish(t, "file://"+logPath)
+		publish(t, "file://"+logPath)
+
+		data, err := os.ReadFile(logPath)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		require.Len(t, lines, 2)
+		for _, line := range lines {
+			require.Contains(t, line, `"user_id":"user"`)
+		}
+	})
+
+	t.Run("unix", func(t *testing.T) {
+		socketPath := filepath.Join(testhelper.TempDir(t), "events.socket")
+
+		listener, err := net.Listen("unix", socketPath)
+		require.NoError(t, err)
+		defer listener.Close()
+
+		received := make(chan string, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+
+			data, _ := io.ReadAll(conn)
+			received <- string(data)
+		}()
+
+		publish(t, "unix://"+socketPath)
+
+		data := <-received
+		require.Contains(t, data, `"user_id":"user"`)
+		require.True(t, strings.HasSuffix(data, "\n"))
+	})
+
+	t.Run("http", func(t *testing.T) {
+		received := make(chan string, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			data, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			received <- string(data)
+		}))
+		defer server.Close()
+
+		publish(t, server.URL)
+
+		require.Contains(t, <-received, `"user_id":"user"`)
+	})
+
+	t.Run("http error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		sink, err := NewSink(server.URL)
+		require.NoError(t, err)
+		require.EqualError(t, sink.Deliver(ctx, []byte("{}")), "unexpected status code: 503")
+	})
+}
+
+func TestNewSink_unsupportedScheme(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSink("ftp://example.com")
+	require.EqualError(t, err, `unsupported sink scheme "ftp"`)
+}
@@ -0,0 +1,128 @@
+package pushevents
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+)
+
+// Sink is a destination that events are delivered to.
+type Sink interface {
+	// Deliver delivers a single encoded event to the sink.
+	Deliver(ctx context.Context, event []byte) error
+}
+
+// NewSink creates a new sink from the given URL. Supported schemes are `unix` to write events to
+// a Unix socket, `file` to append events to a file, and `http` and `https` to POST events to a
+// webhook.
+func NewSink(rawURL string) (Sink, error) {
+	sinkURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing sink URL: %w", err)
+	}
+
+	switch sinkURL.Scheme {
+	case "unix":
+		return unixSink{path: sinkURL.Path}, nil
+	case "file":
+		return fileSink{path: sinkURL.Path}, nil
+	case "http", "https":
+		return httpSink{url: sinkURL.String(), client: &http.Client{}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported sink scheme %q", sinkURL.Scheme)
+	}
+}
+
+// unixSink writes each event as a single newline-terminated line to a Unix socket.
+type unixSink struct {
+	path string
+}
+
+func (s unixSink) Deliver(ctx context.Context, event []byte) (returnedErr error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", s.path)
+	if err != nil {
+		return fmt.Errorf("connecting to socket: %w", err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil && returnedErr == nil {
+			returnedErr = fmt.Errorf("closing socket: %w", err)
+		}
+	}()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetWriteDeadline(deadline); err != nil {
+			return fmt.Errorf("setting write deadline: %w", err)
+		}
+	}
+
+	if _, err := conn.Write(append(event, '\n')); err != nil {
+		return fmt.Errorf("writing event: %w", err)
+	}
+
+	return nil
+}
+
+// fileSink appends each event as a single newline-terminated line to a file.
+type fileSink struct {
+	path string
+}
+
+func (s fileSink) Deliver(ctx context.Context, event []byte) (returnedErr error) {
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, perm.SharedFile)
+	if err != nil {
+		return fmt.Errorf("opening file: %w", err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil && returnedErr == nil {
+			returnedErr = fmt.Errorf("closing file: %w", err)
+		}
+	}()
+
+	if _, err := file.Write(append(event, '\n')); err != nil {
+		return fmt.Errorf("writing event: %w", err)
+	}
+
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("syncing file: %w", err)
+	}
+
+	return nil
+}
+
+// httpSink POSTs each event to a webhook. Any response with a status code other than 2xx is
+// treated as a delivery failure.
+type httpSink struct {
+	url    string
+	client *http.Client
+}
+
+func (s httpSink) Deliver(ctx context.Context, event []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(event))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", err)
+	}
+	defer response.Body.Close()
+
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package pushevents
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/safe"
+)
+
+// spoolFileSuffix is the suffix of spooled events. Any other files in the spool directory, like
+// the temporary files created while writing events, are ignored.
+const spoolFileSuffix = ".json"
+
+// sequence disambiguates events that are spooled in the same nanosecond.
+var sequence uint64
+
+// Spool is an on-disk queue of events which have not yet been delivered.
+type Spool struct {
+	dir string
+}
+
+// NewSpool creates a new spool in the given directory.
+func NewSpool(dir string) *Spool {
+	return &Spool{dir: dir}
+}
+
+// Enqueue atomically writes the event into the spool.
+func (s *Spool) Enqueue(event Event) (returnedErr error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encoding event: %w", err)
+	}
+
+	if err := os.MkdirAll(s.dir, perm.PrivateDir); err != nil {
+		return fmt.Errorf("creating spool directory: %w", err)
+	}
+
+	// Names are chosen such that sorting them lexicographically yields the order in which
+	// events have been enqueued.
+	name := fmt.Sprintf("%020d-%d-%020d%s", time.Now().UnixNano(), os.Getpid(), atomic.AddUint64(&sequence, 1), spoolFileSuffix)
+
+	writer, err := safe.NewFileWriter(filepath.Join(s.dir, name), safe.FileWriterConfig{
+		FileMode: perm.PrivateFile,
+	})
+	if err != nil {
+		return fmt.Errorf("creating spool file: %w", err)
+	}
+	defer func() {
+		if err := writer.Close(); err != nil && !errors.Is(err, safe.ErrAlreadyDone) && returnedErr == nil {
+			returnedErr = fmt.Errorf("closing spool file: %w", err)
+		}
+	}()
+
+	if _, err := writer.Write(data); err != nil {
+		return fmt.Errorf("writing spool file: %w", err)
+	}
+
+	if err := writer.Commit(); err != nil {
+		return fmt.Errorf("committing spool file: %w", err)
+	}
+
+	return nil
+}
+
+// Pending returns the names of all spooled events in the order they have been enqueued in.
+func (s *Spool) Pending() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("reading spool directory: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasSuffix(entry.Name(), spoolFileSuffix) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// Read reads the encoded event with the given name.
+func (s *Spool) Read(name string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.dir, name))
+}
+
+// Remove removes the event with the given name from the spool.
+func (s *Spool) Remove(name string) error {
+	if err := os.Remove(filepath.Join(s.dir, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,289 @@
+package pushrules
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// secretFilePatterns is the list of patterns matching paths of files which are commonly known to
+// contain secrets. It is used to enforce the `prevent_secrets` push rule.
+var secretFilePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(^|/)id_(rsa|dsa|ecdsa|ed25519)$`),
+	regexp.MustCompile(`\.(pem|key|p12|pfx|asc|keychain|kdb|agilekeychain|ovpn|jks)$`),
+	regexp.MustCompile(`(^|/)\.(bash|zsh|sh|mysql|psql|irb)_history$`),
+	regexp.MustCompile(`(^|/)\.(netrc|dockercfg|s3cfg|pgpass|htpasswd)$`),
+	regexp.MustCompile(`(^|/)\.aws/credentials$`),
+	regexp.MustCompile(`(^|/)\.docker/config\.json$`),
+	regexp.MustCompile(`(^|/)credentials\.xml$`),
+	regexp.MustCompile(`(^|/)secret_token\.rb$`),
+}
+
+// ViolationError is returned by Evaluate in case a push violates one of the push rules.
+type ViolationError struct {
+	// Rule is the name of the push rule that has been violated.
+	Rule string
+	// Message is a human-readable description of the violation.
+	Message string
+}
+
+func (e ViolationError) Error() string {
+	return fmt.Sprintf("push rule %q violated: %s", e.Rule, e.Message)
+}
+
+// Change is a single reference update as received by the pre-receive hook.
+type Change struct {
+	// OldOID is the object ID the reference is pointing to before the update.
+	OldOID git.ObjectID
+	// NewOID is the object ID the reference is pointing to after the update.
+	NewOID git.ObjectID
+	// Reference is the name of the reference that is being updated.
+	Reference git.ReferenceName
+}
+
+// ParseChanges parses reference updates in the format passed to the pre-receive hook via its
+// standard input, see githooks(5).
+func ParseChanges(objectHash git.ObjectHash, changes []byte) ([]Change, error) {
+	var result []Change
+
+	scanner := bufio.NewScanner(bytes.NewReader(changes))
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), " ", 3)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid change line: %q", scanner.Text())
+		}
+
+		oldOID, err := objectHash.FromHex(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid old object ID: %w", err)
+		}
+
+		newOID, err := objectHash.FromHex(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid new object ID: %w", err)
+		}
+
+		result = append(result, Change{
+			OldOID:    oldOID,
+			NewOID:    newOID,
+			Reference: git.ReferenceName(fields[2]),
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning changes: %w", err)
+	}
+
+	return result, nil
+}
+
+// Evaluator evaluates push rules against reference updates.
+type Evaluator struct {
+	rules compiledRules
+}
+
+// NewEvaluator creates a new Evaluator for the given push rules. Returns an error in case the push
+// rules are not well-formed.
+func NewEvaluator(rules *gitalypb.PushRules) (*Evaluator, error) {
+	compiled, err := compile(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Evaluator{
+		rules: compiled,
+	}, nil
+}
+
+// Evaluate evaluates the push rules against the given reference updates. Objects introduced by
+// the updates are looked up via the repository, so the repository must be set up such that it
+// can see the quarantined objects of the push. Returns a ViolationError in case any of the rules
+// is violated.
+func (e *Evaluator) Evaluate(ctx context.Context, repo git.RepositoryExecutor, changes []Change) error {
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	var tips []string
+	for _, change := range changes {
+		if change.NewOID == objectHash.ZeroOID {
+			if e.rules.denyDeleteTag && strings.HasPrefix(change.Reference.String(), "refs/tags/") {
+				return ViolationError{
+					Rule:    "deny_delete_tag",
+					Message: fmt.Sprintf("deleting tag %q is not allowed", change.Reference),
+				}
+			}
+
+			continue
+		}
+
+		tips = append(tips, change.NewOID.String())
+	}
+
+	if len(tips) == 0 {
+		return nil
+	}
+
+	// We only want to look at objects which are newly introduced by the push, so we exclude
+	// everything that is reachable from any of the preexisting references.
+	revisions := append(tips, "--not", "--all")
+
+	if e.rules.commitMessageRegex != nil || len(e.rules.authorEmailDomains) > 0 {
+		if err := e.evaluateCommits(ctx, repo, revisions); err != nil {
+			return err
+		}
+	}
+
+	if e.rules.maxFileSize > 0 || len(e.rules.fileNameDenylist) > 0 || e.rules.preventSecrets {
+		if err := e.evaluateBlobs(ctx, repo, revisions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// evaluateCommits evaluates rules that apply to commits which are introduced by the given
+// revisions.
+func (e *Evaluator) evaluateCommits(ctx context.Context, repo git.RepositoryExecutor, revisions []string) error {
+	var stdout, stderr bytes.Buffer
+	if err := repo.ExecAndWait(ctx, git.Command{
+		Name: "rev-list",
+		Flags: []git.Option{
+			git.Flag{Name: "--no-commit-header"},
+			git.Flag{Name: "--format=%H%x00%aE%x00%B%x00"},
+		},
+		Args: revisions,
+	}, git.WithStdout(&stdout), git.WithStderr(&stderr)); err != nil {
+		return structerr.New("listing new commits: %w", err).WithMetadata("stderr", stderr.String())
+	}
+
+	fields := strings.Split(stdout.String(), "\x00")
+	for i := 0; i+2 < len(fields); i += 3 {
+		oid := strings.TrimSpace(fields[i])
+		email := fields[i+1]
+		message := fields[i+2]
+
+		if e.rules.commitMessageRegex != nil && !e.rules.commitMessageRegex.MatchString(message) {
+			return ViolationError{
+				Rule:    "commit_message_regex",
+				Message: fmt.Sprintf("commit %s does not match the required commit message pattern", oid),
+			}
+		}
+
+		if len(e.rules.authorEmailDomains) > 0 && !e.isAllowedEmailDomain(email) {
+			return ViolationError{
+				Rule:    "author_email_domains",
+				Message: fmt.Sprintf("commit %s has author email %q outside of the allowed domains", oid, email),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *Evaluator) isAllowedEmailDomain(email string) bool {
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return false
+	}
+
+	for _, domain := range e.rules.authorEmailDomains {
+		if strings.EqualFold(email[at+1:], domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// evaluateBlobs evaluates rules that apply to blobs which are introduced by the given
+// revisions.
+func (e *Evaluator) evaluateBlobs(ctx context.Context, repo git.RepositoryExecutor, revisions []string) error {
+	var objects, stderr bytes.Buffer
+	if err := repo.ExecAndWait(ctx, git.Command{
+		Name: "rev-list",
+		Flags: []git.Option{
+			git.Flag{Name: "--objects"},
+			git.Flag{Name: "--object-names"},
+		},
+		Args: revisions,
+	}, git.WithStdout(&objects), git.WithStderr(&stderr)); err != nil {
+		return structerr.New("listing new objects: %w", err).WithMetadata("stderr", stderr.String())
+	}
+
+	var infos bytes.Buffer
+	stderr.Reset()
+	if err := repo.ExecAndWait(ctx, git.Command{
+		Name: "cat-file",
+		Flags: []git.Option{
+			git.Flag{Name: "--batch-check=%(objectname) %(objecttype) %(objectsize) %(rest)"},
+		},
+	}, git.WithStdin(&objects), git.WithStdout(&infos), git.WithStderr(&stderr)); err != nil {
+		return structerr.New("reading object info: %w", err).WithMetadata("stderr", stderr.String())
+	}
+
+	scanner := bufio.NewScanner(&infos)
+	for scanner.Scan() {
+		fields := strings.SplitN(scanner.Text(), " ", 4)
+		if len(fields) < 3 {
+			return fmt.Errorf("invalid object info: %q", scanner.Text())
+		}
+
+		if fields[1] != "blob" {
+			continue
+		}
+
+		size, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return fmt.Errorf("parsing object size: %w", err)
+		}
+
+		var path string
+		if len(fields) == 4 {
+			path = fields[3]
+		}
+
+		if e.rules.maxFileSize > 0 && size > e.rules.maxFileSize {
+			return ViolationError{
+				Rule:    "max_file_size",
+				Message: fmt.Sprintf("file %q is %d bytes, which exceeds the limit of %d bytes", path, size, e.rules.maxFileSize),
+			}
+		}
+
+		for _, pattern := range e.rules.fileNameDenylist {
+			if pattern.MatchString(path) {
+				return ViolationError{
+					Rule:    "file_name_denylist",
+					Message: fmt.Sprintf("file %q matches forbidden pattern %q", path, pattern),
+				}
+			}
+		}
+
+		if e.rules.preventSecrets {
+			for _, pattern := range secretFilePatterns {
+				if pattern.MatchString(path) {
+					return ViolationError{
+						Rule:    "prevent_secrets",
+						Message: fmt.Sprintf("file %q is likely to contain secrets", path),
+					}
+				}
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("scanning object info: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package pushrules
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func TestParseChanges(t *testing.T) {
+	t.Parallel()
+
+	oldOID := gittest.DefaultObjectHash.ZeroOID
+	newOID := git.ObjectID(strings.Repeat("1", gittest.DefaultObjectHash.EncodedLen()))
+
+	changes, err := ParseChanges(gittest.DefaultObjectHash, []byte(fmt.Sprintf(
+		"%[1]s %[2]s refs/heads/main\n%[2]s %[1]s refs/tags/v1.0.0\n", oldOID, newOID,
+	)))
+	require.NoError(t, err)
+	require.Equal(t, []Change{
+		{OldOID: oldOID, NewOID: newOID, Reference: "refs/heads/main"},
+		{OldOID: newOID, NewOID: oldOID, Reference: "refs/tags/v1.0.0"},
+	}, changes)
+
+	_, err = ParseChanges(gittest.DefaultObjectHash, []byte("garbage\n"))
+	require.EqualError(t, err, `invalid change line: "garbage"`)
+
+	_, err = ParseChanges(gittest.DefaultObjectHash, []byte(fmt.Sprintf("1234 %s refs/heads/main\n", newOID)))
+	require.ErrorContains(t, err, "invalid old object ID")
+}
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	existingCommit := gittest.WriteCommit(t, cfg, repoPath,
+		gittest.WithBranch("main"),
+		gittest.WithMessage("no ticket reference"),
+		gittest.WithTreeEntries(
+			gittest.TreeEntry{Path: "id_rsa", Mode: "100644", Content: "existing secret"},
+		),
+	)
+	gittest.WriteTag(t, cfg, repoPath, "v1.0.0", existingCommit.Revision())
+
+	newCommit := func(message string, entries ...gittest.TreeEntry) git.ObjectID {
+		return gittest.WriteCommit(t, cfg, repoPath,
+			gittest.WithParents(existingCommit),
+			gittest.WithMessage(message),
+			gittest.WithTreeEntries(entries...),
+		)
+	}
+
+	updateMain := func(oid git.ObjectID) []Change {
+		return []Change{{OldOID: existingCommit, NewOID: oid, Reference: "refs/heads/main"}}
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		rules       *gitalypb.PushRules
+		changes     []Change
+		expectedErr error
+	}{
+		{
+			desc:  "no rules",
+			rules: &gitalypb.PushRules{},
+			changes: updateMain(newCommit("message",
+				gittest.TreeEntry{Path: "id_rsa", Mode: "100644", Content: "secret"},
+			)),
+		},
+		{
+			desc:  "file within size limit",
+			rules: &gitalypb.PushRules{MaxFileSize: 5},
+			changes: updateMain(newCommit("message",
+				gittest.TreeEntry{Path: "small", Mode: "100644", Content: "12345"},
+			)),
+		},
+		{
+			desc:  "file exceeding size limit",
+			rules: &gitalypb.PushRules{MaxFileSize: 5},
+			changes: updateMain(newCommit("message",
+				gittest.TreeEntry{Path: "large", Mode: "100644", Content: "123456"},
+			)),
+			expectedErr: ViolationError{
+				Rule:    "max_file_size",
+				Message: `file "large" is 6 bytes, which exceeds the limit of 5 bytes`,
+			},
+		},
+		{
+			desc:  "forbidden file name",
+			rules: &gitalypb.PushRules{FileNameDenylist: []string{`\.exe$`}},
+			changes: updateMain(newCommit("message",
+				gittest.TreeEntry{Path: "virus.exe", Mode: "100755", Content: "binary"},
+			)),
+			expectedErr: ViolationError{
+				Rule:    "file_name_denylist",
+				Message: `file "virus.exe" matches forbidden pattern "\\.exe$"`,
+			},
+		},
+		{
+			desc:  "secret file",
+			rules: &gitalypb.PushRules{PreventSecrets: true},
+			changes: updateMain(newCommit("message",
+				gittest.TreeEntry{Path: "server.pem", Mode: "100644", Content: "certificate"},
+			)),
+			expectedErr: ViolationError{
+				Rule:    "prevent_secrets",
+				Message: `file "server.pem" is likely to contain secrets`,
+			},
+		},
+		{
+			desc:  "preexisting objects are not checked",
+			rules: &gitalypb.PushRules{PreventSecrets: true},
+			changes: updateMain(newCommit("message",
+				gittest.TreeEntry{Path: "id_rsa", Mode: "100644", Content: "existing secret"},
+				gittest.TreeEntry{Path: "README", Mode: "100644", Content: "readme"},
+			)),
+		},
+		{
+			desc:    "matching commit message",
+			rules:   &gitalypb.PushRules{CommitMessageRegex: `^JIRA-\d+`},
+			changes: updateMain(newCommit("JIRA-123 fix bug")),
+		},
+		{
+			desc:    "mismatching commit message",
+			rules:   &gitalypb.PushRules{CommitMessageRegex: `^JIRA-\d+`},
+			changes: updateMain(newCommit("fix bug")),
+			expectedErr: ViolationError{
+				Rule:    "commit_message_regex",
+				Message: fmt.Sprintf("commit %s does not match the required commit message pattern", newCommit("fix bug")),
+			},
+		},
+		{
+			desc:    "allowed author email domain",
+			rules:   &gitalypb.PushRules{AuthorEmailDomains: []string{"example.com", "McDuck.com"}},
+			changes: updateMain(newCommit("message")),
+		},
+		{
+			desc:    "forbidden author email domain",
+			rules:   &gitalypb.PushRules{AuthorEmailDomains: []string{"example.com"}},
+			changes: updateMain(newCommit("other message")),
+			expectedErr: ViolationError{
+				Rule: "author_email_domains",
+				Message: fmt.Sprintf("commit %s has author email %q outside of the allowed domains",
+					newCommit("other message"), gittest.DefaultCommitterMail),
+			},
+		},
+		{
+			desc:  "tag deletion allowed",
+			rules: &gitalypb.PushRules{},
+			changes: []Change{
+				{OldOID: existingCommit, NewOID: gittest.DefaultObjectHash.ZeroOID, Reference: "refs/tags/v1.0.0"},
+			},
+		},
+		{
+			desc:  "tag deletion denied",
+			rules: &gitalypb.PushRules{DenyDeleteTag: true},
+			changes: []Change{
+				{OldOID: existingCommit, NewOID: gittest.DefaultObjectHash.ZeroOID, Reference: "refs/tags/v1.0.0"},
+			},
+			expectedErr: ViolationError{
+				Rule:    "deny_delete_tag",
+				Message: `deleting tag "refs/tags/v1.0.0" is not allowed`,
+			},
+		},
+		{
+			desc:  "branch deletion with tag deletion denied",
+			rules: &gitalypb.PushRules{DenyDeleteTag: true},
+			changes: []Change{
+				{OldOID: existingCommit, NewOID: gittest.DefaultObjectHash.ZeroOID, Reference: "refs/heads/main"},
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			evaluator, err := NewEvaluator(tc.rules)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedErr, evaluator.Evaluate(ctx, repo, tc.changes))
+		})
+	}
+}
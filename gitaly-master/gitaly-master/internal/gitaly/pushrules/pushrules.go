@@ -0,0 +1,115 @@
+// Package pushrules implements a declarative push-rule engine. Push rules are configured per
+// repository and are evaluated by the pre-receive hook against the objects introduced by a push
+// before any access checks are performed via the GitLab API.
+package pushrules
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// fileName is the name of the file inside of the repository that stores the push rules.
+const fileName = "gitaly-push-rules.json"
+
+// Path returns the path of the push rules file for the repository at the given path.
+func Path(repoPath string) string {
+	return filepath.Join(repoPath, fileName)
+}
+
+// Read reads the push rules of the repository at the given path. Returns an empty set of push
+// rules in case none have been configured.
+func Read(repoPath string) (*gitalypb.PushRules, error) {
+	data, err := os.ReadFile(Path(repoPath))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &gitalypb.PushRules{}, nil
+		}
+
+		return nil, fmt.Errorf("reading push rules: %w", err)
+	}
+
+	var rules gitalypb.PushRules
+	if err := protojson.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("decoding push rules: %w", err)
+	}
+
+	return &rules, nil
+}
+
+// Marshal encodes the push rules into the format used to persist them in the repository.
+func Marshal(rules *gitalypb.PushRules) ([]byte, error) {
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(rules)
+	if err != nil {
+		return nil, fmt.Errorf("encoding push rules: %w", err)
+	}
+
+	return append(data, '\n'), nil
+}
+
+// IsEmpty determines whether the push rules do not enforce anything.
+func IsEmpty(rules *gitalypb.PushRules) bool {
+	return proto.Equal(rules, &gitalypb.PushRules{})
+}
+
+// Validate verifies that the push rules are well-formed.
+func Validate(rules *gitalypb.PushRules) error {
+	_, err := compile(rules)
+	return err
+}
+
+// compiledRules is the parsed representation of the push rules.
+type compiledRules struct {
+	maxFileSize        int64
+	fileNameDenylist   []*regexp.Regexp
+	commitMessageRegex *regexp.Regexp
+	authorEmailDomains []string
+	preventSecrets     bool
+	denyDeleteTag      bool
+}
+
+func compile(rules *gitalypb.PushRules) (compiledRules, error) {
+	if rules.GetMaxFileSize() < 0 {
+		return compiledRules{}, fmt.Errorf("max file size must not be negative: %d", rules.GetMaxFileSize())
+	}
+
+	compiled := compiledRules{
+		maxFileSize:        rules.GetMaxFileSize(),
+		authorEmailDomains: rules.GetAuthorEmailDomains(),
+		preventSecrets:     rules.GetPreventSecrets(),
+		denyDeleteTag:      rules.GetDenyDeleteTag(),
+	}
+
+	for _, pattern := range rules.GetFileNameDenylist() {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return compiledRules{}, fmt.Errorf("invalid file name pattern %q: %w", pattern, err)
+		}
+
+		compiled.fileNameDenylist = append(compiled.fileNameDenylist, regex)
+	}
+
+	if pattern := rules.GetCommitMessageRegex(); pattern != "" {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return compiledRules{}, fmt.Errorf("invalid commit message pattern %q: %w", pattern, err)
+		}
+
+		compiled.commitMessageRegex = regex
+	}
+
+	for _, domain := range compiled.authorEmailDomains {
+		if domain == "" {
+			return compiledRules{}, errors.New("author email domain must not be empty")
+		}
+	}
+
+	return compiled, nil
+}
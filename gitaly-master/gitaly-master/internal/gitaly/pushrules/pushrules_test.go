@@ -0,0 +1,107 @@
+package pushrules
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func TestReadMarshal(t *testing.T) {
+	t.Parallel()
+
+	repoPath := testhelper.TempDir(t)
+
+	rules, err := Read(repoPath)
+	require.NoError(t, err)
+	testhelper.ProtoEqual(t, &gitalypb.PushRules{}, rules)
+	require.True(t, IsEmpty(rules))
+
+	expectedRules := &gitalypb.PushRules{
+		MaxFileSize:        1024,
+		FileNameDenylist:   []string{`\.exe$`},
+		CommitMessageRegex: `^JIRA-\d+`,
+		AuthorEmailDomains: []string{"example.com"},
+		PreventSecrets:     true,
+		DenyDeleteTag:      true,
+	}
+
+	data, err := Marshal(expectedRules)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(Path(repoPath), data, perm.SharedFile))
+
+	rules, err = Read(repoPath)
+	require.NoError(t, err)
+	testhelper.ProtoEqual(t, expectedRules, rules)
+	require.False(t, IsEmpty(rules))
+}
+
+func TestRead_invalid(t *testing.T) {
+	t.Parallel()
+
+	repoPath := testhelper.TempDir(t)
+	require.NoError(t, os.WriteFile(Path(repoPath), []byte("garbage"), perm.SharedFile))
+
+	_, err := Read(repoPath)
+	require.ErrorContains(t, err, "decoding push rules")
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc        string
+		rules       *gitalypb.PushRules
+		expectedErr string
+	}{
+		{
+			desc:  "empty rules",
+			rules: &gitalypb.PushRules{},
+		},
+		{
+			desc: "valid rules",
+			rules: &gitalypb.PushRules{
+				MaxFileSize:        1,
+				FileNameDenylist:   []string{`^vendor/`},
+				CommitMessageRegex: `^\w+: `,
+				AuthorEmailDomains: []string{"example.com"},
+			},
+		},
+		{
+			desc:        "negative file size",
+			rules:       &gitalypb.PushRules{MaxFileSize: -1},
+			expectedErr: "max file size must not be negative: -1",
+		},
+		{
+			desc:        "invalid file name pattern",
+			rules:       &gitalypb.PushRules{FileNameDenylist: []string{"("}},
+			expectedErr: `invalid file name pattern "("`,
+		},
+		{
+			desc:        "invalid commit message pattern",
+			rules:       &gitalypb.PushRules{CommitMessageRegex: "["},
+			expectedErr: `invalid commit message pattern "["`,
+		},
+		{
+			desc:        "empty email domain",
+			rules:       &gitalypb.PushRules{AuthorEmailDomains: []string{""}},
+			expectedErr: "author email domain must not be empty",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(tc.rules)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+// Package quota enforces limits for the on-disk size of repositories and storages. Quotas are
+// checked by the pre-receive hook against the size of the objects staged in the quarantine
+// directory of a write so that writes which would exceed any of the quotas are rejected before
+// their objects are migrated into the repository. Furthermore, the reference-transaction hook
+// rejects all reference updates except for deletions in repositories which exceed their quota so
+// that writes which don't go through the pre-receive hook cannot grow them any further.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"syscall"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/repository"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/repoutil"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"golang.org/x/sync/singleflight"
+)
+
+// ExceededError is returned by Check in case a write would exceed a quota.
+type ExceededError struct {
+	// Scope is the scope of the quota that would be exceeded.
+	Scope gitalypb.QuotaExceededError_Scope
+	// LimitBytes is the configured quota.
+	LimitBytes int64
+	// UsedBytes is the size of the repository or storage before the write.
+	UsedBytes int64
+	// IncomingBytes is the size of the objects which are about to be written.
+	IncomingBytes int64
+	// ReadOnly is set if the quota is exceeded even without the write.
+	ReadOnly bool
+}
+
+func (e ExceededError) Error() string {
+	scope := "repository"
+	if e.Scope == gitalypb.QuotaExceededError_SCOPE_STORAGE {
+		scope = "storage"
+	}
+
+	if e.ReadOnly {
+		return fmt.Sprintf("%s is read-only: size of %d bytes exceeds quota of %d bytes", scope, e.UsedBytes, e.LimitBytes)
+	}
+
+	return fmt.Sprintf("%s quota exceeded: writing %d bytes would grow size from %d bytes beyond quota of %d bytes",
+		scope, e.IncomingBytes, e.UsedBytes, e.LimitBytes)
+}
+
+// Proto returns the Protobuf representation of the error.
+func (e ExceededError) Proto() *gitalypb.QuotaExceededError {
+	return &gitalypb.QuotaExceededError{
+		Scope:         e.Scope,
+		LimitBytes:    e.LimitBytes,
+		UsedBytes:     e.UsedBytes,
+		IncomingBytes: e.IncomingBytes,
+		ReadOnly:      e.ReadOnly,
+	}
+}
+
+// StructuredError returns a structured error with the QuotaExceededError attached as detail.
+func (e ExceededError) StructuredError() structerr.Error {
+	return structerr.NewResourceExhausted("%w", e).WithDetail(e.Proto())
+}
+
+// sizeComputationTimeout is the maximum time computing the size of a repository may take.
+const sizeComputationTimeout = 10 * time.Minute
+
+type cachedSize struct {
+	bytes     int64
+	expiresAt time.Time
+}
+
+// Manager checks writes against the configured quotas. Computing the size of repositories is
+// expensive, so computed sizes are cached for the configured TTL. Writes which have passed the
+// check are accounted for in the cached sizes so that consecutive writes cannot exceed the quota
+// while the cached sizes are still valid. The size of storages is derived from the usage of the
+// filesystem hosting them, which is cheap to query and thus not cached.
+type Manager struct {
+	cfg             config.Quota
+	locator         storage.Locator
+	now             func() time.Time
+	filesystemUsage func(path string) (int64, error)
+
+	group singleflight.Group
+	mutex sync.Mutex
+	sizes map[string]cachedSize
+}
+
+// NewManager creates a new Manager enforcing the given quotas.
+func NewManager(cfg config.Quota, locator storage.Locator) *Manager {
+	return &Manager{
+		cfg:             cfg,
+		locator:         locator,
+		now:             time.Now,
+		filesystemUsage: filesystemUsage,
+		sizes:           map[string]cachedSize{},
+	}
+}
+
+// Check verifies that migrating the objects in the quarantine directory into the repository does
+// not exceed the quota of the repository nor the quota of its storage. The quarantine directory
+// may be empty in case the write does not introduce any new objects, in which case Check only
+// verifies that the repository is not read-only. Returns an ExceededError in case any of the
+// quotas is exceeded. If the check passes, the quarantined objects are accounted for in the cached
+// sizes. A nil Manager doesn't enforce any quotas.
+func (m *Manager) Check(ctx context.Context, repo repository.GitRepo, quarantineDir string) error {
+	if m == nil || !m.cfg.Enabled() {
+		return nil
+	}
+
+	repoPath, err := m.locator.GetRepoPath(repo)
+	if err != nil {
+		return fmt.Errorf("getting repository path: %w", err)
+	}
+
+	var incomingBytes int64
+	if quarantineDir != "" {
+		incomingBytes, err = repoutil.DiskUsage(ctx, quarantineDir)
+		if err != nil {
+			return fmt.Errorf("computing quarantine size: %w", err)
+		}
+	}
+
+	if m.cfg.RepositoryMaxBytes > 0 {
+		usedBytes, err := m.cachedSize(ctx, repoPath, func(ctx context.Context) (int64, error) {
+			// Objects quarantined by git-receive-pack(1) are accounted for as incoming
+			// objects.
+			return repoutil.DiskUsage(ctx, repoPath, incomingObjectsPattern)
+		})
+		if err != nil {
+			return fmt.Errorf("computing repository size: %w", err)
+		}
+
+		if err := checkLimit(gitalypb.QuotaExceededError_SCOPE_REPOSITORY, m.cfg.RepositoryMaxBytes, usedBytes, incomingBytes); err != nil {
+			return err
+		}
+	}
+
+	if m.cfg.StorageMaxBytes > 0 {
+		storagePath, err := m.locator.GetStorageByName(repo.GetStorageName())
+		if err != nil {
+			return fmt.Errorf("getting storage path: %w", err)
+		}
+
+		usedBytes, err := m.filesystemUsage(storagePath)
+		if err != nil {
+			return fmt.Errorf("computing storage size: %w", err)
+		}
+
+		// The quarantine directory is located in the storage, so its objects are already
+		// accounted for in the filesystem's usage.
+		usedBytes -= incomingBytes
+		if usedBytes < 0 {
+			usedBytes = 0
+		}
+
+		if err := checkLimit(gitalypb.QuotaExceededError_SCOPE_STORAGE, m.cfg.StorageMaxBytes, usedBytes, incomingBytes); err != nil {
+			return err
+		}
+	}
+
+	m.account(incomingBytes, repoPath)
+
+	return nil
+}
+
+// checkLimit returns an ExceededError in case writing the incoming bytes would grow the used bytes
+// beyond the limit.
+func checkLimit(scope gitalypb.QuotaExceededError_Scope, limit, usedBytes, incomingBytes int64) error {
+	exceededErr := ExceededError{
+		Scope:         scope,
+		LimitBytes:    limit,
+		UsedBytes:     usedBytes,
+		IncomingBytes: incomingBytes,
+	}
+
+	switch {
+	case usedBytes > limit:
+		exceededErr.ReadOnly = true
+		return exceededErr
+	case usedBytes+incomingBytes > limit:
+		return exceededErr
+	}
+
+	return nil
+}
+
+// cachedSize returns the cached size of the given path. The size is computed in case it is not
+// cached or if the cached size has expired. Concurrent computations of the same path are
+// deduplicated. The computation is shared by all callers waiting for it, so it must not be
+// cancelled when the caller which has started it goes away. It is thus run with a context that is
+// detached from the caller's context and which times out after sizeComputationTimeout instead.
+func (m *Manager) cachedSize(ctx context.Context, path string, compute func(context.Context) (int64, error)) (int64, error) {
+	m.mutex.Lock()
+	cached, ok := m.sizes[path]
+	m.mutex.Unlock()
+
+	if ok && m.now().Before(cached.expiresAt) {
+		return cached.bytes, nil
+	}
+
+	resultCh := m.group.DoChan(path, func() (interface{}, error) {
+		computeCtx, cancel := context.WithTimeout(helper.SuppressCancellation(ctx), sizeComputationTimeout)
+		defer cancel()
+
+		size, err := compute(computeCtx)
+		if err != nil {
+			return 0, err
+		}
+
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+		m.sizes[path] = cachedSize{
+			bytes:     size,
+			expiresAt: m.now().Add(m.cfg.CacheTTL.Duration()),
+		}
+
+		return size, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case result := <-resultCh:
+		if result.Err != nil {
+			return 0, result.Err
+		}
+
+		return result.Val.(int64), nil
+	}
+}
+
+// account adds the given number of bytes to the cached sizes of the given paths. Writes which fail
+// after having passed the quota check are thus overaccounted for until the cached size expires.
+func (m *Manager) account(bytes int64, paths ...string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, path := range paths {
+		if cached, ok := m.sizes[path]; ok {
+			cached.bytes += bytes
+			m.sizes[path] = cached
+		}
+	}
+}
+
+// incomingObjectsPattern matches the temporary object directories which git-receive-pack(1)
+// creates to quarantine objects of pushes.
+const incomingObjectsPattern = "incoming-*"
+
+// filesystemUsage returns the number of bytes used on the filesystem hosting the given path.
+func filesystemUsage(path string) (int64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, fmt.Errorf("statfs: %w", err)
+	}
+
+	return int64(stat.Blocks-stat.Bfree) * int64(stat.Bsize), nil
+}
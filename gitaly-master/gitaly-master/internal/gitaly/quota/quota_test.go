@@ -0,0 +1,255 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/repoutil"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func TestExceededError(t *testing.T) {
+	t.Parallel()
+
+	err := ExceededError{
+		Scope:         gitalypb.QuotaExceededError_SCOPE_STORAGE,
+		LimitBytes:    100,
+		UsedBytes:     80,
+		IncomingBytes: 30,
+	}
+
+	require.Equal(t, "storage quota exceeded: writing 30 bytes would grow size from 80 bytes beyond quota of 100 bytes", err.Error())
+	testhelper.RequireGrpcError(t,
+		structerr.NewResourceExhausted("storage quota exceeded: writing 30 bytes would grow size from 80 bytes beyond quota of 100 bytes").WithDetail(
+			&gitalypb.QuotaExceededError{
+				Scope:         gitalypb.QuotaExceededError_SCOPE_STORAGE,
+				LimitBytes:    100,
+				UsedBytes:     80,
+				IncomingBytes: 30,
+			},
+		),
+		err.StructuredError(),
+	)
+
+	err.Scope = gitalypb.QuotaExceededError_SCOPE_REPOSITORY
+	err.UsedBytes = 120
+	err.ReadOnly = true
+	require.Equal(t, "repository is read-only: size of 120 bytes exceeds quota of 100 bytes", err.Error())
+}
+
+func TestManager_Check(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+	locator := config.NewLocator(cfg)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	repoSize, err := repoutil.DiskUsage(ctx, repoPath)
+	require.NoError(t, err)
+
+	// Objects which are quarantined by git-receive-pack(1) must not be accounted for in the
+	// repository's size.
+	incomingDir := filepath.Join(repoPath, "objects", "incoming-123456")
+	require.NoError(t, os.MkdirAll(incomingDir, perm.SharedDir))
+	require.NoError(t, os.WriteFile(filepath.Join(incomingDir, "object"), make([]byte, 10000), perm.SharedFile))
+	incomingSize, err := repoutil.DiskUsage(ctx, incomingDir)
+	require.NoError(t, err)
+
+	t.Run("nil manager", func(t *testing.T) {
+		var manager *Manager
+		require.NoError(t, manager.Check(ctx, repo, incomingDir))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		require.NoError(t, NewManager(config.Quota{}, locator).Check(ctx, repo, incomingDir))
+	})
+
+	t.Run("within repository quota", func(t *testing.T) {
+		manager := NewManager(config.Quota{
+			RepositoryMaxBytes: repoSize + incomingSize,
+			CacheTTL:           duration.Duration(time.Hour),
+		}, locator)
+
+		require.NoError(t, manager.Check(ctx, repo, incomingDir))
+
+		// The incoming objects are accounted for in the cached size, so a second push of
+		// the same size exceeds the quota.
+		require.Equal(t, ExceededError{
+			Scope:         gitalypb.QuotaExceededError_SCOPE_REPOSITORY,
+			LimitBytes:    repoSize + incomingSize,
+			UsedBytes:     repoSize + incomingSize,
+			IncomingBytes: incomingSize,
+		}, manager.Check(ctx, repo, incomingDir))
+
+		// Writes which don't introduce any new objects are still accepted.
+		require.NoError(t, manager.Check(ctx, repo, ""))
+	})
+
+	t.Run("exceeding repository quota", func(t *testing.T) {
+		manager := NewManager(config.Quota{
+			RepositoryMaxBytes: repoSize + incomingSize - 1,
+			CacheTTL:           duration.Duration(time.Hour),
+		}, locator)
+
+		require.Equal(t, ExceededError{
+			Scope:         gitalypb.QuotaExceededError_SCOPE_REPOSITORY,
+			LimitBytes:    repoSize + incomingSize - 1,
+			UsedBytes:     repoSize,
+			IncomingBytes: incomingSize,
+		}, manager.Check(ctx, repo, incomingDir))
+	})
+
+	t.Run("read-only repository", func(t *testing.T) {
+		manager := NewManager(config.Quota{
+			RepositoryMaxBytes: repoSize - 1,
+			CacheTTL:           duration.Duration(time.Hour),
+		}, locator)
+
+		require.Equal(t, ExceededError{
+			Scope:      gitalypb.QuotaExceededError_SCOPE_REPOSITORY,
+			LimitBytes: repoSize - 1,
+			UsedBytes:  repoSize,
+			ReadOnly:   true,
+		}, manager.Check(ctx, repo, ""))
+	})
+
+	t.Run("within storage quota", func(t *testing.T) {
+		manager := NewManager(config.Quota{
+			StorageMaxBytes: 1000 + incomingSize,
+		}, locator)
+
+		// The usage of the filesystem includes the incoming objects.
+		var statfsPath string
+		manager.filesystemUsage = func(path string) (int64, error) {
+			statfsPath = path
+			return 1000 + incomingSize, nil
+		}
+
+		require.NoError(t, manager.Check(ctx, repo, incomingDir))
+		require.Equal(t, cfg.Storages[0].Path, statfsPath)
+	})
+
+	t.Run("exceeding storage quota", func(t *testing.T) {
+		manager := NewManager(config.Quota{
+			StorageMaxBytes: 1000 + incomingSize - 1,
+		}, locator)
+		manager.filesystemUsage = func(string) (int64, error) {
+			return 1000 + incomingSize, nil
+		}
+
+		require.Equal(t, ExceededError{
+			Scope:         gitalypb.QuotaExceededError_SCOPE_STORAGE,
+			LimitBytes:    1000 + incomingSize - 1,
+			UsedBytes:     1000,
+			IncomingBytes: incomingSize,
+		}, manager.Check(ctx, repo, incomingDir))
+	})
+
+	t.Run("read-only storage", func(t *testing.T) {
+		manager := NewManager(config.Quota{
+			StorageMaxBytes: 999,
+		}, locator)
+		manager.filesystemUsage = func(string) (int64, error) {
+			return 1000, nil
+		}
+
+		require.Equal(t, ExceededError{
+			Scope:      gitalypb.QuotaExceededError_SCOPE_STORAGE,
+			LimitBytes: 999,
+			UsedBytes:  1000,
+			ReadOnly:   true,
+		}, manager.Check(ctx, repo, ""))
+	})
+
+	t.Run("expired cache", func(t *testing.T) {
+		now := time.Now()
+
+		manager := NewManager(config.Quota{
+			RepositoryMaxBytes: repoSize + incomingSize,
+			CacheTTL:           duration.Duration(time.Minute),
+		}, locator)
+		manager.now = func() time.Time { return now }
+
+		require.NoError(t, manager.Check(ctx, repo, incomingDir))
+		require.Error(t, manager.Check(ctx, repo, incomingDir))
+
+		// After the cache has expired, the size is recomputed from disk.
+		now = now.Add(time.Minute)
+		require.NoError(t, manager.Check(ctx, repo, incomingDir))
+	})
+}
+
+func TestManager_cachedSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	manager := NewManager(config.Quota{CacheTTL: duration.Duration(time.Minute)}, nil)
+
+	computing := make(chan struct{})
+	release := make(chan struct{})
+	compute := func(ctx context.Context) (int64, error) {
+		close(computing)
+		<-release
+
+		// The computation is shared by all waiting callers, so it must not be cancelled
+		// together with the caller which has started it.
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		return 42, nil
+	}
+
+	startingCtx, cancel := context.WithCancel(ctx)
+	startingErr := make(chan error, 1)
+	go func() {
+		_, err := manager.cachedSize(startingCtx, "path", compute)
+		startingErr <- err
+	}()
+	<-computing
+
+	type result struct {
+		size int64
+		err  error
+	}
+
+	waiting := make(chan result, 1)
+	go func() {
+		size, err := manager.cachedSize(ctx, "path", func(context.Context) (int64, error) {
+			return 0, errors.New("computation should have been deduplicated")
+		})
+		waiting <- result{size: size, err: err}
+	}()
+
+	// Cancelling the caller which has started the computation only affects that caller.
+	cancel()
+	require.Equal(t, context.Canceled, <-startingErr)
+
+	close(release)
+	waited := <-waiting
+	require.NoError(t, waited.err)
+	require.Equal(t, int64(42), waited.size)
+
+	// The computed size has been cached.
+	size, err := manager.cachedSize(ctx, "path", func(context.Context) (int64, error) {
+		return 0, errors.New("size should have been cached")
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(42), size)
+}
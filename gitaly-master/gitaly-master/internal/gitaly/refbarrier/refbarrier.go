@@ -0,0 +1,242 @@
+// Package refbarrier provides a barrier which temporarily blocks reference updates of a set of
+// repositories. It is used to capture references of multiple repositories at a single logical
+// point in time.
+package refbarrier
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/repository"
+)
+
+// inFlightTimeout is the time after which an in-flight reference transaction is not waited for
+// anymore. This guards against transactions whose end is never announced, e.g. because the Git
+// process performing them has been killed.
+const inFlightTimeout = 5 * time.Minute
+
+type key struct {
+	storageName  string
+	relativePath string
+}
+
+func newKey(repo repository.GitRepo) key {
+	return key{
+		storageName:  repo.GetStorageName(),
+		relativePath: repo.GetRelativePath(),
+	}
+}
+
+// inFlight tracks reference transactions of a single repository which have entered the barrier
+// but which have not yet left it.
+type inFlight struct {
+	// transactions maps transaction IDs to the expiry timers of their entries. The same
+	// transaction ID may be used by multiple concurrent transactions.
+	transactions map[string][]*time.Timer
+	// drained is closed once all transactions have left the barrier.
+	drained chan struct{}
+}
+
+// Barrier blocks reference updates of frozen repositories. Reference transactions are expected to
+// call Enter before they are committed, which blocks until the repository has been thawed again,
+// and Leave after they have been committed or aborted. A nil Barrier never blocks.
+type Barrier struct {
+	mutex    sync.Mutex
+	frozen   map[key]chan struct{}
+	inFlight map[key]*inFlight
+
+	inFlightTimeout time.Duration
+	// blocked is invoked whenever a call blocks on the barrier. It is only used for testing.
+	blocked func()
+}
+
+// New creates a new Barrier.
+func New() *Barrier {
+	return &Barrier{
+		frozen:          map[key]chan struct{}{},
+		inFlight:        map[key]*inFlight{},
+		inFlightTimeout: inFlightTimeout,
+	}
+}
+
+// Freeze freezes the given repositories so that their references cannot be updated until the
+// returned thaw function is called. If any of the repositories is already frozen, Freeze waits
+// until it has been thawed. Freeze furthermore waits for all reference transactions which have
+// entered the barrier before the repositories were frozen to leave it again. Freezing
+// repositories via a nil Barrier is a no-op.
+func (b *Barrier) Freeze(ctx context.Context, repos ...repository.GitRepo) (func(), error) {
+	if b == nil {
+		return func() {}, nil
+	}
+
+	for {
+		b.mutex.Lock()
+
+		var blocking chan struct{}
+		for _, repo := range repos {
+			if thawed, ok := b.frozen[newKey(repo)]; ok {
+				blocking = thawed
+				break
+			}
+		}
+
+		if blocking == nil {
+			break
+		}
+
+		b.mutex.Unlock()
+
+		if err := b.block(ctx, blocking); err != nil {
+			return nil, err
+		}
+	}
+
+	thawed := make(chan struct{})
+	var drained []chan struct{}
+	for _, repo := range repos {
+		b.frozen[newKey(repo)] = thawed
+
+		if transactions, ok := b.inFlight[newKey(repo)]; ok {
+			drained = append(drained, transactions.drained)
+		}
+	}
+
+	b.mutex.Unlock()
+
+	var once sync.Once
+	thaw := func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			defer b.mutex.Unlock()
+
+			for _, repo := range repos {
+				delete(b.frozen, newKey(repo))
+			}
+
+			close(thawed)
+		})
+	}
+
+	// No new transactions can enter the barrier anymore, so we only need to wait for the ones
+	// which are already in flight.
+	for _, ch := range drained {
+		if err := b.block(ctx, ch); err != nil {
+			thaw()
+			return nil, err
+		}
+	}
+
+	return thaw, nil
+}
+
+// Enter blocks until the given repository is not frozen anymore and then registers the reference
+// transaction identified by the given ID as being in flight. Returns an error in case the context
+// is cancelled before the repository has been thawed. Transactions which have entered the barrier
+// must leave it via Leave, or otherwise they are considered to have left it after a timeout.
+func (b *Barrier) Enter(ctx context.Context, repo repository.GitRepo, transactionID string) error {
+	if b == nil {
+		return nil
+	}
+
+	key := newKey(repo)
+
+	for {
+		b.mutex.Lock()
+
+		thawed, ok := b.frozen[key]
+		if !ok {
+			break
+		}
+
+		b.mutex.Unlock()
+
+		if err := b.block(ctx, thawed); err != nil {
+			return err
+		}
+	}
+	defer b.mutex.Unlock()
+
+	transactions, ok := b.inFlight[key]
+	if !ok {
+		transactions = &inFlight{
+			transactions: map[string][]*time.Timer{},
+			drained:      make(chan struct{}),
+		}
+		b.inFlight[key] = transactions
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(b.inFlightTimeout, func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+
+		b.leave(key, transactionID, timer)
+	})
+	transactions.transactions[transactionID] = append(transactions.transactions[transactionID], timer)
+
+	return nil
+}
+
+// Leave announces that the reference transaction identified by the given ID has been committed or
+// aborted. Leaving the barrier with a transaction that hasn't entered it is a no-op.
+func (b *Barrier) Leave(repo repository.GitRepo, transactionID string) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.leave(newKey(repo), transactionID, nil)
+}
+
+// leave removes the entry of the given transaction which is tracked via the given timer, or its
+// oldest entry if no timer is given. The caller must hold the mutex.
+func (b *Barrier) leave(key key, transactionID string, timer *time.Timer) {
+	transactions, ok := b.inFlight[key]
+	if !ok {
+		return
+	}
+
+	timers := transactions.transactions[transactionID]
+	for i, t := range timers {
+		if timer != nil && t != timer {
+			continue
+		}
+
+		t.Stop()
+		timers = append(timers[:i], timers[i+1:]...)
+		break
+	}
+
+	if len(timers) > 0 {
+		transactions.transactions[transactionID] = timers
+		return
+	}
+	delete(transactions.transactions, transactionID)
+
+	if len(transactions.transactions) == 0 {
+		delete(b.inFlight, key)
+		close(transactions.drained)
+	}
+}
+
+// block waits until the given channel is closed or the context is cancelled.
+func (b *Barrier) block(ctx context.Context, ch chan struct{}) error {
+	if b.blocked != nil {
+		select {
+		case <-ch:
+			return nil
+		default:
+			b.blocked()
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ch:
+		return nil
+	}
+}
@@ -0,0 +1,229 @@
+package refbarrier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func TestBarrier(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	repoA := &gitalypb.Repository{StorageName: "default", RelativePath: "a.git"}
+	repoB := &gitalypb.Repository{StorageName: "default", RelativePath: "b.git"}
+	otherStorage := &gitalypb.Repository{StorageName: "other", RelativePath: "a.git"}
+
+	// newBarrier creates a new barrier which notifies the returned channel whenever a call
+	// blocks on it.
+	newBarrier := func() (*Barrier, <-chan struct{}) {
+		blocked := make(chan struct{}, 16)
+
+		barrier := New()
+		barrier.blocked = func() {
+			blocked <- struct{}{}
+		}
+
+		return barrier, blocked
+	}
+
+	cancelledContext := func() context.Context {
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		return cancelledCtx
+	}
+
+	t.Run("nil barrier", func(t *testing.T) {
+		var barrier *Barrier
+		require.NoError(t, barrier.Enter(ctx, repoA, "tx"))
+		barrier.Leave(repoA, "tx")
+
+		thaw, err := barrier.Freeze(ctx, repoA)
+		require.NoError(t, err)
+		thaw()
+	})
+
+	t.Run("unfrozen repository", func(t *testing.T) {
+		barrier := New()
+
+		require.NoError(t, barrier.Enter(ctx, repoA, "tx"))
+		barrier.Leave(repoA, "tx")
+
+		// Leaving with unknown transactions is fine.
+		barrier.Leave(repoA, "unknown")
+	})
+
+	t.Run("frozen repository", func(t *testing.T) {
+		barrier, blocked := newBarrier()
+
+		thaw, err := barrier.Freeze(ctx, repoA)
+		require.NoError(t, err)
+
+		// Other repositories are not blocked.
+		require.NoError(t, barrier.Enter(ctx, repoB, "tx"))
+		barrier.Leave(repoB, "tx")
+		require.NoError(t, barrier.Enter(ctx, otherStorage, "tx"))
+		barrier.Leave(otherStorage, "tx")
+
+		thawing := make(chan struct{})
+		enterErr := make(chan error, 1)
+		go func() {
+			err := barrier.Enter(ctx, repoA, "tx")
+
+			select {
+			case <-thawing:
+				enterErr <- err
+			default:
+				enterErr <- errors.New("enter returned while repository was frozen")
+			}
+		}()
+
+		<-blocked
+		close(thawing)
+		thaw()
+		require.NoError(t, <-enterErr)
+		barrier.Leave(repoA, "tx")
+
+		// Thawing multiple times is fine.
+		thaw()
+		require.NoError(t, barrier.Enter(ctx, repoA, "tx"))
+		barrier.Leave(repoA, "tx")
+	})
+
+	t.Run("cancelled enter", func(t *testing.T) {
+		barrier := New()
+
+		thaw, err := barrier.Freeze(ctx, repoA)
+		require.NoError(t, err)
+		defer thaw()
+
+		require.Equal(t, context.Canceled, barrier.Enter(cancelledContext(), repoA, "tx"))
+	})
+
+	t.Run("in-flight transactions", func(t *testing.T) {
+		barrier, blocked := newBarrier()
+
+		// The same transaction ID may be in flight multiple times.
+		require.NoError(t, barrier.Enter(ctx, repoA, "tx"))
+		require.NoError(t, barrier.Enter(ctx, repoA, "tx"))
+		require.NoError(t, barrier.Enter(ctx, repoB, "other"))
+
+		_, err := barrier.Freeze(cancelledContext(), repoA)
+		require.Equal(t, context.Canceled, err)
+		<-blocked
+
+		// The failed freeze has thawed the repository again.
+		require.NoError(t, barrier.Enter(ctx, repoA, "tx"))
+		barrier.Leave(repoA, "tx")
+
+		barrier.Leave(repoA, "tx")
+		_, err = barrier.Freeze(cancelledContext(), repoA)
+		require.Equal(t, context.Canceled, err)
+		<-blocked
+
+		leaving := make(chan struct{})
+		type result struct {
+			thaw func()
+			err  error
+		}
+
+		freezeResult := make(chan result, 1)
+		go func() {
+			thaw, err := barrier.Freeze(ctx, repoA)
+
+			select {
+			case <-leaving:
+				freezeResult <- result{thaw: thaw, err: err}
+			default:
+				freezeResult <- result{err: errors.New("freeze returned while transaction was in flight")}
+			}
+		}()
+
+		<-blocked
+		close(leaving)
+		barrier.Leave(repoA, "tx")
+
+		frozen := <-freezeResult
+		require.NoError(t, frozen.err)
+
+		// New transactions cannot enter the frozen repository.
+		require.Equal(t, context.Canceled, barrier.Enter(cancelledContext(), repoA, "tx"))
+		frozen.thaw()
+
+		// Transactions in flight in other repositories are not waited for.
+		thaw, err := barrier.Freeze(ctx, repoA)
+		require.NoError(t, err)
+		thaw()
+		barrier.Leave(repoB, "other")
+	})
+
+	t.Run("expired transactions", func(t *testing.T) {
+		barrier := New()
+		barrier.inFlightTimeout = time.Nanosecond
+
+		require.NoError(t, barrier.Enter(ctx, repoA, "tx"))
+
+		// The transaction never leaves the barrier, but the freeze succeeds once it has
+		// expired.
+		thaw, err := barrier.Freeze(ctx, repoA)
+		require.NoError(t, err)
+		thaw()
+
+		// Leaving after the transaction has expired is fine.
+		barrier.Leave(repoA, "tx")
+	})
+
+	t.Run("overlapping freezes", func(t *testing.T) {
+		barrier, blocked := newBarrier()
+
+		thawA, err := barrier.Freeze(ctx, repoA)
+		require.NoError(t, err)
+
+		// Freezing a disjoint set of repositories doesn't block.
+		thawB, err := barrier.Freeze(ctx, repoB)
+		require.NoError(t, err)
+		thawB()
+
+		_, err = barrier.Freeze(cancelledContext(), repoB, repoA)
+		require.Equal(t, context.Canceled, err)
+		<-blocked
+
+		type result struct {
+			thaw func()
+			err  error
+		}
+
+		thawing := make(chan struct{})
+		freezeResult := make(chan result, 1)
+		go func() {
+			thaw, err := barrier.Freeze(ctx, repoB, repoA)
+
+			select {
+			case <-thawing:
+				freezeResult <- result{thaw: thaw, err: err}
+			default:
+				freezeResult <- result{err: errors.New("freeze returned while repository was frozen")}
+			}
+		}()
+
+		<-blocked
+		close(thawing)
+		thawA()
+
+		frozen := <-freezeResult
+		require.NoError(t, frozen.err)
+
+		// The repository has been frozen again by the second freeze.
+		require.Equal(t, context.Canceled, barrier.Enter(cancelledContext(), repoA, "tx"))
+
+		frozen.thaw()
+		require.NoError(t, barrier.Enter(ctx, repoA, "tx"))
+		require.NoError(t, barrier.Enter(ctx, repoB, "tx"))
+	})
+}
@@ -0,0 +1,326 @@
+// Package reload implements reloading of the Gitaly configuration at runtime. Only a subset of the
+// configuration can be reloaded: changes to any other keys are ignored until Gitaly is restarted.
+package reload
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
+)
+
+const (
+	// KeyConcurrency is the key of the per-RPC concurrency limits.
+	KeyConcurrency = "concurrency"
+	// KeyRateLimiting is the key of the per-RPC rate limits.
+	KeyRateLimiting = "rate_limiting"
+	// KeyPackObjectsLimiting is the key of the pack-objects concurrency limits.
+	KeyPackObjectsLimiting = "pack_objects_limiting"
+	// KeyLogging is the key of the logging configuration.
+	KeyLogging = "logging"
+	// KeyPackObjectsCache is the key of the pack-objects cache configuration.
+	KeyPackObjectsCache = "pack_objects_cache"
+	// KeyDailyMaintenance is the key of the daily maintenance schedule.
+	KeyDailyMaintenance = "daily_maintenance"
+	// KeyCgroups is the key of the cgroups configuration.
+	KeyCgroups = "cgroups"
+)
+
+// reloadableKeys maps the top-level keys that can be reloaded to a function that returns the
+// nested keys whose change nevertheless requires a restart. A key is only reloaded if none of
+// its nested restart-only keys have changed.
+var reloadableKeys = map[string]func(current, updated config.Cfg) []string{
+	KeyConcurrency:  nil,
+	KeyRateLimiting: nil,
+	KeyPackObjectsLimiting: func(current, updated config.Cfg) []string {
+		// The key is used as label of the pack-objects concurrency monitor, which is
+		// registered once at startup.
+		if current.PackObjectsLimiting.Key != updated.PackObjectsLimiting.Key {
+			return []string{"pack_objects_limiting.key"}
+		}
+		return nil
+	},
+	KeyLogging: func(current, updated config.Cfg) []string {
+		var keys []string
+		if current.Logging.Dir != updated.Logging.Dir {
+			keys = append(keys, "logging.dir")
+		}
+		if current.Logging.Sentry != updated.Logging.Sentry {
+			keys = append(keys, "logging.sentry")
+		}
+		if current.Logging.RubySentryDSN != updated.Logging.RubySentryDSN {
+			keys = append(keys, "logging.ruby_sentry_dsn")
+		}
+		return keys
+	},
+	KeyPackObjectsCache: func(current, updated config.Cfg) []string {
+		// Whether the pack-objects hook is invoked at all is decided when setting up the
+		// Git command factory.
+		if current.PackObjectsCache.Enabled != updated.PackObjectsCache.Enabled {
+			return []string{"pack_objects_cache.enabled"}
+		}
+		return nil
+	},
+	KeyDailyMaintenance: nil,
+	KeyCgroups: func(current, updated config.Cfg) []string {
+		// The cgroup hierarchy is created once at startup, so only limits can be changed.
+		var keys []string
+		if current.Cgroups.Mountpoint != updated.Cgroups.Mountpoint {
+			keys = append(keys, "cgroups.mountpoint")
+		}
+		if current.Cgroups.HierarchyRoot != updated.Cgroups.HierarchyRoot {
+			keys = append(keys, "cgroups.hierarchy_root")
+		}
+		if current.Cgroups.Repositories.Count != updated.Cgroups.Repositories.Count {
+			keys = append(keys, "cgroups.repositories.count")
+		}
+		if !equalClassNames(current.Cgroups, updated.Cgroups) {
+			keys = append(keys, "cgroups.classes")
+		}
+		return keys
+	},
+}
+
+// ErrInvalidConfig is returned when the reloaded configuration cannot be loaded or is invalid.
+var ErrInvalidConfig = errors.New("invalid config")
+
+// ApplyFunc applies the reloaded configuration to a component.
+type ApplyFunc func(config.Cfg) error
+
+type applier struct {
+	key   string
+	apply ApplyFunc
+}
+
+// Result describes the outcome of a reload.
+type Result struct {
+	// ChangedKeys are the keys whose changes have been applied.
+	ChangedKeys []string
+	// RestartRequiredKeys are the keys which have changed but which can only be applied by
+	// restarting Gitaly.
+	RestartRequiredKeys []string
+}
+
+// Reloader reloads the configuration and applies changes of reloadable keys to the components
+// which have been registered for them.
+type Reloader struct {
+	m        sync.Mutex
+	logger   logrus.FieldLogger
+	load     func() (config.Cfg, error)
+	current  config.Cfg
+	appliers []applier
+
+	reloadsTotal     *prometheus.CounterVec
+	changedKeysTotal *prometheus.CounterVec
+}
+
+// NewReloader creates a new Reloader. The current configuration is the configuration Gitaly has
+// been started with, and load is used to load and validate the updated configuration.
+func NewReloader(logger logrus.FieldLogger, current config.Cfg, load func() (config.Cfg, error)) *Reloader {
+	return &Reloader{
+		logger:  logger,
+		load:    load,
+		current: current,
+		reloadsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "gitaly_config_reloads_total",
+				Help: "Number of configuration reloads by their result",
+			},
+			[]string{"result"},
+		),
+		changedKeysTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "gitaly_config_reload_changed_keys_total",
+				Help: "Number of times a configuration key has been changed by a reload",
+			},
+			[]string{"key", "applied"},
+		),
+	}
+}
+
+// Register registers a function that applies changes of the given key. Functions are invoked
+// in the order they have been registered in.
+func (r *Reloader) Register(key string, apply ApplyFunc) {
+	if _, ok := reloadableKeys[key]; !ok {
+		panic(fmt.Sprintf("configuration key %q cannot be reloaded", key))
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.appliers = append(r.appliers, applier{key: key, apply: apply})
+}
+
+// Describe is used to describe Prometheus metrics.
+func (r *Reloader) Describe(descs chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(r, descs)
+}
+
+// Collect is used to collect Prometheus metrics.
+func (r *Reloader) Collect(metrics chan<- prometheus.Metric) {
+	r.reloadsTotal.Collect(metrics)
+	r.changedKeysTotal.Collect(metrics)
+}
+
+// Reload loads the configuration and applies all changes of reloadable keys. The configuration
+// is validated before any change is applied, so an invalid configuration leaves the running
+// configuration untouched.
+func (r *Reloader) Reload() (Result, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	updated, err := r.load()
+	if err != nil {
+		r.reloadsTotal.WithLabelValues("invalid").Inc()
+		r.logger.WithError(err).Error("configuration reload rejected")
+		return Result{}, fmt.Errorf("%w: %s", ErrInvalidConfig, err)
+	}
+
+	var result Result
+	var changed []string
+	applied := r.current
+	for _, key := range changedKeys(r.current, updated) {
+		restartCheck, reloadable := reloadableKeys[key]
+		if !reloadable {
+			result.RestartRequiredKeys = append(result.RestartRequiredKeys, key)
+			continue
+		}
+
+		if restartCheck != nil {
+			if keys := restartCheck(r.current, updated); len(keys) > 0 {
+				result.RestartRequiredKeys = append(result.RestartRequiredKeys, keys...)
+				continue
+			}
+		}
+
+		copyKey(&applied, updated, key)
+		changed = append(changed, key)
+	}
+
+	failed := map[string]bool{}
+	var applyErrs []error
+	for _, a := range r.appliers {
+		if !contains(changed, a.key) || failed[a.key] {
+			continue
+		}
+
+		if err := a.apply(applied); err != nil {
+			applyErrs = append(applyErrs, fmt.Errorf("%s: %w", a.key, err))
+			failed[a.key] = true
+		}
+	}
+
+	for _, key := range changed {
+		if failed[key] {
+			// Retain the previous value so that the change is retried on the next reload.
+			copyKey(&applied, r.current, key)
+			continue
+		}
+
+		result.ChangedKeys = append(result.ChangedKeys, key)
+		r.changedKeysTotal.WithLabelValues(key, "true").Inc()
+	}
+	for _, key := range result.RestartRequiredKeys {
+		r.changedKeysTotal.WithLabelValues(key, "false").Inc()
+	}
+
+	r.current = applied
+
+	logger := r.logger.WithFields(logrus.Fields{
+		"changed_keys":          result.ChangedKeys,
+		"restart_required_keys": result.RestartRequiredKeys,
+	})
+
+	if len(result.RestartRequiredKeys) > 0 {
+		logger.Warn("configuration keys changed which require a restart to take effect")
+	}
+
+	if len(applyErrs) > 0 {
+		r.reloadsTotal.WithLabelValues("failed").Inc()
+		err := errors.New(joinErrors(applyErrs))
+		logger.WithError(err).Error("configuration reload failed")
+		return result, fmt.Errorf("apply config: %w", err)
+	}
+
+	r.reloadsTotal.WithLabelValues("success").Inc()
+	logger.Info("configuration reloaded")
+
+	return result, nil
+}
+
+// changedKeys returns the top-level keys whose values differ between both configurations.
+func changedKeys(current, updated config.Cfg) []string {
+	currentValue, updatedValue := reflect.ValueOf(current), reflect.ValueOf(updated)
+
+	var keys []string
+	for i := 0; i < currentValue.NumField(); i++ {
+		field := currentValue.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if !reflect.DeepEqual(currentValue.Field(i).Interface(), updatedValue.Field(i).Interface()) {
+			keys = append(keys, tomlKey(field))
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// copyKey copies the value of the given top-level key from the source into the target
+// configuration.
+func copyKey(target *config.Cfg, source config.Cfg, key string) {
+	targetValue, sourceValue := reflect.ValueOf(target).Elem(), reflect.ValueOf(source)
+
+	for i := 0; i < targetValue.NumField(); i++ {
+		if tomlKey(targetValue.Type().Field(i)) == key {
+			targetValue.Field(i).Set(sourceValue.Field(i))
+			return
+		}
+	}
+}
+
+func tomlKey(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("toml"), ","); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+func equalClassNames(current, updated cgroups.Config) bool {
+	if len(current.Classes) != len(updated.Classes) {
+		return false
+	}
+
+	for i := range current.Classes {
+		if current.Classes[i].Name != updated.Classes[i].Name {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func joinErrors(errs []error) string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
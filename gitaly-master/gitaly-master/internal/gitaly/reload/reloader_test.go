@@ -0,0 +1,159 @@
+package reload
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+)
+
+func TestReloader_Reload(t *testing.T) {
+	t.Parallel()
+
+	current := config.Cfg{
+		ListenAddr: "localhost:1234",
+		Concurrency: []config.Concurrency{
+			{RPC: "/gitaly.SmartHTTPService/PostUploadPackWithSidechannel", MaxPerRepo: 10},
+		},
+		PackObjectsLimiting: config.PackObjectsLimiting{
+			Key:            config.PackObjectsLimitingKeyUser,
+			MaxConcurrency: 100,
+		},
+		Cgroups: cgroups.Config{
+			HierarchyRoot: "gitaly",
+			Repositories:  cgroups.Repositories{Count: 10, MemoryBytes: 1024},
+		},
+	}
+
+	updated := current
+	updated.ListenAddr = "localhost:5678"
+	updated.Concurrency = []config.Concurrency{
+		{RPC: "/gitaly.SmartHTTPService/PostUploadPackWithSidechannel", MaxPerRepo: 20},
+	}
+	updated.PackObjectsLimiting.Key = config.PackObjectsLimitingKeyRepository
+	updated.Cgroups.Repositories.MemoryBytes = 2048
+	updated.DailyMaintenance = config.DailyJob{
+		Hour:     1,
+		Duration: duration.Duration(time.Hour),
+		Storages: []string{"default"},
+	}
+
+	reloader := NewReloader(testhelper.NewDiscardingLogger(t), current, func() (config.Cfg, error) {
+		return updated, nil
+	})
+
+	applied := map[string]config.Cfg{}
+	for _, key := range []string{KeyConcurrency, KeyPackObjectsLimiting, KeyCgroups, KeyDailyMaintenance, KeyLogging} {
+		key := key
+		reloader.Register(key, func(cfg config.Cfg) error {
+			applied[key] = cfg
+			return nil
+		})
+	}
+
+	result, err := reloader.Reload()
+	require.NoError(t, err)
+	require.Equal(t, Result{
+		ChangedKeys:         []string{KeyCgroups, KeyConcurrency, KeyDailyMaintenance},
+		RestartRequiredKeys: []string{"listen_addr", "pack_objects_limiting.key"},
+	}, result)
+
+	require.Len(t, applied, 3)
+	for _, cfg := range applied {
+		require.Equal(t, updated.Concurrency, cfg.Concurrency)
+		require.Equal(t, updated.Cgroups, cfg.Cgroups)
+		require.Equal(t, updated.DailyMaintenance, cfg.DailyMaintenance)
+		// Keys which require a restart keep their previous values.
+		require.Equal(t, current.ListenAddr, cfg.ListenAddr)
+		require.Equal(t, current.PackObjectsLimiting, cfg.PackObjectsLimiting)
+	}
+
+	// Reloading the same configuration again doesn't apply any changes.
+	applied = map[string]config.Cfg{}
+	result, err = reloader.Reload()
+	require.NoError(t, err)
+	require.Equal(t, Result{
+		RestartRequiredKeys: []string{"listen_addr", "pack_objects_limiting.key"},
+	}, result)
+	require.Empty(t, applied)
+
+	require.NoError(t, testutil.CollectAndCompare(reloader, strings.NewReader(`
+# HELP gitaly_config_reloads_total Number of configuration reloads by their result
+# TYPE gitaly_config_reloads_total counter
+gitaly_config_reloads_total{result="success"} 2
+`), "gitaly_config_reloads_total"))
+}
+
+func TestReloader_Reload_invalidConfig(t *testing.T) {
+	t.Parallel()
+
+	current := config.Cfg{
+		Concurrency: []config.Concurrency{{RPC: "/gitaly.RepositoryService/RepositorySize", MaxPerRepo: 1}},
+	}
+
+	reloader := NewReloader(testhelper.NewDiscardingLogger(t), current, func() (config.Cfg, error) {
+		return config.Cfg{}, errors.New("bin_dir: is not set")
+	})
+	reloader.Register(KeyConcurrency, func(config.Cfg) error {
+		require.FailNow(t, "invalid configuration must not be applied")
+		return nil
+	})
+
+	_, err := reloader.Reload()
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	require.EqualError(t, err, "invalid config: bin_dir: is not set")
+
+	require.NoError(t, testutil.CollectAndCompare(reloader, strings.NewReader(`
+# HELP gitaly_config_reloads_total Number of configuration reloads by their result
+# TYPE gitaly_config_reloads_total counter
+gitaly_config_reloads_total{result="invalid"} 1
+`), "gitaly_config_reloads_total"))
+}
+
+func TestReloader_Reload_applyFailure(t *testing.T) {
+	t.Parallel()
+
+	current := config.Cfg{}
+	updated := config.Cfg{
+		Concurrency:  []config.Concurrency{{RPC: "/gitaly.RepositoryService/RepositorySize", MaxPerRepo: 1}},
+		RateLimiting: []config.RateLimiting{{RPC: "/gitaly.RepositoryService/RepositorySize", Burst: 1}},
+	}
+
+	reloader := NewReloader(testhelper.NewDiscardingLogger(t), current, func() (config.Cfg, error) {
+		return updated, nil
+	})
+
+	applyErr := errors.New("apply failed")
+	reloader.Register(KeyConcurrency, func(config.Cfg) error {
+		return applyErr
+	})
+	reloader.Register(KeyRateLimiting, func(config.Cfg) error {
+		return nil
+	})
+
+	result, err := reloader.Reload()
+	require.EqualError(t, err, "apply config: concurrency: apply failed")
+	require.Equal(t, Result{ChangedKeys: []string{KeyRateLimiting}}, result)
+
+	// The change that failed to apply is retried on the next reload.
+	applyErr = nil
+	result, err = reloader.Reload()
+	require.NoError(t, err)
+	require.Equal(t, Result{ChangedKeys: []string{KeyConcurrency}}, result)
+}
+
+func TestReloader_Register_unreloadableKey(t *testing.T) {
+	t.Parallel()
+
+	reloader := NewReloader(testhelper.NewDiscardingLogger(t), config.Cfg{}, nil)
+	require.PanicsWithValue(t, `configuration key "storage" cannot be reloaded`, func() {
+		reloader.Register("storage", func(config.Cfg) error { return nil })
+	})
+}
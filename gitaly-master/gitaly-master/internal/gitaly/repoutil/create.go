@@ -45,6 +45,18 @@ func WithObjectHash(hash git.ObjectHash) CreateOption {
 	}
 }
 
+// WithReferenceBackend overrides the default reference backend of the created repository. The
+// files backend is Git's default, so the option only has an effect for other backends.
+func WithReferenceBackend(backend git.ReferenceBackend) CreateOption {
+	return func(cfg *createConfig) {
+		if backend == git.ReferenceBackendFiles {
+			return
+		}
+
+		cfg.gitOptions = append(cfg.gitOptions, git.ValueFlag{Name: "--ref-format", Value: backend.Name})
+	}
+}
+
 // WithSkipInit causes Create to skip calling git-init(1) so that the seeding function will be
 // called with a nonexistent target directory. This can be useful when using git-clone(1) to seed
 // the repository.
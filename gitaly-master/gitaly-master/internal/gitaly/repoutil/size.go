@@ -0,0 +1,42 @@
+package repoutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/command"
+)
+
+// DiskUsage returns the on-disk size of the given path in bytes as computed by du(1). Files and
+// directories whose names match any of the given shell patterns are excluded.
+func DiskUsage(ctx context.Context, path string, excludes ...string) (int64, error) {
+	args := []string{"du", "-sk"}
+	for _, exclude := range excludes {
+		args = append(args, "--exclude="+exclude)
+	}
+	args = append(args, path)
+
+	var stdout, stderr bytes.Buffer
+	cmd, err := command.New(ctx, args, command.WithStdout(&stdout), command.WithStderr(&stderr))
+	if err != nil {
+		return 0, fmt.Errorf("spawning du: %w", err)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return 0, fmt.Errorf("computing disk usage: %w, stderr: %q", err, stderr.String())
+	}
+
+	sizeParts := bytes.Split(stdout.Bytes(), []byte("\t"))
+	if len(sizeParts) != 2 {
+		return 0, fmt.Errorf("malformed du output: %q", stdout.String())
+	}
+
+	sizeKiB, err := strconv.ParseInt(string(sizeParts[0]), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing size: %w", err)
+	}
+
+	return sizeKiB * 1024, nil
+}
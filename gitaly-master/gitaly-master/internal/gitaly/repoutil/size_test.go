@@ -0,0 +1,39 @@
+package repoutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+)
+
+func TestDiskUsage(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	dir := testhelper.TempDir(t)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "a"), perm.SharedDir))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "b"), perm.SharedDir))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a", "file"), make([]byte, 10000), perm.SharedFile))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b", "file"), make([]byte, 20000), perm.SharedFile))
+
+	fileA, err := DiskUsage(ctx, filepath.Join(dir, "a"))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, fileA, int64(10000))
+
+	total, err := DiskUsage(ctx, dir)
+	require.NoError(t, err)
+	require.Greater(t, total, fileA+20000)
+
+	excluded, err := DiskUsage(ctx, dir, "b")
+	require.NoError(t, err)
+	require.Less(t, excluded, total)
+	require.GreaterOrEqual(t, excluded, fileA)
+
+	_, err = DiskUsage(ctx, filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
@@ -5,12 +5,15 @@ import (
 	"time"
 
 	grpcmwauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	grpcmwtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	gitalyauth "gitlab.com/gitlab-org/gitaly/v15/auth"
 	gitalycfgauth "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -39,7 +42,29 @@ func checkFunc(conf gitalycfgauth.Config) func(ctx context.Context) (context.Con
 			return ctx, nil
 		}
 
-		err := gitalyauth.CheckToken(ctx, conf.Token, time.Now())
+		if client, ok := certificateClient(ctx, conf.Clients); ok {
+			countStatus(okLabel(conf.Transitioning), conf.Transitioning).Inc()
+			return withClient(ctx, client), nil
+		}
+
+		now := time.Now()
+
+		err := gitalyauth.CheckToken(ctx, conf.Token, now)
+		if status.Code(err) == codes.PermissionDenied {
+			// The request may have been signed with the token of an individual client
+			// instead of with the shared token.
+			for _, client := range conf.Clients {
+				if client.Token == "" {
+					continue
+				}
+
+				if gitalyauth.CheckToken(ctx, client.Token, now) == nil {
+					countStatus(okLabel(conf.Transitioning), conf.Transitioning).Inc()
+					return withClient(ctx, client), nil
+				}
+			}
+		}
+
 		switch status.Code(err) {
 		case codes.OK:
 			countStatus(okLabel(conf.Transitioning), conf.Transitioning).Inc()
@@ -59,6 +84,64 @@ func checkFunc(conf gitalycfgauth.Config) func(ctx context.Context) (context.Con
 	}
 }
 
+type clientKey struct{}
+
+// ClientFromContext returns the client a request has been authenticated as. No client is returned
+// if the request has been authenticated with the shared token or if authentication is disabled,
+// in which case the request is not subject to any per-client restrictions.
+func ClientFromContext(ctx context.Context) (gitalycfgauth.Client, bool) {
+	client, ok := ctx.Value(clientKey{}).(gitalycfgauth.Client)
+	return client, ok
+}
+
+func withClient(ctx context.Context, client gitalycfgauth.Client) context.Context {
+	grpcmwtags.Extract(ctx).Set("grpc.meta.auth_client", client.Name)
+	return context.WithValue(ctx, clientKey{}, client)
+}
+
+// certificateClient returns the client identified by the verified TLS client certificate of the
+// peer, if any.
+func certificateClient(ctx context.Context, clients []gitalycfgauth.Client) (gitalycfgauth.Client, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return gitalycfgauth.Client{}, false
+	}
+
+	authInfo := p.AuthInfo
+	for {
+		// Connections may be multiplexed, in which case the TLS information is wrapped.
+		wrapper, ok := authInfo.(interface{ Unwrap() credentials.AuthInfo })
+		if !ok {
+			break
+		}
+		authInfo = wrapper.Unwrap()
+	}
+
+	tlsInfo, ok := authInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.PeerCertificates) == 0 {
+		return gitalycfgauth.Client{}, false
+	}
+
+	cert := tlsInfo.State.PeerCertificates[0]
+	identities := append([]string{cert.Subject.CommonName}, cert.DNSNames...)
+	identities = append(identities, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+
+	for _, client := range clients {
+		for _, expected := range client.CertificateIdentities {
+			for _, identity := range identities {
+				if identity != "" && identity == expected {
+					return client, true
+				}
+			}
+		}
+	}
+
+	return gitalycfgauth.Client{}, false
+}
+
 func okLabel(transitioning bool) string {
 	if transitioning {
 		// This special value is an extra warning sign to administrators that
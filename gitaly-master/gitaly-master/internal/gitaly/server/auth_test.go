@@ -4,11 +4,19 @@ package server
 
 import (
 	netctx "context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"math/big"
 	"net"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -28,7 +36,9 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service/setup"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitlab"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/middleware/limithandler"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
@@ -192,6 +202,28 @@ func runServer(t *testing.T, cfg config.Cfg) string {
 	t.Helper()
 
 	registry := backchannel.NewRegistry()
+	locator := config.NewLocator(cfg)
+	diskCache := cache.New(cfg, locator)
+	limitHandler := limithandler.New(cfg, limithandler.LimitConcurrencyByRepo, limithandler.WithConcurrencyLimiters)
+
+	srv, err := NewGitalyServerFactory(cfg, testhelper.NewDiscardingLogEntry(t), registry, diskCache, []*limithandler.LimiterMiddleware{limitHandler}).New(false)
+	require.NoError(t, err)
+
+	registerServices(t, cfg, srv, registry)
+
+	serverSocketPath := testhelper.GetTemporaryGitalySocketFileName(t)
+
+	listener, err := net.Listen("unix", serverSocketPath)
+	require.NoError(t, err)
+	t.Cleanup(srv.Stop)
+	go testhelper.MustServe(t, srv, listener)
+
+	return "unix://" + serverSocketPath
+}
+
+func registerServices(t *testing.T, cfg config.Cfg, srv *grpc.Server, registry *backchannel.Registry) {
+	t.Helper()
+
 	conns := client.NewPool()
 	t.Cleanup(func() { conns.Close() })
 	locator := config.NewLocator(cfg)
@@ -199,16 +231,11 @@ func runServer(t *testing.T, cfg config.Cfg) string {
 	gitCmdFactory := gittest.NewCommandFactory(t, cfg)
 	hookManager := hook.NewManager(cfg, locator, gitCmdFactory, txManager, gitlab.NewMockClient(
 		t, gitlab.MockAllowed, gitlab.MockPreReceive, gitlab.MockPostReceive,
-	))
+	), nil, nil)
 	catfileCache := catfile.NewCache(cfg)
 	t.Cleanup(catfileCache.Stop)
-	diskCache := cache.New(cfg, locator)
-	limitHandler := limithandler.New(cfg, limithandler.LimitConcurrencyByRepo, limithandler.WithConcurrencyLimiters)
 	updaterWithHooks := updateref.NewUpdaterWithHooks(cfg, locator, hookManager, gitCmdFactory, catfileCache)
 
-	srv, err := NewGitalyServerFactory(cfg, testhelper.NewDiscardingLogEntry(t), registry, diskCache, []*limithandler.LimiterMiddleware{limitHandler}).New(false)
-	require.NoError(t, err)
-
 	setup.RegisterAll(srv, &service.Dependencies{
 		Cfg:                cfg,
 		GitalyHookManager:  hookManager,
@@ -219,14 +246,6 @@ func runServer(t *testing.T, cfg config.Cfg) string {
 		CatfileCache:       catfileCache,
 		UpdaterWithHooks:   updaterWithHooks,
 	})
-	serverSocketPath := testhelper.GetTemporaryGitalySocketFileName(t)
-
-	listener, err := net.Listen("unix", serverSocketPath)
-	require.NoError(t, err)
-	t.Cleanup(srv.Stop)
-	go testhelper.MustServe(t, srv, listener)
-
-	return "unix://" + serverSocketPath
 }
 
 //go:generate openssl req -newkey rsa:4096 -new -nodes -x509 -days 3650 -out testdata/gitalycert.pem -keyout testdata/gitalykey.pem -subj "/C=US/ST=California/L=San Francisco/O=GitLab/OU=GitLab-Shell/CN=localhost" -addext "subjectAltName = IP:127.0.0.1, DNS:localhost"
@@ -371,3 +390,218 @@ sleep %v
 		}
 	}
 }
+
+func TestAuthorization_clientToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t, testcfg.WithStorages("default", "other"), testcfg.WithBase(config.Cfg{
+		Auth: auth.Config{
+			Token: "shared-secret",
+			Clients: []auth.Client{
+				{
+					Name:       "analytics",
+					Token:      "analytics-secret",
+					Categories: []string{auth.CategoryReadOnly},
+					Storages:   []string{"default"},
+				},
+			},
+		},
+	}))
+	serverSocketPath := runServer(t, cfg)
+
+	newConn := func(t *testing.T, token string) *grpc.ClientConn {
+		conn, err := dial(serverSocketPath, []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithPerRPCCredentials(gitalyauth.RPCCredentialsV2(token)),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { testhelper.MustClose(t, conn) })
+		return conn
+	}
+
+	repo := func(storage string) *gitalypb.Repository {
+		return &gitalypb.Repository{StorageName: storage, RelativePath: "does/not/exist.git"}
+	}
+
+	t.Run("client", func(t *testing.T) {
+		conn := newConn(t, "analytics-secret")
+		repoClient := gitalypb.NewRepositoryServiceClient(conn)
+
+		require.NoError(t, healthCheck(t, conn))
+
+		response, err := repoClient.RepositoryExists(ctx, &gitalypb.RepositoryExistsRequest{Repository: repo("default")})
+		require.NoError(t, err)
+		require.False(t, response.GetExists())
+
+		_, err = repoClient.RepositoryExists(ctx, &gitalypb.RepositoryExistsRequest{Repository: repo("other")})
+		testhelper.RequireGrpcError(t, structerr.NewPermissionDenied(`client "analytics" is not allowed to access storage "other"`), err)
+
+		_, err = repoClient.CreateRepository(ctx, &gitalypb.CreateRepositoryRequest{Repository: repo("default")})
+		testhelper.RequireGrpcError(t, structerr.NewPermissionDenied(
+			`client "analytics" is not allowed to call mutator RPC "/gitaly.RepositoryService/CreateRepository"`,
+		), err)
+
+		stream, err := repoClient.GetInfoAttributes(ctx, &gitalypb.GetInfoAttributesRequest{Repository: repo("other")})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		testhelper.RequireGrpcError(t, structerr.NewPermissionDenied(`client "analytics" is not allowed to access storage "other"`), err)
+
+		_, err = gitalypb.NewServerServiceClient(conn).ServerInfo(ctx, &gitalypb.ServerInfoRequest{})
+		testhelper.RequireGrpcError(t, structerr.NewPermissionDenied(
+			`client "analytics" is not allowed to call "/gitaly.ServerService/ServerInfo"`,
+		), err)
+	})
+
+	t.Run("shared token", func(t *testing.T) {
+		conn := newConn(t, "shared-secret")
+
+		response, err := gitalypb.NewRepositoryServiceClient(conn).RepositoryExists(ctx, &gitalypb.RepositoryExistsRequest{Repository: repo("other")})
+		require.NoError(t, err)
+		require.False(t, response.GetExists())
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		conn := newConn(t, "unknown-secret")
+
+		_, err := gitalypb.NewRepositoryServiceClient(conn).RepositoryExists(ctx, &gitalypb.RepositoryExistsRequest{Repository: repo("default")})
+		testhelper.RequireGrpcCode(t, err, codes.PermissionDenied)
+	})
+}
+
+func TestAuthorization_clientCertificate(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	caPath, clientCert := generateClientCertificate(t, "analytics.example.com")
+
+	runServer := func(t *testing.T, requireClientCertificate bool) string {
+		cfg := testcfg.Build(t, testcfg.WithBase(config.Cfg{
+			Auth: auth.Config{
+				Token: "shared-secret",
+				Clients: []auth.Client{
+					{
+						Name:                  "analytics",
+						CertificateIdentities: []string{"analytics.example.com"},
+						Categories:            []string{auth.CategoryReadOnly},
+					},
+				},
+			},
+			TLS: config.TLS{
+				CertPath:                 "testdata/gitalycert.pem",
+				KeyPath:                  "testdata/gitalykey.pem",
+				ClientCAPath:             caPath,
+				RequireClientCertificate: requireClientCertificate,
+			},
+		}))
+
+		registry := backchannel.NewRegistry()
+		srv, err := NewGitalyServerFactory(
+			cfg,
+			testhelper.NewDiscardingLogEntry(t),
+			registry,
+			cache.New(cfg, config.NewLocator(cfg)),
+			nil,
+		).New(true)
+		require.NoError(t, err)
+
+		registerServices(t, cfg, srv, registry)
+
+		listener, hostPort := testhelper.GetLocalhostListener(t)
+		t.Cleanup(srv.Stop)
+		go testhelper.MustServe(t, srv, listener)
+
+		return hostPort
+	}
+
+	dialTLS := func(t *testing.T, addr string, certificates ...tls.Certificate) *grpc.ClientConn {
+		certPool := x509.NewCertPool()
+		require.True(t, certPool.AppendCertsFromPEM(testhelper.MustReadFile(t, "testdata/gitalycert.pem")))
+
+		conn, err := dial(addr, []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+				RootCAs:      certPool,
+				Certificates: certificates,
+				MinVersion:   tls.VersionTLS12,
+			})),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { testhelper.MustClose(t, conn) })
+		return conn
+	}
+
+	repo := &gitalypb.Repository{StorageName: "default", RelativePath: "does/not/exist.git"}
+
+	t.Run("client certificate", func(t *testing.T) {
+		conn := dialTLS(t, runServer(t, false), clientCert)
+		repoClient := gitalypb.NewRepositoryServiceClient(conn)
+
+		response, err := repoClient.RepositoryExists(ctx, &gitalypb.RepositoryExistsRequest{Repository: repo})
+		require.NoError(t, err)
+		require.False(t, response.GetExists())
+
+		_, err = repoClient.CreateRepository(ctx, &gitalypb.CreateRepositoryRequest{Repository: repo})
+		testhelper.RequireGrpcError(t, structerr.NewPermissionDenied(
+			`client "analytics" is not allowed to call mutator RPC "/gitaly.RepositoryService/CreateRepository"`,
+		), err)
+	})
+
+	t.Run("without client certificate", func(t *testing.T) {
+		conn := dialTLS(t, runServer(t, false))
+
+		_, err := gitalypb.NewRepositoryServiceClient(conn).RepositoryExists(ctx, &gitalypb.RepositoryExistsRequest{Repository: repo})
+		testhelper.RequireGrpcCode(t, err, codes.Unauthenticated)
+	})
+
+	t.Run("required client certificate", func(t *testing.T) {
+		addr := runServer(t, true)
+
+		require.NoError(t, healthCheck(t, dialTLS(t, addr, clientCert)))
+		testhelper.RequireGrpcCode(t, healthCheck(t, dialTLS(t, addr)), codes.Unavailable)
+	})
+}
+
+// generateClientCertificate generates a certificate authority and a client certificate signed by
+// it that has the given identity as DNS name. It returns the path to the certificate authority and
+// the client certificate.
+func generateClientCertificate(t *testing.T, identity string) (string, tls.Certificate) {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Client CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(0, 0, 1),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	caCert, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	clientCert, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "client"},
+		DNSNames:     []string{identity},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().AddDate(0, 0, 1),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, caTemplate, &clientKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	caPath := filepath.Join(testhelper.TempDir(t), "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert}), perm.SharedFile))
+
+	return caPath, tls.Certificate{
+		Certificate: [][]byte{clientCert},
+		PrivateKey:  clientKey,
+	}
+}
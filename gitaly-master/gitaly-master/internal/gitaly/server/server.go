@@ -17,6 +17,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/listenmux"
 	gitalylog "gitlab.com/gitlab-org/gitaly/v15/internal/log"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/logsanitizer"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/middleware/authorizationhandler"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/middleware/cache"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/middleware/commandstatshandler"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/middleware/featureflag"
@@ -27,6 +28,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/middleware/statushandler"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/protoregistry"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/tracing"
 	grpccorrelation "gitlab.com/gitlab-org/labkit/correlation/grpc"
 	grpctracing "gitlab.com/gitlab-org/labkit/tracing/grpc"
 	"google.golang.org/grpc"
@@ -92,10 +94,26 @@ func (s *GitalyServerFactory) New(secure bool, opts ...Option) (*grpc.Server, er
 			return nil, fmt.Errorf("error reading certificate and key paths: %v", err)
 		}
 
-		transportCredentials = credentials.NewTLS(&tls.Config{
+		tlsConfig := &tls.Config{
 			Certificates: []tls.Certificate{cert},
 			MinVersion:   tls.VersionTLS12,
-		})
+		}
+
+		// Client certificates are used to identify clients for authorization, so they are
+		// only verified when the certificate authorities have been configured.
+		if s.cfg.TLS.ClientCAPath != "" {
+			tlsConfig.ClientCAs, err = s.cfg.TLS.ClientCertPool()
+			if err != nil {
+				return nil, fmt.Errorf("loading client certificate authorities: %w", err)
+			}
+
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+			if s.cfg.TLS.RequireClientCertificate {
+				tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+		}
+
+		transportCredentials = credentials.NewTLS(tlsConfig)
 	}
 
 	lm := listenmux.New(transportCredentials)
@@ -132,6 +150,7 @@ func (s *GitalyServerFactory) New(secure bool, opts ...Option) (*grpc.Server, er
 		sentryhandler.StreamLogHandler,
 		statushandler.Stream, // Should be below LogHandler
 		auth.StreamServerInterceptor(s.cfg.Auth),
+		authorizationhandler.StreamInterceptor(protoregistry.GitalyProtoPreregistered),
 	}
 	unaryServerInterceptors := []grpc.UnaryServerInterceptor{
 		grpcmwtags.UnaryServerInterceptor(ctxTagOpts...),
@@ -149,6 +168,7 @@ func (s *GitalyServerFactory) New(secure bool, opts ...Option) (*grpc.Server, er
 		sentryhandler.UnaryLogHandler,
 		statushandler.Unary, // Should be below LogHandler
 		auth.UnaryServerInterceptor(s.cfg.Auth),
+		authorizationhandler.UnaryInterceptor(protoregistry.GitalyProtoPreregistered),
 	}
 	// Should be below auth handler to prevent v2 hmac tokens from timing out while queued
 	for _, limitHandler := range s.limitHandlers {
@@ -158,6 +178,7 @@ func (s *GitalyServerFactory) New(secure bool, opts ...Option) (*grpc.Server, er
 
 	streamServerInterceptors = append(streamServerInterceptors,
 		grpctracing.StreamServerTracingInterceptor(),
+		tracing.StreamServerInterceptor(),
 		cache.StreamInvalidator(s.cacheInvalidator, protoregistry.GitalyProtoPreregistered),
 		// Panic handler should remain last so that application panics will be
 		// converted to errors and logged
@@ -166,6 +187,7 @@ func (s *GitalyServerFactory) New(secure bool, opts ...Option) (*grpc.Server, er
 
 	unaryServerInterceptors = append(unaryServerInterceptors,
 		grpctracing.UnaryServerTracingInterceptor(),
+		tracing.UnaryServerInterceptor(),
 		cache.UnaryInvalidator(s.cacheInvalidator, protoregistry.GitalyProtoPreregistered),
 		// Panic handler should remain last so that application panics will be
 		// converted to errors and logged
@@ -32,7 +32,12 @@ func (s *server) FindCommit(ctx context.Context, in *gitalypb.FindCommitRequest)
 		opts = []localrepo.ReadCommitOpt{localrepo.WithTrailers()}
 	}
 
-	commit, err := repo.ReadCommit(ctx, git.Revision(in.GetRevision()), opts...)
+	revision, err := resolveLegacyRevision(ctx, repo, git.Revision(in.GetRevision()))
+	if err != nil {
+		return nil, structerr.NewInternal("%w", err)
+	}
+
+	commit, err := repo.ReadCommit(ctx, revision, opts...)
 	if err != nil {
 		if errors.Is(err, localrepo.ErrObjectNotFound) {
 			return &gitalypb.FindCommitResponse{}, nil
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/oidmap"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
@@ -382,3 +383,37 @@ func TestFindCommitWithCache(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestFindCommit_legacyObjectID(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupCommitService(t, ctx)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+
+	legacyObjectHash := oidmap.LegacyObjectHash(gittest.DefaultObjectHash)
+	legacyOID, err := legacyObjectHash.FromHex(strings.Repeat("1", legacyObjectHash.EncodedLen()))
+	require.NoError(t, err)
+	unmappedOID, err := legacyObjectHash.FromHex(strings.Repeat("2", legacyObjectHash.EncodedLen()))
+	require.NoError(t, err)
+
+	require.NoError(t, oidmap.Write(repoPath, []oidmap.Entry{
+		{LegacyOID: legacyOID, OID: commitID},
+	}))
+
+	response, err := client.FindCommit(ctx, &gitalypb.FindCommitRequest{
+		Repository: repo,
+		Revision:   []byte(legacyOID),
+	})
+	require.NoError(t, err)
+	require.Equal(t, commitID.String(), response.GetCommit().GetId())
+
+	response, err = client.FindCommit(ctx, &gitalypb.FindCommitRequest{
+		Repository: repo,
+		Revision:   []byte(unmappedOID),
+	})
+	require.NoError(t, err)
+	require.Nil(t, response.GetCommit())
+}
@@ -0,0 +1,49 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/oidmap"
+)
+
+// resolveLegacyRevision translates the revision in case it is an object ID in the object format
+// the repository has been converted from. Any other revision is returned unchanged.
+func resolveLegacyRevision(ctx context.Context, repo *localrepo.Repo, revision git.Revision) (git.Revision, error) {
+	// Avoid detecting the object hash for anything that cannot be a legacy object ID.
+	if len(revision) != git.ObjectHashSHA1.EncodedLen() && len(revision) != git.ObjectHashSHA256.EncodedLen() {
+		return revision, nil
+	}
+
+	repoPath, err := repo.Path()
+	if err != nil {
+		return "", fmt.Errorf("getting repository path: %w", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(repoPath, oidmap.Filename)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return revision, nil
+		}
+		return "", fmt.Errorf("statting object ID mapping: %w", err)
+	}
+
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return "", fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	oid, ok, err := oidmap.Lookup(repoPath, objectHash, revision.String())
+	if err != nil {
+		return "", fmt.Errorf("looking up legacy object ID: %w", err)
+	}
+	if !ok {
+		return revision, nil
+	}
+
+	return oid.Revision(), nil
+}
@@ -41,7 +41,12 @@ func (s *server) ListCommitsByOid(in *gitalypb.ListCommitsByOidRequest, stream g
 	listCommitsbyOidHistogram.Observe(float64(len(in.Oid)))
 
 	for _, oid := range in.Oid {
-		commit, err := catfile.GetCommit(ctx, objectReader, git.Revision(oid))
+		revision, err := resolveLegacyRevision(ctx, repo, git.Revision(oid))
+		if err != nil {
+			return structerr.NewInternal("%w", err)
+		}
+
+		commit, err := catfile.GetCommit(ctx, objectReader, revision)
 		if catfile.IsNotFound(err) {
 			continue
 		}
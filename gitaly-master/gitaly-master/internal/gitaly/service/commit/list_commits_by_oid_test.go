@@ -3,9 +3,13 @@
 package commit
 
 import (
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/oidmap"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 	"google.golang.org/grpc/codes"
@@ -201,3 +205,42 @@ func TestListCommitsByOid_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestListCommitsByOid_legacyObjectID(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupCommitService(t, ctx)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+
+	legacyObjectHash := oidmap.LegacyObjectHash(gittest.DefaultObjectHash)
+	legacyOID, err := legacyObjectHash.FromHex(strings.Repeat("1", legacyObjectHash.EncodedLen()))
+	require.NoError(t, err)
+
+	require.NoError(t, oidmap.Write(repoPath, []oidmap.Entry{
+		{LegacyOID: legacyOID, OID: commitID},
+	}))
+
+	stream, err := client.ListCommitsByOid(ctx, &gitalypb.ListCommitsByOidRequest{
+		Repository: repo,
+		Oid:        []string{legacyOID.String(), commitID.String()},
+	})
+	require.NoError(t, err)
+
+	var ids []string
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		for _, commit := range response.GetCommits() {
+			ids = append(ids, commit.GetId())
+		}
+	}
+
+	require.Equal(t, []string{commitID.String(), commitID.String()}, ids)
+}
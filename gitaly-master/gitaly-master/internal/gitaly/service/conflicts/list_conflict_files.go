@@ -2,14 +2,18 @@ package conflicts
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"unicode/utf8"
 
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/quarantine"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git2go"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 	"gitlab.com/gitlab-org/gitaly/v15/streamio"
@@ -34,27 +38,45 @@ func (s *server) ListConflictFiles(request *gitalypb.ListConflictFilesRequest, s
 		return structerr.NewFailedPrecondition("could not lookup 'their' OID: %s", err)
 	}
 
-	repoPath, err := s.locator.GetPath(request.Repository)
-	if err != nil {
-		return err
-	}
+	var conflicts []git2go.Conflict
+	if featureflag.MergeTreeListConflictFiles.IsEnabled(ctx) {
+		// git-merge-tree(1) writes the merged trees and blobs into the object database. This
+		// RPC doesn't modify the repository, so we write them into a quarantine directory that
+		// is never migrated and thus gets discarded when the RPC finishes.
+		quarantineDir, err := quarantine.New(ctx, request.GetRepository(), s.locator)
+		if err != nil {
+			return structerr.NewInternal("creating object quarantine: %w", err)
+		}
 
-	conflicts, err := s.git2goExecutor.Conflicts(ctx, repo, git2go.ConflictsCommand{
-		Repository: repoPath,
-		Ours:       ours.String(),
-		Theirs:     theirs.String(),
-	})
-	if err != nil {
-		if errors.Is(err, git2go.ErrInvalidArgument) {
-			return structerr.NewInvalidArgument("%w", err)
+		conflicts, err = s.conflicts(ctx, s.localrepo(quarantineDir.QuarantinedRepo()), ours, theirs)
+		if err != nil {
+			return structerr.NewInternal("%w", err)
 		}
-		return structerr.NewInternal("%w", err)
+	} else {
+		repoPath, err := s.locator.GetPath(request.Repository)
+		if err != nil {
+			return err
+		}
+
+		result, err := s.git2goExecutor.Conflicts(ctx, repo, git2go.ConflictsCommand{
+			Repository: repoPath,
+			Ours:       ours.String(),
+			Theirs:     theirs.String(),
+		})
+		if err != nil {
+			if errors.Is(err, git2go.ErrInvalidArgument) {
+				return structerr.NewInvalidArgument("%w", err)
+			}
+			return structerr.NewInternal("%w", err)
+		}
+
+		conflicts = result.Conflicts
 	}
 
 	var conflictFiles []*gitalypb.ConflictFile
 	msgSize := 0
 
-	for _, conflict := range conflicts.Conflicts {
+	for _, conflict := range conflicts {
 		if !request.AllowTreeConflicts && (conflict.Their.Path == "" || conflict.Our.Path == "") {
 			return structerr.NewFailedPrecondition("conflict side missing")
 		}
@@ -126,6 +148,52 @@ func (s *server) ListConflictFiles(request *gitalypb.ListConflictFilesRequest, s
 	return nil
 }
 
+// conflicts computes the conflicts of merging theirs into ours via git-merge-tree(1). Conflicts are
+// returned in the same format as the ones computed by gitaly-git2go so that both implementations
+// can share the remaining logic.
+func (s *server) conflicts(ctx context.Context, repo *localrepo.Repo, ours, theirs git.ObjectID) ([]git2go.Conflict, error) {
+	_, err := repo.MergeTree(ctx, ours.String(), theirs.String(),
+		localrepo.WithAllowUnrelatedHistories(),
+		localrepo.WithConflictingFileInfo(),
+	)
+	if err == nil {
+		return nil, nil
+	}
+
+	var mergeTreeErr *localrepo.MergeTreeError
+	if !errors.As(err, &mergeTreeErr) {
+		return nil, fmt.Errorf("merging trees: %w", err)
+	}
+
+	var conflicts []git2go.Conflict
+	for _, conflict := range mergeTreeErr.Conflicts() {
+		content, err := repo.MergeFile(ctx, conflict)
+		if err != nil {
+			return nil, fmt.Errorf("merging file: %w", err)
+		}
+
+		conflicts = append(conflicts, git2go.Conflict{
+			Ancestor: conflictEntry(conflict.Ancestor),
+			Our:      conflictEntry(conflict.Ours),
+			Their:    conflictEntry(conflict.Theirs),
+			Content:  content,
+		})
+	}
+
+	return conflicts, nil
+}
+
+func conflictEntry(info *localrepo.ConflictingFileInfo) git2go.ConflictEntry {
+	if info == nil {
+		return git2go.ConflictEntry{}
+	}
+
+	return git2go.ConflictEntry{
+		Path: info.FileName,
+		Mode: info.Mode,
+	}
+}
+
 func validateListConflictFilesRequest(in *gitalypb.ListConflictFilesRequest) error {
 	if err := service.ValidateRepository(in.GetRepository()); err != nil {
 		return err
@@ -3,12 +3,14 @@
 package conflicts
 
 import (
+	"context"
 	"io"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 	"google.golang.org/grpc/codes"
@@ -21,8 +23,12 @@ type conflictFile struct {
 }
 
 func TestSuccessfulListConflictFilesRequest(t *testing.T) {
-	ctx := testhelper.Context(t)
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.MergeTreeListConflictFiles).Run(t, testSuccessfulListConflictFilesRequest)
+}
 
+func testSuccessfulListConflictFilesRequest(t *testing.T, ctx context.Context) {
 	_, repo, _, client := setupConflictsService(t, ctx, nil)
 
 	ourCommitOid := "1a35b5a77cf6af7edf6703f88e82f6aff613666f"
@@ -81,8 +87,12 @@ end
 }
 
 func TestSuccessfulListConflictFilesRequestWithAncestor(t *testing.T) {
-	ctx := testhelper.Context(t)
+	t.Parallel()
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeListConflictFiles).Run(t, testSuccessfulListConflictFilesRequestWithAncestor)
+}
+
+func testSuccessfulListConflictFilesRequestWithAncestor(t *testing.T, ctx context.Context) {
 	_, repo, _, client := setupConflictsService(t, ctx, nil)
 
 	ourCommitOid := "824be604a34828eb682305f0d963056cfac87b2d"
@@ -127,8 +137,12 @@ func TestSuccessfulListConflictFilesRequestWithAncestor(t *testing.T) {
 }
 
 func TestListConflictFilesHugeDiff(t *testing.T) {
-	ctx := testhelper.Context(t)
+	t.Parallel()
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeListConflictFiles).Run(t, testListConflictFilesHugeDiff)
+}
+
+func testListConflictFilesHugeDiff(t *testing.T, ctx context.Context) {
 	cfg, repo, repoPath, client := setupConflictsService(t, ctx, nil)
 
 	ourCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
@@ -166,8 +180,12 @@ func TestListConflictFilesHugeDiff(t *testing.T) {
 }
 
 func TestListConflictFilesFailedPrecondition(t *testing.T) {
-	ctx := testhelper.Context(t)
+	t.Parallel()
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeListConflictFiles).Run(t, testListConflictFilesFailedPrecondition)
+}
+
+func testListConflictFilesFailedPrecondition(t *testing.T, ctx context.Context) {
 	_, repo, _, client := setupConflictsService(t, ctx, nil)
 
 	testCases := []struct {
@@ -223,8 +241,12 @@ func TestListConflictFilesFailedPrecondition(t *testing.T) {
 }
 
 func TestListConflictFilesAllowTreeConflicts(t *testing.T) {
-	ctx := testhelper.Context(t)
+	t.Parallel()
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeListConflictFiles).Run(t, testListConflictFilesAllowTreeConflicts)
+}
+
+func testListConflictFilesAllowTreeConflicts(t *testing.T, ctx context.Context) {
 	_, repo, _, client := setupConflictsService(t, ctx, nil)
 
 	ourCommitOid := "eb227b3e214624708c474bdab7bde7afc17cefcc"
@@ -314,6 +336,91 @@ end
 	testhelper.ProtoEqual(t, expectedFiles, getConflictFiles(t, c))
 }
 
+func TestListConflictFiles_mergeTreeMatchesGit2Go(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	_, repo, _, client := setupConflictsService(t, ctx, nil)
+
+	for _, tc := range []struct {
+		desc               string
+		ourCommitOid       string
+		theirCommitOid     string
+		allowTreeConflicts bool
+	}{
+		{
+			desc:           "content conflicts",
+			ourCommitOid:   "1a35b5a77cf6af7edf6703f88e82f6aff613666f",
+			theirCommitOid: "8309e68585b28d61eb85b7e2834849dda6bf1733",
+		},
+		{
+			desc:           "conflicts with ancestor",
+			ourCommitOid:   "824be604a34828eb682305f0d963056cfac87b2d",
+			theirCommitOid: "1450cd639e0bc6721eb02800169e464f212cde06",
+		},
+		{
+			desc:               "tree conflicts",
+			ourCommitOid:       "eb227b3e214624708c474bdab7bde7afc17cefcc",
+			theirCommitOid:     "824be604a34828eb682305f0d963056cfac87b2d",
+			allowTreeConflicts: true,
+		},
+		{
+			desc:           "tree conflicts not allowed",
+			ourCommitOid:   "eb227b3e214624708c474bdab7bde7afc17cefcc",
+			theirCommitOid: "824be604a34828eb682305f0d963056cfac87b2d",
+		},
+		{
+			desc:           "submodule object lookup error",
+			ourCommitOid:   "de78448b0b504f3f60093727bddfda1ceee42345",
+			theirCommitOid: "2f61d70f862c6a4f782ef7933e020a118282db29",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			request := &gitalypb.ListConflictFilesRequest{
+				Repository:         repo,
+				OurCommitOid:       tc.ourCommitOid,
+				TheirCommitOid:     tc.theirCommitOid,
+				AllowTreeConflicts: tc.allowTreeConflicts,
+			}
+
+			listConflictFiles := func(mergeTree bool) ([]*conflictFile, codes.Code) {
+				ctx := featureflag.ContextWithFeatureFlag(ctx, featureflag.MergeTreeListConflictFiles, mergeTree)
+
+				c, err := client.ListConflictFiles(ctx, request)
+				require.NoError(t, err)
+
+				var files []*conflictFile
+				for {
+					response, err := c.Recv()
+					if err == io.EOF {
+						return files, codes.OK
+					} else if err != nil {
+						return nil, status.Code(err)
+					}
+
+					for _, file := range response.GetFiles() {
+						if header := file.GetHeader(); header != nil {
+							files = append(files, &conflictFile{Header: header})
+						} else {
+							files[len(files)-1].Content = append(files[len(files)-1].Content, file.GetContent()...)
+						}
+					}
+				}
+			}
+
+			git2goFiles, git2goCode := listConflictFiles(false)
+			mergeTreeFiles, mergeTreeCode := listConflictFiles(true)
+
+			require.Equal(t, git2goCode, mergeTreeCode)
+			testhelper.ProtoEqual(t, git2goFiles, mergeTreeFiles)
+		})
+	}
+}
+
 func TestFailedListConflictFilesRequestDueToValidation(t *testing.T) {
 	ctx := testhelper.Context(t)
 
@@ -15,11 +15,13 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/conflict"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/lstree"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/quarantine"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/remoterepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/repository"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git2go"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 )
@@ -157,11 +159,6 @@ func (s *server) resolveConflicts(header *gitalypb.ResolveConflictsRequestHeader
 		return err
 	}
 
-	repoPath, err := s.locator.GetRepoPath(quarantineRepo)
-	if err != nil {
-		return err
-	}
-
 	authorDate := time.Now()
 	if header.Timestamp != nil {
 		authorDate = header.Timestamp.AsTime()
@@ -172,28 +169,41 @@ func (s *server) resolveConflicts(header *gitalypb.ResolveConflictsRequestHeader
 		return errors.New("Rugged::InvalidError: unable to parse OID - contains invalid characters")
 	}
 
-	result, err := s.git2goExecutor.Resolve(ctx, quarantineRepo, git2go.ResolveCommand{
-		MergeCommand: git2go.MergeCommand{
-			Repository: repoPath,
-			AuthorName: string(header.User.Name),
-			AuthorMail: string(header.User.Email),
-			AuthorDate: authorDate,
-			Message:    string(header.CommitMessage),
-			Ours:       header.GetOurCommitOid(),
-			Theirs:     header.GetTheirCommitOid(),
-		},
-		Resolutions: resolutions,
-	})
-	if err != nil {
-		if errors.Is(err, git2go.ErrInvalidArgument) {
-			return structerr.NewInvalidArgument("%w", err)
+	var commitOID git.ObjectID
+	if featureflag.MergeTreeResolveConflicts.IsEnabled(ctx) {
+		commitOID, err = s.resolveConflictsWithMergeTree(ctx, quarantineRepo, header, resolutions, authorDate)
+		if err != nil {
+			return err
+		}
+	} else {
+		repoPath, err := s.locator.GetRepoPath(quarantineRepo)
+		if err != nil {
+			return err
 		}
-		return err
-	}
 
-	commitOID, err := git.ObjectHashSHA1.FromHex(result.CommitID)
-	if err != nil {
-		return err
+		result, err := s.git2goExecutor.Resolve(ctx, quarantineRepo, git2go.ResolveCommand{
+			MergeCommand: git2go.MergeCommand{
+				Repository: repoPath,
+				AuthorName: string(header.User.Name),
+				AuthorMail: string(header.User.Email),
+				AuthorDate: authorDate,
+				Message:    string(header.CommitMessage),
+				Ours:       header.GetOurCommitOid(),
+				Theirs:     header.GetTheirCommitOid(),
+			},
+			Resolutions: resolutions,
+		})
+		if err != nil {
+			if errors.Is(err, git2go.ErrInvalidArgument) {
+				return structerr.NewInvalidArgument("%w", err)
+			}
+			return err
+		}
+
+		commitOID, err = git.ObjectHashSHA1.FromHex(result.CommitID)
+		if err != nil {
+			return err
+		}
 	}
 
 	if err := s.updater.UpdateReference(
@@ -211,6 +221,223 @@ func (s *server) resolveConflicts(header *gitalypb.ResolveConflictsRequestHeader
 	return nil
 }
 
+// resolveConflictsWithMergeTree merges theirs into ours via git-merge-tree(1) and resolves all
+// conflicts with the given resolutions. It returns the resulting merge commit. Errors match the
+// ones returned by gitaly-git2go so that they are handled the same by handleResolveConflictsErr.
+func (s *server) resolveConflictsWithMergeTree(
+	ctx context.Context,
+	repo *localrepo.Repo,
+	header *gitalypb.ResolveConflictsRequestHeader,
+	resolutions []conflict.Resolution,
+	authorDate time.Time,
+) (git.ObjectID, error) {
+	ours := git.ObjectID(header.GetOurCommitOid())
+	theirs := git.ObjectID(header.GetTheirCommitOid())
+
+	treeOID, err := repo.MergeTree(ctx, ours.String(), theirs.String(),
+		localrepo.WithAllowUnrelatedHistories(),
+		localrepo.WithConflictingFileInfo(),
+	)
+
+	var conflicts []localrepo.Conflict
+	if err != nil {
+		var mergeTreeErr *localrepo.MergeTreeError
+		if !errors.As(err, &mergeTreeErr) {
+			return "", fmt.Errorf("merging trees: %w", err)
+		}
+
+		// The tree written by git-merge-tree(1) contains conflict markers for all conflicting
+		// files, which we replace with their resolved contents below.
+		treeOID = mergeTreeErr.TreeOID
+		conflicts = mergeTreeErr.Conflicts()
+	}
+
+	type paths struct {
+		theirs, ours string
+	}
+	unresolvedConflicts := map[paths]localrepo.Conflict{}
+
+	for _, c := range conflicts {
+		if c.Ours == nil || c.Theirs == nil {
+			return "", errors.New("conflict side missing")
+		}
+
+		unresolvedConflicts[paths{theirs: c.Theirs.FileName, ours: c.Ours.FileName}] = c
+	}
+
+	resolvedEntries := map[string]localrepo.TreeEntry{}
+	for _, r := range resolutions {
+		key := paths{theirs: r.OldPath, ours: r.NewPath}
+
+		c, ok := unresolvedConflicts[key]
+		if !ok {
+			// Note: this emulates the Ruby error that occurs when there are no conflicts
+			// for a resolution
+			return "", errors.New("NoMethodError: undefined method `resolve_lines' for nil:NilClass")
+		}
+
+		contents, err := repo.MergeFile(ctx, c)
+		if err != nil {
+			return "", fmt.Errorf("merge file result for %q: %w", r.NewPath, err)
+		}
+
+		if r.Content != "" && bytes.Equal([]byte(r.Content), contents) {
+			return "", fmt.Errorf("Resolved content has no changes for file %s", r.NewPath) //nolint
+		}
+
+		ancestor, our, their, err := readConflictEntries(ctx, repo, c)
+		if err != nil {
+			return "", fmt.Errorf("read conflict entries: %w", err)
+		}
+
+		conflictFile, err := conflict.Parse(bytes.NewReader(contents), ancestor, our, their)
+		if err != nil {
+			return "", fmt.Errorf("parse conflict for %q: %w", c.Ours.FileName, err)
+		}
+
+		resolvedBlob, err := conflictFile.Resolve(r)
+		if err != nil {
+			return "", err // do not decorate this error to satisfy old test
+		}
+
+		resolvedBlobOID, err := repo.WriteBlob(ctx, c.Ours.FileName, bytes.NewReader(resolvedBlob))
+		if err != nil {
+			return "", fmt.Errorf("write object for %q: %w", c.Ours.FileName, err)
+		}
+
+		resolvedEntries[c.Ours.FileName] = localrepo.TreeEntry{
+			OID:  resolvedBlobOID,
+			Mode: fmt.Sprintf("%o", c.Ours.Mode),
+			Type: localrepo.Blob,
+		}
+		delete(unresolvedConflicts, key)
+	}
+
+	if len(unresolvedConflicts) > 0 {
+		var conflictPaths []string
+		for _, c := range conflicts {
+			if _, ok := unresolvedConflicts[paths{theirs: c.Theirs.FileName, ours: c.Ours.FileName}]; !ok {
+				continue
+			}
+
+			if c.Ancestor != nil {
+				conflictPaths = append(conflictPaths, c.Ancestor.FileName)
+			} else {
+				conflictPaths = append(conflictPaths, c.Ours.FileName)
+			}
+		}
+
+		return "", fmt.Errorf("Missing resolutions for the following files: %s", strings.Join(conflictPaths, ", ")) //nolint
+	}
+
+	treeOID, err = replaceTreeEntries(ctx, repo, treeOID, resolvedEntries)
+	if err != nil {
+		return "", fmt.Errorf("writing resolved tree: %w", err)
+	}
+
+	commitOID, err := repo.WriteCommit(ctx, localrepo.WriteCommitConfig{
+		TreeID:         treeOID,
+		Parents:        []git.ObjectID{ours, theirs},
+		AuthorName:     string(header.GetUser().GetName()),
+		AuthorEmail:    string(header.GetUser().GetEmail()),
+		AuthorDate:     authorDate,
+		CommitterName:  string(header.GetUser().GetName()),
+		CommitterEmail: string(header.GetUser().GetEmail()),
+		CommitterDate:  authorDate,
+		Message:        string(header.GetCommitMessage()),
+	})
+	if err != nil {
+		return "", fmt.Errorf("create commit: %w", err)
+	}
+
+	return commitOID, nil
+}
+
+func readConflictEntries(ctx context.Context, repo *localrepo.Repo, c localrepo.Conflict) (*conflict.Entry, *conflict.Entry, *conflict.Entry, error) {
+	var ancestor, our, their *conflict.Entry
+
+	for _, part := range []struct {
+		info   *localrepo.ConflictingFileInfo
+		result **conflict.Entry
+	}{
+		{info: c.Ancestor, result: &ancestor},
+		{info: c.Ours, result: &our},
+		{info: c.Theirs, result: &their},
+	} {
+		if part.info == nil {
+			continue
+		}
+
+		contents, err := repo.ReadObject(ctx, part.info.OID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		*part.result = &conflict.Entry{
+			Path:     part.info.FileName,
+			Mode:     uint(part.info.Mode),
+			Contents: contents,
+		}
+	}
+
+	return ancestor, our, their, nil
+}
+
+// replaceTreeEntries writes a new tree based on the given tree where all entries are replaced with
+// the given entries. Entries are keyed by their full path and must exist in the tree already.
+func replaceTreeEntries(ctx context.Context, repo *localrepo.Repo, treeOID git.ObjectID, replacements map[string]localrepo.TreeEntry) (git.ObjectID, error) {
+	if len(replacements) == 0 {
+		return treeOID, nil
+	}
+
+	direct := map[string]localrepo.TreeEntry{}
+	nested := map[string]map[string]localrepo.TreeEntry{}
+	for path, entry := range replacements {
+		directory, remainder, found := strings.Cut(path, "/")
+		if !found {
+			direct[path] = entry
+			continue
+		}
+
+		if nested[directory] == nil {
+			nested[directory] = map[string]localrepo.TreeEntry{}
+		}
+		nested[directory][remainder] = entry
+	}
+
+	entries, err := lstree.ListEntries(ctx, repo, treeOID.Revision(), nil)
+	if err != nil {
+		return "", fmt.Errorf("listing tree entries: %w", err)
+	}
+
+	newEntries := make([]localrepo.TreeEntry, 0, len(entries))
+	for _, entry := range entries {
+		if replacement, ok := direct[entry.Path]; ok {
+			entry.OID = replacement.OID
+			entry.Mode = replacement.Mode
+			entry.Type = replacement.Type
+			delete(direct, entry.Path)
+		} else if subtreeReplacements, ok := nested[entry.Path]; ok && entry.Type == localrepo.Tree {
+			entry.OID, err = replaceTreeEntries(ctx, repo, entry.OID, subtreeReplacements)
+			if err != nil {
+				return "", err
+			}
+			delete(nested, entry.Path)
+		}
+
+		newEntries = append(newEntries, *entry)
+	}
+
+	for path := range direct {
+		return "", fmt.Errorf("tree entry not found: %q", path)
+	}
+	for path := range nested {
+		return "", fmt.Errorf("tree not found: %q", path)
+	}
+
+	return repo.WriteTree(ctx, newEntries)
+}
+
 func sameRepo(left, right repository.GitRepo) bool {
 	lgaod := left.GetGitAlternateObjectDirectories()
 	rgaod := right.GetGitAlternateObjectDirectories()
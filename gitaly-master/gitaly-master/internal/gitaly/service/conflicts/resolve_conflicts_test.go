@@ -21,6 +21,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/hook"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
@@ -59,6 +60,12 @@ var (
 )
 
 func TestSuccessfulResolveConflictsRequestHelper(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.MergeTreeResolveConflicts).Run(t, testSuccessfulResolveConflictsRequestHelper)
+}
+
+func testSuccessfulResolveConflictsRequestHelper(t *testing.T, ctx context.Context) {
 	var verifyFunc func(tb testing.TB, pushOptions []string, stdin io.Reader)
 	verifyFuncProxy := func(t *testing.T, ctx context.Context, repo *gitalypb.Repository, pushOptions, env []string, stdin io.Reader, stdout, stderr io.Writer) error {
 		// We use a proxy func here as we need to provide the hookManager dependency while creating the service but we only
@@ -68,7 +75,6 @@ func TestSuccessfulResolveConflictsRequestHelper(t *testing.T) {
 		return nil
 	}
 
-	ctx := testhelper.Context(t)
 	hookManager := hook.NewMockManager(t, verifyFuncProxy, verifyFuncProxy, hook.NopUpdate, hook.NopReferenceTransaction)
 	cfg, repoProto, repoPath, client := setupConflictsService(t, ctx, hookManager)
 
@@ -202,7 +208,12 @@ func TestSuccessfulResolveConflictsRequestHelper(t *testing.T) {
 func TestResolveConflictsWithRemoteRepo(t *testing.T) {
 	t.Parallel()
 
-	ctx := testhelper.Context(t)
+	testhelper.NewFeatureSets(featureflag.MergeTreeResolveConflicts).Run(t, testResolveConflictsWithRemoteRepo)
+}
+
+func testResolveConflictsWithRemoteRepo(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
 	hookManager := hook.NewMockManager(t, hook.NopPreReceive, hook.NopPostReceive, hook.NopUpdate, hook.NopReferenceTransaction)
 	cfg, sourceRepo, sourceRepoPath, client := setupConflictsService(t, ctx, hookManager)
 
@@ -272,7 +283,12 @@ func TestResolveConflictsWithRemoteRepo(t *testing.T) {
 }
 
 func TestResolveConflictsLineEndings(t *testing.T) {
-	ctx := testhelper.Context(t)
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.MergeTreeResolveConflicts).Run(t, testResolveConflictsLineEndings)
+}
+
+func testResolveConflictsLineEndings(t *testing.T, ctx context.Context) {
 	hookManager := hook.NewMockManager(t, hook.NopPreReceive, hook.NopPostReceive, hook.NopUpdate, hook.NopReferenceTransaction)
 	cfg, repo, repoPath, client := setupConflictsService(t, ctx, hookManager)
 
@@ -450,8 +466,12 @@ func TestResolveConflictsNonOIDRequests(t *testing.T) {
 }
 
 func TestResolveConflictsIdenticalContent(t *testing.T) {
-	ctx := testhelper.Context(t)
+	t.Parallel()
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeResolveConflicts).Run(t, testResolveConflictsIdenticalContent)
+}
+
+func testResolveConflictsIdenticalContent(t *testing.T, ctx context.Context) {
 	hookManager := hook.NewMockManager(t, hook.NopPreReceive, hook.NopPostReceive, hook.NopUpdate, hook.NopReferenceTransaction)
 	cfg, repoProto, repoPath, client := setupConflictsService(t, ctx, hookManager)
 
@@ -547,8 +567,12 @@ func TestResolveConflictsIdenticalContent(t *testing.T) {
 }
 
 func TestResolveConflictsStableID(t *testing.T) {
-	ctx := testhelper.Context(t)
+	t.Parallel()
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeResolveConflicts).Run(t, testResolveConflictsStableID)
+}
+
+func testResolveConflictsStableID(t *testing.T, ctx context.Context) {
 	hookManager := hook.NewMockManager(t, hook.NopPreReceive, hook.NopPostReceive, hook.NopUpdate, hook.NopReferenceTransaction)
 	cfg, repoProto, _, client := setupConflictsService(t, ctx, hookManager)
 
@@ -616,7 +640,12 @@ func TestResolveConflictsStableID(t *testing.T) {
 }
 
 func TestFailedResolveConflictsRequestDueToResolutionError(t *testing.T) {
-	ctx := testhelper.Context(t)
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.MergeTreeResolveConflicts).Run(t, testFailedResolveConflictsRequestDueToResolutionError)
+}
+
+func testFailedResolveConflictsRequestDueToResolutionError(t *testing.T, ctx context.Context) {
 	hookManager := hook.NewMockManager(t, hook.NopPreReceive, hook.NopPostReceive, hook.NopUpdate, hook.NopReferenceTransaction)
 	cfg, repo, _, client := setupConflictsService(t, ctx, hookManager)
 
@@ -829,7 +858,12 @@ func TestFailedResolveConflictsRequestDueToValidation(t *testing.T) {
 func TestResolveConflictsQuarantine(t *testing.T) {
 	t.Parallel()
 
-	ctx := testhelper.Context(t)
+	testhelper.NewFeatureSets(featureflag.MergeTreeResolveConflicts).Run(t, testResolveConflictsQuarantine)
+}
+
+func testResolveConflictsQuarantine(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
 	cfg, sourceRepoProto, sourceRepoPath, client := setupConflictsService(t, ctx, nil)
 
 	testcfg.BuildGitalySSH(t, cfg)
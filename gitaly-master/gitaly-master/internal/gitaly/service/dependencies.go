@@ -9,8 +9,13 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/housekeeping"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/updateref"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git2go"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/bundleuri"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
 	gitalyhook "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/hook"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/partialclone"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/refbarrier"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/reload"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/rubyserver"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
@@ -39,6 +44,11 @@ type Dependencies struct {
 	Git2goExecutor                *git2go.Executor
 	UpdaterWithHooks              *updateref.UpdaterWithHooks
 	HousekeepingManager           housekeeping.Manager
+	BundleURIManager              *bundleuri.Manager
+	PartialCloneEnforcer          *partialclone.Enforcer
+	ConfigReloader                *reload.Reloader
+	RefBarrier                    *refbarrier.Barrier
+	PartitionManager              *gitaly.PartitionManager
 }
 
 // GetCfg returns service configuration.
@@ -130,3 +140,28 @@ func (dc *Dependencies) GetHousekeepingManager() housekeeping.Manager {
 func (dc *Dependencies) GetPackObjectsLimiter() limithandler.Limiter {
 	return dc.PackObjectsLimiter
 }
+
+// GetBundleURIManager returns the bundle-URI manager.
+func (dc *Dependencies) GetBundleURIManager() *bundleuri.Manager {
+	return dc.BundleURIManager
+}
+
+// GetPartialCloneEnforcer returns the enforcer of partial clone policies.
+func (dc *Dependencies) GetPartialCloneEnforcer() *partialclone.Enforcer {
+	return dc.PartialCloneEnforcer
+}
+
+// GetConfigReloader returns the reloader of the configuration.
+func (dc *Dependencies) GetConfigReloader() *reload.Reloader {
+	return dc.ConfigReloader
+}
+
+// GetRefBarrier returns the barrier used to block reference updates.
+func (dc *Dependencies) GetRefBarrier() *refbarrier.Barrier {
+	return dc.RefBarrier
+}
+
+// GetPartitionManager returns the manager of the repositories' write-ahead logs.
+func (dc *Dependencies) GetPartitionManager() *gitaly.PartitionManager {
+	return dc.PartitionManager
+}
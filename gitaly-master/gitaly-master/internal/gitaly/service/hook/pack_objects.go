@@ -20,6 +20,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/pktline"
+	cgroupscfg "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
 	gitalyhook "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/hook"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper"
@@ -249,6 +250,7 @@ func runPackObjects(
 		git.WithStdout(stdout),
 		git.WithStderr(stderr),
 		git.WithGlobalOption(args.globals()...),
+		git.WithCgroupClass(cgroupscfg.ClassPackObjects),
 	)
 	if err != nil {
 		return err
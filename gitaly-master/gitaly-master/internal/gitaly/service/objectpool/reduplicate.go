@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	cgroupscfg "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
@@ -21,7 +22,7 @@ func (s *server) ReduplicateRepository(ctx context.Context, req *gitalypb.Redupl
 			git.Flag{Name: "--quiet"},
 			git.Flag{Name: "-a"},
 		},
-	})
+	}, git.WithCgroupClass(cgroupscfg.ClassHousekeeping))
 	if err != nil {
 		return nil, err
 	}
@@ -40,6 +40,7 @@ func TestReduplicate(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, stats.RepositoryInfo{
 		References: stats.ReferencesInfo{
+			ReferenceBackendName: "files",
 			PackedReferencesSize: uint64(packedRefsStat.Size()),
 		},
 		CommitGraph: stats.CommitGraphInfo{
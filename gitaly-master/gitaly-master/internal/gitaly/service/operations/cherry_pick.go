@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/updateref"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git2go"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 )
@@ -51,16 +53,21 @@ func (s *Server) UserCherryPick(ctx context.Context, req *gitalypb.UserCherryPic
 		committerDate = req.Timestamp.AsTime()
 	}
 
-	newrev, err := s.git2goExecutor.CherryPick(ctx, quarantineRepo, git2go.CherryPickCommand{
-		Repository:    repoPath,
-		CommitterName: string(req.User.Name),
-		CommitterMail: string(req.User.Email),
-		CommitterDate: committerDate,
-		Message:       string(req.Message),
-		Commit:        req.Commit.Id,
-		Ours:          startRevision.String(),
-		Mainline:      mainline,
-	})
+	var newrev git.ObjectID
+	if featureflag.MergeTreeCherryPick.IsEnabled(ctx) {
+		newrev, err = s.cherryPick(ctx, quarantineRepo, req, startRevision, mainline, committerDate)
+	} else {
+		newrev, err = s.git2goExecutor.CherryPick(ctx, quarantineRepo, git2go.CherryPickCommand{
+			Repository:    repoPath,
+			CommitterName: string(req.User.Name),
+			CommitterMail: string(req.User.Email),
+			CommitterDate: committerDate,
+			Message:       string(req.Message),
+			Commit:        req.Commit.Id,
+			Ours:          startRevision.String(),
+			Mainline:      mainline,
+		})
+	}
 	if err != nil {
 		var conflictErr git2go.ConflictingFilesError
 		var emptyErr git2go.EmptyError
@@ -122,10 +129,6 @@ func (s *Server) UserCherryPick(ctx context.Context, req *gitalypb.UserCherryPic
 		}
 	}
 
-	if req.DryRun {
-		newrev = startRevision
-	}
-
 	if !branchCreated {
 		ancestor, err := quarantineRepo.IsAncestor(ctx, oldrev.Revision(), newrev.Revision())
 		if err != nil {
@@ -145,6 +148,18 @@ func (s *Server) UserCherryPick(ctx context.Context, req *gitalypb.UserCherryPic
 		}
 	}
 
+	// In dry-run mode we only report the commit the branch would have been updated to. As the
+	// quarantine directory is never migrated, the commit itself is discarded.
+	if req.DryRun {
+		return &gitalypb.UserCherryPickResponse{
+			BranchUpdate: &gitalypb.OperationBranchUpdate{
+				CommitId:      newrev.String(),
+				BranchCreated: branchCreated,
+				RepoCreated:   !repoHadBranches,
+			},
+		}, nil
+	}
+
 	if err := s.updateReferenceWithHooks(ctx, req.GetRepository(), req.User, quarantineDir, referenceName, newrev, oldrev); err != nil {
 		var customHookErr updateref.CustomHookError
 		if errors.As(err, &customHookErr) {
@@ -170,3 +185,68 @@ func (s *Server) UserCherryPick(ctx context.Context, req *gitalypb.UserCherryPic
 		},
 	}, nil
 }
+
+// cherryPick picks the given commit onto ours via git-merge-tree(1). The changes introduced by the
+// commit relative to its mainline parent are merged into ours by using that parent as the merge
+// base. Errors are returned as their gitaly-git2go equivalents so that both implementations can
+// share their error handling.
+func (s *Server) cherryPick(
+	ctx context.Context,
+	quarantineRepo *localrepo.Repo,
+	req *gitalypb.UserCherryPickRequest,
+	ours git.ObjectID,
+	mainline uint,
+	committerDate time.Time,
+) (git.ObjectID, error) {
+	commit, err := quarantineRepo.ReadCommit(ctx, git.Revision(req.GetCommit().GetId()))
+	if err != nil {
+		if errors.Is(err, localrepo.ErrObjectNotFound) {
+			return "", git2go.CommitNotFoundError{Revision: req.GetCommit().GetId()}
+		}
+
+		return "", fmt.Errorf("reading commit: %w", err)
+	}
+
+	base, err := mergeBaseFromParents(ctx, quarantineRepo, commit, mainline)
+	if err != nil {
+		return "", err
+	}
+
+	treeOID, err := quarantineRepo.MergeTree(ctx, ours.String(), commit.GetId(), localrepo.WithMergeBase(base))
+	if err != nil {
+		var mergeTreeErr *localrepo.MergeTreeError
+		if errors.As(err, &mergeTreeErr) {
+			return "", git2go.ConflictingFilesError{
+				ConflictingFiles: mergeTreeErr.ConflictingFiles,
+			}
+		}
+
+		return "", fmt.Errorf("merging trees: %w", err)
+	}
+
+	oursTreeOID, err := quarantineRepo.ResolveRevision(ctx, ours.Revision()+"^{tree}")
+	if err != nil {
+		return "", fmt.Errorf("resolving tree of ours: %w", err)
+	}
+
+	if treeOID == oursTreeOID {
+		return "", git2go.EmptyError{}
+	}
+
+	commitID, err := quarantineRepo.WriteCommit(ctx, localrepo.WriteCommitConfig{
+		TreeID:         treeOID,
+		Parents:        []git.ObjectID{ours},
+		AuthorName:     string(commit.GetAuthor().GetName()),
+		AuthorEmail:    string(commit.GetAuthor().GetEmail()),
+		AuthorDate:     signatureDate(commit.GetAuthor()),
+		CommitterName:  string(req.GetUser().GetName()),
+		CommitterEmail: string(req.GetUser().GetEmail()),
+		CommitterDate:  committerDate,
+		Message:        string(req.GetMessage()),
+	})
+	if err != nil {
+		return "", fmt.Errorf("writing cherry-pick commit: %w", err)
+	}
+
+	return commitID, nil
+}
@@ -3,6 +3,7 @@
 package operations
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
@@ -25,7 +27,13 @@ import (
 func TestUserCherryPick(t *testing.T) {
 	t.Parallel()
 
-	ctx, cfg, client := setupOperationsServiceWithoutRepo(t, testhelper.Context(t))
+	testhelper.NewFeatureSets(featureflag.MergeTreeCherryPick).Run(t, testUserCherryPick)
+}
+
+func testUserCherryPick(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsServiceWithoutRepo(t, ctx)
 
 	destinationBranch := "dst-branch"
 
@@ -300,15 +308,30 @@ func TestUserCherryPick(t *testing.T) {
 				masterCommit:           masterCommitCopyID.String(),
 			})
 
+			branchBefore, branchBeforeErr := repo.ResolveRevision(ctx, git.Revision(destinationBranch))
+
 			resp, err := client.UserCherryPick(ctx, req)
 			if tc.expectedErr != nil || err != nil {
 				testhelper.RequireGrpcError(t, tc.expectedErr, err)
 				return
 			}
 
-			headCommit, err := repo.ReadCommit(ctx, git.Revision(destinationBranch))
-			require.NoError(t, err)
-			expectedResp.BranchUpdate.CommitId = headCommit.Id
+			if req.DryRun {
+				// The branch must not have been touched and the computed commit must
+				// not have been persisted.
+				branchAfter, branchAfterErr := repo.ResolveRevision(ctx, git.Revision(destinationBranch))
+				require.Equal(t, branchBefore, branchAfter)
+				require.Equal(t, branchBeforeErr, branchAfterErr)
+
+				_, err := repo.ReadCommit(ctx, git.Revision(resp.GetBranchUpdate().GetCommitId()))
+				require.Equal(t, localrepo.ErrObjectNotFound, err)
+
+				expectedResp.BranchUpdate.CommitId = resp.GetBranchUpdate().GetCommitId()
+			} else {
+				headCommit, err := repo.ReadCommit(ctx, git.Revision(destinationBranch))
+				require.NoError(t, err)
+				expectedResp.BranchUpdate.CommitId = headCommit.Id
+			}
 
 			testhelper.ProtoEqual(t, expectedResp, resp)
 		})
@@ -317,8 +340,12 @@ func TestUserCherryPick(t *testing.T) {
 
 func TestServer_UserCherryPick_successfulGitHooks(t *testing.T) {
 	t.Parallel()
-	ctx := testhelper.Context(t)
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeCherryPick).Run(t, testServer_UserCherryPick_successfulGitHooks)
+}
+
+func testServer_UserCherryPick_successfulGitHooks(t *testing.T, ctx context.Context) {
+	t.Parallel()
 	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
@@ -356,8 +383,12 @@ func TestServer_UserCherryPick_successfulGitHooks(t *testing.T) {
 
 func TestServer_UserCherryPick_stableID(t *testing.T) {
 	t.Parallel()
-	ctx := testhelper.Context(t)
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeCherryPick).Run(t, testServer_UserCherryPick_stableID)
+}
+
+func testServer_UserCherryPick_stableID(t *testing.T, ctx context.Context) {
+	t.Parallel()
 	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
@@ -411,6 +442,63 @@ func TestServer_UserCherryPick_stableID(t *testing.T) {
 	}, pickedCommit)
 }
 
+func TestUserCherryPick_mergeTreeMatchesGit2Go(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	ctx, cfg, repoProto, _, client := setupOperationsService(t, ctx)
+
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	for _, tc := range []struct {
+		desc       string
+		branchName string
+		commitID   git.Revision
+	}{
+		{
+			desc:       "clean cherry-pick",
+			branchName: "master",
+			commitID:   "8a0f2ee90d940bfb0ba1e14e8214b0649056e4ab",
+		},
+		{
+			desc:       "conflicting cherry-pick",
+			branchName: "conflict_branch_a",
+			commitID:   "f0f390655872bb2772c85a0128b2fbc2d88670cb",
+		},
+		{
+			desc:       "already applied cherry-pick",
+			branchName: "master",
+			commitID:   "1e292f8fedd741b75372e19097c76d327140c312",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			commit, err := repo.ReadCommit(ctx, tc.commitID)
+			require.NoError(t, err)
+
+			request := &gitalypb.UserCherryPickRequest{
+				Repository: repoProto,
+				User:       gittest.TestUser,
+				Commit:     commit,
+				BranchName: []byte(tc.branchName),
+				Message:    []byte("Cherry-picking " + commit.Id),
+				Timestamp:  &timestamppb.Timestamp{Seconds: 12345},
+				DryRun:     true,
+			}
+
+			git2goResponse, git2goErr := client.UserCherryPick(featureflag.ContextWithFeatureFlag(ctx, featureflag.MergeTreeCherryPick, false), request)
+			mergeTreeResponse, mergeTreeErr := client.UserCherryPick(featureflag.ContextWithFeatureFlag(ctx, featureflag.MergeTreeCherryPick, true), request)
+
+			testhelper.ProtoEqual(t, git2goResponse, mergeTreeResponse)
+			require.Equal(t, status.Code(git2goErr), status.Code(mergeTreeErr))
+			testhelper.ProtoEqual(t, status.Convert(git2goErr).Details(), status.Convert(mergeTreeErr).Details())
+		})
+	}
+}
+
 func TestServer_UserCherryPick_failedValidations(t *testing.T) {
 	t.Parallel()
 	ctx := testhelper.Context(t)
@@ -508,7 +596,13 @@ func TestServer_UserCherryPick_failedValidations(t *testing.T) {
 func TestServer_UserCherryPick_failedWithPreReceiveError(t *testing.T) {
 	t.Parallel()
 
-	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, testhelper.Context(t))
+	testhelper.NewFeatureSets(featureflag.MergeTreeCherryPick).Run(t, testServer_UserCherryPick_failedWithPreReceiveError)
+}
+
+func testServer_UserCherryPick_failedWithPreReceiveError(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
 
@@ -550,7 +644,13 @@ func TestServer_UserCherryPick_failedWithPreReceiveError(t *testing.T) {
 func TestServer_UserCherryPick_failedWithCreateTreeError(t *testing.T) {
 	t.Parallel()
 
-	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, testhelper.Context(t))
+	testhelper.NewFeatureSets(featureflag.MergeTreeCherryPick).Run(t, testServer_UserCherryPick_failedWithCreateTreeError)
+}
+
+func testServer_UserCherryPick_failedWithCreateTreeError(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
 
@@ -581,7 +681,13 @@ func TestServer_UserCherryPick_failedWithCreateTreeError(t *testing.T) {
 func TestServer_UserCherryPick_failedWithCommitError(t *testing.T) {
 	t.Parallel()
 
-	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, testhelper.Context(t))
+	testhelper.NewFeatureSets(featureflag.MergeTreeCherryPick).Run(t, testServer_UserCherryPick_failedWithCommitError)
+}
+
+func testServer_UserCherryPick_failedWithCommitError(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
 
@@ -620,7 +726,13 @@ func TestServer_UserCherryPick_failedWithCommitError(t *testing.T) {
 func TestServerUserCherryPickRailedWithConflict(t *testing.T) {
 	t.Parallel()
 
-	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, testhelper.Context(t))
+	testhelper.NewFeatureSets(featureflag.MergeTreeCherryPick).Run(t, testServerUserCherryPickRailedWithConflict)
+}
+
+func testServerUserCherryPickRailedWithConflict(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
 
@@ -657,8 +769,12 @@ func TestServerUserCherryPickRailedWithConflict(t *testing.T) {
 
 func TestServer_UserCherryPick_successfulWithGivenCommits(t *testing.T) {
 	t.Parallel()
-	ctx := testhelper.Context(t)
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeCherryPick).Run(t, testServer_UserCherryPick_successfulWithGivenCommits)
+}
+
+func testServer_UserCherryPick_successfulWithGivenCommits(t *testing.T, ctx context.Context) {
+	t.Parallel()
 	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
@@ -713,7 +829,13 @@ func TestServer_UserCherryPick_successfulWithGivenCommits(t *testing.T) {
 func TestServer_UserCherryPick_quarantine(t *testing.T) {
 	t.Parallel()
 
-	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, testhelper.Context(t))
+	testhelper.NewFeatureSets(featureflag.MergeTreeCherryPick).Run(t, testServer_UserCherryPick_quarantine)
+}
+
+func testServer_UserCherryPick_quarantine(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
 
 	// Set up a hook that parses the new object and then aborts the update. Like this, we can
@@ -0,0 +1,344 @@
+package operations
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/patch"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/updateref"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git2go"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/hook"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// UserCommitPatches applies unified diffs to the files of a branch and commits the result. See
+// the protobuf documentation for details.
+func (s *Server) UserCommitPatches(stream gitalypb.OperationService_UserCommitPatchesServer) error {
+	firstRequest, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	header := firstRequest.GetHeader()
+	if header == nil {
+		return structerr.NewInvalidArgument("empty UserCommitPatchesRequestHeader")
+	}
+
+	if err := validateUserCommitPatchesHeader(header); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	branchUpdate, err := s.userCommitPatches(stream.Context(), header, stream)
+	if err != nil {
+		var (
+			unknownErr      git2go.UnknownIndexError
+			indexErr        git2go.IndexError
+			notAllowedError hook.NotAllowedError
+			customHookErr   updateref.CustomHookError
+			updateRefError  updateref.Error
+		)
+
+		switch {
+		case errors.As(err, &unknownErr):
+			return unknownErr
+		case errors.As(err, &indexErr):
+			return indexErr.StructuredError().WithDetail(
+				&gitalypb.UserCommitPatchesError{
+					Error: &gitalypb.UserCommitPatchesError_IndexUpdate{
+						IndexUpdate: indexErr.Proto(),
+					},
+				},
+			)
+		case errors.As(err, new(git2go.InvalidArgumentError)):
+			return structerr.NewInvalidArgument("%w", err)
+		case errors.As(err, &notAllowedError):
+			return structerr.NewPermissionDenied("%w", notAllowedError).WithDetail(
+				&gitalypb.UserCommitPatchesError{
+					Error: &gitalypb.UserCommitPatchesError_AccessCheck{
+						AccessCheck: &gitalypb.AccessCheckError{
+							ErrorMessage: notAllowedError.Message,
+							UserId:       notAllowedError.UserID,
+							Protocol:     notAllowedError.Protocol,
+							Changes:      notAllowedError.Changes,
+						},
+					},
+				},
+			)
+		case errors.As(err, &customHookErr):
+			return structerr.NewPermissionDenied("%w", customHookErr).WithDetail(
+				&gitalypb.UserCommitPatchesError{
+					Error: &gitalypb.UserCommitPatchesError_CustomHook{
+						CustomHook: customHookErr.Proto(),
+					},
+				},
+			)
+		case errors.As(err, &updateRefError):
+			// The branch has been updated concurrently, so the patches have been applied
+			// against an outdated commit. The client may retry with the new tip.
+			return structerr.NewFailedPrecondition("%w", updateRefError).WithDetail(
+				&gitalypb.UserCommitPatchesError{
+					Error: &gitalypb.UserCommitPatchesError_ReferenceUpdate{
+						ReferenceUpdate: &gitalypb.ReferenceUpdateError{
+							ReferenceName: []byte(updateRefError.Reference.String()),
+							OldOid:        updateRefError.OldOID.String(),
+							NewOid:        updateRefError.NewOID.String(),
+						},
+					},
+				},
+			)
+		default:
+			return structerr.NewInternal("%w", err)
+		}
+	}
+
+	return stream.SendAndClose(&gitalypb.UserCommitPatchesResponse{
+		BranchUpdate: branchUpdate,
+	})
+}
+
+func validateUserCommitPatchesHeader(header *gitalypb.UserCommitPatchesRequestHeader) error {
+	if err := service.ValidateRepository(header.GetRepository()); err != nil {
+		return err
+	}
+	if header.GetUser() == nil {
+		return errors.New("empty User")
+	}
+	if len(header.GetCommitMessage()) == 0 {
+		return errors.New("empty CommitMessage")
+	}
+	if len(header.GetBranchName()) == 0 {
+		return errors.New("empty BranchName")
+	}
+
+	return nil
+}
+
+type filePatch struct {
+	header *gitalypb.UserCommitPatchesActionHeader
+	diff   []byte
+}
+
+func receiveFilePatches(stream gitalypb.OperationService_UserCommitPatchesServer) ([]filePatch, error) {
+	var filePatches []filePatch
+
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, fmt.Errorf("receive request: %w", err)
+		}
+
+		switch payload := req.GetAction().GetUserCommitPatchesActionPayload().(type) {
+		case *gitalypb.UserCommitPatchesAction_Header:
+			filePatches = append(filePatches, filePatch{header: payload.Header})
+		case *gitalypb.UserCommitPatchesAction_Diff:
+			if len(filePatches) == 0 {
+				return nil, structerr.NewInvalidArgument("diff sent before action")
+			}
+
+			// append the diff to the previous action
+			diff := &filePatches[len(filePatches)-1].diff
+			*diff = append(*diff, payload.Diff...)
+		default:
+			return nil, structerr.NewInvalidArgument("unhandled action payload type: %T", payload)
+		}
+	}
+
+	if len(filePatches) == 0 {
+		return nil, structerr.NewInvalidArgument("no patches")
+	}
+
+	return filePatches, nil
+}
+
+func (s *Server) userCommitPatches(
+	ctx context.Context,
+	header *gitalypb.UserCommitPatchesRequestHeader,
+	stream gitalypb.OperationService_UserCommitPatchesServer,
+) (*gitalypb.OperationBranchUpdate, error) {
+	quarantineDir, quarantineRepo, err := s.quarantinedRepo(ctx, header.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	repoPath, err := quarantineRepo.Path()
+	if err != nil {
+		return nil, err
+	}
+
+	branchName := git.NewReferenceNameFromBranchName(string(header.GetBranchName()))
+
+	// The patches are applied against the expected old commit if given so that clients get a
+	// consistent view of the files they have computed the diffs against. The reference update
+	// will then fail in case the branch has been updated concurrently.
+	var parentCommitOID git.ObjectID
+	if expectedOldOID := header.GetExpectedOldOid(); expectedOldOID != "" {
+		objectHash, err := quarantineRepo.ObjectHash(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("detecting object hash: %w", err)
+		}
+
+		if err := objectHash.ValidateHex(expectedOldOID); err != nil {
+			return nil, structerr.NewInvalidArgument("invalid expected old object ID: %w", err)
+		}
+
+		parentCommitOID, err = quarantineRepo.ResolveRevision(ctx, git.Revision(expectedOldOID+"^{commit}"))
+		if err != nil {
+			return nil, structerr.NewInvalidArgument("cannot resolve expected old object ID: %w", err).
+				WithMetadata("old_object_id", expectedOldOID)
+		}
+	} else {
+		parentCommitOID, err = quarantineRepo.ResolveRevision(ctx, branchName.Revision()+"^{commit}")
+		if err != nil {
+			if errors.Is(err, git.ErrReferenceNotFound) {
+				return nil, structerr.NewNotFound("branch not found").WithMetadata("branch_name", string(header.GetBranchName()))
+			}
+
+			return nil, fmt.Errorf("resolve branch commit: %w", err)
+		}
+	}
+
+	filePatches, err := receiveFilePatches(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	var failedHunks []*gitalypb.PatchApplyError_FailedHunk
+	actions := make([]git2go.Action, 0, len(filePatches))
+	seenPaths := make(map[string]struct{}, len(filePatches))
+
+	for _, filePatch := range filePatches {
+		path, err := validatePath(repoPath, string(filePatch.header.GetFilePath()))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := seenPaths[path]; ok {
+			return nil, structerr.NewInvalidArgument("multiple patches for the same file").WithMetadata("path", path)
+		}
+		seenPaths[path] = struct{}{}
+
+		parsedPatch, err := patch.Parse(bytes.NewReader(filePatch.diff))
+		if err != nil {
+			return nil, structerr.NewInvalidArgument("parsing patch: %w", err).WithMetadata("path", path)
+		}
+
+		var content []byte
+		if !parsedPatch.IsCreation() {
+			blobID, err := quarantineRepo.ResolveRevision(ctx, git.Revision(fmt.Sprintf("%s:%s", parentCommitOID, path)))
+			if err != nil {
+				if errors.Is(err, git.ErrReferenceNotFound) {
+					return nil, git2go.IndexError{Type: git2go.ErrFileNotFound, Path: path}
+				}
+
+				return nil, fmt.Errorf("resolve blob: %w", err)
+			}
+
+			content, err = quarantineRepo.ReadObject(ctx, blobID)
+			if err != nil {
+				return nil, fmt.Errorf("read blob: %w", err)
+			}
+		}
+
+		patchedContent, err := patch.Apply(content, parsedPatch, uint(header.GetMaxFuzz()))
+		if err != nil {
+			var applyErr *patch.ApplyError
+			if !errors.As(err, &applyErr) {
+				return nil, fmt.Errorf("apply patch: %w", err)
+			}
+
+			// We keep on applying the remaining patches so that all failed hunks can be
+			// reported at once.
+			for _, failedHunk := range applyErr.FailedHunks {
+				failedHunks = append(failedHunks, &gitalypb.PatchApplyError_FailedHunk{
+					Path:      []byte(path),
+					HunkIndex: uint32(failedHunk.Index),
+					Header:    []byte(failedHunk.Hunk.Header),
+					OldStart:  uint32(failedHunk.Hunk.OldStart),
+					OldLines:  uint32(failedHunk.Hunk.OldLines),
+				})
+			}
+
+			continue
+		}
+
+		if parsedPatch.IsDeletion() {
+			if len(patchedContent) != 0 {
+				return nil, structerr.NewFailedPrecondition("deleted file is not empty after applying the patch").WithMetadata("path", path)
+			}
+
+			actions = append(actions, git2go.DeleteFile{Path: path})
+			continue
+		}
+
+		blobID, err := quarantineRepo.WriteBlob(ctx, path, bytes.NewReader(patchedContent))
+		if err != nil {
+			return nil, fmt.Errorf("write patched blob: %w", err)
+		}
+
+		if parsedPatch.IsCreation() {
+			actions = append(actions, git2go.CreateFile{
+				OID:            blobID.String(),
+				Path:           path,
+				ExecutableMode: filePatch.header.GetExecuteFilemode(),
+			})
+		} else {
+			actions = append(actions, git2go.UpdateFile{
+				OID:  blobID.String(),
+				Path: path,
+			})
+		}
+	}
+
+	if len(failedHunks) > 0 {
+		return nil, structerr.NewFailedPrecondition("%d hunks failed to apply", len(failedHunks)).WithDetail(
+			&gitalypb.UserCommitPatchesError{
+				Error: &gitalypb.UserCommitPatchesError_PatchApply{
+					PatchApply: &gitalypb.PatchApplyError{
+						FailedHunks: failedHunks,
+					},
+				},
+			},
+		)
+	}
+
+	now, err := dateFromProto(header)
+	if err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	committer := git2go.NewSignature(string(header.GetUser().GetName()), string(header.GetUser().GetEmail()), now)
+	author := committer
+	if len(header.GetCommitAuthorName()) > 0 && len(header.GetCommitAuthorEmail()) > 0 {
+		author = git2go.NewSignature(string(header.GetCommitAuthorName()), string(header.GetCommitAuthorEmail()), now)
+	}
+
+	commitID, err := s.git2goExecutor.Commit(ctx, quarantineRepo, git2go.CommitCommand{
+		Repository: repoPath,
+		Author:     author,
+		Committer:  committer,
+		Message:    string(header.GetCommitMessage()),
+		Parent:     parentCommitOID.String(),
+		Actions:    actions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	if err := s.updateReferenceWithHooks(ctx, header.GetRepository(), header.GetUser(), quarantineDir, branchName, commitID, parentCommitOID); err != nil {
+		return nil, fmt.Errorf("update reference: %w", err)
+	}
+
+	return &gitalypb.OperationBranchUpdate{
+		CommitId: commitID.String(),
+	}, nil
+}
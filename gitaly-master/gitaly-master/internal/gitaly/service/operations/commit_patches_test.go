@@ -0,0 +1,314 @@
+//go:build !gitaly_test_sha256
+
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func userCommitPatches(
+	t *testing.T,
+	ctx context.Context,
+	client gitalypb.OperationServiceClient,
+	header *gitalypb.UserCommitPatchesRequestHeader,
+	patches map[string]string,
+	order ...string,
+) (*gitalypb.UserCommitPatchesResponse, error) {
+	t.Helper()
+
+	stream, err := client.UserCommitPatches(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, stream.Send(&gitalypb.UserCommitPatchesRequest{
+		UserCommitPatchesRequestPayload: &gitalypb.UserCommitPatchesRequest_Header{
+			Header: header,
+		},
+	}))
+
+	for _, path := range order {
+		require.NoError(t, stream.Send(&gitalypb.UserCommitPatchesRequest{
+			UserCommitPatchesRequestPayload: &gitalypb.UserCommitPatchesRequest_Action{
+				Action: &gitalypb.UserCommitPatchesAction{
+					UserCommitPatchesActionPayload: &gitalypb.UserCommitPatchesAction_Header{
+						Header: &gitalypb.UserCommitPatchesActionHeader{
+							FilePath: []byte(path),
+						},
+					},
+				},
+			},
+		}))
+
+		require.NoError(t, stream.Send(&gitalypb.UserCommitPatchesRequest{
+			UserCommitPatchesRequestPayload: &gitalypb.UserCommitPatchesRequest_Action{
+				Action: &gitalypb.UserCommitPatchesAction{
+					UserCommitPatchesActionPayload: &gitalypb.UserCommitPatchesAction_Diff{
+						Diff: []byte(patches[path]),
+					},
+				},
+			},
+		}))
+	}
+
+	return stream.CloseAndRecv()
+}
+
+func TestUserCommitPatches(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	ctx, cfg, client := setupOperationsServiceWithoutRepo(t, ctx)
+
+	const branchName = "main"
+
+	setupRepo := func(t *testing.T) (*gitalypb.Repository, string, git.ObjectID) {
+		repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		commitID := gittest.WriteCommit(t, cfg, repoPath,
+			gittest.WithBranch(branchName),
+			gittest.WithTreeEntries(
+				gittest.TreeEntry{Path: "modified", Mode: "100644", Content: "a\nb\nc\nd\ne\nf\ng\n"},
+				gittest.TreeEntry{Path: "deleted", Mode: "100644", Content: "x\ny\n"},
+			),
+		)
+		return repoProto, repoPath, commitID
+	}
+
+	header := func(repo *gitalypb.Repository) *gitalypb.UserCommitPatchesRequestHeader {
+		return &gitalypb.UserCommitPatchesRequestHeader{
+			Repository:    repo,
+			User:          gittest.TestUser,
+			BranchName:    []byte(branchName),
+			CommitMessage: []byte("apply patches"),
+		}
+	}
+
+	t.Run("successful", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, repoPath, _ := setupRepo(t)
+
+		response, err := userCommitPatches(t, ctx, client, header(repoProto), map[string]string{
+			// The hunk header announces the wrong line, so the hunk needs to be applied
+			// with an offset.
+			"modified": "--- a/modified\n+++ b/modified\n@@ -4,3 +4,3 @@\n b\n-c\n+changed\n d\n",
+			"created":  "--- /dev/null\n+++ b/created\n@@ -0,0 +1,2 @@\n+new\n+file\n",
+			"deleted":  "--- a/deleted\n+++ /dev/null\n@@ -1,2 +0,0 @@\n-x\n-y\n",
+		}, "modified", "created", "deleted")
+		require.NoError(t, err)
+
+		commitID := response.GetBranchUpdate().GetCommitId()
+		require.Equal(t, commitID, text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", branchName)))
+		gittest.RequireTree(t, cfg, repoPath, commitID, []gittest.TreeEntry{
+			{Path: "created", Mode: "100644", Content: "new\nfile\n"},
+			{Path: "modified", Mode: "100644", Content: "a\nb\nchanged\nd\ne\nf\ng\n"},
+		})
+	})
+
+	t.Run("fuzz", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, repoPath, _ := setupRepo(t)
+
+		patches := map[string]string{
+			"modified": "@@ -2,5 +2,5 @@\n b\n c\n-d\n+changed\n e\n unknown\n",
+		}
+
+		request := header(repoProto)
+		_, err := userCommitPatches(t, ctx, client, request, patches, "modified")
+		testhelper.RequireGrpcCode(t, err, codes.FailedPrecondition)
+
+		request.MaxFuzz = 1
+		response, err := userCommitPatches(t, ctx, client, request, patches, "modified")
+		require.NoError(t, err)
+
+		gittest.RequireTree(t, cfg, repoPath, response.GetBranchUpdate().GetCommitId(), []gittest.TreeEntry{
+			{Path: "deleted", Mode: "100644", Content: "x\ny\n"},
+			{Path: "modified", Mode: "100644", Content: "a\nb\nc\nchanged\ne\nf\ng\n"},
+		})
+	})
+
+	t.Run("failed hunks", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, repoPath, commitID := setupRepo(t)
+
+		_, err := userCommitPatches(t, ctx, client, header(repoProto), map[string]string{
+			"modified": "@@ -1,2 +1,2 @@\n a\n-b\n+changed\n@@ -5,2 +5,2 @@\n-unknown\n+changed\n f\n",
+			"deleted":  "@@ -1 +1 @@\n-z\n+changed\n",
+		}, "modified", "deleted")
+		testhelper.RequireGrpcError(t, structerr.NewFailedPrecondition("2 hunks failed to apply").WithDetail(
+			&gitalypb.UserCommitPatchesError{
+				Error: &gitalypb.UserCommitPatchesError_PatchApply{
+					PatchApply: &gitalypb.PatchApplyError{
+						FailedHunks: []*gitalypb.PatchApplyError_FailedHunk{
+							{Path: []byte("modified"), HunkIndex: 1, Header: []byte("@@ -5,2 +5,2 @@"), OldStart: 5, OldLines: 2},
+							{Path: []byte("deleted"), HunkIndex: 0, Header: []byte("@@ -1 +1 @@"), OldStart: 1, OldLines: 1},
+						},
+					},
+				},
+			},
+		), err)
+
+		// The branch must not have been updated.
+		require.Equal(t, commitID.String(), text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", branchName)))
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, _, _ := setupRepo(t)
+
+		_, err := userCommitPatches(t, ctx, client, header(repoProto), map[string]string{
+			"missing": "@@ -1 +1 @@\n-a\n+b\n",
+		}, "missing")
+		testhelper.RequireGrpcError(t, structerr.NewNotFound("A file with this name doesn't exist").WithDetail(
+			&gitalypb.UserCommitPatchesError{
+				Error: &gitalypb.UserCommitPatchesError_IndexUpdate{
+					IndexUpdate: &gitalypb.IndexError{
+						Path:      []byte("missing"),
+						ErrorType: gitalypb.IndexError_ERROR_TYPE_FILE_NOT_FOUND,
+					},
+				},
+			},
+		), err)
+	})
+
+	t.Run("stale expected old object ID", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, repoPath, commitID := setupRepo(t)
+		newCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(commitID), gittest.WithBranch(branchName))
+
+		request := header(repoProto)
+		request.ExpectedOldOid = commitID.String()
+
+		_, err := userCommitPatches(t, ctx, client, request, map[string]string{
+			"modified": "@@ -1,2 +1,2 @@\n a\n-b\n+changed\n",
+		}, "modified")
+		testhelper.RequireGrpcCode(t, err, codes.FailedPrecondition)
+
+		details := status.Convert(err).Details()
+		require.Len(t, details, 1)
+		referenceUpdate := details[0].(*gitalypb.UserCommitPatchesError).GetReferenceUpdate()
+		require.Equal(t, "refs/heads/"+branchName, string(referenceUpdate.GetReferenceName()))
+		require.Equal(t, commitID.String(), referenceUpdate.GetOldOid())
+
+		// The concurrent update must not have been overwritten.
+		require.Equal(t, newCommitID.String(), text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", branchName)))
+	})
+
+	t.Run("SHA256 expected old object ID", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			ObjectFormat: "sha256",
+		})
+		gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch(branchName))
+
+		patches := map[string]string{
+			"modified": "@@ -1,2 +1,2 @@\n a\n-b\n+changed\n",
+		}
+
+		request := header(repoProto)
+		request.ExpectedOldOid = git.ObjectHashSHA1.ZeroOID.String()
+		_, err := userCommitPatches(t, ctx, client, request, patches, "modified")
+		testhelper.RequireGrpcError(t, structerr.NewInvalidArgument(
+			`invalid expected old object ID: invalid object ID: %q, expected length 64, got 40`, git.ObjectHashSHA1.ZeroOID,
+		), err)
+
+		// Object IDs of the repository's object hash are accepted, even though this one
+		// doesn't exist.
+		request.ExpectedOldOid = git.ObjectHashSHA256.ZeroOID.String()
+		_, err = userCommitPatches(t, ctx, client, request, patches, "modified")
+		testhelper.RequireGrpcError(t, structerr.NewInvalidArgument(
+			"cannot resolve expected old object ID: %w", git.ErrReferenceNotFound,
+		).WithMetadata("old_object_id", git.ObjectHashSHA256.ZeroOID.String()), err)
+	})
+
+	t.Run("missing branch", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, _, _ := setupRepo(t)
+
+		request := header(repoProto)
+		request.BranchName = []byte("does-not-exist")
+
+		_, err := userCommitPatches(t, ctx, client, request, map[string]string{
+			"modified": "@@ -1,2 +1,2 @@\n a\n-b\n+changed\n",
+		}, "modified")
+		testhelper.RequireGrpcError(t, structerr.NewNotFound("branch not found").WithMetadata("branch_name", "does-not-exist"), err)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		t.Parallel()
+
+		repoProto, _, _ := setupRepo(t)
+
+		for _, tc := range []struct {
+			desc        string
+			header      func(*gitalypb.UserCommitPatchesRequestHeader)
+			patches     map[string]string
+			order       []string
+			expectedErr error
+		}{
+			{
+				desc:        "missing user",
+				header:      func(h *gitalypb.UserCommitPatchesRequestHeader) { h.User = nil },
+				expectedErr: structerr.NewInvalidArgument("empty User"),
+			},
+			{
+				desc:        "missing commit message",
+				header:      func(h *gitalypb.UserCommitPatchesRequestHeader) { h.CommitMessage = nil },
+				expectedErr: structerr.NewInvalidArgument("empty CommitMessage"),
+			},
+			{
+				desc:        "missing branch name",
+				header:      func(h *gitalypb.UserCommitPatchesRequestHeader) { h.BranchName = nil },
+				expectedErr: structerr.NewInvalidArgument("empty BranchName"),
+			},
+			{
+				desc:        "invalid expected old object ID",
+				header:      func(h *gitalypb.UserCommitPatchesRequestHeader) { h.ExpectedOldOid = "foobar" },
+				expectedErr: structerr.NewInvalidArgument(`invalid expected old object ID: invalid object ID: "foobar", expected length 40, got 6`),
+			},
+			{
+				desc:        "no patches",
+				expectedErr: structerr.NewInvalidArgument("no patches"),
+			},
+			{
+				desc:        "malformed patch",
+				patches:     map[string]string{"modified": "@@ -1,2 +1,2 @@\n a\n"},
+				order:       []string{"modified"},
+				expectedErr: structerr.NewInvalidArgument("parsing patch: invalid patch at line 0: last hunk is incomplete").WithMetadata("path", "modified"),
+			},
+			{
+				desc:        "patch without hunks",
+				patches:     map[string]string{"modified": "--- a/modified\n+++ b/modified\n"},
+				order:       []string{"modified"},
+				expectedErr: structerr.NewInvalidArgument("parsing patch: patch does not contain any hunks").WithMetadata("path", "modified"),
+			},
+		} {
+			tc := tc
+
+			t.Run(tc.desc, func(t *testing.T) {
+				request := header(repoProto)
+				if tc.header != nil {
+					tc.header(request)
+				}
+
+				_, err := userCommitPatches(t, ctx, client, request, tc.patches, tc.order...)
+				testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			})
+		}
+	})
+}
@@ -245,6 +245,13 @@ func (s *Server) UserMergeBranch(stream gitalypb.OperationService_UserMergeBranc
 		return err
 	}
 
+	// When doing a dry run we neither wait for the client to confirm the merge nor do we
+	// update the branch. The merge commit has only been written into the quarantine directory,
+	// which gets discarded when we return.
+	if firstRequest.GetDryRun() {
+		return nil
+	}
+
 	secondRequest, err := stream.Recv()
 	if err != nil {
 		return err
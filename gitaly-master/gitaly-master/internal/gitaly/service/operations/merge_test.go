@@ -675,6 +675,83 @@ func testUserMergeBranchAbort(t *testing.T, ctx context.Context) {
 	}
 }
 
+func TestUserMergeBranch_dryRun(t *testing.T) {
+	t.Parallel()
+	testhelper.NewFeatureSets(featureflag.MergeTreeMerge).Run(
+		t,
+		testUserMergeBranchDryRun,
+	)
+}
+
+func testUserMergeBranchDryRun(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
+	ctx, cfg, client := setupOperationsServiceWithoutRepo(t, ctx)
+	repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	baseCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "a", Content: "apple"},
+	))
+	targetCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(baseCommit), gittest.WithBranch("target"), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "a", Content: "apple"},
+		gittest.TreeEntry{Mode: "100644", Path: "b", Content: "banana"},
+	))
+	sourceCommit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(baseCommit), gittest.WithTreeEntries(
+		gittest.TreeEntry{Mode: "100644", Path: "a", Content: "apple"},
+		gittest.TreeEntry{Mode: "100644", Path: "c", Content: "cherry"},
+	))
+
+	// Dry runs must not execute any hooks, so a failing hook must not cause the RPC to fail.
+	gittest.WriteCustomHook(t, repoPath, "pre-receive", []byte("#!/bin/sh\nexit 1\n"))
+
+	request := &gitalypb.UserMergeBranchRequest{
+		Repository: repoProto,
+		User:       gittest.TestUser,
+		CommitId:   sourceCommit.String(),
+		Branch:     []byte("target"),
+		Message:    []byte("Merged by Gitaly"),
+		Timestamp:  &timestamppb.Timestamp{Seconds: 12, Nanos: 34},
+		DryRun:     true,
+	}
+
+	stream, err := client.UserMergeBranch(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(request))
+
+	response, err := stream.Recv()
+	require.NoError(t, err)
+	require.NotEmpty(t, response.GetCommitId())
+
+	// The RPC finishes without waiting for the client to apply the merge.
+	_, err = stream.Recv()
+	require.Equal(t, io.EOF, err)
+
+	require.Equal(t, targetCommit, gittest.ResolveRevision(t, cfg, repoPath, "refs/heads/target"))
+
+	exists, err := repo.HasRevision(ctx, git.Revision(response.GetCommitId()+"^{commit}"))
+	require.NoError(t, err)
+	require.False(t, exists, "merge commit of dry run should have been discarded")
+
+	// The reported commit ID must match the merge commit that would be created when actually
+	// performing the merge.
+	gittest.WriteCustomHook(t, repoPath, "pre-receive", []byte("#!/bin/sh\nexit 0\n"))
+	request.DryRun = false
+
+	stream, err = client.UserMergeBranch(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(request))
+
+	firstResponse, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, response.GetCommitId(), firstResponse.GetCommitId())
+
+	require.NoError(t, stream.Send(&gitalypb.UserMergeBranchRequest{Apply: true}))
+	secondResponse, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, response.GetCommitId(), secondResponse.GetBranchUpdate().GetCommitId())
+}
+
 func TestUserMergeBranch_concurrentUpdate(t *testing.T) {
 	t.Parallel()
 	testhelper.NewFeatureSets(featureflag.MergeTreeMerge).Run(
@@ -1036,7 +1113,7 @@ func testUserMergeBranchAllowed(t *testing.T, ctx context.Context) {
 				},
 				gitlab.MockPreReceive,
 				gitlab.MockPostReceive,
-			))
+			), nil, nil)
 
 			ctx, cfg, repoProto, repoPath, client := setupOperationsServiceWithCfg(
 				t, ctx, cfg,
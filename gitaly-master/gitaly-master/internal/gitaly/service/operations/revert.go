@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/remoterepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/updateref"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git2go"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 )
@@ -50,16 +52,21 @@ func (s *Server) UserRevert(ctx context.Context, req *gitalypb.UserRevertRequest
 		return nil, structerr.NewInvalidArgument("%w", err)
 	}
 
-	newrev, err := s.git2goExecutor.Revert(ctx, quarantineRepo, git2go.RevertCommand{
-		Repository: repoPath,
-		AuthorName: string(req.User.Name),
-		AuthorMail: string(req.User.Email),
-		AuthorDate: authorDate,
-		Message:    string(req.Message),
-		Ours:       startRevision.String(),
-		Revert:     req.Commit.Id,
-		Mainline:   mainline,
-	})
+	var newrev git.ObjectID
+	if featureflag.MergeTreeRevert.IsEnabled(ctx) {
+		newrev, err = s.revert(ctx, quarantineRepo, req, startRevision, mainline, authorDate)
+	} else {
+		newrev, err = s.git2goExecutor.Revert(ctx, quarantineRepo, git2go.RevertCommand{
+			Repository: repoPath,
+			AuthorName: string(req.User.Name),
+			AuthorMail: string(req.User.Email),
+			AuthorDate: authorDate,
+			Message:    string(req.Message),
+			Ours:       startRevision.String(),
+			Revert:     req.Commit.Id,
+			Mainline:   mainline,
+		})
+	}
 	if err != nil {
 		if errors.As(err, &git2go.HasConflictsError{}) {
 			return &gitalypb.UserRevertResponse{
@@ -104,10 +111,6 @@ func (s *Server) UserRevert(ctx context.Context, req *gitalypb.UserRevertRequest
 		}
 	}
 
-	if req.DryRun {
-		newrev = startRevision
-	}
-
 	if !branchCreated {
 		ancestor, err := quarantineRepo.IsAncestor(ctx, oldrev.Revision(), newrev.Revision())
 		if err != nil {
@@ -120,6 +123,18 @@ func (s *Server) UserRevert(ctx context.Context, req *gitalypb.UserRevertRequest
 		}
 	}
 
+	// Dry runs stop before any hooks are executed. The revert commit only exists in the
+	// quarantine directory and is thus discarded.
+	if req.DryRun {
+		return &gitalypb.UserRevertResponse{
+			BranchUpdate: &gitalypb.OperationBranchUpdate{
+				CommitId:      newrev.String(),
+				BranchCreated: branchCreated,
+				RepoCreated:   !repoHadBranches,
+			},
+		}, nil
+	}
+
 	if err := s.updateReferenceWithHooks(ctx, req.GetRepository(), req.User, quarantineDir, referenceName, newrev, oldrev); err != nil {
 		var customHookErr updateref.CustomHookError
 		if errors.As(err, &customHookErr) {
@@ -140,6 +155,69 @@ func (s *Server) UserRevert(ctx context.Context, req *gitalypb.UserRevertRequest
 	}, nil
 }
 
+// revert reverts the given commit on top of ours via git-merge-tree(1). The commit's changes are
+// reverted by merging its mainline parent into ours while using the commit itself as merge base.
+// Errors are returned as their gitaly-git2go equivalents so that both implementations can share
+// their error handling.
+func (s *Server) revert(
+	ctx context.Context,
+	quarantineRepo *localrepo.Repo,
+	req *gitalypb.UserRevertRequest,
+	ours git.ObjectID,
+	mainline uint,
+	authorDate time.Time,
+) (git.ObjectID, error) {
+	commit, err := quarantineRepo.ReadCommit(ctx, git.Revision(req.GetCommit().GetId()))
+	if err != nil {
+		if errors.Is(err, localrepo.ErrObjectNotFound) {
+			return "", git2go.CommitNotFoundError{Revision: req.GetCommit().GetId()}
+		}
+
+		return "", fmt.Errorf("reading commit: %w", err)
+	}
+
+	theirs, err := mergeBaseFromParents(ctx, quarantineRepo, commit, mainline)
+	if err != nil {
+		return "", err
+	}
+
+	treeOID, err := quarantineRepo.MergeTree(ctx, ours.String(), theirs.String(), localrepo.WithMergeBase(git.Revision(commit.GetId())))
+	if err != nil {
+		var mergeTreeErr *localrepo.MergeTreeError
+		if errors.As(err, &mergeTreeErr) {
+			return "", git2go.HasConflictsError{}
+		}
+
+		return "", fmt.Errorf("merging trees: %w", err)
+	}
+
+	oursTreeOID, err := quarantineRepo.ResolveRevision(ctx, ours.Revision()+"^{tree}")
+	if err != nil {
+		return "", fmt.Errorf("resolving tree of ours: %w", err)
+	}
+
+	if treeOID == oursTreeOID {
+		return "", git2go.EmptyError{}
+	}
+
+	commitID, err := quarantineRepo.WriteCommit(ctx, localrepo.WriteCommitConfig{
+		TreeID:         treeOID,
+		Parents:        []git.ObjectID{ours},
+		AuthorName:     string(req.GetUser().GetName()),
+		AuthorEmail:    string(req.GetUser().GetEmail()),
+		AuthorDate:     authorDate,
+		CommitterName:  string(req.GetUser().GetName()),
+		CommitterEmail: string(req.GetUser().GetEmail()),
+		CommitterDate:  authorDate,
+		Message:        string(req.GetMessage()),
+	})
+	if err != nil {
+		return "", fmt.Errorf("writing revert commit: %w", err)
+	}
+
+	return commitID, nil
+}
+
 type requestFetchingStartRevision interface {
 	GetBranchName() []byte
 	GetStartRepository() *gitalypb.Repository
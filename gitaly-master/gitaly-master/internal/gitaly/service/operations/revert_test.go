@@ -3,6 +3,7 @@
 package operations
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"testing"
@@ -12,16 +13,23 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestUserRevert(t *testing.T) {
 	t.Parallel()
 
-	ctx := testhelper.Context(t)
+	testhelper.NewFeatureSets(featureflag.MergeTreeRevert).Run(t, testUserRevert)
+}
+
+func testUserRevert(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
 	ctx, cfg, client := setupOperationsServiceWithoutRepo(t, ctx)
 
 	branchName := "revert-branch"
@@ -160,7 +168,6 @@ func TestUserRevert(t *testing.T) {
 						Message:         []byte("Reverting " + firstCommitID),
 						DryRun:          true,
 					},
-					expectedCommitID: firstCommit.Id,
 				}
 			},
 			expectedResponse: &gitalypb.UserRevertResponse{BranchUpdate: &gitalypb.OperationBranchUpdate{
@@ -192,7 +199,6 @@ func TestUserRevert(t *testing.T) {
 						Message:         []byte("Reverting " + firstCommitID),
 						DryRun:          true,
 					},
-					expectedCommitID: firstCommitID.String(),
 				}
 			},
 			expectedResponse: &gitalypb.UserRevertResponse{BranchUpdate: &gitalypb.OperationBranchUpdate{BranchCreated: true, RepoCreated: true}},
@@ -391,16 +397,29 @@ func TestUserRevert(t *testing.T) {
 				return
 			}
 
-			branchCommitID := text.ChompBytes(gittest.Exec(t, cfg, "-C", data.repoPath, "rev-parse", branchName))
-			tc.expectedResponse.BranchUpdate.CommitId = branchCommitID
+			if data.request.DryRun {
+				// Dry runs must neither create nor update the branch. If it existed
+				// before, it must still point at the expected commit.
+				branchCommitID, err := repo.ResolveRevision(ctx, git.Revision(branchName))
+				if data.expectedCommitID != "" {
+					require.NoError(t, err)
+					require.Equal(t, data.expectedCommitID, branchCommitID.String(), "dry run should point at expected commit")
+				} else {
+					require.Equal(t, git.ErrReferenceNotFound, err)
+				}
+
+				// The computed revert commit must not have been persisted.
+				_, err = repo.ReadCommit(ctx, git.Revision(response.GetBranchUpdate().GetCommitId()))
+				require.Equal(t, localrepo.ErrObjectNotFound, err)
 
-			// For dry-run, we only skip the `update-ref` section, so a non-existent branch
-			// will be created by `UserRevert`. But, we need to ensure that the
-			// expectedCommitID of the branch on which we requested revert doesn't change.
-			if data.expectedCommitID != "" {
-				require.Equal(t, data.expectedCommitID, branchCommitID, "dry run should point at expected commit")
+				tc.expectedResponse.BranchUpdate.CommitId = response.GetBranchUpdate().GetCommitId()
+				testhelper.ProtoEqual(t, tc.expectedResponse, response)
+				return
 			}
 
+			branchCommitID := text.ChompBytes(gittest.Exec(t, cfg, "-C", data.repoPath, "rev-parse", branchName))
+			tc.expectedResponse.BranchUpdate.CommitId = branchCommitID
+
 			testhelper.ProtoEqual(t, tc.expectedResponse, response)
 		})
 	}
@@ -408,8 +427,12 @@ func TestUserRevert(t *testing.T) {
 
 func TestServer_UserRevert_quarantine(t *testing.T) {
 	t.Parallel()
-	ctx := testhelper.Context(t)
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeRevert).Run(t, testServer_UserRevert_quarantine)
+}
+
+func testServer_UserRevert_quarantine(t *testing.T, ctx context.Context) {
+	t.Parallel()
 	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
 
@@ -449,8 +472,12 @@ func TestServer_UserRevert_quarantine(t *testing.T) {
 
 func TestServer_UserRevert_stableID(t *testing.T) {
 	t.Parallel()
-	ctx := testhelper.Context(t)
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeRevert).Run(t, testServer_UserRevert_stableID)
+}
+
+func testServer_UserRevert_stableID(t *testing.T, ctx context.Context) {
+	t.Parallel()
 	ctx, cfg, repoProto, _, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
@@ -501,10 +528,72 @@ func TestServer_UserRevert_stableID(t *testing.T) {
 	}, revertedCommit)
 }
 
-func TestServer_UserRevert_successfulIntoEmptyRepo(t *testing.T) {
+func TestUserRevert_mergeTreeMatchesGit2Go(t *testing.T) {
 	t.Parallel()
 
 	ctx := testhelper.Context(t)
+	ctx, cfg, repoProto, _, client := setupOperationsService(t, ctx)
+
+	repo := localrepo.NewTestRepo(t, cfg, repoProto)
+
+	for _, tc := range []struct {
+		desc       string
+		branchName string
+		commitID   git.Revision
+	}{
+		{
+			desc:       "clean revert",
+			branchName: "master",
+			commitID:   "d59c60028b053793cecfb4022de34602e1a9218e",
+		},
+		{
+			desc:       "conflicting revert",
+			branchName: "conflict_branch_a",
+			commitID:   "f0f390655872bb2772c85a0128b2fbc2d88670cb",
+		},
+		{
+			desc:       "revert of unrelated commit",
+			branchName: "master",
+			commitID:   "8a0f2ee90d940bfb0ba1e14e8214b0649056e4ab",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			commit, err := repo.ReadCommit(ctx, tc.commitID)
+			require.NoError(t, err)
+
+			request := &gitalypb.UserRevertRequest{
+				Repository: repoProto,
+				User:       gittest.TestUser,
+				Commit:     commit,
+				BranchName: []byte(tc.branchName),
+				Message:    []byte("Reverting " + commit.Id),
+				Timestamp:  &timestamppb.Timestamp{Seconds: 12345},
+				DryRun:     true,
+			}
+
+			git2goResponse, git2goErr := client.UserRevert(featureflag.ContextWithFeatureFlag(ctx, featureflag.MergeTreeRevert, false), request)
+			mergeTreeResponse, mergeTreeErr := client.UserRevert(featureflag.ContextWithFeatureFlag(ctx, featureflag.MergeTreeRevert, true), request)
+
+			testhelper.ProtoEqual(t, git2goResponse, mergeTreeResponse)
+			require.Equal(t, status.Code(git2goErr), status.Code(mergeTreeErr))
+			testhelper.ProtoEqual(t, status.Convert(git2goErr).Details(), status.Convert(mergeTreeErr).Details())
+		})
+	}
+}
+
+func TestServer_UserRevert_successfulIntoEmptyRepo(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.MergeTreeRevert).Run(t, testServer_UserRevert_successfulIntoEmptyRepo)
+}
+
+func testServer_UserRevert_successfulIntoEmptyRepo(t *testing.T, ctx context.Context) {
+	t.Parallel()
+
 	ctx, cfg, startRepoProto, _, client := setupOperationsService(t, ctx)
 
 	startRepo := localrepo.NewTestRepo(t, cfg, startRepoProto)
@@ -549,8 +638,12 @@ func TestServer_UserRevert_successfulIntoEmptyRepo(t *testing.T) {
 
 func TestServer_UserRevert_successfulGitHooks(t *testing.T) {
 	t.Parallel()
-	ctx := testhelper.Context(t)
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeRevert).Run(t, testServer_UserRevert_successfulGitHooks)
+}
+
+func testServer_UserRevert_successfulGitHooks(t *testing.T, ctx context.Context) {
+	t.Parallel()
 	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
@@ -587,8 +680,12 @@ func TestServer_UserRevert_successfulGitHooks(t *testing.T) {
 
 func TestServer_UserRevert_failedDueToPreReceiveError(t *testing.T) {
 	t.Parallel()
-	ctx := testhelper.Context(t)
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeRevert).Run(t, testServer_UserRevert_failedDueToPreReceiveError)
+}
+
+func testServer_UserRevert_failedDueToPreReceiveError(t *testing.T, ctx context.Context) {
+	t.Parallel()
 	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
@@ -622,8 +719,12 @@ func TestServer_UserRevert_failedDueToPreReceiveError(t *testing.T) {
 
 func TestServer_UserRevert_failedDueToCreateTreeErrorConflict(t *testing.T) {
 	t.Parallel()
-	ctx := testhelper.Context(t)
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeRevert).Run(t, testServer_UserRevert_failedDueToCreateTreeErrorConflict)
+}
+
+func testServer_UserRevert_failedDueToCreateTreeErrorConflict(t *testing.T, ctx context.Context) {
+	t.Parallel()
 	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
@@ -651,8 +752,12 @@ func TestServer_UserRevert_failedDueToCreateTreeErrorConflict(t *testing.T) {
 
 func TestServer_UserRevert_failedDueToCreateTreeErrorEmpty(t *testing.T) {
 	t.Parallel()
-	ctx := testhelper.Context(t)
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeRevert).Run(t, testServer_UserRevert_failedDueToCreateTreeErrorEmpty)
+}
+
+func testServer_UserRevert_failedDueToCreateTreeErrorEmpty(t *testing.T, ctx context.Context) {
+	t.Parallel()
 	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
@@ -684,8 +789,12 @@ func TestServer_UserRevert_failedDueToCreateTreeErrorEmpty(t *testing.T) {
 
 func TestServer_UserRevert_failedDueToCommitError(t *testing.T) {
 	t.Parallel()
-	ctx := testhelper.Context(t)
 
+	testhelper.NewFeatureSets(featureflag.MergeTreeRevert).Run(t, testServer_UserRevert_failedDueToCommitError)
+}
+
+func testServer_UserRevert_failedDueToCommitError(t *testing.T, ctx context.Context) {
+	t.Parallel()
 	ctx, cfg, repoProto, repoPath, client := setupOperationsService(t, ctx)
 
 	repo := localrepo.NewTestRepo(t, cfg, repoProto)
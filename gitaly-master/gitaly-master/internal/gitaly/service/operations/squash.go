@@ -176,6 +176,12 @@ func (s *Server) userSquash(ctx context.Context, req *gitalypb.UserSquashRequest
 
 	commitID := merge.CommitID
 
+	// A dry run only computes the squashed commit, so we skip migrating the quarantine
+	// directory. This also means that there is nothing to vote on.
+	if req.GetDryRun() {
+		return commitID, nil
+	}
+
 	// The RPC is badly designed in that it never updates any references, but only creates the
 	// objects and writes them to disk. We still use a quarantine directory to stage the new
 	// objects, vote on them and migrate them into the main directory if quorum was reached so
@@ -111,6 +111,7 @@ func TestUserSquash_transactional(t *testing.T) {
 
 	for _, tc := range []struct {
 		desc           string
+		dryRun         bool
 		voteFn         func(context.Context, txinfo.Transaction, voting.Vote, voting.Phase) error
 		expectedErr    error
 		expectedVotes  []voting.Vote
@@ -157,6 +158,16 @@ func TestUserSquash_transactional(t *testing.T) {
 			// the repository.
 			expectedExists: true,
 		},
+		{
+			desc:   "dry run",
+			dryRun: true,
+			voteFn: func(context.Context, txinfo.Transaction, voting.Vote, voting.Phase) error {
+				return errors.New("unexpected vote")
+			},
+			// A dry run neither votes nor migrates the quarantined objects into the
+			// repository.
+			expectedExists: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			ctx, err := txinfo.InjectTransaction(ctx, 1, "node", true)
@@ -188,6 +199,7 @@ func TestUserSquash_transactional(t *testing.T) {
 				StartSha:      startSha,
 				EndSha:        endSha,
 				Timestamp:     &timestamppb.Timestamp{Seconds: 1234512345},
+				DryRun:        tc.dryRun,
 			})
 
 			if tc.expectedErr == nil {
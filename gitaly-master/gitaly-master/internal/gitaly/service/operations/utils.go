@@ -1,9 +1,14 @@
 package operations
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git2go"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -63,3 +68,39 @@ func dateFromProto(p userTimestampProto) (time.Time, error) {
 
 	return date, nil
 }
+
+// signatureDate returns the date of the given signature in the signature's own timezone.
+func signatureDate(signature *gitalypb.CommitAuthor) time.Time {
+	date := signature.GetDate().AsTime()
+
+	if timezone, err := time.Parse("-0700", string(signature.GetTimezone())); err == nil {
+		date = date.In(timezone.Location())
+	}
+
+	return date
+}
+
+// mergeBaseFromParents returns the parent of the commit that shall be used as merge base when
+// cherry-picking or reverting it. mainline is the 1-based index of the parent and must be set
+// for merge commits. The empty tree is returned for root commits.
+func mergeBaseFromParents(ctx context.Context, repo *localrepo.Repo, commit *gitalypb.GitCommit, mainline uint) (git.Revision, error) {
+	parentIDs := commit.GetParentIds()
+
+	switch {
+	case len(parentIDs) == 0:
+		objectHash, err := repo.ObjectHash(ctx)
+		if err != nil {
+			return "", fmt.Errorf("detecting object hash: %w", err)
+		}
+
+		return objectHash.EmptyTreeOID.Revision(), nil
+	case mainline == 0 && len(parentIDs) > 1:
+		return "", fmt.Errorf("%w: mainline branch is not specified but %s is a merge commit", git2go.ErrInvalidArgument, commit.GetId())
+	case mainline == 0:
+		mainline = 1
+	case mainline > uint(len(parentIDs)):
+		return "", fmt.Errorf("%w: mainline %d does not exist for commit %s", git2go.ErrInvalidArgument, mainline, commit.GetId())
+	}
+
+	return git.Revision(parentIDs[mainline-1]), nil
+}
@@ -33,27 +33,47 @@ func (s *server) FindAllTags(in *gitalypb.FindAllTagsRequest, stream gitalypb.Re
 
 	repo := s.localrepo(in.GetRepository())
 
-	if err := s.findAllTags(ctx, repo, sortField, stream, opts); err != nil {
+	patterns := patternsOrDefault(in.GetPatterns(), "refs/tags/")
+
+	if err := s.findAllTags(ctx, repo, sortField, patterns, stream, opts); err != nil {
 		return structerr.NewInternal("%w", err)
 	}
 
 	return nil
 }
 
-func (s *server) findAllTags(ctx context.Context, repo *localrepo.Repo, sortField string, stream gitalypb.RefService_FindAllTagsServer, opts *paginationOpts) error {
+func (s *server) findAllTags(ctx context.Context, repo *localrepo.Repo, sortField string, patterns []string, stream gitalypb.RefService_FindAllTagsServer, opts *paginationOpts) error {
+	// Nothing is to be returned with a limit of zero, so there is no need to verify that the page
+	// token exists either.
+	if opts.Limit == 0 {
+		return nil
+	}
+
 	objectReader, cancel, err := s.catfileCache.ObjectReader(ctx, repo)
 	if err != nil {
 		return fmt.Errorf("creating object reader: %w", err)
 	}
 	defer cancel()
 
-	forEachRefIter := gitpipe.ForEachRef(
-		ctx,
-		repo,
-		[]string{"refs/tags/"},
-		gitpipe.WithSortField(sortField),
-		gitpipe.WithForEachRefFormat("%(objectname) %(refname)%(if)%(*objectname)%(then)\n%(objectname)^{} PEELED%(end)"),
-	)
+	ctx, cancelPipeline := context.WithCancel(ctx)
+	defer cancelPipeline()
+
+	// If `PageToken` is not provided, then `IsPageToken` will always return `true`
+	// and disable pagination logic. If `PageToken` is set, then we will skip all tags
+	// until we reach the tag equal to `PageToken`. After that, tags will be returned
+	// as usual. Skipping happens before we read any objects so that we don't have to
+	// parse tags which aren't returned to the caller anyway.
+	forEachRefIter := &pageTokenIterator{
+		RevisionIterator: gitpipe.ForEachRef(
+			ctx,
+			repo,
+			patterns,
+			gitpipe.WithSortField(sortField),
+			gitpipe.WithForEachRefFormat("%(objectname) %(refname)%(if)%(*objectname)%(then)\n%(objectname)^{} PEELED%(end)"),
+		),
+		isPageToken:   opts.IsPageToken,
+		pastPageToken: opts.IsPageToken([]byte{}),
+	}
 
 	catfileObjectsIter, err := gitpipe.CatfileObject(ctx, objectReader, forEachRefIter)
 	if err != nil {
@@ -62,11 +82,8 @@ func (s *server) findAllTags(ctx context.Context, repo *localrepo.Repo, sortFiel
 
 	chunker := chunk.New(&tagSender{stream: stream})
 
-	// If `PageToken` is not provided, then `IsPageToken` will always return `true`
-	// and disable pagination logic. If `PageToken` is set, then we will skip all tags
-	// until we reach the tag equal to `PageToken`. After that, tags will be returned
-	// as usual.
 	pastPageToken := opts.IsPageToken([]byte{})
+	exhausted := true
 	limit := opts.Limit
 	i := 0
 
@@ -76,8 +93,10 @@ func (s *server) findAllTags(ctx context.Context, repo *localrepo.Repo, sortFiel
 		tag := catfileObjectsIter.Result()
 
 		if i >= limit {
+			exhausted = false
 			break
 		}
+		pastPageToken = true
 
 		var result *gitalypb.Tag
 		switch tag.ObjectType() {
@@ -138,11 +157,6 @@ func (s *server) findAllTags(ctx context.Context, repo *localrepo.Repo, sortFiel
 			result.Name = tagName
 		}
 
-		if !pastPageToken {
-			pastPageToken = opts.IsPageToken(tag.ObjectName)
-			continue
-		}
-
 		if err := chunker.Send(result); err != nil {
 			return fmt.Errorf("sending tag: %w", err)
 		}
@@ -150,14 +164,17 @@ func (s *server) findAllTags(ctx context.Context, repo *localrepo.Repo, sortFiel
 		i++
 	}
 
-	if !pastPageToken {
-		return structerr.NewInvalidArgument("could not find page token")
-	}
-
 	if err := catfileObjectsIter.Err(); err != nil {
 		return fmt.Errorf("iterating over tags: %w", err)
 	}
 
+	// The page token may have been the last tag, in which case we didn't receive any tags at
+	// all. We can only ask the iterator about this in case the pipeline has been exhausted
+	// though, as it is still running otherwise.
+	if !pastPageToken && (!exhausted || !forEachRefIter.pastPageToken) {
+		return structerr.NewInvalidArgument("could not find page token")
+	}
+
 	if err := chunker.Flush(); err != nil {
 		return fmt.Errorf("flushing chunker: %w", err)
 	}
@@ -165,6 +182,39 @@ func (s *server) findAllTags(ctx context.Context, repo *localrepo.Repo, sortFiel
 	return nil
 }
 
+// pageTokenIterator skips all references returned by the wrapped iterator up to and including the
+// reference that matches the page token. Peeled objects of skipped annotated tags are skipped, too.
+type pageTokenIterator struct {
+	gitpipe.RevisionIterator
+	isPageToken   func([]byte) bool
+	pastPageToken bool
+	skipPeeled    bool
+}
+
+func (it *pageTokenIterator) Next() bool {
+	for it.RevisionIterator.Next() {
+		isPeeled := bytes.Equal(it.ObjectName(), []byte("PEELED"))
+
+		if it.pastPageToken {
+			if it.skipPeeled {
+				it.skipPeeled = false
+				if isPeeled {
+					continue
+				}
+			}
+
+			return true
+		}
+
+		if !isPeeled && it.isPageToken(it.ObjectName()) {
+			it.pastPageToken = true
+			it.skipPeeled = true
+		}
+	}
+
+	return false
+}
+
 func (s *server) validateFindAllTagsRequest(request *gitalypb.FindAllTagsRequest) error {
 	repository := request.GetRepository()
 	if err := service.ValidateRepository(repository); err != nil {
@@ -175,7 +225,7 @@ func (s *server) validateFindAllTagsRequest(request *gitalypb.FindAllTagsRequest
 		return fmt.Errorf("invalid git directory: %w", err)
 	}
 
-	return nil
+	return validatePatterns(request.GetPatterns(), "refs/tags/")
 }
 
 type tagSender struct {
@@ -548,7 +548,8 @@ func TestFindAllTags_pagination(t *testing.T) {
 			desc:             "with page token only",
 			paginationParams: &gitalypb.PaginationParameter{PageToken: "refs/tags/v1.1.0"},
 			expected: func(context.Context) ([]string, error) {
-				return nil, structerr.NewInvalidArgument("could not find page token")
+				// The limit defaults to zero, so no tags are returned.
+				return nil, nil
 			},
 		},
 		{
@@ -789,3 +790,106 @@ func BenchmarkFindAllTags(b *testing.B) {
 		}
 	})
 }
+
+func TestFindAllTags_patternsAndPagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRefServiceWithoutRepo(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+
+	tagIDs := map[string]string{}
+	for _, name := range []string{"v1.0.0", "v1.1.0", "v2.0.0"} {
+		// Annotated tags make the pipeline emit an additional peeled object per tag, which must be
+		// skipped together with the tag itself.
+		tagIDs[name] = gittest.WriteTag(t, cfg, repoPath, name, commitID.Revision(), gittest.WriteTagConfig{
+			Message: name,
+		}).String()
+	}
+	gittest.WriteTag(t, cfg, repoPath, "release", commitID.Revision())
+	tagIDs["release"] = commitID.String()
+
+	for _, tc := range []struct {
+		desc             string
+		patterns         [][]byte
+		paginationParams *gitalypb.PaginationParameter
+		expectedTags     []string
+		expectedErr      error
+	}{
+		{
+			desc:         "without patterns",
+			expectedTags: []string{"release", "v1.0.0", "v1.1.0", "v2.0.0"},
+		},
+		{
+			desc:         "with glob pattern",
+			patterns:     [][]byte{[]byte("refs/tags/v1.*")},
+			expectedTags: []string{"v1.0.0", "v1.1.0"},
+		},
+		{
+			desc:             "with page token",
+			paginationParams: &gitalypb.PaginationParameter{Limit: 2, PageToken: "refs/tags/v1.0.0"},
+			expectedTags:     []string{"v1.1.0", "v2.0.0"},
+		},
+		{
+			desc:             "with page token pointing to last tag",
+			paginationParams: &gitalypb.PaginationParameter{Limit: 2, PageToken: "refs/tags/v2.0.0"},
+		},
+		{
+			desc:             "with pattern and page token",
+			patterns:         [][]byte{[]byte("refs/tags/v*")},
+			paginationParams: &gitalypb.PaginationParameter{Limit: 1, PageToken: "refs/tags/v1.1.0"},
+			expectedTags:     []string{"v2.0.0"},
+		},
+		{
+			desc:             "with zero limit and page token",
+			paginationParams: &gitalypb.PaginationParameter{Limit: 0, PageToken: "refs/tags/v1.0.0"},
+		},
+		{
+			desc:             "with page token filtered by pattern",
+			patterns:         [][]byte{[]byte("refs/tags/v2.*")},
+			paginationParams: &gitalypb.PaginationParameter{Limit: 1, PageToken: "refs/tags/v1.1.0"},
+			expectedErr:      structerr.NewInvalidArgument("could not find page token"),
+		},
+		{
+			desc:        "pattern outside of tags",
+			patterns:    [][]byte{[]byte("refs/heads/")},
+			expectedErr: structerr.NewInvalidArgument(`pattern "refs/heads/" must be located in refs/tags/`),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			stream, err := client.FindAllTags(ctx, &gitalypb.FindAllTagsRequest{
+				Repository:       repo,
+				Patterns:         tc.patterns,
+				PaginationParams: tc.paginationParams,
+			})
+			require.NoError(t, err)
+
+			var tags []string
+			for {
+				response, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					testhelper.RequireGrpcError(t, tc.expectedErr, err)
+					return
+				}
+
+				for _, tag := range response.GetTags() {
+					require.Equal(t, tagIDs[string(tag.GetName())], tag.GetId())
+					require.Equal(t, commitID.String(), tag.GetTargetCommit().GetId())
+					tags = append(tags, string(tag.GetName()))
+				}
+			}
+
+			require.NoError(t, tc.expectedErr)
+			require.Equal(t, tc.expectedTags, tags)
+		})
+	}
+}
@@ -20,8 +20,9 @@ func (s *server) ListRefs(in *gitalypb.ListRefsRequest, stream gitalypb.RefServi
 	repo := s.localrepo(in.GetRepository())
 	ctx := stream.Context()
 
+	// HEAD is only returned as part of the first page.
 	var headOID git.ObjectID
-	if in.GetHead() {
+	if in.GetHead() && in.GetPaginationParams().GetPageToken() == "" {
 		var err error
 		headOID, err = repo.ResolveRevision(ctx, git.Revision("HEAD"))
 		if err != nil && !errors.Is(err, git.ErrReferenceNotFound) {
@@ -42,7 +43,7 @@ func (s *server) ListRefs(in *gitalypb.ListRefsRequest, stream gitalypb.RefServi
 	}
 
 	sorting := sortDirectionByEnum[in.GetSortBy().GetDirection()] + sortKeyByEnum[in.GetSortBy().GetKey()]
-	opts := buildFindRefsOpts(ctx, nil)
+	opts := buildFindRefsOpts(ctx, in.GetPaginationParams())
 	opts.cmdArgs = []git.Option{
 		// %00 inserts the null character into the output (see for-each-ref docs)
 		git.ValueFlag{Name: "--format", Value: strings.Join(format, "%00")},
@@ -253,6 +253,74 @@ func TestServer_ListRefs(t *testing.T) {
 	}
 }
 
+func TestListRefs_pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRefServiceWithoutRepo(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+	gittest.WriteRef(t, cfg, repoPath, "refs/heads/feature", commitID)
+	gittest.WriteRef(t, cfg, repoPath, "refs/tags/v1.0.0", commitID)
+
+	for _, tc := range []struct {
+		desc             string
+		paginationParams *gitalypb.PaginationParameter
+		expected         []string
+		expectedErr      error
+	}{
+		{
+			desc:             "first page",
+			paginationParams: &gitalypb.PaginationParameter{Limit: 2},
+			expected:         []string{"HEAD", "refs/heads/feature", "refs/heads/main"},
+		},
+		{
+			desc:             "second page",
+			paginationParams: &gitalypb.PaginationParameter{Limit: 2, PageToken: "refs/heads/main"},
+			expected:         []string{"refs/tags/v1.0.0"},
+		},
+		{
+			desc:             "missing page token",
+			paginationParams: &gitalypb.PaginationParameter{Limit: 2, PageToken: "refs/heads/missing"},
+			expectedErr:      status.Error(codes.Internal, "could not find page token"),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			stream, err := client.ListRefs(ctx, &gitalypb.ListRefsRequest{
+				Repository:       repo,
+				Head:             true,
+				Patterns:         [][]byte{[]byte("refs/")},
+				PaginationParams: tc.paginationParams,
+			})
+			require.NoError(t, err)
+
+			var refs []string
+			for {
+				response, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					testhelper.RequireGrpcError(t, tc.expectedErr, err)
+					return
+				}
+
+				for _, ref := range response.GetReferences() {
+					refs = append(refs, string(ref.GetName()))
+				}
+			}
+
+			require.NoError(t, tc.expectedErr)
+			require.Equal(t, tc.expected, refs)
+		})
+	}
+}
+
 func TestListRefs_validate(t *testing.T) {
 	t.Parallel()
 	ctx := testhelper.Context(t)
@@ -21,7 +21,7 @@ func (s *server) FindAllBranchNames(in *gitalypb.FindAllBranchNamesRequest, stre
 
 	chunker := chunk.New(&findAllBranchNamesSender{stream: stream})
 
-	if err := s.listRefNames(stream.Context(), chunker, "refs/heads", in.Repository, nil); err != nil {
+	if err := s.listRefNames(stream.Context(), chunker, []string{"refs/heads"}, in.Repository, nil, buildPaginationOpts(stream.Context(), nil)); err != nil {
 		return structerr.NewInternal("%w", err)
 	}
 
@@ -49,7 +49,7 @@ func (s *server) FindAllTagNames(in *gitalypb.FindAllTagNamesRequest, stream git
 	}
 
 	chunker := chunk.New(&findAllTagNamesSender{stream: stream})
-	if err := s.listRefNames(stream.Context(), chunker, "refs/tags", in.Repository, nil); err != nil {
+	if err := s.listRefNames(stream.Context(), chunker, []string{"refs/tags"}, in.Repository, nil, buildPaginationOpts(stream.Context(), nil)); err != nil {
 		return structerr.NewInternal("%w", err)
 	}
 
@@ -70,7 +70,13 @@ func (ts *findAllTagNamesSender) Send() error {
 	return ts.stream.Send(&gitalypb.FindAllTagNamesResponse{Names: ts.tagNames})
 }
 
-func (s *server) listRefNames(ctx context.Context, chunker *chunk.Chunker, prefix string, repo *gitalypb.Repository, extraArgs []string) error {
+func (s *server) listRefNames(ctx context.Context, chunker *chunk.Chunker, patterns []string, repo *gitalypb.Repository, extraArgs []string, opts *paginationOpts) error {
+	// Nothing is to be returned with a limit of zero, so there is no need to verify that the page
+	// token exists either.
+	if opts.Limit == 0 {
+		return nil
+	}
+
 	flags := []git.Option{
 		git.Flag{Name: "--format=%(refname)"},
 	}
@@ -79,26 +85,50 @@ func (s *server) listRefNames(ctx context.Context, chunker *chunk.Chunker, prefi
 		flags = append(flags, git.Flag{Name: arg})
 	}
 
+	// The command is cancelled once we have reached the limit so that we don't need to wait for
+	// it to list all remaining references.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	cmd, err := s.gitCmdFactory.New(ctx, repo, git.Command{
 		Name:  "for-each-ref",
 		Flags: flags,
-		Args:  []string{prefix},
+		Args:  patterns,
 	})
 	if err != nil {
 		return err
 	}
 
+	// If no page token has been given, then `IsPageToken` will always return `true`. Otherwise,
+	// we skip all references up to and including the page token.
+	pastPageToken := opts.IsPageToken([]byte{})
+	sent := 0
+	limitReached := false
+
 	scanner := bufio.NewScanner(cmd)
 	for scanner.Scan() {
+		if sent >= opts.Limit {
+			limitReached = true
+			cancel()
+			break
+		}
+
+		if !pastPageToken {
+			pastPageToken = opts.IsPageToken(scanner.Bytes())
+			continue
+		}
+
 		// Important: don't use scanner.Bytes() because the slice will become
 		// invalid on the next loop iteration. Instead, use scanner.Text() to
 		// force a copy.
 		if err := chunker.Send(&wrapperspb.StringValue{Value: scanner.Text()}); err != nil {
 			return err
 		}
+		sent++
 	}
 
-	if err := cmd.Wait(); err != nil {
+	// The command fails when we have cancelled it after reaching the limit, which is expected.
+	if err := cmd.Wait(); err != nil && !limitReached {
 		return err
 	}
 
@@ -106,5 +136,9 @@ func (s *server) listRefNames(ctx context.Context, chunker *chunk.Chunker, prefi
 		return err
 	}
 
+	if !pastPageToken && opts.PageTokenError {
+		return structerr.NewInvalidArgument("could not find page token")
+	}
+
 	return chunker.Flush()
 }
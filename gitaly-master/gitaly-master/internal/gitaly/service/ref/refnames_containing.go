@@ -22,10 +22,16 @@ func (s *server) ListBranchNamesContainingCommit(in *gitalypb.ListBranchNamesCon
 	if err := git.ObjectHashSHA1.ValidateHex(in.GetCommitId()); err != nil {
 		return structerr.NewInvalidArgument("%w", err)
 	}
+	if err := validatePatterns(in.GetPatterns(), "refs/heads/"); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	args := append(containingArgs(in), "--sort="+parseSortKey(in.GetSortBy()))
+	patterns := patternsOrDefault(in.GetPatterns(), "refs/heads")
 
 	chunker := chunk.New(&branchNamesContainingCommitSender{stream: stream})
 	ctx := stream.Context()
-	if err := s.listRefNames(ctx, chunker, "refs/heads", in.Repository, containingArgs(in)); err != nil {
+	if err := s.listRefNames(ctx, chunker, patterns, in.Repository, args, buildPaginationOpts(ctx, in.GetPaginationParams())); err != nil {
 		return structerr.NewInternal("%w", err)
 	}
 
@@ -35,11 +41,14 @@ func (s *server) ListBranchNamesContainingCommit(in *gitalypb.ListBranchNamesCon
 type containingRequest interface {
 	GetCommitId() string
 	GetLimit() uint32
+	GetPaginationParams() *gitalypb.PaginationParameter
 }
 
 func containingArgs(req containingRequest) []string {
 	args := []string{fmt.Sprintf("--contains=%s", req.GetCommitId())}
-	if limit := req.GetLimit(); limit != 0 {
+	// The legacy limit is only honored in case the caller doesn't use pagination, in which case
+	// the limit is enforced when scanning the output.
+	if limit := req.GetLimit(); limit != 0 && req.GetPaginationParams() == nil {
 		args = append(args, fmt.Sprintf("--count=%d", limit))
 	}
 	return args
@@ -68,10 +77,27 @@ func (s *server) ListTagNamesContainingCommit(in *gitalypb.ListTagNamesContainin
 	if err := git.ObjectHashSHA1.ValidateHex(in.GetCommitId()); err != nil {
 		return structerr.NewInvalidArgument("%w", err)
 	}
+	if err := validatePatterns(in.GetPatterns(), "refs/tags/"); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	args := containingArgs(in)
+	if sortBy := in.GetSortBy(); sortBy != nil {
+		sortField, err := getTagSortField(&gitalypb.FindAllTagsRequest_SortBy{
+			Key:       sortBy.GetKey(),
+			Direction: sortBy.GetDirection(),
+		})
+		if err != nil {
+			return structerr.NewInvalidArgument("%w", err)
+		}
+
+		args = append(args, "--sort="+sortField)
+	}
+	patterns := patternsOrDefault(in.GetPatterns(), "refs/tags")
 
 	chunker := chunk.New(&tagNamesContainingCommitSender{stream: stream})
 	ctx := stream.Context()
-	if err := s.listRefNames(ctx, chunker, "refs/tags", in.Repository, containingArgs(in)); err != nil {
+	if err := s.listRefNames(ctx, chunker, patterns, in.Repository, args, buildPaginationOpts(ctx, in.GetPaginationParams())); err != nil {
 		return structerr.NewInternal("%w", err)
 	}
 
@@ -3,6 +3,7 @@ package ref
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
@@ -102,6 +103,9 @@ func (s *server) FindLocalBranches(in *gitalypb.FindLocalBranchesRequest, stream
 	if err := service.ValidateRepository(in.GetRepository()); err != nil {
 		return structerr.NewInvalidArgument("%w", err)
 	}
+	if err := validatePatterns(in.GetPatterns(), "refs/heads/"); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
 	if err := s.findLocalBranches(in, stream); err != nil {
 		return structerr.NewInternal("%w", err)
 	}
@@ -127,7 +131,9 @@ func (s *server) findLocalBranches(in *gitalypb.FindLocalBranchesRequest, stream
 		git.Flag{Name: "--sort=" + parseSortKey(in.GetSortBy())},
 	}
 
-	if err := s.findRefs(ctx, writer, repo, []string{"refs/heads"}, opts); err != nil {
+	patterns := patternsOrDefault(in.GetPatterns(), "refs/heads")
+
+	if err := s.findRefs(ctx, writer, repo, patterns, opts); err != nil {
 		return fmt.Errorf("finding refs: %w", err)
 	}
 
@@ -135,7 +141,7 @@ func (s *server) findLocalBranches(in *gitalypb.FindLocalBranchesRequest, stream
 }
 
 func (s *server) FindAllBranches(in *gitalypb.FindAllBranchesRequest, stream gitalypb.RefService_FindAllBranchesServer) error {
-	if err := service.ValidateRepository(in.GetRepository()); err != nil {
+	if err := validateFindAllBranchesRequest(in); err != nil {
 		return structerr.NewInvalidArgument("%w", err)
 	}
 	if err := s.findAllBranches(in, stream); err != nil {
@@ -151,9 +157,10 @@ func (s *server) findAllBranches(in *gitalypb.FindAllBranchesRequest, stream git
 	args := []git.Option{
 		// %00 inserts the null character into the output (see for-each-ref docs)
 		git.Flag{Name: "--format=" + strings.Join(localBranchFormatFields, "%00")},
+		git.Flag{Name: "--sort=" + parseSortKey(in.GetSortBy())},
 	}
 
-	patterns := []string{"refs/heads", "refs/remotes"}
+	patterns := patternsOrDefault(in.GetPatterns(), "refs/heads", "refs/remotes")
 
 	if in.MergedOnly {
 		defaultBranch, err := repo.GetDefaultBranch(stream.Context())
@@ -179,7 +186,7 @@ func (s *server) findAllBranches(in *gitalypb.FindAllBranchesRequest, stream git
 	}
 	defer cancel()
 
-	opts := buildFindRefsOpts(ctx, nil)
+	opts := buildFindRefsOpts(ctx, in.GetPaginationParams())
 	opts.cmdArgs = args
 
 	writer := newFindAllBranchesWriter(stream, objectReader)
@@ -191,6 +198,54 @@ func (s *server) findAllBranches(in *gitalypb.FindAllBranchesRequest, stream git
 	return nil
 }
 
+func validateFindAllBranchesRequest(in *gitalypb.FindAllBranchesRequest) error {
+	if err := service.ValidateRepository(in.GetRepository()); err != nil {
+		return err
+	}
+
+	if len(in.GetPatterns()) > 0 && len(in.GetMergedBranches()) > 0 {
+		return errors.New("patterns cannot be combined with merged branches")
+	}
+
+	return validatePatterns(in.GetPatterns(), "refs/heads/", "refs/remotes/")
+}
+
+// validatePatterns verifies that all patterns are located in one of the given reference
+// namespaces. This makes sure that callers cannot use patterns to list references that the RPC
+// is not supposed to return.
+func validatePatterns(patterns [][]byte, namespaces ...string) error {
+	for _, pattern := range patterns {
+		var found bool
+		for _, namespace := range namespaces {
+			if bytes.HasPrefix(pattern, []byte(namespace)) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("pattern %q must be located in %s", pattern, strings.Join(namespaces, " or "))
+		}
+	}
+
+	return nil
+}
+
+// patternsOrDefault converts the given patterns into arguments for git-for-each-ref(1). The
+// default patterns are returned in case no patterns have been given.
+func patternsOrDefault(patterns [][]byte, defaults ...string) []string {
+	if len(patterns) == 0 {
+		return defaults
+	}
+
+	result := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		result = append(result, string(pattern))
+	}
+
+	return result
+}
+
 func buildPaginationOpts(ctx context.Context, p *gitalypb.PaginationParameter) *paginationOpts {
 	opts := &paginationOpts{}
 	opts.IsPageToken = func(_ []byte) bool { return true }
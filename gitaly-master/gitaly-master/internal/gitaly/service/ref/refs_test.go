@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
@@ -1012,3 +1013,313 @@ func TestListBranchNamesContainingCommit_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestFindLocalBranches_patterns(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRefServiceWithoutRepo(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	commitID := gittest.WriteCommit(t, cfg, repoPath)
+	for _, branch := range []string{"main", "feature/a", "feature/b", "feature/c"} {
+		gittest.WriteRef(t, cfg, repoPath, git.NewReferenceNameFromBranchName(branch), commitID)
+	}
+
+	for _, tc := range []struct {
+		desc             string
+		patterns         [][]byte
+		paginationParams *gitalypb.PaginationParameter
+		expectedBranches []string
+		expectedErr      error
+	}{
+		{
+			desc:             "without patterns",
+			expectedBranches: []string{"refs/heads/feature/a", "refs/heads/feature/b", "refs/heads/feature/c", "refs/heads/main"},
+		},
+		{
+			desc:             "with glob pattern",
+			patterns:         [][]byte{[]byte("refs/heads/feature/*")},
+			expectedBranches: []string{"refs/heads/feature/a", "refs/heads/feature/b", "refs/heads/feature/c"},
+		},
+		{
+			desc:             "with pattern and pagination",
+			patterns:         [][]byte{[]byte("refs/heads/feature/")},
+			paginationParams: &gitalypb.PaginationParameter{Limit: 1, PageToken: "refs/heads/feature/a"},
+			expectedBranches: []string{"refs/heads/feature/b"},
+		},
+		{
+			desc:        "pattern outside of branches",
+			patterns:    [][]byte{[]byte("refs/tags/*")},
+			expectedErr: structerr.NewInvalidArgument(`pattern "refs/tags/*" must be located in refs/heads/`),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			stream, err := client.FindLocalBranches(ctx, &gitalypb.FindLocalBranchesRequest{
+				Repository:       repo,
+				Patterns:         tc.patterns,
+				PaginationParams: tc.paginationParams,
+			})
+			require.NoError(t, err)
+
+			var branches []string
+			for {
+				response, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					testhelper.RequireGrpcError(t, tc.expectedErr, err)
+					return
+				}
+
+				for _, branch := range response.GetLocalBranches() {
+					branches = append(branches, string(branch.GetName()))
+				}
+			}
+
+			require.NoError(t, tc.expectedErr)
+			require.Equal(t, tc.expectedBranches, branches)
+		})
+	}
+}
+
+func TestFindAllBranches_paginationAndPatterns(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRefServiceWithoutRepo(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	oldCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithCommitterDate(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)))
+	newCommitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(oldCommitID), gittest.WithCommitterDate(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)))
+	gittest.WriteRef(t, cfg, repoPath, "refs/heads/main", newCommitID)
+	gittest.WriteRef(t, cfg, repoPath, "refs/heads/old", oldCommitID)
+	gittest.WriteRef(t, cfg, repoPath, "refs/remotes/origin/main", newCommitID)
+	gittest.WriteRef(t, cfg, repoPath, "refs/remotes/origin/old", oldCommitID)
+
+	for _, tc := range []struct {
+		desc             string
+		request          *gitalypb.FindAllBranchesRequest
+		expectedBranches []string
+		expectedErr      error
+	}{
+		{
+			desc:             "all branches",
+			request:          &gitalypb.FindAllBranchesRequest{Repository: repo},
+			expectedBranches: []string{"refs/heads/main", "refs/heads/old", "refs/remotes/origin/main", "refs/remotes/origin/old"},
+		},
+		{
+			desc: "paginated",
+			request: &gitalypb.FindAllBranchesRequest{
+				Repository:       repo,
+				PaginationParams: &gitalypb.PaginationParameter{Limit: 2, PageToken: "refs/heads/old"},
+			},
+			expectedBranches: []string{"refs/remotes/origin/main", "refs/remotes/origin/old"},
+		},
+		{
+			desc: "sorted by committer date",
+			request: &gitalypb.FindAllBranchesRequest{
+				Repository: repo,
+				SortBy:     gitalypb.FindLocalBranchesRequest_UPDATED_DESC,
+				Patterns:   [][]byte{[]byte("refs/heads/")},
+			},
+			expectedBranches: []string{"refs/heads/main", "refs/heads/old"},
+		},
+		{
+			desc: "remote patterns",
+			request: &gitalypb.FindAllBranchesRequest{
+				Repository: repo,
+				Patterns:   [][]byte{[]byte("refs/remotes/origin/o*")},
+			},
+			expectedBranches: []string{"refs/remotes/origin/old"},
+		},
+		{
+			desc: "missing page token",
+			request: &gitalypb.FindAllBranchesRequest{
+				Repository:       repo,
+				PaginationParams: &gitalypb.PaginationParameter{Limit: 2, PageToken: "refs/heads/missing"},
+			},
+			expectedErr: structerr.NewInternal("finding refs: could not find page token"),
+		},
+		{
+			desc: "patterns with merged branches",
+			request: &gitalypb.FindAllBranchesRequest{
+				Repository:     repo,
+				MergedOnly:     true,
+				MergedBranches: [][]byte{[]byte("refs/heads/old")},
+				Patterns:       [][]byte{[]byte("refs/heads/")},
+			},
+			expectedErr: structerr.NewInvalidArgument("patterns cannot be combined with merged branches"),
+		},
+		{
+			desc: "pattern outside of branches",
+			request: &gitalypb.FindAllBranchesRequest{
+				Repository: repo,
+				Patterns:   [][]byte{[]byte("refs/tags/")},
+			},
+			expectedErr: structerr.NewInvalidArgument(`pattern "refs/tags/" must be located in refs/heads/ or refs/remotes/`),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			stream, err := client.FindAllBranches(ctx, tc.request)
+			require.NoError(t, err)
+
+			var branches []string
+			for {
+				response, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					testhelper.RequireGrpcError(t, tc.expectedErr, err)
+					return
+				}
+
+				for _, branch := range response.GetBranches() {
+					branches = append(branches, string(branch.GetName()))
+				}
+			}
+
+			require.NoError(t, tc.expectedErr)
+			require.Equal(t, tc.expectedBranches, branches)
+		})
+	}
+}
+
+func TestListRefNamesContainingCommit_paginationAndPatterns(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRefServiceWithoutRepo(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	commitID := gittest.WriteCommit(t, cfg, repoPath)
+	for _, name := range []string{"a", "b", "c", "other"} {
+		gittest.WriteRef(t, cfg, repoPath, git.NewReferenceNameFromBranchName(name), commitID)
+	}
+	for _, name := range []string{"v1.2.0", "v1.10.0", "v1.9.0", "other"} {
+		gittest.WriteRef(t, cfg, repoPath, git.ReferenceName("refs/tags/"+name), commitID)
+	}
+
+	receiveBranches := func(t *testing.T, request *gitalypb.ListBranchNamesContainingCommitRequest) ([]string, error) {
+		stream, err := client.ListBranchNamesContainingCommit(ctx, request)
+		require.NoError(t, err)
+
+		var names []string
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				return names, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			for _, name := range response.GetBranchNames() {
+				names = append(names, string(name))
+			}
+		}
+	}
+
+	receiveTags := func(t *testing.T, request *gitalypb.ListTagNamesContainingCommitRequest) ([]string, error) {
+		stream, err := client.ListTagNamesContainingCommit(ctx, request)
+		require.NoError(t, err)
+
+		var names []string
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				return names, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			for _, name := range response.GetTagNames() {
+				names = append(names, string(name))
+			}
+		}
+	}
+
+	t.Run("branches with pagination", func(t *testing.T) {
+		names, err := receiveBranches(t, &gitalypb.ListBranchNamesContainingCommitRequest{
+			Repository:       repo,
+			CommitId:         commitID.String(),
+			Limit:            1,
+			PaginationParams: &gitalypb.PaginationParameter{Limit: 2, PageToken: "refs/heads/a"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"b", "c"}, names)
+	})
+
+	t.Run("branches with patterns", func(t *testing.T) {
+		names, err := receiveBranches(t, &gitalypb.ListBranchNamesContainingCommitRequest{
+			Repository: repo,
+			CommitId:   commitID.String(),
+			Patterns:   [][]byte{[]byte("refs/heads/[ab]")},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b"}, names)
+	})
+
+	t.Run("branches with missing page token", func(t *testing.T) {
+		_, err := receiveBranches(t, &gitalypb.ListBranchNamesContainingCommitRequest{
+			Repository:       repo,
+			CommitId:         commitID.String(),
+			PaginationParams: &gitalypb.PaginationParameter{Limit: 2, PageToken: "refs/heads/missing"},
+		})
+		testhelper.RequireGrpcError(t, structerr.NewInvalidArgument("could not find page token"), err)
+	})
+
+	t.Run("branches with zero limit and page token", func(t *testing.T) {
+		names, err := receiveBranches(t, &gitalypb.ListBranchNamesContainingCommitRequest{
+			Repository:       repo,
+			CommitId:         commitID.String(),
+			PaginationParams: &gitalypb.PaginationParameter{Limit: 0, PageToken: "refs/heads/a"},
+		})
+		require.NoError(t, err)
+		require.Empty(t, names)
+	})
+
+	t.Run("branches with invalid pattern", func(t *testing.T) {
+		_, err := receiveBranches(t, &gitalypb.ListBranchNamesContainingCommitRequest{
+			Repository: repo,
+			CommitId:   commitID.String(),
+			Patterns:   [][]byte{[]byte("refs/tags/")},
+		})
+		testhelper.RequireGrpcError(t, structerr.NewInvalidArgument(`pattern "refs/tags/" must be located in refs/heads/`), err)
+	})
+
+	t.Run("tags sorted by version", func(t *testing.T) {
+		names, err := receiveTags(t, &gitalypb.ListTagNamesContainingCommitRequest{
+			Repository: repo,
+			CommitId:   commitID.String(),
+			Patterns:   [][]byte{[]byte("refs/tags/v*")},
+			SortBy: &gitalypb.ListTagNamesContainingCommitRequest_SortBy{
+				Key:       gitalypb.FindAllTagsRequest_SortBy_VERSION_REFNAME,
+				Direction: gitalypb.SortDirection_DESCENDING,
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"v1.10.0", "v1.9.0", "v1.2.0"}, names)
+	})
+
+	t.Run("tags with pagination", func(t *testing.T) {
+		names, err := receiveTags(t, &gitalypb.ListTagNamesContainingCommitRequest{
+			Repository:       repo,
+			CommitId:         commitID.String(),
+			PaginationParams: &gitalypb.PaginationParameter{Limit: 1, PageToken: "refs/tags/v1.10.0"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"v1.2.0"}, names)
+	})
+}
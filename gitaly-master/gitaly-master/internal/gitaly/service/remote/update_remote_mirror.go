@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"sort"
 	"strings"
 
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
@@ -22,6 +23,9 @@ const (
 	// maxDivergentRefs is the maximum number of divergent refs to return in UpdateRemoteMirror's
 	// response.
 	maxDivergentRefs = 100
+	// maxReferenceStatuses is the maximum number of reference statuses to return in
+	// UpdateRemoteMirror's response.
+	maxReferenceStatuses = 10000
 )
 
 func (s *server) UpdateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMirrorServer) error {
@@ -45,6 +49,8 @@ func (s *server) updateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMi
 	ctx := stream.Context()
 
 	branchMatchers := firstRequest.GetOnlyBranchesMatching()
+	includePatterns := firstRequest.GetIncludeRefs()
+	excludePatterns := firstRequest.GetExcludeRefs()
 	for {
 		req, err := stream.Recv()
 		if err != nil {
@@ -56,6 +62,8 @@ func (s *server) updateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMi
 		}
 
 		branchMatchers = append(branchMatchers, req.GetOnlyBranchesMatching()...)
+		includePatterns = append(includePatterns, req.GetIncludeRefs()...)
+		excludePatterns = append(excludePatterns, req.GetExcludeRefs()...)
 	}
 
 	referenceMatcher, err := newReferenceMatcher(branchMatchers)
@@ -63,6 +71,11 @@ func (s *server) updateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMi
 		return fmt.Errorf("create reference matcher: %w", err)
 	}
 
+	filter, err := newReferenceFilter(referenceMatcher, includePatterns, excludePatterns)
+	if err != nil {
+		return err
+	}
+
 	repo := s.localrepo(firstRequest.GetRepository())
 	remote := firstRequest.GetRemote()
 
@@ -73,6 +86,7 @@ func (s *server) updateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMi
 	remoteName := "inmemory-" + remoteSuffix
 
 	var remoteConfig []git.ConfigPair
+	var hostKeyAlias string
 	remoteURL := remote.GetUrl()
 
 	if resolvedAddress := remote.GetResolvedAddress(); resolvedAddress != "" {
@@ -81,6 +95,11 @@ func (s *server) updateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMi
 			return fmt.Errorf("couldn't get curloptResolve config: %w", err)
 		}
 
+		hostKeyAlias, err = git.SSHHostKeyAlias(remoteURL)
+		if err != nil {
+			return fmt.Errorf("get host key alias: %w", err)
+		}
+
 		remoteURL = modifiedURL
 		remoteConfig = append(remoteConfig, resolveConfig...)
 	}
@@ -103,14 +122,22 @@ func (s *server) updateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMi
 		})
 	}
 
-	sshCommand, clean, err := git.BuildSSHInvocation(ctx, firstRequest.GetSshKey(), firstRequest.GetKnownHosts())
+	sshKey, knownHosts := firstRequest.GetSshKey(), firstRequest.GetKnownHosts()
+	if remote.GetSshKey() != "" {
+		sshKey = remote.GetSshKey()
+	}
+	if remote.GetKnownHosts() != "" {
+		knownHosts = remote.GetKnownHosts()
+	}
+
+	sshCommand, clean, err := git.BuildSSHInvocation(ctx, sshKey, knownHosts, hostKeyAlias)
 	if err != nil {
 		return fmt.Errorf("build ssh invocation: %w", err)
 	}
 	defer clean()
 
 	remoteRefsSlice, err := repo.GetRemoteReferences(ctx, remoteName,
-		localrepo.WithPatterns("refs/heads/*", "refs/tags/*"),
+		localrepo.WithPatterns(filter.remotePatterns()...),
 		localrepo.WithConfig(remoteConfig...),
 		localrepo.WithSSHCommand(sshCommand),
 	)
@@ -118,7 +145,7 @@ func (s *server) updateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMi
 		return fmt.Errorf("get remote references: %w", err)
 	}
 
-	localRefs, err := repo.GetReferences(ctx, "refs/heads/", "refs/tags/")
+	localRefs, err := repo.GetReferences(ctx, filter.prefixes...)
 	if err != nil {
 		return fmt.Errorf("get local references: %w", err)
 	}
@@ -136,30 +163,44 @@ func (s *server) updateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMi
 			continue
 		}
 
+		if !filter.matches(ref.Name) {
+			continue
+		}
+
 		remoteRefs[ref.Name] = ref.Target
 	}
 
 	var divergentRefs [][]byte
-	toUpdate := map[git.ReferenceName]string{}
+	var updates []referenceUpdate
 	for _, localRef := range localRefs {
-		if localRef.IsSymbolic {
+		if localRef.IsSymbolic || !filter.matches(localRef.Name) {
 			continue
 		}
 
 		remoteTarget, ok := remoteRefs[localRef.Name]
 		if !ok {
 			// ref does not exist on the mirror, it should be created
-			toUpdate[localRef.Name] = localRef.Target
-			delete(remoteRefs, localRef.Name)
+			updates = append(updates, referenceUpdate{
+				reference: localRef.Name,
+				action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_CREATE,
+				newOID:    localRef.Target,
+			})
 			continue
 		}
+		delete(remoteRefs, localRef.Name)
 
 		if remoteTarget == localRef.Target {
 			// ref is up to date on the mirror
-			delete(remoteRefs, localRef.Name)
 			continue
 		}
 
+		update := referenceUpdate{
+			reference: localRef.Name,
+			action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_UPDATE,
+			oldOID:    remoteTarget,
+			newOID:    localRef.Target,
+		}
+
 		if firstRequest.GetKeepDivergentRefs() {
 			isAncestor, err := repo.IsAncestor(ctx, git.Revision(remoteTarget), git.Revision(localRef.Target))
 			if err != nil && !errors.Is(err, localrepo.InvalidCommitError(remoteTarget)) {
@@ -169,62 +210,82 @@ func (s *server) updateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMi
 			if !isAncestor {
 				// The mirror's reference has diverged from the local ref, or the mirror contains a commit
 				// which is not present in the local repository.
-				if referenceMatcher.MatchString(localRef.Name.String()) && len(divergentRefs) < maxDivergentRefs {
-					// diverged branches on the mirror are only included in the response if they match
-					// one of the branches in the selector
+				if len(divergentRefs) < maxDivergentRefs {
 					divergentRefs = append(divergentRefs, []byte(localRef.Name))
 				}
 
-				delete(remoteRefs, localRef.Name)
-				continue
+				update.skipReason = "diverged"
 			}
 		}
 
 		// the mirror's ref does not match ours, we should update it.
-		toUpdate[localRef.Name] = localRef.Target
-		delete(remoteRefs, localRef.Name)
+		updates = append(updates, update)
 	}
 
-	toDelete := remoteRefs
-	if len(defaultBranch) == 0 || firstRequest.GetKeepDivergentRefs() {
-		toDelete = map[git.ReferenceName]string{}
+	if len(defaultBranch) != 0 && !firstRequest.GetKeepDivergentRefs() {
+		for remoteRef, remoteCommitOID := range remoteRefs {
+			update := referenceUpdate{
+				reference: remoteRef,
+				action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_DELETE,
+				oldOID:    remoteCommitOID,
+			}
+
+			isAncestor, err := repo.IsAncestor(ctx, git.Revision(remoteCommitOID), git.Revision(defaultBranch))
+			if err != nil && !errors.Is(err, localrepo.InvalidCommitError(remoteCommitOID)) {
+				return fmt.Errorf("checking for ancestry: %w", err)
+			}
+
+			if !isAncestor {
+				// The commit in the extra branch in the remote repository has not been merged in to the
+				// local repository's default branch. Keep it to avoid losing work.
+				update.skipReason = "not merged into the default branch"
+			}
+
+			updates = append(updates, update)
+		}
 	}
 
-	for remoteRef, remoteCommitOID := range toDelete {
-		isAncestor, err := repo.IsAncestor(ctx, git.Revision(remoteCommitOID), git.Revision(defaultBranch))
-		if err != nil && !errors.Is(err, localrepo.InvalidCommitError(remoteCommitOID)) {
-			return fmt.Errorf("checking for ancestry: %w", err)
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].reference < updates[j].reference
+	})
+
+	statuses := make(map[git.ReferenceName]*gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus, len(updates))
+	var refspecs []string
+	for _, update := range updates {
+		status := &gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus{
+			Reference: []byte(update.reference),
+			Action:    update.action,
+			OldOid:    update.oldOID,
+			NewOid:    update.newOID,
 		}
+		statuses[update.reference] = status
 
-		if isAncestor {
+		switch {
+		case update.skipReason != "":
+			status.Status = gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SKIPPED
+			status.Reason = update.skipReason
+			continue
+		case firstRequest.GetDryRun():
+			status.Status = gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_PLANNED
 			continue
 		}
 
-		// The commit in the extra branch in the remote repository has not been merged in to the
-		// local repository's default branch. Keep it to avoid losing work.
-		delete(toDelete, remoteRef)
-	}
-
-	var refspecs []string
-	for prefix, references := range map[string]map[git.ReferenceName]string{
-		"": toUpdate, ":": toDelete,
-	} {
-		for reference := range references {
-			if !referenceMatcher.MatchString(reference.String()) {
-				continue
-			}
+		refspec := update.reference.String()
+		if update.action == gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_DELETE {
+			refspec = ":" + refspec
+		}
 
-			refspecs = append(refspecs, prefix+reference.String())
-			if reference == defaultBranch {
-				// The default branch needs to be pushed in the first batch of refspecs as some features
-				// depend on it existing in the repository. The default branch may not exist in the repo
-				// yet if this is the first mirroring push.
-				last := len(refspecs) - 1
-				refspecs[0], refspecs[last] = refspecs[last], refspecs[0]
-			}
+		refspecs = append(refspecs, refspec)
+		if update.reference == defaultBranch {
+			// The default branch needs to be pushed in the first batch of refspecs as some features
+			// depend on it existing in the repository. The default branch may not exist in the repo
+			// yet if this is the first mirroring push.
+			last := len(refspecs) - 1
+			refspecs[0], refspecs[last] = refspecs[last], refspecs[0]
 		}
 	}
 
+	var rejected int
 	for len(refspecs) > 0 {
 		batch := refspecs
 		if len(refspecs) > pushBatchSize {
@@ -233,16 +294,185 @@ func (s *server) updateRemoteMirror(stream gitalypb.RemoteService_UpdateRemoteMi
 
 		refspecs = refspecs[len(batch):]
 
-		if err := repo.Push(ctx, remoteName, batch, localrepo.PushOptions{
+		results, err := repo.PushWithResults(ctx, remoteName, batch, localrepo.PushOptions{
 			SSHCommand: sshCommand,
 			Force:      !firstRequest.KeepDivergentRefs,
 			Config:     remoteConfig,
-		}); err != nil {
+		})
+		if err != nil {
 			return fmt.Errorf("push to mirror: %w", err)
 		}
+
+		for _, result := range results {
+			if status, ok := statuses[result.Reference]; ok {
+				status.Status = gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SUCCEEDED
+				if result.Rejected {
+					status.Status = gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_FAILED
+					status.Reason = result.Summary
+				}
+			}
+		}
+
+		for _, refspec := range batch {
+			status := statuses[git.ReferenceName(strings.TrimPrefix(refspec, ":"))]
+			if status.Status == gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_UNSPECIFIED {
+				status.Status = gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_FAILED
+				status.Reason = "no result reported by git-push(1)"
+			}
+
+			if status.Status == gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_FAILED {
+				rejected++
+			}
+		}
+	}
+
+	referenceStatuses := make([]*gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus, 0, len(updates))
+	for _, update := range updates {
+		referenceStatuses = append(referenceStatuses, statuses[update.reference])
+	}
+
+	truncated := len(referenceStatuses) > maxReferenceStatuses
+	if truncated {
+		referenceStatuses = referenceStatuses[:maxReferenceStatuses]
 	}
 
-	return stream.SendAndClose(&gitalypb.UpdateRemoteMirrorResponse{DivergentRefs: divergentRefs})
+	if rejected > 0 {
+		return structerr.New("push to mirror: %d references rejected", rejected).WithDetail(
+			&gitalypb.UpdateRemoteMirrorError{
+				ReferenceStatuses:          referenceStatuses,
+				ReferenceStatusesTruncated: truncated,
+			},
+		)
+	}
+
+	return stream.SendAndClose(&gitalypb.UpdateRemoteMirrorResponse{
+		DivergentRefs:              divergentRefs,
+		ReferenceStatuses:          referenceStatuses,
+		ReferenceStatusesTruncated: truncated,
+	})
+}
+
+// referenceUpdate is a planned update of a reference in the mirror.
+type referenceUpdate struct {
+	reference git.ReferenceName
+	action    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_Action
+	oldOID    string
+	newOID    string
+	// skipReason is set if the update is skipped to avoid losing data in the mirror.
+	skipReason string
+}
+
+// referenceFilter decides which references are mirrored.
+type referenceFilter struct {
+	// branchMatcher restricts the branches which are mirrored, see newReferenceMatcher.
+	branchMatcher *regexp.Regexp
+	// include matches the references which are mirrored. All branches and tags are mirrored
+	// if it is nil.
+	include *regexp.Regexp
+	// exclude matches the references which are never mirrored.
+	exclude *regexp.Regexp
+	// prefixes are the reference prefixes which contain all references that may be mirrored.
+	prefixes []string
+}
+
+// newReferenceFilter creates a new filter for the given include and exclude patterns. The
+// patterns must be fully-qualified reference names and may contain "*" as a wildcard.
+func newReferenceFilter(branchMatcher *regexp.Regexp, includePatterns, excludePatterns [][]byte) (referenceFilter, error) {
+	filter := referenceFilter{
+		branchMatcher: branchMatcher,
+		prefixes:      []string{"refs/heads/", "refs/tags/"},
+	}
+
+	for _, pattern := range append(includePatterns, excludePatterns...) {
+		if !strings.HasPrefix(string(pattern), "refs/") {
+			return referenceFilter{}, structerr.NewInvalidArgument("reference pattern must start with %q: %q", "refs/", pattern)
+		}
+	}
+
+	if len(includePatterns) > 0 {
+		include, err := regexp.Compile("^(" + wildcardExpression(includePatterns) + ")$")
+		if err != nil {
+			return referenceFilter{}, fmt.Errorf("compiling include patterns: %w", err)
+		}
+
+		filter.include = include
+		filter.prefixes = referencePrefixes(includePatterns)
+	}
+
+	if len(excludePatterns) > 0 {
+		exclude, err := regexp.Compile("^(" + wildcardExpression(excludePatterns) + ")$")
+		if err != nil {
+			return referenceFilter{}, fmt.Errorf("compiling exclude patterns: %w", err)
+		}
+
+		filter.exclude = exclude
+	}
+
+	return filter, nil
+}
+
+// matches determines whether the reference is mirrored.
+func (f referenceFilter) matches(reference git.ReferenceName) bool {
+	name := reference.String()
+
+	if f.include != nil {
+		if !f.include.MatchString(name) {
+			return false
+		}
+	} else if !strings.HasPrefix(name, "refs/heads/") && !strings.HasPrefix(name, "refs/tags/") {
+		return false
+	}
+
+	if f.exclude != nil && f.exclude.MatchString(name) {
+		return false
+	}
+
+	// Branches are additionally restricted by the branch matchers, while all other references
+	// pass.
+	if strings.HasPrefix(name, "refs/heads/") && !f.branchMatcher.MatchString(name) {
+		return false
+	}
+
+	return true
+}
+
+// remotePatterns returns the patterns passed to git-ls-remote(1) to list all references in the
+// mirror that may be mirrored.
+func (f referenceFilter) remotePatterns() []string {
+	patterns := make([]string, 0, len(f.prefixes))
+	for _, prefix := range f.prefixes {
+		patterns = append(patterns, prefix+"*")
+	}
+	return patterns
+}
+
+// referencePrefixes returns the directory prefixes of the patterns up to their first wildcard, or
+// the pattern itself if it doesn't contain any wildcard. Prefixes which are contained in another
+// directory prefix are omitted.
+func referencePrefixes(patterns [][]byte) []string {
+	var prefixes []string
+	for _, pattern := range patterns {
+		prefix := string(pattern)
+		if i := strings.IndexByte(prefix, '*'); i >= 0 {
+			prefix = prefix[:strings.LastIndexByte(prefix[:i], '/')+1]
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	sort.Strings(prefixes)
+
+	deduplicated := prefixes[:0]
+	for _, prefix := range prefixes {
+		if len(deduplicated) > 0 {
+			previous := deduplicated[len(deduplicated)-1]
+			if prefix == previous || (strings.HasSuffix(previous, "/") && strings.HasPrefix(prefix, previous)) {
+				continue
+			}
+		}
+		deduplicated = append(deduplicated, prefix)
+	}
+
+	return deduplicated
 }
 
 // newReferenceMatcher returns a regexp which matches references that should
@@ -255,21 +485,10 @@ func newReferenceMatcher(branchMatchers [][]byte) (*regexp.Regexp, error) {
 	sb := &strings.Builder{}
 	sb.WriteString("^refs/tags/.+$|^refs/heads/(")
 
-	for i, expression := range branchMatchers {
-		segments := strings.Split(string(expression), "*")
-		for i := range segments {
-			segments[i] = regexp.QuoteMeta(segments[i])
-		}
-
-		sb.WriteString(strings.Join(segments, ".*"))
-
-		if i < len(branchMatchers)-1 {
-			sb.WriteString("|")
-		}
-	}
-
 	if len(branchMatchers) == 0 {
 		sb.WriteString(".+")
+	} else {
+		sb.WriteString(wildcardExpression(branchMatchers))
 	}
 
 	sb.WriteString(")$")
@@ -277,6 +496,22 @@ func newReferenceMatcher(branchMatchers [][]byte) (*regexp.Regexp, error) {
 	return regexp.Compile(sb.String())
 }
 
+// wildcardExpression returns an unanchored regular expression which matches any of the given
+// patterns. "*" can be used as a wildcard in the patterns.
+func wildcardExpression(patterns [][]byte) string {
+	expressions := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		segments := strings.Split(string(pattern), "*")
+		for i := range segments {
+			segments[i] = regexp.QuoteMeta(segments[i])
+		}
+
+		expressions = append(expressions, strings.Join(segments, ".*"))
+	}
+
+	return strings.Join(expressions, "|")
+}
+
 func validateUpdateRemoteMirrorRequest(ctx context.Context, req *gitalypb.UpdateRemoteMirrorRequest) error {
 	if err := service.ValidateRepository(req.GetRepository()); err != nil {
 		return err
@@ -290,5 +525,13 @@ func validateUpdateRemoteMirrorRequest(ctx context.Context, req *gitalypb.Update
 		return errors.New("remote is missing URL")
 	}
 
+	if req.GetSshKey() != "" && req.GetRemote().GetSshKey() != "" {
+		return errors.New("SSH key must not be set on both the request and the remote")
+	}
+
+	if req.GetKnownHosts() != "" && req.GetRemote().GetKnownHosts() != "" {
+		return errors.New("known hosts must not be set on both the request and the remote")
+	}
+
 	return nil
 }
@@ -3,6 +3,8 @@ package remote
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -53,6 +55,8 @@ func TestUpdateRemoteMirror(t *testing.T) {
 		mirrorSymRefs        map[string]string
 		keepDivergentRefs    bool
 		onlyBranchesMatching []string
+		includeRefs          []string
+		excludeRefs          []string
 		wrapCommandFactory   func(testing.TB, git.CommandFactory) git.CommandFactory
 		requests             []*gitalypb.UpdateRemoteMirrorRequest
 		expectedError        *expectedError
@@ -195,6 +199,76 @@ func TestUpdateRemoteMirror(t *testing.T) {
 				"refs/heads/not-matched": "commit 1",
 			},
 		},
+		{
+			desc: "mirrors only included references",
+			sourceRefs: refs{
+				"refs/heads/master":          {"commit 1"},
+				"refs/tags/v1.0.0":           {"commit 1"},
+				"refs/tags/latest":           {"commit 1"},
+				"refs/merge-requests/1/head": {"commit 1"},
+				"refs/keep-around/1":         {"commit 1"},
+			},
+			includeRefs: []string{"refs/heads/*", "refs/tags/v*", "refs/merge-requests/*/head"},
+			response:    &gitalypb.UpdateRemoteMirrorResponse{},
+			expectedMirrorRefs: map[string]string{
+				"refs/heads/master":          "commit 1",
+				"refs/tags/v1.0.0":           "commit 1",
+				"refs/merge-requests/1/head": "commit 1",
+			},
+		},
+		{
+			desc: "deletes only included references",
+			sourceRefs: refs{
+				"refs/heads/master": {"commit 1"},
+			},
+			mirrorRefs: refs{
+				"refs/heads/master":          {"commit 1"},
+				"refs/heads/branch":          {"commit 1"},
+				"refs/merge-requests/1/head": {"commit 1"},
+				"refs/keep-around/1":         {"commit 1"},
+			},
+			includeRefs: []string{"refs/heads/*", "refs/merge-requests/*"},
+			response:    &gitalypb.UpdateRemoteMirrorResponse{},
+			expectedMirrorRefs: map[string]string{
+				"refs/heads/master":  "commit 1",
+				"refs/keep-around/1": "commit 1",
+			},
+		},
+		{
+			desc: "does not touch excluded references",
+			sourceRefs: refs{
+				"refs/heads/master":    {"commit 1", "commit 2"},
+				"refs/heads/protected": {"commit 1", "commit 2"},
+				"refs/tags/tag":        {"commit 1"},
+			},
+			mirrorRefs: refs{
+				"refs/heads/master":    {"commit 1"},
+				"refs/heads/protected": {"commit 1"},
+				"refs/tags/stale":      {"commit 1"},
+			},
+			excludeRefs: []string{"refs/heads/protected", "refs/tags/*"},
+			response:    &gitalypb.UpdateRemoteMirrorResponse{},
+			expectedMirrorRefs: map[string]string{
+				"refs/heads/master":    "commit 2",
+				"refs/heads/protected": "commit 1",
+				"refs/tags/stale":      "commit 1",
+			},
+		},
+		{
+			desc: "branch selector applies to included references",
+			sourceRefs: refs{
+				"refs/heads/matched":         {"commit 1"},
+				"refs/heads/not-matched":     {"commit 1"},
+				"refs/merge-requests/1/head": {"commit 1"},
+			},
+			includeRefs:          []string{"refs/heads/*", "refs/merge-requests/*"},
+			onlyBranchesMatching: []string{"matched"},
+			response:             &gitalypb.UpdateRemoteMirrorResponse{},
+			expectedMirrorRefs: map[string]string{
+				"refs/heads/matched":         "commit 1",
+				"refs/merge-requests/1/head": "commit 1",
+			},
+		},
 		{
 			desc: "ignores diverged branches not matched by the branch selector",
 			sourceRefs: refs{
@@ -394,7 +468,7 @@ func TestUpdateRemoteMirror(t *testing.T) {
 				}
 			},
 			expectedError: &expectedError{
-				contains: "Updates were rejected because a pushed branch tip is behind its remote",
+				contains: "push to mirror: 1 references rejected",
 				code:     codes.Internal,
 			},
 		},
@@ -552,7 +626,7 @@ func TestUpdateRemoteMirror(t *testing.T) {
 				"refs/heads/branch/conflict": {"commit 2"},
 			},
 			expectedError: &expectedError{
-				contains: "push to mirror: git push: exit status 1",
+				contains: "push to mirror: 1 references rejected",
 				code:     codes.Internal,
 			},
 		},
@@ -685,6 +759,18 @@ func TestUpdateRemoteMirror(t *testing.T) {
 				}))
 			}
 
+			for _, pattern := range tc.includeRefs {
+				require.NoError(t, stream.Send(&gitalypb.UpdateRemoteMirrorRequest{
+					IncludeRefs: [][]byte{[]byte(pattern)},
+				}))
+			}
+
+			for _, pattern := range tc.excludeRefs {
+				require.NoError(t, stream.Send(&gitalypb.UpdateRemoteMirrorRequest{
+					ExcludeRefs: [][]byte{[]byte(pattern)},
+				}))
+			}
+
 			resp, err := stream.CloseAndRecv()
 			if tc.expectedError != nil {
 				testhelper.RequireGrpcCode(t, err, tc.expectedError.code)
@@ -693,6 +779,9 @@ func TestUpdateRemoteMirror(t *testing.T) {
 			}
 
 			require.NoError(t, err)
+			// Reference statuses contain object IDs, which is why they're verified separately by
+			// TestUpdateRemoteMirror_referenceStatuses.
+			resp.ReferenceStatuses = nil
 			testhelper.ProtoEqual(t, tc.response, resp)
 
 			// Check that the refs on the mirror now refer to the correct commits.
@@ -767,6 +856,53 @@ func TestUpdateRemoteMirror_Validations(t *testing.T) {
 			},
 			expectedErr: structerr.NewInvalidArgument("remote is missing URL"),
 		},
+		{
+			desc: "SSH key on request and remote",
+			setup: func(t *testing.T) *gitalypb.UpdateRemoteMirrorRequest {
+				mirrorRepoPb, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return &gitalypb.UpdateRemoteMirrorRequest{
+					Repository: mirrorRepoPb,
+					Remote: &gitalypb.UpdateRemoteMirrorRequest_Remote{
+						Url:    "git@example.com:group/project.git",
+						SshKey: "key",
+					},
+					SshKey: "key",
+				}
+			},
+			expectedErr: structerr.NewInvalidArgument("SSH key must not be set on both the request and the remote"),
+		},
+		{
+			desc: "known hosts on request and remote",
+			setup: func(t *testing.T) *gitalypb.UpdateRemoteMirrorRequest {
+				mirrorRepoPb, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return &gitalypb.UpdateRemoteMirrorRequest{
+					Repository: mirrorRepoPb,
+					Remote: &gitalypb.UpdateRemoteMirrorRequest_Remote{
+						Url:        "git@example.com:group/project.git",
+						KnownHosts: "hosts",
+					},
+					KnownHosts: "hosts",
+				}
+			},
+			expectedErr: structerr.NewInvalidArgument("known hosts must not be set on both the request and the remote"),
+		},
+		{
+			desc: "reference pattern not starting with refs/",
+			setup: func(t *testing.T) *gitalypb.UpdateRemoteMirrorRequest {
+				mirrorRepoPb, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return &gitalypb.UpdateRemoteMirrorRequest{
+					Repository: mirrorRepoPb,
+					Remote: &gitalypb.UpdateRemoteMirrorRequest_Remote{
+						Url: "/path/to/mirror.git",
+					},
+					IncludeRefs: [][]byte{[]byte("heads/*")},
+				}
+			},
+			expectedErr: structerr.NewInvalidArgument(`reference pattern must start with "refs/": "heads/*"`),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -784,3 +920,361 @@ func TestUpdateRemoteMirror_Validations(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateRemoteMirror_sshCommand(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	outputPath := filepath.Join(testhelper.TempDir(t), "output")
+
+	// Intercept the first command which connects to the remote and print the SSH command it
+	// would have used.
+	gitCmdFactory := gittest.NewInterceptingCommandFactory(t, ctx, cfg, func(execEnv git.ExecutionEnvironment) string {
+		return fmt.Sprintf(
+			`#!/usr/bin/env bash
+
+			if test -z "$GIT_SSH_COMMAND"
+			then
+				exec %q "$@"
+			fi
+
+			for arg in $GIT_SSH_COMMAND
+			do
+				case "$arg" in
+				-oIdentityFile=*|-oUserKnownHostsFile=*)
+					path=$(echo "$arg" | cut -d= -f2)
+					cat "$path";;
+				*)
+					echo "$arg";;
+				esac
+			done >'%s'
+
+			exit 7
+		`, execEnv.BinaryPath, outputPath)
+	})
+
+	addr := testserver.RunGitalyServer(t, cfg, nil, func(srv *grpc.Server, deps *service.Dependencies) {
+		gitalypb.RegisterRemoteServiceServer(srv, NewServer(
+			deps.GetLocator(),
+			gitCmdFactory,
+			deps.GetCatfileCache(),
+			deps.GetTxManager(),
+			deps.GetConnsPool(),
+		))
+	})
+
+	client, conn := newRemoteClient(t, addr)
+	defer testhelper.MustClose(t, conn)
+
+	repo, _ := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+
+	for _, tc := range []struct {
+		desc           string
+		request        *gitalypb.UpdateRemoteMirrorRequest
+		expectedOutput string
+	}{
+		{
+			desc: "SSH key and known hosts on the request",
+			request: &gitalypb.UpdateRemoteMirrorRequest{
+				Repository: repo,
+				Remote: &gitalypb.UpdateRemoteMirrorRequest_Remote{
+					Url: "git@example.com:group/project.git",
+				},
+				SshKey:     "mykey",
+				KnownHosts: "myhosts",
+			},
+			expectedOutput: "ssh\n-oIdentitiesOnly=yes\nmykey-oStrictHostKeyChecking=yes\n-oCheckHostIP=no\nmyhosts",
+		},
+		{
+			desc: "SSH key and known hosts on the remote",
+			request: &gitalypb.UpdateRemoteMirrorRequest{
+				Repository: repo,
+				Remote: &gitalypb.UpdateRemoteMirrorRequest_Remote{
+					Url:        "ssh://git@example.com/group/project.git",
+					SshKey:     "mykey",
+					KnownHosts: "myhosts",
+				},
+			},
+			expectedOutput: "ssh\n-oIdentitiesOnly=yes\nmykey-oStrictHostKeyChecking=yes\n-oCheckHostIP=no\nmyhosts",
+		},
+		{
+			desc: "resolved address",
+			request: &gitalypb.UpdateRemoteMirrorRequest{
+				Repository: repo,
+				Remote: &gitalypb.UpdateRemoteMirrorRequest_Remote{
+					Url:             "ssh://git@example.com/group/project.git",
+					ResolvedAddress: "192.0.2.1",
+					KnownHosts:      "myhosts",
+				},
+			},
+			expectedOutput: "ssh\n-oStrictHostKeyChecking=yes\n-oCheckHostIP=no\nmyhosts-oHostKeyAlias=example.com\n",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			stream, err := client.UpdateRemoteMirror(ctx)
+			require.NoError(t, err)
+			require.NoError(t, stream.Send(tc.request))
+
+			_, err = stream.CloseAndRecv()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "get remote references")
+
+			require.Equal(t, tc.expectedOutput, string(testhelper.MustReadFile(t, outputPath)))
+			require.NoError(t, os.Remove(outputPath))
+		})
+	}
+}
+
+func TestUpdateRemoteMirror_referenceStatuses(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRemoteService(t, ctx)
+
+	setup := func(t *testing.T) (*gitalypb.Repository, string, map[string]git.ObjectID) {
+		sourceRepo, sourceRepoPath := gittest.CreateRepository(t, ctx, cfg)
+		_, mirrorRepoPath := gittest.CreateRepository(t, ctx, cfg)
+
+		// Commits are written with the same parameters into both repositories, so they
+		// have the same object IDs.
+		commits := map[string]git.ObjectID{}
+		for _, repoPath := range []string{sourceRepoPath, mirrorRepoPath} {
+			commits["base"] = gittest.WriteCommit(t, cfg, repoPath, gittest.WithMessage("base"))
+		}
+		commits["new"] = gittest.WriteCommit(t, cfg, sourceRepoPath, gittest.WithMessage("new"), gittest.WithParents(commits["base"]))
+		commits["unmerged"] = gittest.WriteCommit(t, cfg, mirrorRepoPath, gittest.WithMessage("unmerged"), gittest.WithParents(commits["base"]))
+
+		for reference, commit := range map[string]git.ObjectID{
+			"refs/heads/master":          commits["new"],
+			"refs/heads/feature":         commits["base"],
+			"refs/tags/v1.0.0":           commits["base"],
+			"refs/merge-requests/1/head": commits["new"],
+		} {
+			gittest.WriteRef(t, cfg, sourceRepoPath, git.ReferenceName(reference), commit)
+		}
+
+		for reference, commit := range map[string]git.ObjectID{
+			"refs/heads/master":   commits["base"],
+			"refs/heads/stale":    commits["base"],
+			"refs/heads/unmerged": commits["unmerged"],
+		} {
+			gittest.WriteRef(t, cfg, mirrorRepoPath, git.ReferenceName(reference), commit)
+		}
+
+		return sourceRepo, mirrorRepoPath, commits
+	}
+
+	updateRemoteMirror := func(t *testing.T, request *gitalypb.UpdateRemoteMirrorRequest) (*gitalypb.UpdateRemoteMirrorResponse, error) {
+		stream, err := client.UpdateRemoteMirror(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(request))
+		return stream.CloseAndRecv()
+	}
+
+	mirrorRefs := func(t *testing.T, repoPath string) string {
+		return text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "for-each-ref", "--format=%(refname) %(objectname)"))
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		sourceRepo, mirrorRepoPath, commits := setup(t)
+		refsBefore := mirrorRefs(t, mirrorRepoPath)
+
+		response, err := updateRemoteMirror(t, &gitalypb.UpdateRemoteMirrorRequest{
+			Repository:  sourceRepo,
+			Remote:      &gitalypb.UpdateRemoteMirrorRequest_Remote{Url: mirrorRepoPath},
+			IncludeRefs: [][]byte{[]byte("refs/heads/*"), []byte("refs/merge-requests/*")},
+			ExcludeRefs: [][]byte{[]byte("refs/heads/stale")},
+			DryRun:      true,
+		})
+		require.NoError(t, err)
+		testhelper.ProtoEqual(t, &gitalypb.UpdateRemoteMirrorResponse{
+			ReferenceStatuses: []*gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus{
+				{
+					Reference: []byte("refs/heads/feature"),
+					Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_CREATE,
+					Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_PLANNED,
+					NewOid:    commits["base"].String(),
+				},
+				{
+					Reference: []byte("refs/heads/master"),
+					Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_UPDATE,
+					Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_PLANNED,
+					OldOid:    commits["base"].String(),
+					NewOid:    commits["new"].String(),
+				},
+				{
+					Reference: []byte("refs/heads/unmerged"),
+					Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_DELETE,
+					Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SKIPPED,
+					OldOid:    commits["unmerged"].String(),
+					Reason:    "not merged into the default branch",
+				},
+				{
+					Reference: []byte("refs/merge-requests/1/head"),
+					Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_CREATE,
+					Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_PLANNED,
+					NewOid:    commits["new"].String(),
+				},
+			},
+		}, response)
+
+		require.Equal(t, refsBefore, mirrorRefs(t, mirrorRepoPath))
+	})
+
+	t.Run("push", func(t *testing.T) {
+		sourceRepo, mirrorRepoPath, commits := setup(t)
+
+		response, err := updateRemoteMirror(t, &gitalypb.UpdateRemoteMirrorRequest{
+			Repository: sourceRepo,
+			Remote:     &gitalypb.UpdateRemoteMirrorRequest_Remote{Url: mirrorRepoPath},
+		})
+		require.NoError(t, err)
+		testhelper.ProtoEqual(t, &gitalypb.UpdateRemoteMirrorResponse{
+			ReferenceStatuses: []*gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus{
+				{
+					Reference: []byte("refs/heads/feature"),
+					Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_CREATE,
+					Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SUCCEEDED,
+					NewOid:    commits["base"].String(),
+				},
+				{
+					Reference: []byte("refs/heads/master"),
+					Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_UPDATE,
+					Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SUCCEEDED,
+					OldOid:    commits["base"].String(),
+					NewOid:    commits["new"].String(),
+				},
+				{
+					Reference: []byte("refs/heads/stale"),
+					Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_DELETE,
+					Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SUCCEEDED,
+					OldOid:    commits["base"].String(),
+				},
+				{
+					Reference: []byte("refs/heads/unmerged"),
+					Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_DELETE,
+					Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SKIPPED,
+					OldOid:    commits["unmerged"].String(),
+					Reason:    "not merged into the default branch",
+				},
+				{
+					Reference: []byte("refs/tags/v1.0.0"),
+					Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_CREATE,
+					Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SUCCEEDED,
+					NewOid:    commits["base"].String(),
+				},
+			},
+		}, response)
+
+		require.Equal(t, strings.Join([]string{
+			"refs/heads/feature " + commits["base"].String(),
+			"refs/heads/master " + commits["new"].String(),
+			"refs/heads/unmerged " + commits["unmerged"].String(),
+			"refs/tags/v1.0.0 " + commits["base"].String(),
+		}, "\n"), mirrorRefs(t, mirrorRepoPath))
+	})
+
+	t.Run("rejected references", func(t *testing.T) {
+		sourceRepo, mirrorRepoPath, commits := setup(t)
+		gittest.Exec(t, cfg, "-C", mirrorRepoPath, "config", "receive.denyDeletes", "true")
+
+		_, err := updateRemoteMirror(t, &gitalypb.UpdateRemoteMirrorRequest{
+			Repository:  sourceRepo,
+			Remote:      &gitalypb.UpdateRemoteMirrorRequest_Remote{Url: mirrorRepoPath},
+			IncludeRefs: [][]byte{[]byte("refs/heads/*")},
+		})
+		testhelper.RequireGrpcError(t, structerr.NewInternal("push to mirror: 1 references rejected").WithDetail(
+			&gitalypb.UpdateRemoteMirrorError{
+				ReferenceStatuses: []*gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus{
+					{
+						Reference: []byte("refs/heads/feature"),
+						Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_CREATE,
+						Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SUCCEEDED,
+						NewOid:    commits["base"].String(),
+					},
+					{
+						Reference: []byte("refs/heads/master"),
+						Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_UPDATE,
+						Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SUCCEEDED,
+						OldOid:    commits["base"].String(),
+						NewOid:    commits["new"].String(),
+					},
+					{
+						Reference: []byte("refs/heads/stale"),
+						Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_DELETE,
+						Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_FAILED,
+						OldOid:    commits["base"].String(),
+						Reason:    "[remote rejected] (deletion prohibited)",
+					},
+					{
+						Reference: []byte("refs/heads/unmerged"),
+						Action:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_DELETE,
+						Status:    gitalypb.UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SKIPPED,
+						OldOid:    commits["unmerged"].String(),
+						Reason:    "not merged into the default branch",
+					},
+				},
+			},
+		), err)
+
+		require.Equal(t, strings.Join([]string{
+			"refs/heads/feature " + commits["base"].String(),
+			"refs/heads/master " + commits["new"].String(),
+			"refs/heads/stale " + commits["base"].String(),
+			"refs/heads/unmerged " + commits["unmerged"].String(),
+		}, "\n"), mirrorRefs(t, mirrorRepoPath))
+	})
+}
+
+func TestReferencePrefixes(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc             string
+		patterns         []string
+		expectedPrefixes []string
+	}{
+		{
+			desc:             "wildcard",
+			patterns:         []string{"refs/merge-requests/*/head"},
+			expectedPrefixes: []string{"refs/merge-requests/"},
+		},
+		{
+			desc:             "partial wildcard",
+			patterns:         []string{"refs/tags/v*"},
+			expectedPrefixes: []string{"refs/tags/"},
+		},
+		{
+			desc:             "literal reference",
+			patterns:         []string{"refs/heads/main", "refs/heads/mainline"},
+			expectedPrefixes: []string{"refs/heads/main", "refs/heads/mainline"},
+		},
+		{
+			desc:             "contained prefixes",
+			patterns:         []string{"refs/heads/feature/*", "refs/heads/*", "refs/heads/main", "refs/tags/*"},
+			expectedPrefixes: []string{"refs/heads/", "refs/tags/"},
+		},
+		{
+			desc:             "all references",
+			patterns:         []string{"refs/*", "refs/heads/*"},
+			expectedPrefixes: []string{"refs/"},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			patterns := make([][]byte, 0, len(tc.patterns))
+			for _, pattern := range tc.patterns {
+				patterns = append(patterns, []byte(pattern))
+			}
+
+			require.Equal(t, tc.expectedPrefixes, referencePrefixes(patterns))
+		})
+	}
+}
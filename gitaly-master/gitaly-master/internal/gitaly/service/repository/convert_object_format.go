@@ -0,0 +1,593 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/housekeeping"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/oidmap"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushrules"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/repoutil"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/safe"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/tempdir"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// convertedRepositoryFiles are Gitaly-specific files and directories which are carried over from
+// the original repository into the converted repository as-is.
+var convertedRepositoryFiles = []string{
+	customHooksDir,
+	filepath.Join("info", "attributes"),
+	filepath.Base(pushrules.Path("")),
+}
+
+// ConvertObjectFormat converts the repository to use a different object format.
+func (s *server) ConvertObjectFormat(ctx context.Context, req *gitalypb.ConvertObjectFormatRequest) (*gitalypb.ConvertObjectFormatResponse, error) {
+	repository := req.GetRepository()
+	if err := service.ValidateRepository(repository); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	if req.GetObjectFormat() == gitalypb.ObjectFormat_OBJECT_FORMAT_UNSPECIFIED {
+		return nil, structerr.NewInvalidArgument("object format must be specified")
+	}
+
+	targetObjectHash, err := git.ObjectHashByProto(req.GetObjectFormat())
+	if err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	repo := s.localrepo(repository)
+	repoPath, err := repo.Path()
+	if err != nil {
+		return nil, structerr.New("%w", err)
+	}
+
+	sourceObjectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return nil, structerr.NewInternal("detecting object hash: %w", err)
+	}
+
+	if sourceObjectHash.Format == targetObjectHash.Format {
+		return &gitalypb.ConvertObjectFormatResponse{}, nil
+	}
+
+	alternatesPath, err := repo.InfoAlternatesPath()
+	if err != nil {
+		return nil, structerr.NewInternal("getting alternates path: %w", err)
+	}
+	if _, err := os.Stat(alternatesPath); err == nil {
+		return nil, structerr.NewFailedPrecondition("repository with alternates cannot be converted")
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, structerr.NewInternal("checking for alternates: %w", err)
+	}
+
+	// Lock the repository such that it cannot be created or removed by any concurrent RPC call
+	// while we're about to replace it.
+	unlock, err := repoutil.Lock(ctx, s.locator, repository)
+	if err != nil {
+		if errors.Is(err, safe.ErrFileAlreadyLocked) {
+			return nil, structerr.NewFailedPrecondition("repository is already locked")
+		}
+		return nil, structerr.NewInternal("locking repository: %w", err)
+	}
+	defer unlock()
+
+	// All objects get replaced by the conversion, so we must not convert the repository while
+	// its objects are being preserved, e.g. by a storage snapshot. Objects are locked before
+	// references are frozen, which is the same order storage snapshots use.
+	unlockObjects, err := s.housekeepingManager.LockObjects(ctx, repo)
+	if err != nil {
+		return nil, structerr.NewAborted("locking objects: %w", err)
+	}
+	defer unlockObjects()
+
+	// The repository lock only guards against concurrent creation and removal of the
+	// repository, but not against concurrent reference updates. We thus freeze references for
+	// the whole conversion so that no updates get lost when swapping out the repository.
+	thaw, err := s.refBarrier.Freeze(ctx, repository)
+	if err != nil {
+		return nil, structerr.NewInternal("freezing references: %w", err)
+	}
+	defer thaw()
+
+	// git-fast-export(1) silently drops commit signatures and any other headers it doesn't know
+	// about. Converting such commits would thus lose data, so we refuse to convert them.
+	if commitID, header, err := findUnconvertibleCommit(ctx, repo); err != nil {
+		return nil, structerr.NewInternal("checking for unconvertible commits: %w", err)
+	} else if commitID != "" {
+		return nil, structerr.NewFailedPrecondition("commit has header which cannot be converted").
+			WithMetadata("commit_id", commitID).
+			WithMetadata("header", header)
+	}
+
+	newRepoProto, newRepoDir, err := tempdir.NewRepository(ctx, repository.GetStorageName(), s.locator)
+	if err != nil {
+		return nil, structerr.NewInternal("creating temporary repository: %w", err)
+	}
+	newRepo := s.localrepo(newRepoProto)
+
+	mappedObjects, err := s.convertRepository(ctx, repo, newRepo, newRepoDir.Path(), targetObjectHash)
+	if err != nil {
+		return nil, structerr.NewInternal("converting repository: %w", err)
+	}
+
+	// The files are copied instead of moved so that the original repository stays intact in
+	// case any of the remaining steps fails.
+	for _, file := range convertedRepositoryFiles {
+		if err := copyRepositoryFile(filepath.Join(repoPath, file), filepath.Join(newRepoDir.Path(), file)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, structerr.NewInternal("copying %q into converted repository: %w", file, err)
+		}
+	}
+
+	// Swap out the original repository with the converted one. The original repository is
+	// moved into a temporary directory first so that it gets cleaned up automatically.
+	oldRepoDir, err := tempdir.New(ctx, repository.GetStorageName(), s.locator)
+	if err != nil {
+		return nil, structerr.NewInternal("creating temporary directory: %w", err)
+	}
+
+	if err := os.Rename(repoPath, filepath.Join(oldRepoDir.Path(), "repo.git")); err != nil {
+		return nil, structerr.NewInternal("staging original repository for removal: %w", err)
+	}
+
+	if err := os.Rename(newRepoDir.Path(), repoPath); err != nil {
+		// Try to restore the original repository so that we don't leave the repository
+		// missing.
+		if restoreErr := os.Rename(filepath.Join(oldRepoDir.Path(), "repo.git"), repoPath); restoreErr != nil {
+			return nil, structerr.NewInternal("moving converted repository into place: %w, restoring original repository: %v", err, restoreErr)
+		}
+
+		return nil, structerr.NewInternal("moving converted repository into place: %w", err)
+	}
+
+	return &gitalypb.ConvertObjectFormatResponse{
+		MappedObjects: mappedObjects,
+	}, nil
+}
+
+// convertibleCommitHeaders are the commit headers which are retained by git-fast-export(1).
+var convertibleCommitHeaders = map[string]struct{}{
+	"tree":      {},
+	"parent":    {},
+	"author":    {},
+	"committer": {},
+	"encoding":  {},
+}
+
+// findUnconvertibleCommit searches for a reachable commit which has a header that would be lost
+// when converting it via git-fast-export(1), like for example `gpgsig` or `mergetag`. Returns the
+// commit ID and the name of the header of the first such commit, or empty strings in case all
+// commits can be converted.
+func findUnconvertibleCommit(ctx context.Context, repo *localrepo.Repo) (string, string, error) {
+	// We may stop reading commits early, in which case git-rev-list(1) gets killed by cancelling
+	// the context.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	revlist, err := repo.Exec(ctx, git.Command{
+		Name: "rev-list",
+		Flags: []git.Option{
+			git.Flag{Name: "--all"},
+			git.Flag{Name: "--header"},
+		},
+	}, git.WithStderr(&stderr))
+	if err != nil {
+		return "", "", fmt.Errorf("spawning rev-list: %w", err)
+	}
+
+	// Each commit is printed as its object ID followed by the raw commit and is terminated by
+	// a NUL byte.
+	reader := bufio.NewReader(revlist)
+	for {
+		record, err := reader.ReadBytes(0)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", "", fmt.Errorf("reading commits: %w", err)
+		}
+
+		headers, _, _ := bytes.Cut(bytes.TrimSuffix(record, []byte{0}), []byte("\n\n"))
+		if len(headers) > 0 {
+			lines := strings.Split(string(headers), "\n")
+
+			for _, line := range lines[1:] {
+				// Continuation lines of multi-line headers are indented.
+				if strings.HasPrefix(line, " ") {
+					continue
+				}
+
+				header, _, _ := strings.Cut(line, " ")
+				if _, ok := convertibleCommitHeaders[header]; !ok {
+					return lines[0], header, nil
+				}
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+	}
+
+	if err := revlist.Wait(); err != nil {
+		return "", "", fmt.Errorf("listing commits: %w, stderr: %q", err, stderr.String())
+	}
+
+	return "", "", nil
+}
+
+// copyRepositoryFile copies the file or directory at source to target. Directories are copied
+// recursively.
+func copyRepositoryFile(source, target string) error {
+	return filepath.WalkDir(source, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(source, path)
+		if err != nil {
+			return fmt.Errorf("computing relative path: %w", err)
+		}
+		targetPath := filepath.Join(target, relativePath)
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("statting %q: %w", path, err)
+		}
+
+		if entry.IsDir() {
+			if err := os.MkdirAll(targetPath, info.Mode().Perm()); err != nil {
+				return fmt.Errorf("creating directory: %w", err)
+			}
+			return nil
+		}
+
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("unsupported file type %q", path)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(targetPath), perm.SharedDir); err != nil {
+			return fmt.Errorf("creating parent directory: %w", err)
+		}
+
+		return copyFile(path, targetPath, info.Mode().Perm())
+	})
+}
+
+func copyFile(source, target string, mode fs.FileMode) (returnedErr error) {
+	sourceFile, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("opening source: %w", err)
+	}
+	defer sourceFile.Close()
+
+	targetFile, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode)
+	if err != nil {
+		return fmt.Errorf("creating target: %w", err)
+	}
+	defer func() {
+		if err := targetFile.Close(); err != nil && returnedErr == nil {
+			returnedErr = fmt.Errorf("closing target: %w", err)
+		}
+	}()
+
+	if _, err := io.Copy(targetFile, sourceFile); err != nil {
+		return fmt.Errorf("copying file: %w", err)
+	}
+
+	return nil
+}
+
+// convertRepository converts all objects and references of the source repository into the
+// target repository. The target repository is initialized with the target object hash. Returns
+// the number of objects that have been recorded in the object ID mapping.
+func (s *server) convertRepository(
+	ctx context.Context,
+	sourceRepo, targetRepo *localrepo.Repo,
+	targetRepoPath string,
+	targetObjectHash git.ObjectHash,
+) (uint64, error) {
+	var stderr bytes.Buffer
+	initCmd, err := s.gitCmdFactory.NewWithoutRepo(ctx, git.Command{
+		Name: "init",
+		Flags: []git.Option{
+			git.Flag{Name: "--bare"},
+			git.Flag{Name: "--quiet"},
+			git.ValueFlag{Name: "--object-format", Value: targetObjectHash.Format},
+		},
+		Args: []string{targetRepoPath},
+	}, git.WithStderr(&stderr))
+	if err != nil {
+		return 0, fmt.Errorf("spawning git-init: %w", err)
+	}
+	if err := initCmd.Wait(); err != nil {
+		return 0, fmt.Errorf("initializing repository: %w, stderr: %q", err, stderr.String())
+	}
+
+	marksDir, err := tempdir.New(ctx, sourceRepo.GetStorageName(), s.locator)
+	if err != nil {
+		return 0, fmt.Errorf("creating marks directory: %w", err)
+	}
+	sourceMarksPath := filepath.Join(marksDir.Path(), "source-marks")
+	targetMarksPath := filepath.Join(marksDir.Path(), "target-marks")
+
+	// git-fast-export(1) announces all objects with marks which we can map back to the object
+	// IDs in both repositories. Signatures of tags cannot be retained as they'd be invalid
+	// after the conversion anyway, so we strip them.
+	var exportStderr bytes.Buffer
+	exportCmd, err := sourceRepo.Exec(ctx, git.Command{
+		Name: "fast-export",
+		Flags: []git.Option{
+			git.Flag{Name: "--all"},
+			git.Flag{Name: "--signed-tags=strip"},
+			git.Flag{Name: "--reencode=no"},
+			git.Flag{Name: "--mark-tags"},
+			git.Flag{Name: "--export-marks=" + sourceMarksPath},
+		},
+	}, git.WithStderr(&exportStderr))
+	if err != nil {
+		return 0, fmt.Errorf("spawning fast-export: %w", err)
+	}
+
+	var importStderr bytes.Buffer
+	if err := targetRepo.ExecAndWait(ctx, git.Command{
+		Name: "fast-import",
+		Flags: []git.Option{
+			git.Flag{Name: "--quiet"},
+			git.Flag{Name: "--export-marks=" + targetMarksPath},
+		},
+	}, git.WithStdin(exportCmd), git.WithStderr(&importStderr), git.WithDisabledHooks()); err != nil {
+		return 0, fmt.Errorf("importing objects: %w, stderr: %q", err, importStderr.String())
+	}
+
+	if err := exportCmd.Wait(); err != nil {
+		return 0, fmt.Errorf("exporting objects: %w, stderr: %q", err, exportStderr.String())
+	}
+
+	if err := convertHead(ctx, sourceRepo, targetRepo); err != nil {
+		return 0, fmt.Errorf("converting HEAD: %w", err)
+	}
+
+	if err := convertConfig(ctx, sourceRepo, targetRepo); err != nil {
+		return 0, fmt.Errorf("converting config: %w", err)
+	}
+
+	sourceObjectHash, err := sourceRepo.ObjectHash(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	entries, err := mapMarks(sourceMarksPath, sourceObjectHash, targetMarksPath, targetObjectHash)
+	if err != nil {
+		return 0, fmt.Errorf("mapping object IDs: %w", err)
+	}
+
+	// git-fast-export(1) doesn't write marks for annotated tags, so we map them via the
+	// references pointing to them instead.
+	entries, err = mapReferences(ctx, sourceRepo, sourceObjectHash, targetRepo, targetObjectHash, entries)
+	if err != nil {
+		return 0, fmt.Errorf("mapping references: %w", err)
+	}
+
+	if err := oidmap.Write(targetRepoPath, entries); err != nil {
+		return 0, fmt.Errorf("writing object ID mapping: %w", err)
+	}
+
+	// git-fast-import(1) doesn't generate packfiles that are optimized for serving, and the
+	// commit-graph of the original repository can't be reused either. We thus repack the
+	// converted repository and write a new commit-graph.
+	if err := housekeeping.RepackObjects(ctx, targetRepo, housekeeping.RepackObjectsConfig{
+		FullRepack:  true,
+		WriteBitmap: true,
+	}); err != nil {
+		return 0, fmt.Errorf("repacking objects: %w", err)
+	}
+
+	if err := housekeeping.WriteCommitGraph(ctx, targetRepo, housekeeping.WriteCommitGraphConfig{
+		ReplaceChain: true,
+	}); err != nil {
+		return 0, fmt.Errorf("writing commit-graph: %w", err)
+	}
+
+	return uint64(len(entries)), nil
+}
+
+// convertHead points the target repository's HEAD to the same reference as the source
+// repository's HEAD.
+func convertHead(ctx context.Context, sourceRepo, targetRepo *localrepo.Repo) error {
+	var stdout bytes.Buffer
+	if err := sourceRepo.ExecAndWait(ctx, git.Command{
+		Name: "symbolic-ref",
+		Args: []string{"HEAD"},
+	}, git.WithStdout(&stdout), git.WithDisabledHooks()); err != nil {
+		return fmt.Errorf("reading HEAD: %w", err)
+	}
+
+	if err := targetRepo.ExecAndWait(ctx, git.Command{
+		Name: "symbolic-ref",
+		Args: []string{"HEAD", strings.TrimSpace(stdout.String())},
+	}, git.WithDisabledHooks()); err != nil {
+		return fmt.Errorf("writing HEAD: %w", err)
+	}
+
+	return nil
+}
+
+// convertConfig copies over all repository-local configuration from the source repository to the
+// target repository, except for the core settings and extensions which are specific to the
+// repository format.
+func convertConfig(ctx context.Context, sourceRepo, targetRepo *localrepo.Repo) error {
+	var stdout bytes.Buffer
+	if err := sourceRepo.ExecAndWait(ctx, git.Command{
+		Name: "config",
+		Flags: []git.Option{
+			git.Flag{Name: "--local"},
+			git.Flag{Name: "--null"},
+			git.Flag{Name: "--list"},
+		},
+	}, git.WithStdout(&stdout)); err != nil {
+		return fmt.Errorf("listing config: %w", err)
+	}
+
+	for _, entry := range strings.Split(stdout.String(), "\x00") {
+		if entry == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(entry, "\n")
+		if strings.HasPrefix(key, "core.") || strings.HasPrefix(key, "extensions.") {
+			continue
+		}
+
+		if err := targetRepo.ExecAndWait(ctx, git.Command{
+			Name: "config",
+			Flags: []git.Option{
+				git.Flag{Name: "--add"},
+			},
+			Args: []string{key, value},
+		}); err != nil {
+			return fmt.Errorf("writing config entry %q: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// mapMarks joins the marks exported by git-fast-export(1) and git-fast-import(1) into a mapping
+// from the source object IDs to the target object IDs.
+func mapMarks(sourceMarksPath string, sourceObjectHash git.ObjectHash, targetMarksPath string, targetObjectHash git.ObjectHash) ([]oidmap.Entry, error) {
+	sourceMarks, err := readMarks(sourceMarksPath, sourceObjectHash)
+	if err != nil {
+		return nil, fmt.Errorf("reading source marks: %w", err)
+	}
+
+	targetMarks, err := readMarks(targetMarksPath, targetObjectHash)
+	if err != nil {
+		return nil, fmt.Errorf("reading target marks: %w", err)
+	}
+
+	entries := make([]oidmap.Entry, 0, len(sourceMarks))
+	for mark, sourceOID := range sourceMarks {
+		targetOID, ok := targetMarks[mark]
+		if !ok {
+			return nil, fmt.Errorf("mark %q has not been imported", mark)
+		}
+
+		entries = append(entries, oidmap.Entry{
+			LegacyOID: sourceOID,
+			OID:       targetOID,
+		})
+	}
+
+	return entries, nil
+}
+
+// mapReferences amends the entries with mappings for all objects directly pointed to by references
+// which have not been mapped yet.
+func mapReferences(
+	ctx context.Context,
+	sourceRepo *localrepo.Repo, sourceObjectHash git.ObjectHash,
+	targetRepo *localrepo.Repo, targetObjectHash git.ObjectHash,
+	entries []oidmap.Entry,
+) ([]oidmap.Entry, error) {
+	sourceReferences, err := sourceRepo.GetReferences(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading source references: %w", err)
+	}
+
+	targetReferences, err := targetRepo.GetReferences(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading target references: %w", err)
+	}
+
+	targetReferencesByName := make(map[git.ReferenceName]string, len(targetReferences))
+	for _, reference := range targetReferences {
+		targetReferencesByName[reference.Name] = reference.Target
+	}
+
+	mapped := make(map[git.ObjectID]struct{}, len(entries))
+	for _, entry := range entries {
+		mapped[entry.LegacyOID] = struct{}{}
+	}
+
+	for _, reference := range sourceReferences {
+		if reference.IsSymbolic {
+			continue
+		}
+
+		sourceOID, err := sourceObjectHash.FromHex(reference.Target)
+		if err != nil {
+			return nil, fmt.Errorf("parsing source reference %q: %w", reference.Name, err)
+		}
+
+		if _, ok := mapped[sourceOID]; ok {
+			continue
+		}
+
+		target, ok := targetReferencesByName[reference.Name]
+		if !ok {
+			return nil, fmt.Errorf("reference %q has not been imported", reference.Name)
+		}
+
+		targetOID, err := targetObjectHash.FromHex(target)
+		if err != nil {
+			return nil, fmt.Errorf("parsing target reference %q: %w", reference.Name, err)
+		}
+
+		entries = append(entries, oidmap.Entry{
+			LegacyOID: sourceOID,
+			OID:       targetOID,
+		})
+		mapped[sourceOID] = struct{}{}
+	}
+
+	return entries, nil
+}
+
+// readMarks reads a marks file as written by git-fast-export(1) and git-fast-import(1). Each line
+// has the format ":<mark> <object ID>".
+func readMarks(path string, objectHash git.ObjectHash) (map[string]git.ObjectID, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// Git doesn't write the marks file if there were no objects at all.
+			return map[string]git.ObjectID{}, nil
+		}
+		return nil, fmt.Errorf("opening marks: %w", err)
+	}
+	defer file.Close()
+
+	marks := map[string]git.ObjectID{}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		mark, oid, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid marks line: %q", scanner.Text())
+		}
+
+		objectID, err := objectHash.FromHex(oid)
+		if err != nil {
+			return nil, fmt.Errorf("parsing marked object ID: %w", err)
+		}
+
+		marks[mark] = objectID
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning marks: %w", err)
+	}
+
+	return marks, nil
+}
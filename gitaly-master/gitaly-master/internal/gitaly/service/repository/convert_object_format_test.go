@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/oidmap"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func TestConvertObjectFormat(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryServiceWithoutRepo(t)
+
+	for _, tc := range []struct {
+		desc         string
+		sourceFormat git.ObjectHash
+		targetFormat git.ObjectHash
+	}{
+		{
+			desc:         "SHA1 to SHA256",
+			sourceFormat: git.ObjectHashSHA1,
+			targetFormat: git.ObjectHashSHA256,
+		},
+		{
+			desc:         "SHA256 to SHA1",
+			sourceFormat: git.ObjectHashSHA256,
+			targetFormat: git.ObjectHashSHA1,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+				ObjectFormat: tc.sourceFormat.Format,
+			})
+
+			// gittest.WriteCommit always uses the default object hash, so we need to write
+			// the commits manually.
+			treeID := text.ChompBytes(gittest.ExecOpts(t, cfg, gittest.ExecConfig{Stdin: &bytes.Buffer{}},
+				"-C", repoPath, "mktree",
+			))
+			rootID := text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "commit-tree", treeID, "-m", "root"))
+			childID := text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "commit-tree", treeID, "-p", rootID, "-m", "child"))
+			gittest.Exec(t, cfg, "-C", repoPath, "update-ref", "refs/heads/main", childID)
+			gittest.Exec(t, cfg, "-C", repoPath, "update-ref", "refs/heads/feature", rootID)
+			gittest.Exec(t, cfg, "-C", repoPath, "tag", "-a", "v1.0.0", "-m", "annotated", rootID)
+			gittest.Exec(t, cfg, "-C", repoPath, "symbolic-ref", "HEAD", "refs/heads/feature")
+			gittest.Exec(t, cfg, "-C", repoPath, "config", "gitlab.fullpath", "group/project")
+			require.NoError(t, os.Mkdir(filepath.Join(repoPath, customHooksDir), perm.SharedDir))
+			require.NoError(t, os.WriteFile(filepath.Join(repoPath, customHooksDir, "pre-receive"), []byte("hook"), perm.SharedExecutable))
+
+			response, err := client.ConvertObjectFormat(ctx, &gitalypb.ConvertObjectFormatRequest{
+				Repository:   repo,
+				ObjectFormat: tc.targetFormat.ProtoFormat,
+			})
+			require.NoError(t, err)
+			// The mapping contains both commits and the annotated tag.
+			testhelper.ProtoEqual(t, &gitalypb.ConvertObjectFormatResponse{
+				MappedObjects: 3,
+			}, response)
+
+			gittest.Exec(t, cfg, "-C", repoPath, "fsck")
+			require.Equal(t, tc.targetFormat.Format, text.ChompBytes(
+				gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", "--show-object-format"),
+			))
+			require.Equal(t, "refs/heads/feature", text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "symbolic-ref", "HEAD")))
+			require.Equal(t, "group/project", text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "config", "gitlab.fullpath")))
+			require.FileExists(t, filepath.Join(repoPath, customHooksDir, "pre-receive"))
+			hookInfo, err := os.Stat(filepath.Join(repoPath, customHooksDir, "pre-receive"))
+			require.NoError(t, err)
+			require.Equal(t, perm.SharedExecutable, hookInfo.Mode().Perm())
+			require.FileExists(t, filepath.Join(repoPath, "objects", "info", "commit-graphs", "commit-graph-chain"))
+
+			for legacyOID, reference := range map[string]string{
+				childID: "refs/heads/main",
+				rootID:  "refs/heads/feature",
+			} {
+				oid, ok, err := oidmap.Lookup(repoPath, tc.targetFormat, legacyOID)
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(t, oid.String(), text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", reference)))
+			}
+
+			require.Equal(t, "refs/heads/feature", text.ChompBytes(
+				gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", "--symbolic-full-name", "HEAD"),
+			))
+			require.Equal(t, "tag", text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "cat-file", "-t", "refs/tags/v1.0.0")))
+			require.Equal(t,
+				text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", "refs/heads/feature")),
+				text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", "refs/tags/v1.0.0^{commit}")),
+			)
+		})
+	}
+}
+
+func TestConvertObjectFormat_sameObjectFormat(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryServiceWithoutRepo(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	commitID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+
+	response, err := client.ConvertObjectFormat(ctx, &gitalypb.ConvertObjectFormatRequest{
+		Repository:   repo,
+		ObjectFormat: gittest.DefaultObjectHash.ProtoFormat,
+	})
+	require.NoError(t, err)
+	testhelper.ProtoEqual(t, &gitalypb.ConvertObjectFormatResponse{}, response)
+
+	require.Equal(t, commitID.String(), text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", "refs/heads/main")))
+	require.NoFileExists(t, filepath.Join(repoPath, oidmap.Filename))
+}
+
+func TestConvertObjectFormat_validation(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryServiceWithoutRepo(t)
+
+	t.Run("unspecified object format", func(t *testing.T) {
+		repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+		_, err := client.ConvertObjectFormat(ctx, &gitalypb.ConvertObjectFormatRequest{
+			Repository: repo,
+		})
+		testhelper.RequireGrpcError(t, structerr.NewInvalidArgument("object format must be specified"), err)
+	})
+
+	t.Run("repository with alternates", func(t *testing.T) {
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+		_, poolPath := gittest.CreateRepository(t, ctx, cfg)
+		require.NoError(t, os.WriteFile(
+			filepath.Join(repoPath, "objects", "info", "alternates"),
+			[]byte(filepath.Join(poolPath, "objects")),
+			perm.SharedFile,
+		))
+
+		targetFormat := git.ObjectHashSHA256
+		if gittest.ObjectHashIsSHA256() {
+			targetFormat = git.ObjectHashSHA1
+		}
+
+		_, err := client.ConvertObjectFormat(ctx, &gitalypb.ConvertObjectFormatRequest{
+			Repository:   repo,
+			ObjectFormat: targetFormat.ProtoFormat,
+		})
+		testhelper.RequireGrpcError(t, structerr.NewFailedPrecondition("repository with alternates cannot be converted"), err)
+	})
+
+	t.Run("signed commit", func(t *testing.T) {
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+		treeID := gittest.DefaultObjectHash.EmptyTreeOID
+		unsignedID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithTree(treeID), gittest.WithBranch("main"))
+		signedID := text.ChompBytes(gittest.ExecOpts(t, cfg, gittest.ExecConfig{
+			Stdin: strings.NewReader(fmt.Sprintf(
+				"tree %s\nparent %s\nauthor Scrooge McDuck <scrooge@mcduck.com> 1000000000 +0000\ncommitter Scrooge McDuck <scrooge@mcduck.com> 1000000000 +0000\ngpgsig -----BEGIN PGP SIGNATURE-----\n \n signature\n -----END PGP SIGNATURE-----\n\nsigned\n",
+				treeID, unsignedID,
+			)),
+		}, "-C", repoPath, "hash-object", "-t", "commit", "-w", "--stdin"))
+		gittest.Exec(t, cfg, "-C", repoPath, "update-ref", "refs/heads/main", signedID)
+
+		targetFormat := git.ObjectHashSHA256
+		if gittest.ObjectHashIsSHA256() {
+			targetFormat = git.ObjectHashSHA1
+		}
+
+		_, err := client.ConvertObjectFormat(ctx, &gitalypb.ConvertObjectFormatRequest{
+			Repository:   repo,
+			ObjectFormat: targetFormat.ProtoFormat,
+		})
+		testhelper.RequireGrpcError(t, structerr.NewFailedPrecondition("commit has header which cannot be converted").
+			WithInterceptedMetadata("commit_id", signedID).
+			WithInterceptedMetadata("header", "gpgsig"), err)
+
+		require.Equal(t, gittest.DefaultObjectHash.Format, text.ChompBytes(
+			gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", "--show-object-format"),
+		))
+		require.Equal(t, signedID, text.ChompBytes(gittest.Exec(t, cfg, "-C", repoPath, "rev-parse", "refs/heads/main")))
+	})
+}
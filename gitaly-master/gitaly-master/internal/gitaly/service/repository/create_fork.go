@@ -23,6 +23,11 @@ func (s *server) CreateFork(ctx context.Context, req *gitalypb.CreateForkRequest
 		return nil, structerr.NewInvalidArgument("%w", err)
 	}
 
+	objectHash, err := s.sourceObjectHash(ctx, sourceRepository)
+	if err != nil {
+		return nil, structerr.NewInternal("detecting source object hash: %w", err)
+	}
+
 	if err := repoutil.Create(ctx, s.locator, s.gitCmdFactory, s.txManager, targetRepository, func(repo *gitalypb.Repository) error {
 		targetPath, err := s.locator.GetPath(repo)
 		if err != nil {
@@ -57,6 +62,11 @@ func (s *server) CreateFork(ctx context.Context, req *gitalypb.CreateForkRequest
 			}),
 			git.WithDisabledHooks(),
 			git.WithStderr(&stderr),
+			// When cloning an empty repository then Git isn't capable to figure out
+			// the correct object hash that the new repository needs to use and just
+			// uses the default object format. We thus set the default object hash to
+			// match the source repository's object hash.
+			git.WithEnv("GIT_DEFAULT_HASH="+objectHash.Format),
 		)
 		if err != nil {
 			return fmt.Errorf("spawning fetch: %w", err)
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	gitalyauth "gitlab.com/gitlab-org/gitaly/v15/auth"
 	"gitlab.com/gitlab-org/gitaly/v15/client"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
@@ -165,6 +166,70 @@ func TestCreateFork_refs(t *testing.T) {
 	)
 }
 
+func TestCreateFork_objectFormat(t *testing.T) {
+	t.Parallel()
+	ctx := testhelper.Context(t)
+
+	cfg := testcfg.Build(t)
+	testcfg.BuildGitalyHooks(t, cfg)
+	testcfg.BuildGitalySSH(t, cfg)
+
+	client, socketPath := runRepositoryService(t, cfg, nil)
+	cfg.SocketPath = socketPath
+
+	ctx = testhelper.MergeOutgoingMetadata(ctx, testcfg.GitalyServersMetadataFromCfg(t, cfg))
+
+	for _, tc := range []struct {
+		desc       string
+		objectHash git.ObjectHash
+		empty      bool
+	}{
+		{desc: "SHA1", objectHash: git.ObjectHashSHA1},
+		{desc: "SHA1 empty repository", objectHash: git.ObjectHashSHA1, empty: true},
+		{desc: "SHA256", objectHash: git.ObjectHashSHA256},
+		{desc: "SHA256 empty repository", objectHash: git.ObjectHashSHA256, empty: true},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			sourceRepo, sourceRepoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+				ObjectFormat: tc.objectHash.Format,
+			})
+
+			if !tc.empty {
+				// gittest.WriteCommit always uses the default object hash, so we
+				// need to write the commit manually.
+				treeID := text.ChompBytes(gittest.ExecOpts(t, cfg, gittest.ExecConfig{Stdin: &bytes.Buffer{}},
+					"-C", sourceRepoPath, "mktree",
+				))
+				commitID := text.ChompBytes(gittest.Exec(t, cfg, "-C", sourceRepoPath,
+					"commit-tree", treeID, "-m", "message",
+				))
+				gittest.Exec(t, cfg, "-C", sourceRepoPath, "update-ref", "refs/heads/main", commitID)
+			}
+
+			targetRepo := &gitalypb.Repository{
+				RelativePath: gittest.NewRepositoryName(t),
+				StorageName:  sourceRepo.GetStorageName(),
+			}
+
+			_, err := client.CreateFork(ctx, &gitalypb.CreateForkRequest{
+				Repository:       targetRepo,
+				SourceRepository: sourceRepo,
+			})
+			require.NoError(t, err)
+
+			objectFormat, err := client.ObjectFormat(ctx, &gitalypb.ObjectFormatRequest{
+				Repository: targetRepo,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.objectHash.ProtoFormat, objectFormat.GetFormat())
+		})
+	}
+}
+
 func TestCreateFork_fsck(t *testing.T) {
 	t.Parallel()
 
@@ -21,6 +21,22 @@ func (s *server) CreateRepository(ctx context.Context, req *gitalypb.CreateRepos
 		return nil, structerr.NewInvalidArgument("%w", err)
 	}
 
+	backend, err := git.ReferenceBackendByProto(req.GetReferenceBackend())
+	if err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	if backend == git.ReferenceBackendReftable {
+		gitVersion, err := s.gitCmdFactory.GitVersion(ctx)
+		if err != nil {
+			return nil, structerr.NewInternal("detecting Git version: %w", err)
+		}
+
+		if !gitVersion.SupportsReftable() {
+			return nil, structerr.NewFailedPrecondition("reftable backend requires Git v2.45.0 or newer")
+		}
+	}
+
 	if err := repoutil.Create(
 		ctx,
 		s.locator,
@@ -34,6 +50,7 @@ func (s *server) CreateRepository(ctx context.Context, req *gitalypb.CreateRepos
 		},
 		repoutil.WithBranchName(string(req.GetDefaultBranch())),
 		repoutil.WithObjectHash(hash),
+		repoutil.WithReferenceBackend(backend),
 	); err != nil {
 		return nil, structerr.NewInternal("creating repository: %w", err)
 	}
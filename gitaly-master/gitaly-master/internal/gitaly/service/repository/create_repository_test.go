@@ -194,6 +194,79 @@ func TestCreateRepository_withObjectFormat(t *testing.T) {
 	}
 }
 
+func TestCreateRepository_withReferenceBackend(t *testing.T) {
+	t.Parallel()
+
+	cfg, client := setupRepositoryServiceWithoutRepo(t)
+	ctx := testhelper.Context(t)
+
+	gitVersion, err := gittest.NewCommandFactory(t, cfg).GitVersion(ctx)
+	require.NoError(t, err)
+
+	reftableResponse := &gitalypb.CreateRepositoryResponse{}
+	var reftableErr error
+	if !gitVersion.SupportsReftable() {
+		reftableResponse = nil
+		reftableErr = structerr.NewFailedPrecondition("reftable backend requires Git v2.45.0 or newer")
+	}
+
+	for _, tc := range []struct {
+		desc             string
+		referenceBackend gitalypb.ReferenceBackend
+		expectedResponse *gitalypb.CreateRepositoryResponse
+		expectedBackend  git.ReferenceBackend
+		expectedErr      error
+	}{
+		{
+			desc:             "unspecified reference backend",
+			referenceBackend: gitalypb.ReferenceBackend_REFERENCE_BACKEND_UNSPECIFIED,
+			expectedResponse: &gitalypb.CreateRepositoryResponse{},
+			expectedBackend:  git.ReferenceBackendFiles,
+		},
+		{
+			desc:             "files",
+			referenceBackend: gitalypb.ReferenceBackend_REFERENCE_BACKEND_FILES,
+			expectedResponse: &gitalypb.CreateRepositoryResponse{},
+			expectedBackend:  git.ReferenceBackendFiles,
+		},
+		{
+			desc:             "reftable",
+			referenceBackend: gitalypb.ReferenceBackend_REFERENCE_BACKEND_REFTABLE,
+			expectedResponse: reftableResponse,
+			expectedBackend:  git.ReferenceBackendReftable,
+			expectedErr:      reftableErr,
+		},
+		{
+			desc:             "invalid reference backend",
+			referenceBackend: 3,
+			expectedErr:      structerr.NewInvalidArgument("unknown reference backend: \"3\""),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoProto := &gitalypb.Repository{
+				StorageName:  cfg.Storages[0].Name,
+				RelativePath: gittest.NewRepositoryName(t),
+			}
+
+			response, err := client.CreateRepository(ctx, &gitalypb.CreateRepositoryRequest{
+				Repository:       repoProto,
+				ReferenceBackend: tc.referenceBackend,
+			})
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			testhelper.ProtoEqual(t, tc.expectedResponse, response)
+
+			if err != nil {
+				return
+			}
+
+			repo := localrepo.NewTestRepo(t, cfg, repoProto)
+			backend, err := repo.ReferenceBackend(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedBackend, backend)
+		})
+	}
+}
+
 func TestCreateRepository_invalidArguments(t *testing.T) {
 	t.Parallel()
 
@@ -40,6 +40,7 @@ func (s *server) FetchRemote(ctx context.Context, req *gitalypb.FetchRemoteReque
 	remoteName := "inmemory"
 	remoteURL := req.GetRemoteParams().GetUrl()
 	var config []git.ConfigPair
+	var hostKeyAlias string
 
 	for _, refspec := range s.getRefspecs(req.GetRemoteParams().GetMirrorRefmaps()) {
 		config = append(config, git.ConfigPair{
@@ -53,6 +54,11 @@ func (s *server) FetchRemote(ctx context.Context, req *gitalypb.FetchRemoteReque
 			return nil, fmt.Errorf("couldn't get curloptResolve config: %w", err)
 		}
 
+		hostKeyAlias, err = git.SSHHostKeyAlias(remoteURL)
+		if err != nil {
+			return nil, fmt.Errorf("get host key alias: %w", err)
+		}
+
 		remoteURL = modifiedURL
 		config = append(config, resolveConfig...)
 	}
@@ -76,7 +82,15 @@ func (s *server) FetchRemote(ctx context.Context, req *gitalypb.FetchRemoteReque
 
 	opts.CommandOptions = append(opts.CommandOptions, git.WithConfigEnv(config...))
 
-	sshCommand, cleanup, err := git.BuildSSHInvocation(ctx, req.GetSshKey(), req.GetKnownHosts())
+	sshKey, knownHosts := req.GetSshKey(), req.GetKnownHosts()
+	if req.GetRemoteParams().GetSshKey() != "" {
+		sshKey = req.GetRemoteParams().GetSshKey()
+	}
+	if req.GetRemoteParams().GetKnownHosts() != "" {
+		knownHosts = req.GetRemoteParams().GetKnownHosts()
+	}
+
+	sshCommand, cleanup, err := git.BuildSSHInvocation(ctx, sshKey, knownHosts, hostKeyAlias)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +180,14 @@ func (s *server) validateFetchRemoteRequest(req *gitalypb.FetchRemoteRequest) er
 		return structerr.NewInvalidArgument("blank or empty remote URL")
 	}
 
+	if req.GetSshKey() != "" && req.GetRemoteParams().GetSshKey() != "" {
+		return structerr.NewInvalidArgument("SSH key must not be set on both the request and the remote params")
+	}
+
+	if req.GetKnownHosts() != "" && req.GetRemoteParams().GetKnownHosts() != "" {
+		return structerr.NewInvalidArgument("known hosts must not be set on both the request and the remote params")
+	}
+
 	return nil
 }
 
@@ -753,6 +753,38 @@ func TestFetchRemote(t *testing.T) {
 				}
 			},
 		},
+		{
+			desc: "SSH key on request and remote params",
+			setup: func(t *testing.T, cfg config.Cfg) setupData {
+				repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					repoPath: repoPath,
+					request: &gitalypb.FetchRemoteRequest{
+						Repository:   repoProto,
+						RemoteParams: &gitalypb.Remote{Url: "git@example.com:group/project.git", SshKey: "key"},
+						SshKey:       "key",
+					},
+					runs: []run{{expectedErr: structerr.NewInvalidArgument("SSH key must not be set on both the request and the remote params")}},
+				}
+			},
+		},
+		{
+			desc: "known hosts on request and remote params",
+			setup: func(t *testing.T, cfg config.Cfg) setupData {
+				repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					repoPath: repoPath,
+					request: &gitalypb.FetchRemoteRequest{
+						Repository:   repoProto,
+						RemoteParams: &gitalypb.Remote{Url: "git@example.com:group/project.git", KnownHosts: "hosts"},
+						KnownHosts:   "hosts",
+					},
+					runs: []run{{expectedErr: structerr.NewInvalidArgument("known hosts must not be set on both the request and the remote params")}},
+				}
+			},
+		},
 		{
 			desc: "/dev/null",
 			setup: func(t *testing.T, cfg config.Cfg) setupData {
@@ -965,7 +997,7 @@ func TestFetchRemote_sshCommand(t *testing.T) {
 			for arg in $GIT_SSH_COMMAND
 			do
 				case "$arg" in
-				-oIdentityFile=*)
+				-oIdentityFile=*|-oUserKnownHostsFile=*)
 					path=$(echo "$arg" | cut -d= -f2)
 					cat "$path";;
 				*)
@@ -1008,6 +1040,30 @@ func TestFetchRemote_sshCommand(t *testing.T) {
 			},
 			expectedOutput: "ssh\n-oIdentitiesOnly=yes\nmykey",
 		},
+		{
+			desc: "remote parameters with SSH key and known hosts on the remote",
+			request: &gitalypb.FetchRemoteRequest{
+				Repository: repo,
+				RemoteParams: &gitalypb.Remote{
+					Url:        "git@example.com:group/project.git",
+					SshKey:     "mykey",
+					KnownHosts: "myhosts",
+				},
+			},
+			expectedOutput: "ssh\n-oIdentitiesOnly=yes\nmykey-oStrictHostKeyChecking=yes\n-oCheckHostIP=no\nmyhosts",
+		},
+		{
+			desc: "remote parameters with resolved address",
+			request: &gitalypb.FetchRemoteRequest{
+				Repository: repo,
+				RemoteParams: &gitalypb.Remote{
+					Url:             "git@example.com:group/project.git",
+					ResolvedAddress: "192.0.2.1",
+					KnownHosts:      "myhosts",
+				},
+			},
+			expectedOutput: "ssh\n-oStrictHostKeyChecking=yes\n-oCheckHostIP=no\nmyhosts-oHostKeyAlias=example.com\n",
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			_, err := client.FetchRemote(ctx, tc.request)
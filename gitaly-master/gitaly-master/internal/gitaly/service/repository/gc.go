@@ -15,6 +15,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/housekeeping"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
+	cgroupscfg "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
@@ -33,6 +34,12 @@ func (s *server) GarbageCollect(ctx context.Context, in *gitalypb.GarbageCollect
 
 	repo := s.localrepo(repository)
 
+	unlock, err := s.housekeepingManager.LockObjects(ctx, repo)
+	if err != nil {
+		return nil, structerr.NewAborted("locking objects: %w", err)
+	}
+	defer unlock()
+
 	if err := housekeeping.CleanupWorktrees(ctx, repo); err != nil {
 		return nil, err
 	}
@@ -72,6 +79,7 @@ func (s *server) gc(ctx context.Context, in *gitalypb.GarbageCollectRequest) err
 	cmd, err := s.gitCmdFactory.New(ctx, in.GetRepository(),
 		git.Command{Name: "gc", Flags: flags},
 		git.WithConfig(config...),
+		git.WithCgroupClass(cgroupscfg.ClassHousekeeping),
 	)
 	if err != nil {
 		if git.IsInvalidArgErr(err) {
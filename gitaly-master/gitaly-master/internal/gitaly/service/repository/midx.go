@@ -33,6 +33,12 @@ func (s *server) MidxRepack(ctx context.Context, in *gitalypb.MidxRepackRequest)
 
 	repo := s.localrepo(repository)
 
+	unlock, err := s.housekeepingManager.LockObjects(ctx, repo)
+	if err != nil {
+		return nil, structerr.NewAborted("locking objects: %w", err)
+	}
+	defer unlock()
+
 	if err := repo.SetConfig(ctx, "core.multiPackIndex", "true", s.txManager); err != nil {
 		return nil, structerr.NewInternal("setting config: %w", err)
 	}
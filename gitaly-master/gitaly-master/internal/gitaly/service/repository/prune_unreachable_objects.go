@@ -6,6 +6,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/housekeeping"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
+	cgroupscfg "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/cgroups"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
@@ -32,12 +33,18 @@ func (s *server) PruneUnreachableObjects(
 		return nil, err
 	}
 
+	unlock, err := s.housekeepingManager.LockObjects(ctx, repo)
+	if err != nil {
+		return nil, structerr.NewAborted("locking objects: %w", err)
+	}
+	defer unlock()
+
 	if err := repo.ExecAndWait(ctx, git.Command{
 		Name: "prune",
 		Flags: []git.Option{
 			git.ValueFlag{Name: "--expire", Value: "30.minutes.ago"},
 		},
-	}); err != nil {
+	}, git.WithCgroupClass(cgroupscfg.ClassHousekeeping)); err != nil {
 		return nil, structerr.NewInternal("pruning objects: %w", err)
 	}
 
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushrules"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// SetPushRules configures the push rules of the repository.
+func (s *server) SetPushRules(ctx context.Context, request *gitalypb.SetPushRulesRequest) (*gitalypb.SetPushRulesResponse, error) {
+	if err := service.ValidateRepository(request.GetRepository()); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	rules := request.GetPushRules()
+	if rules == nil {
+		rules = &gitalypb.PushRules{}
+	}
+
+	if err := pushrules.Validate(rules); err != nil {
+		return nil, structerr.NewInvalidArgument("validating push rules: %w", err)
+	}
+
+	repoPath, err := s.locator.GetRepoPath(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := pushrules.Marshal(rules)
+	if err != nil {
+		return nil, structerr.NewInternal("%w", err)
+	}
+
+	if err := s.writeFile(ctx, pushrules.Path(repoPath), perm.SharedFile, bytes.NewReader(data)); err != nil {
+		return nil, structerr.NewInternal("writing push rules: %w", err)
+	}
+
+	return &gitalypb.SetPushRulesResponse{}, nil
+}
+
+// GetPushRules returns the push rules configured for the repository.
+func (s *server) GetPushRules(ctx context.Context, request *gitalypb.GetPushRulesRequest) (*gitalypb.GetPushRulesResponse, error) {
+	if err := service.ValidateRepository(request.GetRepository()); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	repoPath, err := s.locator.GetRepoPath(request.GetRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := pushrules.Read(repoPath)
+	if err != nil {
+		return nil, structerr.NewInternal("%w", err)
+	}
+
+	return &gitalypb.GetPushRulesResponse{
+		PushRules: rules,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/errors"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushrules"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetPushRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryServiceWithoutRepo(t)
+
+	t.Run("unset repository", func(t *testing.T) {
+		_, err := client.SetPushRules(ctx, &gitalypb.SetPushRulesRequest{})
+		testhelper.RequireGrpcError(t, testhelper.GitalyOrPraefect(
+			structerr.NewInvalidArgument("%w", errors.ErrEmptyRepository),
+			structerr.NewInvalidArgument("repo scoped: %w", errors.ErrEmptyRepository),
+		), err)
+	})
+
+	t.Run("invalid push rules", func(t *testing.T) {
+		repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+		_, err := client.SetPushRules(ctx, &gitalypb.SetPushRulesRequest{
+			Repository: repo,
+			PushRules: &gitalypb.PushRules{
+				CommitMessageRegex: "[",
+			},
+		})
+		testhelper.RequireGrpcCode(t, err, codes.InvalidArgument)
+		require.Contains(t, err.Error(), `validating push rules: invalid commit message pattern "["`)
+	})
+
+	t.Run("successful update", func(t *testing.T) {
+		repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+		response, err := client.GetPushRules(ctx, &gitalypb.GetPushRulesRequest{
+			Repository: repo,
+		})
+		require.NoError(t, err)
+		testhelper.ProtoEqual(t, &gitalypb.GetPushRulesResponse{
+			PushRules: &gitalypb.PushRules{},
+		}, response)
+
+		rules := &gitalypb.PushRules{
+			MaxFileSize:      1024,
+			FileNameDenylist: []string{`\.exe$`},
+			DenyDeleteTag:    true,
+		}
+
+		_, err = client.SetPushRules(ctx, &gitalypb.SetPushRulesRequest{
+			Repository: repo,
+			PushRules:  rules,
+		})
+		require.NoError(t, err)
+
+		response, err = client.GetPushRules(ctx, &gitalypb.GetPushRulesRequest{
+			Repository: repo,
+		})
+		require.NoError(t, err)
+		testhelper.ProtoEqual(t, &gitalypb.GetPushRulesResponse{
+			PushRules: rules,
+		}, response)
+
+		onDiskRules, err := pushrules.Read(repoPath)
+		require.NoError(t, err)
+		testhelper.ProtoEqual(t, rules, onDiskRules)
+
+		_, err = client.SetPushRules(ctx, &gitalypb.SetPushRulesRequest{
+			Repository: repo,
+		})
+		require.NoError(t, err)
+
+		onDiskRules, err = pushrules.Read(repoPath)
+		require.NoError(t, err)
+		require.True(t, pushrules.IsEmpty(onDiskRules))
+	})
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// ReferenceBackend determines the reference backend used by the Git repository.
+func (s *server) ReferenceBackend(ctx context.Context, request *gitalypb.ReferenceBackendRequest) (*gitalypb.ReferenceBackendResponse, error) {
+	if err := service.ValidateRepository(request.GetRepository()); err != nil {
+		return nil, structerr.NewInvalidArgument("%w", err)
+	}
+
+	repo := s.localrepo(request.GetRepository())
+
+	// Check for the path up-front so that we detect missing repositories early on.
+	if _, err := repo.Path(); err != nil {
+		return nil, structerr.New("%w", err)
+	}
+
+	backend, err := repo.ReferenceBackend(ctx)
+	if err != nil {
+		return nil, structerr.New("detecting reference backend: %w", err)
+	}
+
+	return &gitalypb.ReferenceBackendResponse{
+		Backend: backend.ProtoBackend,
+	}, nil
+}
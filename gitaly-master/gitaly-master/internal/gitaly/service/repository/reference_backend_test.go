@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/errors"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func TestReferenceBackend(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryServiceWithoutRepo(t)
+
+	type setupData struct {
+		request          *gitalypb.ReferenceBackendRequest
+		expectedErr      error
+		expectedResponse *gitalypb.ReferenceBackendResponse
+	}
+
+	for _, tc := range []struct {
+		desc  string
+		setup func(t *testing.T) setupData
+	}{
+		{
+			desc: "unset repository",
+			setup: func(t *testing.T) setupData {
+				return setupData{
+					request: &gitalypb.ReferenceBackendRequest{},
+					expectedErr: testhelper.GitalyOrPraefect(
+						structerr.NewInvalidArgument("%w", errors.ErrEmptyRepository),
+						structerr.NewInvalidArgument("repo scoped: %w", errors.ErrEmptyRepository),
+					),
+				}
+			},
+		},
+		{
+			desc: "nonexistent repository",
+			setup: func(t *testing.T) setupData {
+				return setupData{
+					request: &gitalypb.ReferenceBackendRequest{
+						Repository: &gitalypb.Repository{
+							StorageName:  cfg.Storages[0].Name,
+							RelativePath: "nonexistent.git",
+						},
+					},
+					expectedErr: testhelper.GitalyOrPraefect(
+						structerr.NewNotFound(
+							"GetRepoPath: not a git repository: %q", filepath.Join(cfg.Storages[0].Path, "nonexistent.git"),
+						),
+						structerr.NewNotFound(
+							"accessor call: route repository accessor: consistent storages: repository %q/%q not found",
+							cfg.Storages[0].Name, "nonexistent.git",
+						),
+					),
+				}
+			},
+		},
+		{
+			desc: "files",
+			setup: func(t *testing.T) setupData {
+				repoProto, _ := gittest.CreateRepository(t, ctx, cfg)
+
+				return setupData{
+					request: &gitalypb.ReferenceBackendRequest{
+						Repository: repoProto,
+					},
+					expectedResponse: &gitalypb.ReferenceBackendResponse{
+						Backend: gitalypb.ReferenceBackend_REFERENCE_BACKEND_FILES,
+					},
+				}
+			},
+		},
+		{
+			desc: "reftable",
+			setup: func(t *testing.T) setupData {
+				repoProto, repoPath := gittest.CreateRepository(t, ctx, cfg)
+
+				// Git versions before v2.45.0 do not know how to create reftables, so we
+				// fake the on-disk layout instead.
+				require.NoError(t, os.Mkdir(filepath.Join(repoPath, "reftable"), perm.SharedDir))
+				require.NoError(t, os.WriteFile(filepath.Join(repoPath, "reftable", "tables.list"), nil, perm.SharedFile))
+
+				return setupData{
+					request: &gitalypb.ReferenceBackendRequest{
+						Repository: repoProto,
+					},
+					expectedResponse: &gitalypb.ReferenceBackendResponse{
+						Backend: gitalypb.ReferenceBackend_REFERENCE_BACKEND_REFTABLE,
+					},
+				}
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			setupData := tc.setup(t)
+			response, err := client.ReferenceBackend(ctx, setupData.request)
+			testhelper.RequireGrpcError(t, setupData.expectedErr, err)
+			testhelper.ProtoEqual(t, setupData.expectedResponse, response)
+		})
+	}
+}
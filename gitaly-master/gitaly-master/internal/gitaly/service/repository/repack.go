@@ -31,6 +31,12 @@ func (s *server) RepackFull(ctx context.Context, in *gitalypb.RepackFullRequest)
 	}
 
 	repo := s.localrepo(repository)
+
+	unlock, err := s.housekeepingManager.LockObjects(ctx, repo)
+	if err != nil {
+		return nil, structerr.NewAborted("locking objects: %w", err)
+	}
+	defer unlock()
 	cfg := housekeeping.RepackObjectsConfig{
 		FullRepack:  true,
 		WriteBitmap: in.GetCreateBitmap(),
@@ -63,6 +69,12 @@ func (s *server) RepackIncremental(ctx context.Context, in *gitalypb.RepackIncre
 	}
 
 	repo := s.localrepo(repository)
+
+	unlock, err := s.housekeepingManager.LockObjects(ctx, repo)
+	if err != nil {
+		return nil, structerr.NewAborted("locking objects: %w", err)
+	}
+	defer unlock()
 	cfg := housekeeping.RepackObjectsConfig{
 		FullRepack:  false,
 		WriteBitmap: false,
@@ -16,6 +16,8 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/remoterepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/updateref"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushrules"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/repoutil"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
@@ -26,6 +28,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/safe"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/tempdir"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/transaction/voting"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 	"gitlab.com/gitlab-org/gitaly/v15/streamio"
 	"google.golang.org/grpc/codes"
@@ -40,12 +43,27 @@ func (s *server) ReplicateRepository(ctx context.Context, in *gitalypb.Replicate
 		return nil, structerr.NewInvalidArgument("%w", err)
 	}
 
+	// The target repository must end up with the same references as the source repository, so
+	// reference updates performed by replication must not be rejected because of quotas.
+	ctx = git.ContextWithoutQuota(ctx)
+
 	repoPath, err := s.locator.GetPath(in.GetRepository())
 	if err != nil {
 		return nil, structerr.NewInternal("%w", err)
 	}
 
-	if !storage.IsGitDirectory(repoPath) {
+	recreate := !storage.IsGitDirectory(repoPath)
+	if !recreate {
+		// Repositories using different object formats cannot be synced incrementally. This
+		// is the case when the source repository has been converted via
+		// ConvertObjectFormat, so we recreate the target repository from scratch.
+		recreate, err = s.objectHashDiffers(ctx, in)
+		if err != nil {
+			return nil, structerr.NewInternal("comparing object hashes: %w", err)
+		}
+	}
+
+	if recreate {
 		if err = s.create(ctx, in, repoPath); err != nil {
 			if errors.Is(err, ErrInvalidSourceRepository) {
 				return nil, ErrInvalidSourceRepository
@@ -86,8 +104,26 @@ func (s *server) ReplicateRepository(ctx context.Context, in *gitalypb.Replicate
 		return nil, structerr.NewInternal("synchronizing gitattributes: %w", err)
 	}
 
-	if err := s.syncRepository(outgoingCtx, in); err != nil {
-		return nil, structerr.NewInternal("synchronizing repository: %w", err)
+	if err := s.syncPushRules(outgoingCtx, in); err != nil {
+		return nil, structerr.NewInternal("synchronizing push rules: %w", err)
+	}
+
+	// Existing repositories can be replicated incrementally in case we know about the reference
+	// updates which have been performed on the source repository. This is an optimization only,
+	// so we fall back to a full fetch of the source repository if it fails for whatever reason.
+	replicatedIncrementally := false
+	if !recreate && len(in.GetReferenceUpdates()) > 0 {
+		if err := s.syncReferenceUpdates(outgoingCtx, in); err != nil {
+			ctxlogrus.Extract(ctx).WithError(err).Info("incremental replication failed, falling back to full replication")
+		} else {
+			replicatedIncrementally = true
+		}
+	}
+
+	if !replicatedIncrementally {
+		if err := s.syncRepository(outgoingCtx, in); err != nil {
+			return nil, structerr.NewInternal("synchronizing repository: %w", err)
+		}
 	}
 
 	if featureflag.ReplicateRepositoryHooks.IsEnabled(ctx) {
@@ -138,19 +174,95 @@ func (s *server) create(ctx context.Context, in *gitalypb.ReplicateRepositoryReq
 }
 
 func (s *server) createFromSnapshot(ctx context.Context, in *gitalypb.ReplicateRepositoryRequest) error {
+	// The snapshot contains objects and references in the on-disk format of the source
+	// repository, so the target repository must be initialized with the same object hash and
+	// reference backend.
+	objectHash, err := s.sourceObjectHash(ctx, in.GetSource())
+	if err != nil {
+		return fmt.Errorf("detecting source object hash: %w", err)
+	}
+
+	backend, err := s.sourceReferenceBackend(ctx, in.GetSource())
+	if err != nil {
+		return fmt.Errorf("detecting source reference backend: %w", err)
+	}
+
 	if err := repoutil.Create(ctx, s.locator, s.gitCmdFactory, s.txManager, in.GetRepository(), func(repo *gitalypb.Repository) error {
 		if err := s.extractSnapshot(ctx, in.GetSource(), repo); err != nil {
 			return fmt.Errorf("extracting snapshot: %w", err)
 		}
 
 		return nil
-	}); err != nil {
+	}, repoutil.WithObjectHash(objectHash), repoutil.WithReferenceBackend(backend)); err != nil {
 		return fmt.Errorf("creating repository: %w", err)
 	}
 
 	return nil
 }
 
+// sourceObjectHash determines the object hash of the source repository. Missing source
+// repositories are reported when extracting the snapshot, so we fall back to SHA1 in that case.
+func (s *server) sourceObjectHash(ctx context.Context, source *gitalypb.Repository) (git.ObjectHash, error) {
+	repoClient, err := s.newRepoClient(ctx, source.GetStorageName())
+	if err != nil {
+		return git.ObjectHash{}, fmt.Errorf("new client: %w", err)
+	}
+
+	response, err := repoClient.ObjectFormat(ctx, &gitalypb.ObjectFormatRequest{Repository: source})
+	if err != nil {
+		if structerr.GRPCCode(err) == codes.NotFound {
+			return git.ObjectHashSHA1, nil
+		}
+
+		return git.ObjectHash{}, err
+	}
+
+	return git.ObjectHashByProto(response.GetFormat())
+}
+
+// objectHashDiffers determines whether the target repository uses a different object hash than
+// the source repository.
+func (s *server) objectHashDiffers(ctx context.Context, in *gitalypb.ReplicateRepositoryRequest) (bool, error) {
+	targetObjectHash, err := s.localrepo(in.GetRepository()).ObjectHash(ctx)
+	if err != nil {
+		return false, fmt.Errorf("detecting target object hash: %w", err)
+	}
+
+	sourceObjectHash, err := s.sourceObjectHash(ctx, in.GetSource())
+	if err != nil {
+		// We cannot tell whether the object hashes differ in case the source repository is
+		// broken. We thus keep the target repository and let the subsequent syncs report
+		// the actual error.
+		ctxlogrus.Extract(ctx).WithError(err).Warn("detecting source object hash")
+		return false, nil
+	}
+
+	return targetObjectHash.Format != sourceObjectHash.Format, nil
+}
+
+// sourceReferenceBackend determines the reference backend of the source repository. Source nodes
+// which do not yet know about reference backends can only ever host repositories using the files
+// backend. Missing source repositories are reported when extracting the snapshot, so we fall back
+// to the files backend in that case, too.
+func (s *server) sourceReferenceBackend(ctx context.Context, source *gitalypb.Repository) (git.ReferenceBackend, error) {
+	repoClient, err := s.newRepoClient(ctx, source.GetStorageName())
+	if err != nil {
+		return git.ReferenceBackend{}, fmt.Errorf("new client: %w", err)
+	}
+
+	response, err := repoClient.ReferenceBackend(ctx, &gitalypb.ReferenceBackendRequest{Repository: source})
+	if err != nil {
+		switch structerr.GRPCCode(err) {
+		case codes.Unimplemented, codes.NotFound:
+			return git.ReferenceBackendFiles, nil
+		default:
+			return git.ReferenceBackend{}, err
+		}
+	}
+
+	return git.ReferenceBackendByProto(response.GetBackend())
+}
+
 func (s *server) extractSnapshot(ctx context.Context, source, target *gitalypb.Repository) error {
 	repoClient, err := s.newRepoClient(ctx, source.GetStorageName())
 	if err != nil {
@@ -243,6 +355,18 @@ func fetchInternalRemote(
 		return fmt.Errorf("fetch: %w", err)
 	}
 
+	return syncDefaultBranch(ctx, txManager, conns, repo, remoteRepoProto)
+}
+
+// syncDefaultBranch updates the default branch of the repository to match the default branch of
+// the remote repository.
+func syncDefaultBranch(
+	ctx context.Context,
+	txManager transaction.Manager,
+	conns *client.Pool,
+	repo *localrepo.Repo,
+	remoteRepoProto *gitalypb.Repository,
+) error {
 	remoteRepo, err := remoterepo.New(ctx, remoteRepoProto, conns)
 	if err != nil {
 		return structerr.NewInternal("%w", err)
@@ -267,6 +391,196 @@ func fetchInternalRemote(
 	return nil
 }
 
+// referenceUpdate is a single reference update which is to be applied when replicating a
+// repository incrementally.
+type referenceUpdate struct {
+	reference git.ReferenceName
+	oldOID    git.ObjectID
+	newOID    git.ObjectID
+}
+
+// collapseReferenceUpdates parses the reference updates and collapses subsequent updates of the
+// same reference into a single update from the first old to the last new object ID. Updates which
+// do not change the reference are dropped. An error is returned in case the updates cannot be
+// applied incrementally.
+func collapseReferenceUpdates(objectHash git.ObjectHash, updates []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate) ([]referenceUpdate, error) {
+	collapsed := make([]referenceUpdate, 0, len(updates))
+	indexByReference := make(map[git.ReferenceName]int, len(updates))
+
+	for _, update := range updates {
+		reference := git.ReferenceName(update.GetReference())
+		if !strings.HasPrefix(reference.String(), "refs/") {
+			return nil, fmt.Errorf("unsupported reference %q", reference)
+		}
+
+		oldOID, err := objectHash.FromHex(update.GetOldOid())
+		if err != nil {
+			return nil, fmt.Errorf("invalid old object ID for %q: %w", reference, err)
+		}
+
+		newOID, err := objectHash.FromHex(update.GetNewOid())
+		if err != nil {
+			return nil, fmt.Errorf("invalid new object ID for %q: %w", reference, err)
+		}
+
+		if i, ok := indexByReference[reference]; ok {
+			// Git reports the zero OID as old value in case the reference has been
+			// updated without verifying its current value, so we cannot verify that the
+			// updates form a chain in that case.
+			if oldOID != objectHash.ZeroOID && oldOID != collapsed[i].newOID {
+				return nil, fmt.Errorf("non-consecutive updates of %q", reference)
+			}
+
+			collapsed[i].newOID = newOID
+			continue
+		}
+
+		indexByReference[reference] = len(collapsed)
+		collapsed = append(collapsed, referenceUpdate{
+			reference: reference,
+			oldOID:    oldOID,
+			newOID:    newOID,
+		})
+	}
+
+	result := collapsed[:0]
+	for _, update := range collapsed {
+		if update.oldOID == update.newOID && update.newOID != objectHash.ZeroOID {
+			continue
+		}
+
+		result = append(result, update)
+	}
+
+	return result, nil
+}
+
+// syncReferenceUpdates replicates the repository incrementally by fetching only the objects
+// reachable from the updated references and then applying the reference updates to the target
+// repository. The old values of the updates are verified, so the target repository must be in the
+// state the source repository was in before the updates have been performed. Returns an error in
+// case the updates cannot be applied or in case the checksums of the source and target repository
+// don't match afterwards.
+func (s *server) syncReferenceUpdates(ctx context.Context, in *gitalypb.ReplicateRepositoryRequest) error {
+	repo := s.localrepo(in.GetRepository())
+
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	updates, err := collapseReferenceUpdates(objectHash, in.GetReferenceUpdates())
+	if err != nil {
+		return fmt.Errorf("collapsing reference updates: %w", err)
+	}
+
+	wants := make([]string, 0, len(updates))
+	seenWants := make(map[git.ObjectID]struct{}, len(updates))
+	for _, update := range updates {
+		if update.newOID == objectHash.ZeroOID {
+			continue
+		}
+
+		if _, ok := seenWants[update.newOID]; ok {
+			continue
+		}
+		seenWants[update.newOID] = struct{}{}
+
+		wants = append(wants, update.newOID.String())
+	}
+
+	// The refspecs only consist of object IDs without a destination, so the fetch only
+	// downloads the missing objects without updating any references.
+	if len(wants) > 0 {
+		var stderr bytes.Buffer
+		if err := repo.FetchInternal(ctx, in.GetSource(), wants, localrepo.FetchOpts{
+			Tags:   localrepo.FetchOptsTagsNone,
+			Stderr: &stderr,
+			CommandOptions: []git.CmdOpt{
+				git.WithConfig(git.ConfigPair{Key: "fetch.negotiationAlgorithm", Value: "skipping"}),
+			},
+		}); err != nil {
+			if errors.As(err, &localrepo.ErrFetchFailed{}) {
+				return fmt.Errorf("fetch: %w, stderr: %q", err, stderr.String())
+			}
+
+			return fmt.Errorf("fetch: %w", err)
+		}
+	}
+
+	if len(updates) > 0 {
+		if err := applyReferenceUpdates(ctx, repo, objectHash, updates); err != nil {
+			return fmt.Errorf("applying reference updates: %w", err)
+		}
+	}
+
+	if err := syncDefaultBranch(ctx, s.txManager, s.conns, repo, in.GetSource()); err != nil {
+		return fmt.Errorf("synchronizing default branch: %w", err)
+	}
+
+	repoClient, err := s.newRepoClient(ctx, in.GetSource().GetStorageName())
+	if err != nil {
+		return fmt.Errorf("new client: %w", err)
+	}
+
+	sourceChecksum, err := repoClient.CalculateChecksum(ctx, &gitalypb.CalculateChecksumRequest{
+		Repository: in.GetSource(),
+	})
+	if err != nil {
+		return fmt.Errorf("calculating source checksum: %w", err)
+	}
+
+	targetChecksum, err := s.CalculateChecksum(ctx, &gitalypb.CalculateChecksumRequest{
+		Repository: in.GetRepository(),
+	})
+	if err != nil {
+		return fmt.Errorf("calculating target checksum: %w", err)
+	}
+
+	if sourceChecksum.GetChecksum() != targetChecksum.GetChecksum() {
+		return fmt.Errorf("checksum mismatch: source %q, target %q", sourceChecksum.GetChecksum(), targetChecksum.GetChecksum())
+	}
+
+	return nil
+}
+
+// applyReferenceUpdates atomically applies the reference updates to the repository while verifying
+// their old values.
+func applyReferenceUpdates(ctx context.Context, repo *localrepo.Repo, objectHash git.ObjectHash, updates []referenceUpdate) (returnedErr error) {
+	updater, err := updateref.New(ctx, repo)
+	if err != nil {
+		return fmt.Errorf("creating updater: %w", err)
+	}
+	defer func() {
+		if err := updater.Close(); err != nil && returnedErr == nil {
+			returnedErr = fmt.Errorf("closing updater: %w", err)
+		}
+	}()
+
+	if err := updater.Start(); err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	for _, update := range updates {
+		oldOID := update.oldOID
+		// A deletion with a zero old value has been performed without verifying the
+		// reference's value, so we force-delete the reference, too.
+		if update.newOID == objectHash.ZeroOID && oldOID == objectHash.ZeroOID {
+			oldOID = ""
+		}
+
+		if err := updater.Update(update.reference, update.newOID, oldOID); err != nil {
+			return fmt.Errorf("queueing update of %q: %w", update.reference, err)
+		}
+	}
+
+	if err := updater.Commit(); err != nil {
+		return fmt.Errorf("committing: %w", err)
+	}
+
+	return nil
+}
+
 // syncCustomHooks replicates custom hooks from a source to a target.
 func (s *server) syncCustomHooks(ctx context.Context, in *gitalypb.ReplicateRepositoryRequest) error {
 	repoClient, err := s.newRepoClient(ctx, in.GetSource().GetStorageName())
@@ -351,6 +665,53 @@ func (s *server) syncInfoAttributes(ctx context.Context, in *gitalypb.ReplicateR
 	return nil
 }
 
+// syncPushRules replicates push rules from a source to a target.
+func (s *server) syncPushRules(ctx context.Context, in *gitalypb.ReplicateRepositoryRequest) error {
+	repoClient, err := s.newRepoClient(ctx, in.GetSource().GetStorageName())
+	if err != nil {
+		return err
+	}
+
+	repoPath, err := s.locator.GetRepoPath(in.GetRepository())
+	if err != nil {
+		return err
+	}
+
+	response, err := repoClient.GetPushRules(ctx, &gitalypb.GetPushRulesRequest{
+		Repository: in.GetSource(),
+	})
+	if err != nil {
+		// Source nodes which haven't been upgraded yet don't know about push rules, so we
+		// keep whatever rules the target repository has instead of failing replication.
+		if status.Code(err) == codes.Unimplemented {
+			return nil
+		}
+
+		return err
+	}
+
+	// Repositories without push rules don't have a push rules file, so we remove any stale
+	// file from the target instead of writing an empty one.
+	if pushrules.IsEmpty(response.GetPushRules()) {
+		if err := s.removeFile(ctx, pushrules.Path(repoPath)); err != nil {
+			return fmt.Errorf("removing push rules: %w", err)
+		}
+
+		return nil
+	}
+
+	data, err := pushrules.Marshal(response.GetPushRules())
+	if err != nil {
+		return err
+	}
+
+	if err := s.writeFile(ctx, pushrules.Path(repoPath), perm.SharedFile, bytes.NewReader(data)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *server) writeFile(ctx context.Context, path string, mode os.FileMode, reader io.Reader) (returnedErr error) {
 	parentDir := filepath.Dir(path)
 	if err := os.MkdirAll(parentDir, perm.SharedDir); err != nil {
@@ -382,6 +743,38 @@ func (s *server) writeFile(ctx context.Context, path string, mode os.FileMode, r
 	return nil
 }
 
+// removeFile removes the file at the given path while holding its lock. We vote on the removal
+// with the zero OID as placeholder so that all replicas agree on the file having been removed.
+func (s *server) removeFile(ctx context.Context, path string) (returnedErr error) {
+	locker, err := safe.NewLockingFileWriter(path)
+	if err != nil {
+		return fmt.Errorf("creating file lock: %w", err)
+	}
+	defer func() {
+		if err := locker.Close(); err != nil && returnedErr == nil {
+			returnedErr = err
+		}
+	}()
+
+	if err := locker.Lock(); err != nil {
+		return fmt.Errorf("locking file: %w", err)
+	}
+
+	if err := s.vote(ctx, git.ObjectHashSHA1.ZeroOID, voting.Prepared); err != nil {
+		return fmt.Errorf("preimage vote: %w", err)
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if err := s.vote(ctx, git.ObjectHashSHA1.ZeroOID, voting.Committed); err != nil {
+		return fmt.Errorf("postimage vote: %w", err)
+	}
+
+	return nil
+}
+
 // newRepoClient creates a new RepositoryClient that talks to the gitaly of the source repository
 func (s *server) newRepoClient(ctx context.Context, storageName string) (gitalypb.RepositoryServiceClient, error) {
 	gitalyServerInfo, err := storage.ExtractGitalyServer(ctx, storageName)
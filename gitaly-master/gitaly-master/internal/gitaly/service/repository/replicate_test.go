@@ -9,11 +9,14 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v15/client"
@@ -21,10 +24,13 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/oidmap"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
 	gitalyhook "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/hook"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/pushrules"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata"
@@ -36,6 +42,8 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -73,6 +81,12 @@ func testReplicateRepositorySuccess(t *testing.T, ctx context.Context) {
 	attrData := []byte("*.pbxproj binary\n")
 	require.NoError(t, os.WriteFile(attrFilePath, attrData, perm.SharedFile))
 
+	// write push rules
+	pushRules := &gitalypb.PushRules{DenyDeleteTag: true}
+	pushRulesData, err := pushrules.Marshal(pushRules)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(pushrules.Path(repoPath), pushRulesData, perm.SharedFile))
+
 	// Write a modified gitconfig
 	gittest.Exec(t, cfg, "-C", repoPath, "config", "please.replicate", "me")
 	configData := testhelper.MustReadFile(t, filepath.Join(repoPath, "config"))
@@ -97,6 +111,10 @@ func testReplicateRepositorySuccess(t *testing.T, ctx context.Context) {
 	replicatedAttrData := testhelper.MustReadFile(t, replicatedAttrFilePath)
 	require.Equal(t, string(attrData), string(replicatedAttrData), "info/attributes files must match")
 
+	replicatedPushRules, err := pushrules.Read(targetRepoPath)
+	require.NoError(t, err)
+	testhelper.ProtoEqual(t, pushRules, replicatedPushRules)
+
 	replicatedConfigPath := filepath.Join(targetRepoPath, "config")
 	replicatedConfigData := testhelper.MustReadFile(t, replicatedConfigPath)
 	require.Equal(t, string(configData), string(replicatedConfigData), "config files must match")
@@ -205,6 +223,370 @@ func testReplicateRepositoryHiddenRefs(t *testing.T, ctx context.Context) {
 	})
 }
 
+func TestReplicateRepository_referenceUpdates(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.ReplicateRepositoryHooks).
+		Run(t, testReplicateRepositoryReferenceUpdates)
+}
+
+func testReplicateRepositoryReferenceUpdates(t *testing.T, ctx context.Context) {
+	cfgBuilder := testcfg.NewGitalyCfgBuilder(testcfg.WithStorages("default", "replica"))
+	cfg := cfgBuilder.Build(t)
+
+	testcfg.BuildGitalyHooks(t, cfg)
+	testcfg.BuildGitalySSH(t, cfg)
+
+	logger, hook := test.NewNullLogger()
+	client, serverSocketPath := runRepositoryService(t, cfg, nil, testserver.WithLogger(logger))
+	cfg.SocketPath = serverSocketPath
+
+	ctx = testhelper.MergeOutgoingMetadata(ctx, testcfg.GitalyServersMetadataFromCfg(t, cfg))
+
+	type setupData struct {
+		referenceUpdates   []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate
+		expectedFullResync bool
+	}
+
+	for _, tc := range []struct {
+		desc  string
+		setup func(t *testing.T, sourcePath, targetPath string, baseCommit git.ObjectID) setupData
+	}{
+		{
+			desc: "reference updates are applied",
+			setup: func(t *testing.T, sourcePath, targetPath string, baseCommit git.ObjectID) setupData {
+				mainCommit := gittest.WriteCommit(t, cfg, sourcePath, gittest.WithParents(baseCommit), gittest.WithBranch("main"))
+				featureCommit := gittest.WriteCommit(t, cfg, sourcePath, gittest.WithParents(mainCommit), gittest.WithBranch("feature"))
+				gittest.Exec(t, cfg, "-C", sourcePath, "update-ref", "-d", "refs/heads/stale")
+
+				return setupData{
+					referenceUpdates: []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate{
+						{Reference: []byte("refs/heads/main"), OldOid: baseCommit.String(), NewOid: mainCommit.String()},
+						{Reference: []byte("refs/heads/feature"), OldOid: gittest.DefaultObjectHash.ZeroOID.String(), NewOid: mainCommit.String()},
+						{Reference: []byte("refs/heads/feature"), OldOid: mainCommit.String(), NewOid: featureCommit.String()},
+						{Reference: []byte("refs/heads/stale"), OldOid: baseCommit.String(), NewOid: gittest.DefaultObjectHash.ZeroOID.String()},
+					},
+				}
+			},
+		},
+		{
+			desc: "reference created and deleted again",
+			setup: func(t *testing.T, sourcePath, targetPath string, baseCommit git.ObjectID) setupData {
+				return setupData{
+					referenceUpdates: []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate{
+						{Reference: []byte("refs/heads/temporary"), OldOid: gittest.DefaultObjectHash.ZeroOID.String(), NewOid: baseCommit.String()},
+						{Reference: []byte("refs/heads/temporary"), OldOid: baseCommit.String(), NewOid: gittest.DefaultObjectHash.ZeroOID.String()},
+					},
+				}
+			},
+		},
+		{
+			desc: "target does not match old values",
+			setup: func(t *testing.T, sourcePath, targetPath string, baseCommit git.ObjectID) setupData {
+				mainCommit := gittest.WriteCommit(t, cfg, sourcePath, gittest.WithParents(baseCommit), gittest.WithBranch("main"))
+				gittest.WriteCommit(t, cfg, targetPath, gittest.WithParents(baseCommit), gittest.WithMessage("diverged"), gittest.WithBranch("main"))
+
+				return setupData{
+					referenceUpdates: []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate{
+						{Reference: []byte("refs/heads/main"), OldOid: baseCommit.String(), NewOid: mainCommit.String()},
+					},
+					expectedFullResync: true,
+				}
+			},
+		},
+		{
+			desc: "source has diverged from reference updates",
+			setup: func(t *testing.T, sourcePath, targetPath string, baseCommit git.ObjectID) setupData {
+				mainCommit := gittest.WriteCommit(t, cfg, sourcePath, gittest.WithParents(baseCommit), gittest.WithBranch("main"))
+				gittest.WriteCommit(t, cfg, sourcePath, gittest.WithParents(baseCommit), gittest.WithMessage("unknown"), gittest.WithBranch("unknown"))
+
+				return setupData{
+					referenceUpdates: []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate{
+						{Reference: []byte("refs/heads/main"), OldOid: baseCommit.String(), NewOid: mainCommit.String()},
+					},
+					expectedFullResync: true,
+				}
+			},
+		},
+		{
+			desc: "unsupported reference",
+			setup: func(t *testing.T, sourcePath, targetPath string, baseCommit git.ObjectID) setupData {
+				mainCommit := gittest.WriteCommit(t, cfg, sourcePath, gittest.WithParents(baseCommit), gittest.WithBranch("main"))
+
+				return setupData{
+					referenceUpdates: []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate{
+						{Reference: []byte("HEAD"), OldOid: baseCommit.String(), NewOid: mainCommit.String()},
+						{Reference: []byte("refs/heads/main"), OldOid: baseCommit.String(), NewOid: mainCommit.String()},
+					},
+					expectedFullResync: true,
+				}
+			},
+		},
+		{
+			desc: "invalid object ID",
+			setup: func(t *testing.T, sourcePath, targetPath string, baseCommit git.ObjectID) setupData {
+				return setupData{
+					referenceUpdates: []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate{
+						{Reference: []byte("refs/heads/main"), OldOid: baseCommit.String(), NewOid: "invalid"},
+					},
+					expectedFullResync: true,
+				}
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			sourceRepo, sourcePath := gittest.CreateRepository(t, ctx, cfg)
+			targetRepo, targetPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+				RelativePath: sourceRepo.GetRelativePath(),
+				Storage:      cfg.Storages[1],
+			})
+
+			// Create the same commits in both repositories so that they're in a known-good
+			// state.
+			var baseCommit git.ObjectID
+			for _, path := range []string{sourcePath, targetPath} {
+				baseCommit = gittest.WriteCommit(t, cfg, path, gittest.WithParents(), gittest.WithMessage("base"), gittest.WithBranch("main"))
+				gittest.Exec(t, cfg, "-C", path, "update-ref", "refs/heads/stale", baseCommit.String())
+			}
+
+			setup := tc.setup(t, sourcePath, targetPath, baseCommit)
+
+			hook.Reset()
+
+			_, err := client.ReplicateRepository(ctx, &gitalypb.ReplicateRepositoryRequest{
+				Repository:       targetRepo,
+				Source:           sourceRepo,
+				ReferenceUpdates: setup.referenceUpdates,
+			})
+			require.NoError(t, err)
+
+			var fullResync bool
+			for _, entry := range hook.AllEntries() {
+				if entry.Message == "incremental replication failed, falling back to full replication" {
+					fullResync = true
+				}
+			}
+			require.Equal(t, setup.expectedFullResync, fullResync)
+
+			require.Equal(t,
+				text.ChompBytes(gittest.Exec(t, cfg, "-C", sourcePath, "show-ref", "--head")),
+				text.ChompBytes(gittest.Exec(t, cfg, "-C", targetPath, "show-ref", "--head")),
+			)
+			gittest.Exec(t, cfg, "-C", targetPath, "fsck", "--connectivity-only")
+		})
+	}
+}
+
+func TestReplicateRepository_quota(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.ReplicateRepositoryHooks).
+		Run(t, testReplicateRepositoryQuota)
+}
+
+func testReplicateRepositoryQuota(t *testing.T, ctx context.Context) {
+	cfgBuilder := testcfg.NewGitalyCfgBuilder(testcfg.WithStorages("default", "replica"))
+	cfg := cfgBuilder.Build(t)
+
+	// All repositories containing any data exceed the quota, but replication must bring the
+	// target repository up to date regardless.
+	cfg.Quota = config.Quota{
+		RepositoryMaxBytes: 1,
+		CacheTTL:           duration.Duration(time.Minute),
+	}
+
+	testcfg.BuildGitalyHooks(t, cfg)
+	testcfg.BuildGitalySSH(t, cfg)
+
+	logger, hook := test.NewNullLogger()
+	client, serverSocketPath := runRepositoryService(t, cfg, nil, testserver.WithLogger(logger))
+	cfg.SocketPath = serverSocketPath
+
+	ctx = testhelper.MergeOutgoingMetadata(ctx, testcfg.GitalyServersMetadataFromCfg(t, cfg))
+
+	for _, tc := range []struct {
+		desc          string
+		incrementally bool
+	}{
+		{
+			desc: "full replication",
+		},
+		{
+			desc:          "incremental replication",
+			incrementally: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			sourceRepo, sourcePath := gittest.CreateRepository(t, ctx, cfg)
+			targetRepo, targetPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+				RelativePath: sourceRepo.GetRelativePath(),
+				Storage:      cfg.Storages[1],
+			})
+
+			var baseCommit git.ObjectID
+			for _, path := range []string{sourcePath, targetPath} {
+				baseCommit = gittest.WriteCommit(t, cfg, path, gittest.WithParents(), gittest.WithMessage("base"), gittest.WithBranch("main"))
+			}
+			mainCommit := gittest.WriteCommit(t, cfg, sourcePath, gittest.WithParents(baseCommit), gittest.WithBranch("main"))
+
+			var referenceUpdates []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate
+			if tc.incrementally {
+				referenceUpdates = append(referenceUpdates, &gitalypb.ReplicateRepositoryRequest_ReferenceUpdate{
+					Reference: []byte("refs/heads/main"), OldOid: baseCommit.String(), NewOid: mainCommit.String(),
+				})
+			}
+
+			hook.Reset()
+
+			_, err := client.ReplicateRepository(ctx, &gitalypb.ReplicateRepositoryRequest{
+				Repository:       targetRepo,
+				Source:           sourceRepo,
+				ReferenceUpdates: referenceUpdates,
+			})
+			require.NoError(t, err)
+
+			for _, entry := range hook.AllEntries() {
+				require.NotEqual(t, "incremental replication failed, falling back to full replication", entry.Message)
+			}
+
+			require.Equal(t,
+				text.ChompBytes(gittest.Exec(t, cfg, "-C", sourcePath, "show-ref", "--head")),
+				text.ChompBytes(gittest.Exec(t, cfg, "-C", targetPath, "show-ref", "--head")),
+			)
+		})
+	}
+}
+
+func TestReplicateRepository_objectFormat(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.ReplicateRepositoryHooks).
+		Run(t, testReplicateRepositoryObjectFormat)
+}
+
+func testReplicateRepositoryObjectFormat(t *testing.T, ctx context.Context) {
+	cfgBuilder := testcfg.NewGitalyCfgBuilder(testcfg.WithStorages("default", "replica"))
+	cfg := cfgBuilder.Build(t)
+
+	testcfg.BuildGitalyHooks(t, cfg)
+	testcfg.BuildGitalySSH(t, cfg)
+
+	client, serverSocketPath := runRepositoryService(t, cfg, nil)
+	cfg.SocketPath = serverSocketPath
+
+	ctx = testhelper.MergeOutgoingMetadata(ctx, testcfg.GitalyServersMetadataFromCfg(t, cfg))
+
+	for _, objectHash := range []git.ObjectHash{
+		git.ObjectHashSHA1,
+		git.ObjectHashSHA256,
+	} {
+		objectHash := objectHash
+
+		t.Run(objectHash.Format, func(t *testing.T) {
+			sourceRepo, sourceRepoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+				ObjectFormat: objectHash.Format,
+			})
+			// gittest.WriteCommit always uses the default object hash, so we need to write
+			// the commit manually.
+			treeID := text.ChompBytes(gittest.ExecOpts(t, cfg, gittest.ExecConfig{Stdin: &bytes.Buffer{}},
+				"-C", sourceRepoPath, "mktree",
+			))
+			require.Equal(t, objectHash.EmptyTreeOID.String(), treeID)
+
+			commitID := text.ChompBytes(gittest.Exec(t, cfg, "-C", sourceRepoPath,
+				"commit-tree", treeID, "-m", "message",
+			))
+			gittest.Exec(t, cfg, "-C", sourceRepoPath, "update-ref", "refs/heads/main", commitID)
+
+			targetRepo := proto.Clone(sourceRepo).(*gitalypb.Repository)
+			targetRepo.StorageName = cfg.Storages[1].Name
+
+			_, err := client.ReplicateRepository(ctx, &gitalypb.ReplicateRepositoryRequest{
+				Repository: targetRepo,
+				Source:     sourceRepo,
+			})
+			require.NoError(t, err)
+
+			targetRepoPath := filepath.Join(cfg.Storages[1].Path, gittest.GetReplicaPath(t, ctx, cfg, targetRepo))
+			gittest.Exec(t, cfg, "-C", targetRepoPath, "fsck")
+
+			require.Equal(t, objectHash.Format, text.ChompBytes(
+				gittest.Exec(t, cfg, "-C", targetRepoPath, "rev-parse", "--show-object-format"),
+			))
+			require.Equal(t,
+				text.ChompBytes(gittest.Exec(t, cfg, "-C", sourceRepoPath, "for-each-ref")),
+				text.ChompBytes(gittest.Exec(t, cfg, "-C", targetRepoPath, "for-each-ref")),
+			)
+		})
+	}
+}
+
+func TestReplicateRepository_convertedObjectFormat(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewFeatureSets(featureflag.ReplicateRepositoryHooks).
+		Run(t, testReplicateRepositoryConvertedObjectFormat)
+}
+
+func testReplicateRepositoryConvertedObjectFormat(t *testing.T, ctx context.Context) {
+	cfgBuilder := testcfg.NewGitalyCfgBuilder(testcfg.WithStorages("default", "replica"))
+	cfg := cfgBuilder.Build(t)
+
+	testcfg.BuildGitalyHooks(t, cfg)
+	testcfg.BuildGitalySSH(t, cfg)
+
+	client, serverSocketPath := runRepositoryService(t, cfg, nil)
+	cfg.SocketPath = serverSocketPath
+
+	ctx = testhelper.MergeOutgoingMetadata(ctx, testcfg.GitalyServersMetadataFromCfg(t, cfg))
+
+	sourceRepo, sourceRepoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		ObjectFormat: git.ObjectHashSHA1.Format,
+	})
+	// gittest.WriteCommit always uses the default object hash, so we need to write the commit
+	// manually.
+	treeID := text.ChompBytes(gittest.ExecOpts(t, cfg, gittest.ExecConfig{Stdin: &bytes.Buffer{}},
+		"-C", sourceRepoPath, "mktree",
+	))
+	commitID := text.ChompBytes(gittest.Exec(t, cfg, "-C", sourceRepoPath, "commit-tree", treeID, "-m", "message"))
+	gittest.Exec(t, cfg, "-C", sourceRepoPath, "update-ref", "refs/heads/main", commitID)
+
+	targetRepo := proto.Clone(sourceRepo).(*gitalypb.Repository)
+	targetRepo.StorageName = cfg.Storages[1].Name
+
+	_, err := client.ReplicateRepository(ctx, &gitalypb.ReplicateRepositoryRequest{
+		Repository: targetRepo,
+		Source:     sourceRepo,
+	})
+	require.NoError(t, err)
+
+	_, err = client.ConvertObjectFormat(ctx, &gitalypb.ConvertObjectFormatRequest{
+		Repository:   sourceRepo,
+		ObjectFormat: gitalypb.ObjectFormat_OBJECT_FORMAT_SHA256,
+	})
+	require.NoError(t, err)
+
+	// The target repository still uses SHA1 and thus cannot be synced incrementally. It must
+	// be recreated from the converted source repository instead.
+	_, err = client.ReplicateRepository(ctx, &gitalypb.ReplicateRepositoryRequest{
+		Repository: targetRepo,
+		Source:     sourceRepo,
+	})
+	require.NoError(t, err)
+
+	targetRepoPath := filepath.Join(cfg.Storages[1].Path, gittest.GetReplicaPath(t, ctx, cfg, targetRepo))
+	gittest.Exec(t, cfg, "-C", targetRepoPath, "fsck")
+
+	require.Equal(t, git.ObjectHashSHA256.Format, text.ChompBytes(
+		gittest.Exec(t, cfg, "-C", targetRepoPath, "rev-parse", "--show-object-format"),
+	))
+	require.Equal(t,
+		text.ChompBytes(gittest.Exec(t, cfg, "-C", sourceRepoPath, "for-each-ref")),
+		text.ChompBytes(gittest.Exec(t, cfg, "-C", targetRepoPath, "for-each-ref")),
+	)
+	require.FileExists(t, filepath.Join(targetRepoPath, oidmap.Filename))
+}
+
 func TestReplicateRepository_transactional(t *testing.T) {
 	t.Parallel()
 	testhelper.NewFeatureSets(featureflag.ReplicateRepositoryHooks).
@@ -731,3 +1113,109 @@ func testReplicateRepositoryHooks(t *testing.T, ctx context.Context) {
 		require.NoDirExists(t, targetHooksPath)
 	}
 }
+
+type pushRulesRepositoryServer struct {
+	gitalypb.UnimplementedRepositoryServiceServer
+	rules *gitalypb.PushRules
+}
+
+func (s *pushRulesRepositoryServer) GetPushRules(context.Context, *gitalypb.GetPushRulesRequest) (*gitalypb.GetPushRulesResponse, error) {
+	if s.rules == nil {
+		return nil, status.Error(codes.Unimplemented, "unknown method GetPushRules")
+	}
+
+	return &gitalypb.GetPushRulesResponse{PushRules: s.rules}, nil
+}
+
+func TestReplicateRepository_syncPushRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	stalePushRules := &gitalypb.PushRules{DenyDeleteTag: true}
+
+	for _, tc := range []struct {
+		desc              string
+		sourceRules       *gitalypb.PushRules
+		expectedPushRules *gitalypb.PushRules
+		expectedVotes     int
+	}{
+		{
+			desc:              "push rules are replicated",
+			sourceRules:       &gitalypb.PushRules{CommitMessageRegex: "^fix"},
+			expectedPushRules: &gitalypb.PushRules{CommitMessageRegex: "^fix"},
+			expectedVotes:     2,
+		},
+		{
+			desc:              "empty push rules remove the file",
+			sourceRules:       &gitalypb.PushRules{},
+			expectedPushRules: nil,
+			expectedVotes:     2,
+		},
+		{
+			desc:              "source without push rules support",
+			sourceRules:       nil,
+			expectedPushRules: stalePushRules,
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			listener, err := net.Listen("tcp", "localhost:0")
+			require.NoError(t, err)
+
+			srv := grpc.NewServer()
+			gitalypb.RegisterRepositoryServiceServer(srv, &pushRulesRepositoryServer{rules: tc.sourceRules})
+			go testhelper.MustServe(t, srv, listener)
+			t.Cleanup(srv.Stop)
+
+			ctx, err := storage.InjectGitalyServers(ctx, "source", "tcp://"+listener.Addr().String(), "")
+			require.NoError(t, err)
+			ctx = metadata.OutgoingToIncoming(ctx)
+			ctx, err = txinfo.InjectTransaction(ctx, 1, "node", true)
+			require.NoError(t, err)
+			ctx = peer.NewContext(ctx, &peer.Peer{
+				AuthInfo: backchannel.WithID(nil, 1234),
+			})
+
+			repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+				SkipCreationViaService: true,
+			})
+
+			data, err := pushrules.Marshal(stalePushRules)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(pushrules.Path(repoPath), data, perm.SharedFile))
+
+			pool := client.NewPool()
+			defer testhelper.MustClose(t, pool)
+
+			txManager := transaction.NewTrackingManager()
+			srvr := &server{
+				locator:   config.NewLocator(cfg),
+				txManager: txManager,
+				conns:     pool,
+			}
+
+			require.NoError(t, srvr.syncPushRules(ctx, &gitalypb.ReplicateRepositoryRequest{
+				Repository: repo,
+				Source: &gitalypb.Repository{
+					StorageName:  "source",
+					RelativePath: repo.GetRelativePath(),
+				},
+			}))
+			require.Len(t, txManager.Votes(), tc.expectedVotes)
+
+			if tc.expectedPushRules == nil {
+				require.NoFileExists(t, pushrules.Path(repoPath))
+				return
+			}
+
+			pushRules, err := pushrules.Read(repoPath)
+			require.NoError(t, err)
+			testhelper.ProtoEqual(t, tc.expectedPushRules, pushRules)
+		})
+	}
+}
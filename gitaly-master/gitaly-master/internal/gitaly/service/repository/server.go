@@ -11,7 +11,9 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/quarantine"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/repository"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git2go"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/refbarrier"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/rubyserver"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
@@ -32,6 +34,8 @@ type server struct {
 	catfileCache        catfile.Cache
 	git2goExecutor      *git2go.Executor
 	housekeepingManager housekeeping.Manager
+	refBarrier          *refbarrier.Barrier
+	partitionManager    *gitaly.PartitionManager
 
 	licenseCache *unarycache.Cache[git.ObjectID, *gitalypb.FindLicenseResponse]
 }
@@ -47,8 +51,9 @@ func NewServer(
 	connsPool *client.Pool,
 	git2goExecutor *git2go.Executor,
 	housekeepingManager housekeeping.Manager,
+	serverOpts ...ServerOpt,
 ) gitalypb.RepositoryServiceServer {
-	return &server{
+	s := &server{
 		ruby:                rs,
 		locator:             locator,
 		txManager:           txManager,
@@ -62,6 +67,32 @@ func NewServer(
 
 		licenseCache: newLicenseCache(),
 	}
+
+	for _, serverOpt := range serverOpts {
+		serverOpt(s)
+	}
+
+	return s
+}
+
+// ServerOpt is a self referential option for server
+type ServerOpt func(s *server)
+
+// WithRefBarrier sets the barrier which is used to block reference updates while capturing
+// storage snapshots. References are captured without blocking concurrent updates if the barrier
+// is nil.
+func WithRefBarrier(barrier *refbarrier.Barrier) ServerOpt {
+	return func(s *server) {
+		s.refBarrier = barrier
+	}
+}
+
+// WithPartitionManager sets the PartitionManager which manages the write-ahead logs of the
+// repositories. The write-ahead log is considered disabled if the PartitionManager is nil.
+func WithPartitionManager(partitionManager *gitaly.PartitionManager) ServerOpt {
+	return func(s *server) {
+		s.partitionManager = partitionManager
+	}
 }
 
 func (s *server) localrepo(repo repository.GitRepo) *localrepo.Repo {
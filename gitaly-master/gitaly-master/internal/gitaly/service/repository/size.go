@@ -1,20 +1,17 @@
 package repository
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io"
-	"strconv"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
-	"gitlab.com/gitlab-org/gitaly/v15/internal/command"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/catfile"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gitpipe"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/housekeeping"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/objectpool"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/repoutil"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
@@ -180,35 +177,14 @@ func (s *server) GetObjectDirectorySize(ctx context.Context, in *gitalypb.GetObj
 	return &gitalypb.GetObjectDirectorySizeResponse{Size: getPathSize(ctx, path)}, nil
 }
 
+// getPathSize returns the on-disk size of the given path in kibibytes. Errors are logged and
+// result in a size of zero.
 func getPathSize(ctx context.Context, path string) int64 {
-	cmd, err := command.New(ctx, []string{"du", "-sk", path})
+	size, err := repoutil.DiskUsage(ctx, path)
 	if err != nil {
-		ctxlogrus.Extract(ctx).WithError(err).Warn("ignoring du command error")
+		ctxlogrus.Extract(ctx).WithError(err).Warn("ignoring du error")
 		return 0
 	}
 
-	sizeLine, err := io.ReadAll(cmd)
-	if err != nil {
-		ctxlogrus.Extract(ctx).WithError(err).Warn("ignoring command read error")
-		return 0
-	}
-
-	if err := cmd.Wait(); err != nil {
-		ctxlogrus.Extract(ctx).WithError(err).Warn("ignoring du wait error")
-		return 0
-	}
-
-	sizeParts := bytes.Split(sizeLine, []byte("\t"))
-	if len(sizeParts) != 2 {
-		ctxlogrus.Extract(ctx).Warn(fmt.Sprintf("ignoring du malformed output: %q", sizeLine))
-		return 0
-	}
-
-	size, err := strconv.ParseInt(string(sizeParts[0]), 10, 0)
-	if err != nil {
-		ctxlogrus.Extract(ctx).WithError(err).Warn("ignoring parsing size error")
-		return 0
-	}
-
-	return size
+	return size / 1024
 }
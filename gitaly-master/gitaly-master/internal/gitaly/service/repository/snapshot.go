@@ -9,6 +9,7 @@ import (
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/oidmap"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/archive"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
@@ -63,6 +64,8 @@ func (s *server) GetSnapshot(in *gitalypb.GetSnapshotRequest, stream gitalypb.Re
 	_ = builder.FileIfExist("packed-refs")
 	_ = builder.RecursiveDirIfExist("refs")
 	_ = builder.RecursiveDirIfExist("branches")
+	_ = builder.RecursiveDirIfExist("reftable")
+	_ = builder.FileIfExist(oidmap.Filename)
 
 	// The packfiles + any loose objects.
 	_ = builder.RecursiveDirIfExist("objects", objectFiles...)
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/command"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/oidmap"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/repository"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/archive"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"gitlab.com/gitlab-org/gitaly/v15/streamio"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *server) GetStorageSnapshot(in *gitalypb.GetStorageSnapshotRequest, stream gitalypb.RepositoryService_GetStorageSnapshotServer) error {
+	ctx := stream.Context()
+
+	repos, err := validateGetStorageSnapshotRequest(in)
+	if err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	repoPaths := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		repoPath, err := s.locator.GetRepoPath(repo)
+		if err != nil {
+			return err
+		}
+
+		// References are written into the packed-refs file of the archive, which cannot
+		// represent repositories using the reftable backend.
+		backend, err := s.localrepo(repo).ReferenceBackend(ctx)
+		if err != nil {
+			return structerr.NewInternal("detecting reference backend: %w", err)
+		}
+		if backend == git.ReferenceBackendReftable {
+			return structerr.NewFailedPrecondition("repository %q uses the reftable backend", repo.GetRelativePath()).
+				WithMetadata("relative_path", repo.GetRelativePath())
+		}
+
+		repoPaths = append(repoPaths, repoPath)
+	}
+
+	// Objects are copied only after references have been thawed again. Housekeeping could
+	// thus remove objects referenced by the manifest while copying them, so we prevent it from
+	// doing so until all archives have been written.
+	for _, repo := range repos {
+		release, err := s.housekeepingManager.PreserveObjects(ctx, s.localrepo(repo))
+		if err != nil {
+			return structerr.NewAborted("preserving objects of %q: %w", repo.GetRelativePath(), err).
+				WithMetadata("relative_path", repo.GetRelativePath())
+		}
+		defer release()
+	}
+
+	manifest, err := s.captureStorageSnapshotManifest(ctx, repos)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.Send(&gitalypb.GetStorageSnapshotResponse{Manifest: manifest}); err != nil {
+		return structerr.NewAborted("sending manifest: %w", err)
+	}
+
+	for i, repo := range repos {
+		relativePath := repo.GetRelativePath()
+
+		writer := streamio.NewWriter(func(p []byte) error {
+			return stream.Send(&gitalypb.GetStorageSnapshotResponse{
+				RelativePath: relativePath,
+				Data:         p,
+			})
+		})
+
+		if err := s.writeStorageSnapshotArchive(ctx, repo, repoPaths[i], manifest.Repositories[i], writer); err != nil {
+			return structerr.NewInternal("building snapshot of %q: %w", relativePath, err).WithMetadata("relative_path", relativePath)
+		}
+	}
+
+	return nil
+}
+
+func validateGetStorageSnapshotRequest(in *gitalypb.GetStorageSnapshotRequest) ([]*gitalypb.Repository, error) {
+	if len(in.GetRelativePaths()) == 0 {
+		return nil, errors.New("empty RelativePaths")
+	}
+
+	seen := make(map[string]struct{}, len(in.GetRelativePaths()))
+	repos := make([]*gitalypb.Repository, 0, len(in.GetRelativePaths()))
+	for _, relativePath := range in.GetRelativePaths() {
+		repo := &gitalypb.Repository{
+			StorageName:  in.GetStorageName(),
+			RelativePath: relativePath,
+		}
+
+		if err := service.ValidateRepository(repo); err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[relativePath]; ok {
+			return nil, fmt.Errorf("duplicate relative path %q", relativePath)
+		}
+		seen[relativePath] = struct{}{}
+
+		repos = append(repos, repo)
+	}
+
+	return repos, nil
+}
+
+// captureStorageSnapshotManifest captures the references of all repositories while they are
+// frozen so that the captured state corresponds to a single logical point in time across all of
+// the repositories.
+func (s *server) captureStorageSnapshotManifest(ctx context.Context, repos []*gitalypb.Repository) (*gitalypb.StorageSnapshotManifest, error) {
+	gitRepos := make([]repository.GitRepo, 0, len(repos))
+	for _, repo := range repos {
+		gitRepos = append(gitRepos, repo)
+	}
+
+	thaw, err := s.refBarrier.Freeze(ctx, gitRepos...)
+	if err != nil {
+		return nil, structerr.NewAborted("freezing references: %w", err)
+	}
+	defer thaw()
+
+	manifest := &gitalypb.StorageSnapshotManifest{
+		Repositories: make([]*gitalypb.StorageSnapshotManifest_Repository, 0, len(repos)),
+	}
+
+	for _, repo := range repos {
+		snapshot, err := captureRepositoryReferences(ctx, s.localrepo(repo))
+		if err != nil {
+			return nil, structerr.NewInternal("capturing references of %q: %w", repo.GetRelativePath(), err).
+				WithMetadata("relative_path", repo.GetRelativePath())
+		}
+		snapshot.RelativePath = repo.GetRelativePath()
+
+		manifest.Repositories = append(manifest.Repositories, snapshot)
+	}
+
+	manifest.CapturedAt = timestamppb.New(time.Now())
+
+	return manifest, nil
+}
+
+// captureRepositoryReferences captures HEAD and all references of the repository. Symbolic
+// references are captured with the name of the reference they point to.
+func captureRepositoryReferences(ctx context.Context, repo *localrepo.Repo) (*gitalypb.StorageSnapshotManifest_Repository, error) {
+	objectHash, err := repo.ObjectHash(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("detecting object hash: %w", err)
+	}
+
+	head, err := captureHead(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("capturing HEAD: %w", err)
+	}
+
+	var stdout bytes.Buffer
+	if err := repo.ExecAndWait(ctx, git.Command{
+		Name:  "for-each-ref",
+		Flags: []git.Option{git.Flag{Name: "--format=%(refname)%00%(objectname)%00%(symref)"}},
+	}, git.WithStdout(&stdout)); err != nil {
+		return nil, fmt.Errorf("listing references: %w", err)
+	}
+
+	var references []*gitalypb.StorageSnapshotManifest_Reference
+	scanner := bufio.NewScanner(&stdout)
+	for scanner.Scan() {
+		fields := bytes.SplitN(scanner.Bytes(), []byte{0}, 3)
+		if len(fields) != 3 {
+			return nil, errors.New("unexpected reference format")
+		}
+
+		reference := &gitalypb.StorageSnapshotManifest_Reference{
+			Name:   append([]byte(nil), fields[0]...),
+			Target: append([]byte(nil), fields[1]...),
+		}
+		if len(fields[2]) > 0 {
+			reference.Target = append([]byte(nil), fields[2]...)
+			reference.Symbolic = true
+		}
+
+		references = append(references, reference)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning references: %w", err)
+	}
+
+	return &gitalypb.StorageSnapshotManifest_Repository{
+		ObjectFormat: objectHash.ProtoFormat,
+		Head:         head,
+		References:   references,
+	}, nil
+}
+
+// captureHead captures HEAD, which is either a symbolic reference or a detached object ID.
+func captureHead(ctx context.Context, repo *localrepo.Repo) (*gitalypb.StorageSnapshotManifest_Reference, error) {
+	head := &gitalypb.StorageSnapshotManifest_Reference{Name: []byte("HEAD")}
+
+	var stdout bytes.Buffer
+	err := repo.ExecAndWait(ctx, git.Command{
+		Name:  "symbolic-ref",
+		Flags: []git.Option{git.Flag{Name: "--quiet"}},
+		Args:  []string{"HEAD"},
+	}, git.WithStdout(&stdout), git.WithDisabledHooks())
+	if err == nil {
+		head.Target = bytes.TrimSpace(stdout.Bytes())
+		head.Symbolic = true
+		return head, nil
+	}
+
+	// git-symbolic-ref(1) exits with status 1 if HEAD is detached.
+	if status, ok := command.ExitStatus(err); !ok || status != 1 {
+		return nil, err
+	}
+
+	oid, err := repo.ResolveRevision(ctx, "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("resolving detached HEAD: %w", err)
+	}
+	head.Target = []byte(oid.String())
+
+	return head, nil
+}
+
+// writeStorageSnapshotArchive writes the repository's tarball in the same format as GetSnapshot.
+// References are not copied from disk but written as they have been captured in the manifest:
+// direct references are written into the packed-refs file while symbolic references are written as
+// loose references. Objects are copied after the repositories have been thawed again, which is
+// fine given that concurrent writes only ever add new objects and that the caller prevents
+// housekeeping from removing any objects.
+func (s *server) writeStorageSnapshotArchive(
+	ctx context.Context,
+	repo *gitalypb.Repository,
+	repoPath string,
+	snapshot *gitalypb.StorageSnapshotManifest_Repository,
+	writer io.Writer,
+) error {
+	builder := archive.NewTarBuilder(repoPath, writer)
+
+	head := string(snapshot.GetHead().GetTarget())
+	if snapshot.GetHead().GetSymbolic() {
+		head = "ref: " + head
+	}
+	_ = builder.VirtualFileWithBytes("HEAD", []byte(head+"\n"))
+
+	packedRefs := []byte("# pack-refs with: sorted \n")
+	for _, reference := range snapshot.GetReferences() {
+		if reference.GetSymbolic() {
+			_ = builder.VirtualFileWithBytes(string(reference.GetName()), []byte("ref: "+string(reference.GetTarget())+"\n"))
+			continue
+		}
+
+		packedRefs = append(packedRefs, reference.GetTarget()...)
+		packedRefs = append(packedRefs, ' ')
+		packedRefs = append(packedRefs, reference.GetName()...)
+		packedRefs = append(packedRefs, '\n')
+	}
+	_ = builder.VirtualFileWithBytes("packed-refs", packedRefs)
+	_ = builder.FileIfExist(oidmap.Filename)
+
+	_ = builder.RecursiveDirIfExist("objects", objectFiles...)
+	_ = builder.FileIfExist("shallow")
+
+	if err := s.addAlternateFiles(ctx, repo, builder); err != nil {
+		return fmt.Errorf("add alternates: %w", err)
+	}
+
+	return builder.Close()
+}
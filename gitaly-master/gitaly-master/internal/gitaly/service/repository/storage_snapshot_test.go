@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func getStorageSnapshot(tb testing.TB, client gitalypb.RepositoryServiceClient, req *gitalypb.GetStorageSnapshotRequest) (*gitalypb.StorageSnapshotManifest, map[string][]byte, error) {
+	ctx := testhelper.Context(tb)
+
+	stream, err := client.GetStorageSnapshot(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var manifest *gitalypb.StorageSnapshotManifest
+	archives := map[string][]byte{}
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return manifest, archives, nil
+			}
+
+			return nil, nil, err
+		}
+
+		if response.GetManifest() != nil {
+			require.Nil(tb, manifest, "manifest sent multiple times")
+			manifest = response.GetManifest()
+			continue
+		}
+
+		archives[response.GetRelativePath()] = append(archives[response.GetRelativePath()], response.GetData()...)
+	}
+}
+
+func TestGetStorageSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryServiceWithoutRepo(t)
+
+	projectRepo, projectPath := gittest.CreateRepository(t, ctx, cfg)
+	mainCommit := gittest.WriteCommit(t, cfg, projectPath, gittest.WithBranch("main"))
+	featureCommit := gittest.WriteCommit(t, cfg, projectPath, gittest.WithParents(mainCommit), gittest.WithBranch("feature"))
+	tag := gittest.WriteTag(t, cfg, projectPath, "v1.0.0", mainCommit.Revision(), gittest.WriteTagConfig{Message: "annotated"})
+	gittest.Exec(t, cfg, "-C", projectPath, "symbolic-ref", "refs/remotes/origin/HEAD", "refs/heads/main")
+
+	wikiRepo, wikiPath := gittest.CreateRepository(t, ctx, cfg)
+	wikiCommit := gittest.WriteCommit(t, cfg, wikiPath, gittest.WithBranch("main"))
+	gittest.Exec(t, cfg, "-C", wikiPath, "update-ref", "--no-deref", "HEAD", wikiCommit.String())
+
+	manifest, archives, err := getStorageSnapshot(t, client, &gitalypb.GetStorageSnapshotRequest{
+		StorageName:   projectRepo.GetStorageName(),
+		RelativePaths: []string{projectRepo.GetRelativePath(), wikiRepo.GetRelativePath()},
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, manifest.GetCapturedAt())
+	manifest.CapturedAt = nil
+
+	testhelper.ProtoEqual(t, &gitalypb.StorageSnapshotManifest{
+		Repositories: []*gitalypb.StorageSnapshotManifest_Repository{
+			{
+				RelativePath: projectRepo.GetRelativePath(),
+				ObjectFormat: gittest.DefaultObjectHash.ProtoFormat,
+				Head:         &gitalypb.StorageSnapshotManifest_Reference{Name: []byte("HEAD"), Target: []byte("refs/heads/main"), Symbolic: true},
+				References: []*gitalypb.StorageSnapshotManifest_Reference{
+					{Name: []byte("refs/heads/feature"), Target: []byte(featureCommit)},
+					{Name: []byte("refs/heads/main"), Target: []byte(mainCommit)},
+					{Name: []byte("refs/remotes/origin/HEAD"), Target: []byte("refs/heads/main"), Symbolic: true},
+					{Name: []byte("refs/tags/v1.0.0"), Target: []byte(tag)},
+				},
+			},
+			{
+				RelativePath: wikiRepo.GetRelativePath(),
+				ObjectFormat: gittest.DefaultObjectHash.ProtoFormat,
+				Head:         &gitalypb.StorageSnapshotManifest_Reference{Name: []byte("HEAD"), Target: []byte(wikiCommit)},
+				References: []*gitalypb.StorageSnapshotManifest_Reference{
+					{Name: []byte("refs/heads/main"), Target: []byte(wikiCommit)},
+				},
+			},
+		},
+	}, manifest)
+
+	require.Len(t, archives, 2)
+
+	// References which are updated after the snapshot has been captured must not be part of the
+	// restored repositories.
+	gittest.WriteCommit(t, cfg, projectPath, gittest.WithParents(featureCommit), gittest.WithBranch("feature"))
+	gittest.Exec(t, cfg, "-C", projectPath, "update-ref", "-d", "refs/heads/main")
+
+	for _, tc := range []struct {
+		relativePath       string
+		expectedHead       string
+		expectedReferences []string
+	}{
+		{
+			relativePath: projectRepo.GetRelativePath(),
+			expectedHead: "refs/heads/main",
+			expectedReferences: []string{
+				fmt.Sprintf("%s refs/heads/feature", featureCommit),
+				fmt.Sprintf("%s refs/heads/main", mainCommit),
+				fmt.Sprintf("%s refs/remotes/origin/HEAD", mainCommit),
+				fmt.Sprintf("%s refs/tags/v1.0.0", tag),
+			},
+		},
+		{
+			relativePath: wikiRepo.GetRelativePath(),
+			expectedHead: wikiCommit.String(),
+			expectedReferences: []string{
+				fmt.Sprintf("%s refs/heads/main", wikiCommit),
+			},
+		},
+	} {
+		_, restoredPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+			SkipCreationViaService: true,
+		})
+		testhelper.MustRunCommand(t, bytes.NewReader(archives[tc.relativePath]), "tar", "-C", restoredPath, "-xf", "-")
+
+		head := strings.TrimSpace(string(gittest.Exec(t, cfg, "-C", restoredPath, "rev-parse", "--symbolic-full-name", "HEAD")))
+		if head == "HEAD" {
+			head = strings.TrimSpace(string(gittest.Exec(t, cfg, "-C", restoredPath, "rev-parse", "HEAD")))
+		}
+		require.Equal(t, tc.expectedHead, head)
+
+		references := strings.TrimSpace(string(gittest.Exec(t, cfg, "-C", restoredPath, "for-each-ref", "--format=%(objectname) %(refname)")))
+		require.Equal(t, tc.expectedReferences, strings.Split(references, "\n"))
+
+		gittest.Exec(t, cfg, "-C", restoredPath, "fsck", "--no-dangling")
+	}
+}
+
+func TestGetStorageSnapshot_validate(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryServiceWithoutRepo(t)
+	repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+	// We cannot rely on the Git version used in tests to support the reftable backend, so we
+	// fake the on-disk layout of such a repository instead.
+	reftableRepo, reftableRepoPath := gittest.CreateRepository(t, ctx, cfg)
+	require.NoError(t, os.Mkdir(filepath.Join(reftableRepoPath, "reftable"), perm.SharedDir))
+	require.NoError(t, os.WriteFile(filepath.Join(reftableRepoPath, "reftable", "tables.list"), nil, perm.SharedFile))
+
+	for _, tc := range []struct {
+		desc        string
+		req         *gitalypb.GetStorageSnapshotRequest
+		expectedErr error
+	}{
+		{
+			desc:        "missing relative paths",
+			req:         &gitalypb.GetStorageSnapshotRequest{StorageName: repo.GetStorageName()},
+			expectedErr: structerr.NewInvalidArgument("empty RelativePaths"),
+		},
+		{
+			desc: "missing storage name",
+			req: &gitalypb.GetStorageSnapshotRequest{
+				RelativePaths: []string{repo.GetRelativePath()},
+			},
+			expectedErr: structerr.NewInvalidArgument("empty StorageName"),
+		},
+		{
+			desc: "empty relative path",
+			req: &gitalypb.GetStorageSnapshotRequest{
+				StorageName:   repo.GetStorageName(),
+				RelativePaths: []string{repo.GetRelativePath(), ""},
+			},
+			expectedErr: structerr.NewInvalidArgument("empty RelativePath"),
+		},
+		{
+			desc: "duplicate relative path",
+			req: &gitalypb.GetStorageSnapshotRequest{
+				StorageName:   repo.GetStorageName(),
+				RelativePaths: []string{repo.GetRelativePath(), repo.GetRelativePath()},
+			},
+			expectedErr: structerr.NewInvalidArgument("duplicate relative path %q", repo.GetRelativePath()),
+		},
+		{
+			desc: "missing repository",
+			req: &gitalypb.GetStorageSnapshotRequest{
+				StorageName:   repo.GetStorageName(),
+				RelativePaths: []string{repo.GetRelativePath(), "does-not-exist.git"},
+			},
+			expectedErr: structerr.NewNotFound("GetRepoPath: not a git repository: %q", cfg.Storages[0].Path+"/does-not-exist.git"),
+		},
+		{
+			desc: "reftable repository",
+			req: &gitalypb.GetStorageSnapshotRequest{
+				StorageName:   repo.GetStorageName(),
+				RelativePaths: []string{repo.GetRelativePath(), reftableRepo.GetRelativePath()},
+			},
+			expectedErr: structerr.NewFailedPrecondition("repository %q uses the reftable backend", reftableRepo.GetRelativePath()).
+				WithInterceptedMetadata("relative_path", reftableRepo.GetRelativePath()),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := getStorageSnapshot(t, client, tc.req)
+			testhelper.RequireGrpcError(t, tc.expectedErr, err)
+		})
+	}
+}
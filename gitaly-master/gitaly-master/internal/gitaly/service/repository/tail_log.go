@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"google.golang.org/grpc/codes"
+)
+
+// TailLog streams the entries of the repository's write-ahead log.
+func (s *server) TailLog(request *gitalypb.TailLogRequest, stream gitalypb.RepositoryService_TailLogServer) error {
+	if err := service.ValidateRepository(request.GetRepository()); err != nil {
+		return structerr.NewInvalidArgument("%w", err)
+	}
+
+	if s.partitionManager == nil {
+		return structerr.NewFailedPrecondition("write-ahead log is not enabled")
+	}
+
+	if _, err := s.locator.GetRepoPath(request.GetRepository()); err != nil {
+		return err
+	}
+
+	var sendErr error
+	if err := s.partitionManager.TailLog(
+		stream.Context(),
+		request.GetRepository(),
+		gitaly.LogIndex(request.GetStartIndex()),
+		func(logIndex gitaly.LogIndex, logEntry *gitalypb.LogEntry) error {
+			sendErr = stream.Send(&gitalypb.TailLogResponse{
+				LogIndex: uint64(logIndex),
+				LogEntry: logEntry,
+			})
+			return sendErr
+		},
+	); err != nil {
+		var prunedErr gitaly.LogEntryPrunedError
+		switch {
+		case sendErr != nil:
+			return structerr.NewUnavailable("sending log entry: %w", sendErr)
+		case errors.As(err, &prunedErr):
+			return structerr.New("%w", err).WithGRPCCode(codes.OutOfRange).WithMetadata("log_index", uint64(prunedErr.LogIndex))
+		case errors.Is(err, context.Canceled):
+			return structerr.NewCanceled("%w", err)
+		case errors.Is(err, context.DeadlineExceeded):
+			return structerr.NewDeadlineExceeded("%w", err)
+		case errors.Is(err, gitaly.ErrTransactionProcessingStopped), errors.Is(err, gitaly.ErrPartitionManagerStopped):
+			return structerr.NewUnavailable("%w", err)
+		default:
+			return structerr.NewInternal("tailing log: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/errors"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testserver"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"google.golang.org/grpc/codes"
+)
+
+func TestTailLog_disabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg, client := setupRepositoryServiceWithoutRepo(t)
+
+	t.Run("unset repository", func(t *testing.T) {
+		stream, err := client.TailLog(ctx, &gitalypb.TailLogRequest{})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		testhelper.RequireGrpcError(t, testhelper.GitalyOrPraefect(
+			structerr.NewInvalidArgument("%w", errors.ErrEmptyRepository),
+			structerr.NewInvalidArgument("repo scoped: %w", errors.ErrEmptyRepository),
+		), err)
+	})
+
+	t.Run("write-ahead log not enabled", func(t *testing.T) {
+		repo, _ := gittest.CreateRepository(t, ctx, cfg)
+
+		stream, err := client.TailLog(ctx, &gitalypb.TailLogRequest{Repository: repo})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		testhelper.RequireGrpcError(t, structerr.NewFailedPrecondition("write-ahead log is not enabled"), err)
+	})
+}
+
+func TestTailLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	catfileCache := catfile.NewCache(cfg)
+	defer catfileCache.Stop()
+
+	partitionManager, err := gitaly.NewPartitionManager(
+		cfg.Storages,
+		config.NewLocator(cfg),
+		gittest.NewCommandFactory(t, cfg),
+		catfileCache,
+		testhelper.NewDiscardingLogEntry(t),
+		gitaly.WithLogRetention(1),
+	)
+	require.NoError(t, err)
+	defer partitionManager.Stop()
+
+	client, serverSocketPath := runRepositoryService(t, cfg, nil, testserver.WithPartitionManager(partitionManager))
+	cfg.SocketPath = serverSocketPath
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	rootCommitOID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents())
+	secondCommitOID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(rootCommitOID))
+
+	// The write-ahead log is managed by the Gitaly node the repository is stored on, so the
+	// transactions are proposed against the repository as it is known to that node.
+	gitalyRepo := gittest.RewrittenRepository(t, ctx, cfg, repo)
+
+	for _, transaction := range []gitaly.Transaction{
+		{ReferenceUpdates: gitaly.ReferenceUpdates{
+			"refs/heads/main": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: rootCommitOID},
+		}},
+		{ReferenceUpdates: gitaly.ReferenceUpdates{
+			"refs/heads/main": {OldOID: rootCommitOID, NewOID: secondCommitOID},
+		}},
+	} {
+		require.NoError(t, partitionManager.Propose(ctx, gitalyRepo, transaction))
+	}
+
+	t.Run("pruned log entry", func(t *testing.T) {
+		stream, err := client.TailLog(ctx, &gitalypb.TailLogRequest{Repository: repo, StartIndex: 1})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		testhelper.RequireGrpcCode(t, err, codes.OutOfRange)
+		require.Contains(t, err.Error(), "log entry 1 has been pruned")
+	})
+
+	t.Run("retained log entry", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := client.TailLog(ctx, &gitalypb.TailLogRequest{Repository: repo, StartIndex: 2})
+		require.NoError(t, err)
+
+		response, err := stream.Recv()
+		require.NoError(t, err)
+		testhelper.ProtoEqual(t, &gitalypb.TailLogResponse{
+			LogIndex: 2,
+			LogEntry: &gitalypb.LogEntry{
+				ReferenceUpdates: []*gitalypb.LogEntry_ReferenceUpdate{
+					{ReferenceName: []byte("refs/heads/main"), NewOid: []byte(secondCommitOID)},
+				},
+			},
+		}, response)
+
+		// The stream waits for further log entries to be appended until it is canceled.
+		cancel()
+		_, err = stream.Recv()
+		testhelper.RequireGrpcCode(t, err, codes.Canceled)
+	})
+
+	require.Equal(t, secondCommitOID, gittest.ResolveRevision(t, cfg, repoPath, "refs/heads/main"))
+}
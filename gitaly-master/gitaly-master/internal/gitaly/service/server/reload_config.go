@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/reload"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+// ReloadConfig reloads the configuration file and applies all changes of keys that can be
+// reloaded at runtime.
+func (s *server) ReloadConfig(context.Context, *gitalypb.ReloadConfigRequest) (*gitalypb.ReloadConfigResponse, error) {
+	if s.configReloader == nil {
+		return nil, structerr.NewFailedPrecondition("reloading the configuration is not supported")
+	}
+
+	result, err := s.configReloader.Reload()
+	if err != nil {
+		if errors.Is(err, reload.ErrInvalidConfig) {
+			return nil, structerr.NewFailedPrecondition("%w", err)
+		}
+
+		return nil, structerr.NewInternal("%w", err).
+			WithMetadata("changed_keys", result.ChangedKeys).
+			WithMetadata("restart_required_keys", result.RestartRequiredKeys)
+	}
+
+	return &gitalypb.ReloadConfigResponse{
+		ChangedKeys:         result.ChangedKeys,
+		RestartRequiredKeys: result.RestartRequiredKeys,
+	}, nil
+}
@@ -0,0 +1,79 @@
+//go:build !gitaly_test_sha256
+
+package server
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/reload"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testserver"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"google.golang.org/grpc"
+)
+
+func TestReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	updated := cfg
+	updated.ListenAddr = "localhost:1234"
+	updated.Concurrency = []config.Concurrency{
+		{RPC: "/gitaly.SmartHTTPService/PostUploadPackWithSidechannel", MaxPerRepo: 10},
+	}
+
+	var loadErr error
+	reloader := reload.NewReloader(testhelper.NewDiscardingLogger(t), cfg, func() (config.Cfg, error) {
+		return updated, loadErr
+	})
+
+	var applied []config.Concurrency
+	reloader.Register(reload.KeyConcurrency, func(cfg config.Cfg) error {
+		applied = cfg.Concurrency
+		return nil
+	})
+
+	addr := testserver.RunGitalyServer(t, cfg, nil, func(srv *grpc.Server, deps *service.Dependencies) {
+		gitalypb.RegisterServerServiceServer(srv, NewServer(deps.GetGitCmdFactory(), deps.GetCfg().Storages, reloader))
+	}, testserver.WithDisablePraefect())
+	client := newServerClient(t, addr)
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		loadErr = errors.New("bin_dir: is not set")
+		defer func() { loadErr = nil }()
+
+		_, err := client.ReloadConfig(ctx, &gitalypb.ReloadConfigRequest{})
+		testhelper.RequireGrpcError(t, structerr.NewFailedPrecondition("invalid config: bin_dir: is not set"), err)
+		require.Nil(t, applied)
+	})
+
+	t.Run("successful reload", func(t *testing.T) {
+		response, err := client.ReloadConfig(ctx, &gitalypb.ReloadConfigRequest{})
+		require.NoError(t, err)
+		testhelper.ProtoEqual(t, &gitalypb.ReloadConfigResponse{
+			ChangedKeys:         []string{reload.KeyConcurrency},
+			RestartRequiredKeys: []string{"listen_addr"},
+		}, response)
+		require.Equal(t, updated.Concurrency, applied)
+	})
+}
+
+func TestReloadConfig_unsupported(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	client := newServerClient(t, runServer(t, cfg, testserver.WithDisablePraefect()))
+
+	_, err := client.ReloadConfig(ctx, &gitalypb.ReloadConfigRequest{})
+	testhelper.RequireGrpcError(t, structerr.NewFailedPrecondition("reloading the configuration is not supported"), err)
+}
@@ -3,16 +3,19 @@ package server
 import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/reload"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 )
 
 type server struct {
 	gitalypb.UnimplementedServerServiceServer
-	gitCmdFactory git.CommandFactory
-	storages      []config.Storage
+	gitCmdFactory  git.CommandFactory
+	storages       []config.Storage
+	configReloader *reload.Reloader
 }
 
-// NewServer creates a new instance of a grpc ServerServiceServer
-func NewServer(gitCmdFactory git.CommandFactory, storages []config.Storage) gitalypb.ServerServiceServer {
-	return &server{gitCmdFactory: gitCmdFactory, storages: storages}
+// NewServer creates a new instance of a grpc ServerServiceServer. The config reloader is optional
+// and reloading the configuration via RPC is not supported if it is unset.
+func NewServer(gitCmdFactory git.CommandFactory, storages []config.Storage, configReloader *reload.Reloader) gitalypb.ServerServiceServer {
+	return &server{gitCmdFactory: gitCmdFactory, storages: storages, configReloader: configReloader}
 }
@@ -100,12 +100,16 @@ func RegisterAll(srv *grpc.Server, deps *service.Dependencies) {
 		deps.GetConnsPool(),
 		deps.GetGit2goExecutor(),
 		deps.GetHousekeepingManager(),
+		repository.WithRefBarrier(deps.GetRefBarrier()),
+		repository.WithPartitionManager(deps.GetPartitionManager()),
 	))
 	gitalypb.RegisterSSHServiceServer(srv, ssh.NewServer(
 		deps.GetLocator(),
 		deps.GetGitCmdFactory(),
 		deps.GetTxManager(),
 		ssh.WithPackfileNegotiationMetrics(sshPackfileNegotiationMetrics),
+		ssh.WithBundleURIManager(deps.GetBundleURIManager()),
+		ssh.WithPartialCloneEnforcer(deps.GetPartialCloneEnforcer()),
 	))
 	gitalypb.RegisterSmartHTTPServiceServer(srv, smarthttp.NewServer(
 		deps.GetLocator(),
@@ -113,6 +117,8 @@ func RegisterAll(srv *grpc.Server, deps *service.Dependencies) {
 		deps.GetTxManager(),
 		deps.GetDiskCache(),
 		smarthttp.WithPackfileNegotiationMetrics(smarthttpPackfileNegotiationMetrics),
+		smarthttp.WithBundleURIManager(deps.GetBundleURIManager()),
+		smarthttp.WithPartialCloneEnforcer(deps.GetPartialCloneEnforcer()),
 	))
 	gitalypb.RegisterConflictsServiceServer(srv, conflicts.NewServer(
 		deps.GetHookManager(),
@@ -130,7 +136,7 @@ func RegisterAll(srv *grpc.Server, deps *service.Dependencies) {
 		deps.GetTxManager(),
 		deps.GetConnsPool(),
 	))
-	gitalypb.RegisterServerServiceServer(srv, server.NewServer(deps.GetGitCmdFactory(), deps.GetCfg().Storages))
+	gitalypb.RegisterServerServiceServer(srv, server.NewServer(deps.GetGitCmdFactory(), deps.GetCfg().Storages, deps.GetConfigReloader()))
 	gitalypb.RegisterObjectPoolServiceServer(srv, objectpool.NewServer(
 		deps.GetLocator(),
 		deps.GetGitCmdFactory(),
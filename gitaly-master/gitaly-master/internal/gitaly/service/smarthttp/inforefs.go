@@ -68,6 +68,11 @@ func (s *server) handleInfoRefs(ctx context.Context, service, repoPath string, r
 	if err != nil {
 		return err
 	}
+	if service == uploadPackSvc {
+		// The bundle-uri capability is only advertised via protocol v2, which bypasses the
+		// info-refs cache. Configuration passed by the client takes precedence.
+		config = append(s.bundleURIManager.UploadPackGitConfig(ctx, req.GetRepository()), config...)
+	}
 	cmdOpts = append(cmdOpts, git.WithConfig(config...))
 
 	cmd, err := s.gitCmdFactory.New(ctx, req.GetRepository(), git.Command{
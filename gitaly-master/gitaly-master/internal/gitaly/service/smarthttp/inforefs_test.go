@@ -10,18 +10,22 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/backchannel"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/cache"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/catfile"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/housekeeping"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/objectpool"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/bundleuri"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
@@ -247,6 +251,71 @@ func TestInfoRefsUploadPack_gitProtocol(t *testing.T) {
 	require.Contains(t, envData, fmt.Sprintf("GIT_PROTOCOL=%s\n", git.ProtocolV2))
 }
 
+func TestInfoRefsUploadPack_bundleURI(t *testing.T) {
+	t.Parallel()
+
+	cfg := testcfg.Build(t)
+	ctx := testhelper.Context(t)
+
+	realVersion, err := gittest.NewCommandFactory(t, cfg).GitVersion(ctx)
+	require.NoError(t, err)
+
+	// We pretend to be a Git version which supports advertising bundle URIs and record the
+	// configuration git-upload-pack(1) is spawned with so that we can verify the wiring even
+	// if the real Git version doesn't support the capability.
+	argsPath := filepath.Join(testhelper.TempDir(t), "args")
+	gitCmdFactory := gittest.NewInterceptingCommandFactory(t, ctx, cfg, func(execEnv git.ExecutionEnvironment) string {
+		return fmt.Sprintf(`#!/usr/bin/env bash
+		if test "$1" = "version"
+		then
+			echo "git version 2.40.0"
+			exit 0
+		fi
+		for arg
+		do
+			if test "$arg" = "upload-pack"
+			then
+				echo "$@" >>%q
+			fi
+		done
+		exec %q "$@"
+		`, argsPath, execEnv.BinaryPath)
+	}, gittest.WithInterceptedVersion())
+
+	catfileCache := catfile.NewCache(cfg)
+	t.Cleanup(catfileCache.Stop)
+
+	bundleURIManager := bundleuri.NewManager(config.BundleURI{
+		BaseURL:         "https://cdn.example.com",
+		Dir:             testhelper.TempDir(t),
+		Secret:          "secret",
+		RefreshInterval: duration.Duration(time.Hour),
+	}, config.NewLocator(cfg), gitCmdFactory, catfileCache)
+
+	server := startSmartHTTPServerWithOptions(t, cfg, []ServerOpt{WithBundleURIManager(bundleURIManager)}, []testserver.GitalyServerOpt{
+		testserver.WithGitCommandFactory(gitCmdFactory),
+	})
+	cfg.SocketPath = server.Address()
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+	require.NoError(t, bundleURIManager.Generate(ctx, repo))
+
+	response, err := makeInfoRefsUploadPackRequest(t, ctx, cfg.SocketPath, cfg.Auth.Token, &gitalypb.InfoRefsRequest{
+		Repository:  repo,
+		GitProtocol: git.ProtocolV2,
+	})
+	require.NoError(t, err)
+
+	args := string(testhelper.MustReadFile(t, argsPath))
+	require.Contains(t, args, "uploadpack.advertiseBundleURIs=true")
+	require.Contains(t, args, "bundle.gitaly.uri="+bundleURIManager.BundleURL(repo))
+
+	if realVersion.SupportsBundleURI() {
+		require.Contains(t, string(response), "bundle-uri")
+	}
+}
+
 func makeInfoRefsUploadPackRequest(t *testing.T, ctx context.Context, serverSocketPath, token string, rpcRequest *gitalypb.InfoRefsRequest) ([]byte, error) {
 	t.Helper()
 
@@ -4,6 +4,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/cache"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/bundleuri"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/partialclone"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/storage"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
@@ -14,6 +16,8 @@ type server struct {
 	locator                    storage.Locator
 	gitCmdFactory              git.CommandFactory
 	packfileNegotiationMetrics *prometheus.CounterVec
+	bundleURIManager           *bundleuri.Manager
+	partialCloneEnforcer       *partialclone.Enforcer
 	infoRefCache               infoRefCache
 	txManager                  transaction.Manager
 }
@@ -53,3 +57,19 @@ func WithPackfileNegotiationMetrics(c *prometheus.CounterVec) ServerOpt {
 		s.packfileNegotiationMetrics = c
 	}
 }
+
+// WithBundleURIManager sets the manager used to advertise repository bundles to clients via the
+// bundle-uri capability. Bundles are not advertised if the manager is nil.
+func WithBundleURIManager(manager *bundleuri.Manager) ServerOpt {
+	return func(s *server) {
+		s.bundleURIManager = manager
+	}
+}
+
+// WithPartialCloneEnforcer sets the enforcer of partial clone policies. Policies are not enforced
+// and no metrics on object filters are collected if the enforcer is nil.
+func WithPartialCloneEnforcer(enforcer *partialclone.Enforcer) ServerOpt {
+	return func(s *server) {
+		s.partialCloneEnforcer = enforcer
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/command"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/pktline"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/stats"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/sidechannel"
@@ -88,6 +89,10 @@ func (s *server) validateUploadPackRequest(ctx context.Context, req *gitalypb.Po
 	if err != nil {
 		return "", nil, err
 	}
+	// Configuration passed by the client takes precedence over the bundle-uri configuration, but
+	// must not override the partial clone policy.
+	config = append(s.bundleURIManager.UploadPackGitConfig(ctx, repository), config...)
+	config = s.partialCloneEnforcer.UploadPackGitConfig(repository, config)
 
 	return repoPath, config, nil
 }
@@ -95,6 +100,7 @@ func (s *server) validateUploadPackRequest(ctx context.Context, req *gitalypb.Po
 func (s *server) runUploadPack(ctx context.Context, req *gitalypb.PostUploadPackWithSidechannelRequest, repoPath string, gitConfig []git.ConfigPair, stdin io.Reader, stdout io.Writer) error {
 	h := sha1.New()
 
+	stdin, guard := s.partialCloneEnforcer.GuardNegotiation(req.GetRepository(), stdin)
 	stdin = io.TeeReader(stdin, h)
 	stdin, collector := s.runStatsCollector(ctx, stdin)
 	defer collector.finish()
@@ -122,6 +128,16 @@ func (s *server) runUploadPack(ctx context.Context, req *gitalypb.PostUploadPack
 	}
 
 	if err := cmd.Wait(); err != nil {
+		if err := guard.Err(); err != nil {
+			// Git hasn't seen the end of the negotiation yet, so we can tell the client
+			// why its request has been rejected.
+			if _, err := pktline.WriteString(stdout, "ERR "+err.Error()); err != nil {
+				ctxlogrus.Extract(ctx).WithError(err).Error("writing rejection to client")
+			}
+
+			return structerr.NewFailedPrecondition("%w", err)
+		}
+
 		stats := collector.finish()
 
 		if _, ok := command.ExitStatus(err); ok && stats.Deepen != "" {
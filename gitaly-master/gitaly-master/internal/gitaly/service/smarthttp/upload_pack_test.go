@@ -18,6 +18,8 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/pktline"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/partialclone"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/sidechannel"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
@@ -440,6 +442,97 @@ func testServerPostUploadPackPartialClone(t *testing.T, ctx context.Context, mak
 	require.Equal(t, 1.0, promtest.ToFloat64(metric))
 }
 
+func TestServer_PostUploadPackWithSidechannel_partialClonePolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+	testcfg.BuildGitalyHooks(t, cfg)
+
+	enforcer := partialclone.NewEnforcer(config.PartialClone{
+		Policies: []config.PartialClonePolicy{
+			{
+				AllowedFilters:          []string{"blob:none"},
+				RequireFilterAboveBytes: 1,
+			},
+		},
+	}, config.NewLocator(cfg))
+	cfg.SocketPath = runSmartHTTPServer(t, cfg, WithPartialCloneEnforcer(enforcer))
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	commit := gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+	gittest.Exec(t, cfg, "-C", repoPath, "repack", "-ad")
+
+	for _, tc := range []struct {
+		desc             string
+		filter           string
+		gitConfigOptions []string
+		have             bool
+		expectedErr      error
+		expectedResponse string
+	}{
+		{
+			desc:             "clone without filter",
+			expectedErr:      structerr.NewFailedPrecondition("running upload-pack: %w", partialclone.ErrFilterRequired),
+			expectedResponse: "ERR " + partialclone.ErrFilterRequired.Error(),
+		},
+		{
+			desc:             "clone with disallowed filter",
+			filter:           "tree:0",
+			expectedResponse: "filter 'tree' not supported",
+		},
+		{
+			desc:   "clone with disallowed filter allowed via config options",
+			filter: "tree:0",
+			gitConfigOptions: []string{
+				"uploadpackfilter.allow=true",
+				"uploadpackfilter.tree.allow=true",
+			},
+			expectedResponse: "filter 'tree' not supported",
+		},
+		{
+			desc:   "clone with allowed filter",
+			filter: "blob:none",
+		},
+		{
+			desc: "fetch without filter",
+			have: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			var request bytes.Buffer
+			gittest.WritePktlineString(t, &request, fmt.Sprintf("want %s %s\n", commit, clientCapabilities))
+			if tc.filter != "" {
+				gittest.WritePktlineString(t, &request, fmt.Sprintf("filter %s\n", tc.filter))
+			}
+			gittest.WritePktlineFlush(t, &request)
+			if tc.have {
+				gittest.WritePktlineString(t, &request, fmt.Sprintf("have %s\n", commit))
+			}
+			gittest.WritePktlineString(t, &request, "done\n")
+			gittest.WritePktlineFlush(t, &request)
+
+			response, err := makePostUploadPackWithSidechannelRequest(t, ctx, cfg.SocketPath, cfg.Auth.Token, &gitalypb.PostUploadPackWithSidechannelRequest{
+				Repository:       repo,
+				GitConfigOptions: tc.gitConfigOptions,
+			}, &request)
+
+			switch {
+			case tc.expectedErr != nil:
+				testhelper.RequireGrpcError(t, tc.expectedErr, err)
+			case tc.expectedResponse != "":
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+				pack, _, _ := extractPackDataFromResponse(t, response)
+				require.NotEmpty(t, pack)
+			}
+
+			require.Contains(t, response.String(), tc.expectedResponse)
+		})
+	}
+}
+
 func TestServer_PostUploadPackWithSidechannel_allowAnySHA1InWant(t *testing.T) {
 	t.Parallel()
 	ctx := testhelper.Context(t)
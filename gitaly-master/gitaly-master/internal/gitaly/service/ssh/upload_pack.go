@@ -95,6 +95,10 @@ func (s *server) sshUploadPack(rpcContext context.Context, req sshUploadPackRequ
 	if err != nil {
 		return 0, err
 	}
+	// Configuration passed by the client takes precedence over the bundle-uri configuration, but
+	// must not override the partial clone policy.
+	config = append(s.bundleURIManager.UploadPackGitConfig(ctx, repo), config...)
+	config = s.partialCloneEnforcer.UploadPackGitConfig(repo, config)
 
 	var wg sync.WaitGroup
 	pr, pw := io.Pipe()
@@ -103,6 +107,7 @@ func (s *server) sshUploadPack(rpcContext context.Context, req sshUploadPackRequ
 		wg.Wait()
 	}()
 
+	stdin, guard := s.partialCloneEnforcer.GuardNegotiation(repo, stdin)
 	stdin = io.TeeReader(stdin, pw)
 
 	wg.Add(1)
@@ -150,6 +155,16 @@ func (s *server) sshUploadPack(rpcContext context.Context, req sshUploadPackRequ
 	if err := cmd.Wait(); err != nil {
 		status, _ := command.ExitStatus(err)
 
+		if err := guard.Err(); err != nil {
+			// Git hasn't seen the end of the negotiation yet, so we can tell the client
+			// why its request has been rejected.
+			if _, err := pktline.WriteString(stdout, "ERR "+err.Error()); err != nil {
+				ctxlogrus.Extract(ctx).WithError(err).Error("writing rejection to client")
+			}
+
+			return status, structerr.NewFailedPrecondition("%w", err)
+		}
+
 		// When waiting for the packfile negotiation to end times out we'll cancel the local
 		// context, but not cancel the overall RPC's context. Our statushandler middleware
 		// thus cannot observe the fact that we're cancelling the context, and neither do we
@@ -21,6 +21,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/partialclone"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/perm"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/text"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/metadata/featureflag"
@@ -644,6 +645,70 @@ func testUploadPackSuccessful(t *testing.T, sidechannel bool, opts ...testcfg.Op
 	}
 }
 
+func TestUploadPack_partialClonePolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	testcfg.BuildGitalyHooks(t, cfg)
+	testcfg.BuildGitalySSH(t, cfg)
+
+	enforcer := partialclone.NewEnforcer(config.PartialClone{
+		Policies: []config.PartialClonePolicy{
+			{AllowedFilters: []string{"blob:none"}},
+		},
+	}, config.NewLocator(cfg))
+	cfg.SocketPath = runSSHServerWithOptions(t, cfg, []ServerOpt{WithPartialCloneEnforcer(enforcer)})
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg)
+	gittest.WriteCommit(t, cfg, repoPath, gittest.WithBranch("main"))
+
+	for _, tc := range []struct {
+		desc             string
+		filter           string
+		gitConfigOptions []string
+		expectedErr      string
+	}{
+		{
+			desc:   "allowed filter",
+			filter: "blob:none",
+		},
+		{
+			desc:        "disallowed filter",
+			filter:      "tree:0",
+			expectedErr: "filter 'tree' not supported",
+		},
+		{
+			desc:   "disallowed filter allowed via config options",
+			filter: "tree:0",
+			gitConfigOptions: []string{
+				"uploadpackfilter.allow=true",
+				"uploadpackfilter.tree.allow=true",
+			},
+			expectedErr: "filter 'tree' not supported",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := runClone(t, ctx, cfg, true, &gitalypb.SSHUploadPackRequest{
+				Repository:       repo,
+				GitConfigOptions: tc.gitConfigOptions,
+			}, "git@localhost:test/test.git", testhelper.TempDir(t), "--filter="+tc.filter)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
 func TestUploadPack_packObjectsHook(t *testing.T) {
 	t.Parallel()
 
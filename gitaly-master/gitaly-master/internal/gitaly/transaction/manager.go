@@ -44,11 +44,24 @@ var (
 	ErrTransactionStopped = errors.New("transaction was stopped")
 )
 
+// VoteOption is an option which modifies how a vote is cast.
+type VoteOption func(*gitalypb.VoteTransactionRequest)
+
+// WithReferenceUpdates attaches the reference updates the vote has been computed from to the vote
+// so that the transaction host can replicate them to replicas which do not take part in the
+// transaction. The updates are expected in the format Git passes them to the reference-transaction
+// hook.
+func WithReferenceUpdates(updates []byte) VoteOption {
+	return func(request *gitalypb.VoteTransactionRequest) {
+		request.ReferenceUpdates = updates
+	}
+}
+
 // Manager is an interface for handling voting on transactions.
 type Manager interface {
 	// Vote casts a vote on the given transaction which is hosted by the
 	// given Praefect server.
-	Vote(context.Context, txinfo.Transaction, voting.Vote, voting.Phase) error
+	Vote(context.Context, txinfo.Transaction, voting.Vote, voting.Phase, ...VoteOption) error
 
 	// Stop gracefully stops the given transaction which is hosted by the
 	// given Praefect server.
@@ -107,6 +120,7 @@ func (m *PoolManager) Vote(
 	tx txinfo.Transaction,
 	vote voting.Vote,
 	phase voting.Phase,
+	opts ...VoteOption,
 ) error {
 	client, err := m.getTransactionClient(ctx, tx)
 	if err != nil {
@@ -124,12 +138,17 @@ func (m *PoolManager) Vote(
 	transactionCtx, cancel := context.WithTimeout(ctx, transactionTimeout)
 	defer cancel()
 
-	response, err := client.VoteTransaction(transactionCtx, &gitalypb.VoteTransactionRequest{
+	request := &gitalypb.VoteTransactionRequest{
 		TransactionId:        tx.ID,
 		Node:                 tx.Node,
 		ReferenceUpdatesHash: vote.Bytes(),
 		Phase:                phase.ToProto(),
-	})
+	}
+	for _, opt := range opts {
+		opt(request)
+	}
+
+	response, err := client.VoteTransaction(transactionCtx, request)
 	if err != nil {
 		// Add some additional context to cancellation errors so that
 		// we know which of the contexts got canceled.
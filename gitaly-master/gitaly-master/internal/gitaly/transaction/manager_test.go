@@ -62,6 +62,7 @@ func TestPoolManager_Vote(t *testing.T) {
 		transaction txinfo.Transaction
 		vote        voting.Vote
 		phase       voting.Phase
+		opts        []transaction.VoteOption
 		voteFn      func(*testing.T, *gitalypb.VoteTransactionRequest) (*gitalypb.VoteTransactionResponse, error)
 		expectedErr error
 	}{
@@ -125,6 +126,26 @@ func TestPoolManager_Vote(t *testing.T) {
 				}, nil
 			},
 		},
+		{
+			desc: "successful committed vote with reference updates",
+			transaction: txinfo.Transaction{
+				BackchannelID: backchannelID,
+				ID:            1,
+				Node:          "node",
+			},
+			vote:  voting.VoteFromData([]byte("foobar")),
+			phase: voting.Committed,
+			opts:  []transaction.VoteOption{transaction.WithReferenceUpdates([]byte("foobar"))},
+			voteFn: func(t *testing.T, request *gitalypb.VoteTransactionRequest) (*gitalypb.VoteTransactionResponse, error) {
+				require.Equal(t, request.ReferenceUpdatesHash, voting.VoteFromData([]byte("foobar")).Bytes())
+				require.Equal(t, gitalypb.VoteTransactionRequest_COMMITTED_PHASE, request.Phase)
+				require.Equal(t, []byte("foobar"), request.ReferenceUpdates)
+
+				return &gitalypb.VoteTransactionResponse{
+					State: gitalypb.VoteTransactionResponse_COMMIT,
+				}, nil
+			},
+		},
 		{
 			desc: "aborted vote",
 			transaction: txinfo.Transaction{
@@ -165,7 +186,7 @@ func TestPoolManager_Vote(t *testing.T) {
 				return tc.voteFn(t, request)
 			}
 
-			err := manager.Vote(ctx, tc.transaction, tc.vote, tc.phase)
+			err := manager.Vote(ctx, tc.transaction, tc.vote, tc.phase, tc.opts...)
 			testhelper.RequireGrpcError(t, tc.expectedErr, err)
 		})
 	}
@@ -16,7 +16,7 @@ type MockManager struct {
 }
 
 // Vote calls the MockManager's Vote function, if set. Otherwise, it returns an error.
-func (m *MockManager) Vote(ctx context.Context, tx txinfo.Transaction, vote voting.Vote, phase voting.Phase) error {
+func (m *MockManager) Vote(ctx context.Context, tx txinfo.Transaction, vote voting.Vote, phase voting.Phase, _ ...VoteOption) error {
 	if m.VoteFn == nil {
 		return errors.New("mock does not implement Vote function")
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/dgraph-io/badger/v3"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
@@ -20,6 +21,18 @@ import (
 // ErrTransactionProcessingStopped is returned when the TransactionManager stops processing transactions.
 var ErrTransactionProcessingStopped = errors.New("transaction processing stopped")
 
+// LogEntryPrunedError is returned when reading a log entry which has already been pruned from the
+// write-ahead log.
+type LogEntryPrunedError struct {
+	// LogIndex is the index of the log entry that was attempted to be read.
+	LogIndex LogIndex
+}
+
+// Error returns the formatted error string.
+func (err LogEntryPrunedError) Error() string {
+	return fmt.Sprintf("log entry %d has been pruned", err.LogIndex)
+}
+
 // InvalidReferenceFormatError is returned when a reference name was invalid.
 type InvalidReferenceFormatError struct {
 	// ReferenceName is the reference with invalid format.
@@ -105,6 +118,13 @@ type Transaction struct {
 // TransactionManager recovers transactions after interruptions by applying the write-ahead logged transactions to
 // the repository on start up.
 //
+// Log entries are pruned from the write-ahead log once they have been applied to the repository. A number of
+// applied log entries can be retained by configuring WithLogRetention so that replicas can tail the log via
+// TailLog and apply the same reference updates without having to replicate the whole repository.
+//
+// PartitionManager starts a TransactionManager on boot for each repository that has a write-ahead log in order to
+// recover it. The log can be tailed by replicas via the TailLog RPC of the RepositoryService.
+//
 // TransactionManager maintains the write-ahead log in a key-value store. It maintains the following key spaces:
 // - `repository/<repository_id:string>/log/index/applied`
 //   - This key stores the index of the log entry that has been applied to the repository. This allows for
@@ -140,10 +160,35 @@ type TransactionManager struct {
 	// manager.
 	admissionQueue chan transactionFuture
 
-	// appendedLogIndex holds the index of the last log entry appended to the log.
-	appendedLogIndex LogIndex
+	// logRetention is the number of applied log entries that are retained in the log.
+	logRetention uint64
+	// initialized is closed once the log indexes have been loaded from the database.
+	initialized chan struct{}
+	// recovered is closed once the log entries that were appended prior to Run being called have been
+	// applied to the repository.
+	recovered chan struct{}
+
 	// appliedLogIndex holds the index of the last log entry applied to the repository
 	appliedLogIndex LogIndex
+
+	// mutex guards the fields below which are read by TailLog concurrently to Run. They are only
+	// modified by the goroutine executing Run, which can thus read them without holding the mutex.
+	mutex sync.Mutex
+	// appendedLogIndex holds the index of the last log entry appended to the log.
+	appendedLogIndex LogIndex
+	// appendedNotification is closed and replaced when a new log entry has been appended to the log.
+	appendedNotification chan struct{}
+}
+
+// TransactionManagerOption is an option for the TransactionManager.
+type TransactionManagerOption func(*TransactionManager)
+
+// WithLogRetention configures the number of applied log entries which are retained in the
+// write-ahead log. Applied log entries are pruned immediately by default.
+func WithLogRetention(entries uint64) TransactionManagerOption {
+	return func(mgr *TransactionManager) {
+		mgr.logRetention = entries
+	}
 }
 
 // repository is the localrepo interface used by TransactionManager.
@@ -153,17 +198,26 @@ type repository interface {
 }
 
 // NewTransactionManager returns a new TransactionManager for the given repository.
-func NewTransactionManager(db *badger.DB, repository *localrepo.Repo) *TransactionManager {
+func NewTransactionManager(db *badger.DB, repository *localrepo.Repo, opts ...TransactionManagerOption) *TransactionManager {
 	ctx, cancel := context.WithCancel(context.Background())
-	return &TransactionManager{
-		ctx:            ctx,
-		stopCalled:     ctx.Done(),
-		runDone:        make(chan struct{}),
-		stop:           cancel,
-		repository:     repository,
-		db:             newDatabaseAdapter(db),
-		admissionQueue: make(chan transactionFuture),
+	mgr := &TransactionManager{
+		ctx:                  ctx,
+		stopCalled:           ctx.Done(),
+		runDone:              make(chan struct{}),
+		stop:                 cancel,
+		repository:           repository,
+		db:                   newDatabaseAdapter(db),
+		admissionQueue:       make(chan transactionFuture),
+		initialized:          make(chan struct{}),
+		recovered:            make(chan struct{}),
+		appendedNotification: make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(mgr)
 	}
+
+	return mgr
 }
 
 // resultChannel represents a future that will yield the result of a transaction once its
@@ -241,6 +295,7 @@ func (mgr *TransactionManager) Run() error {
 	// value is the resultChannel that is waiting the result.
 	awaitingTransactions := make(map[LogIndex]resultChannel)
 
+	recovered := false
 	for {
 		if mgr.appliedLogIndex < mgr.appendedLogIndex {
 			logIndex := mgr.appliedLogIndex + 1
@@ -259,6 +314,13 @@ func (mgr *TransactionManager) Run() error {
 			continue
 		}
 
+		// All log entries have been applied once the loop gets here for the first time. This includes
+		// the log entries that were left unapplied when the TransactionManager was previously stopped.
+		if !recovered {
+			close(mgr.recovered)
+			recovered = true
+		}
+
 		var transaction transactionFuture
 		select {
 		case transaction = <-mgr.admissionQueue:
@@ -290,6 +352,59 @@ func (mgr *TransactionManager) Run() error {
 // Stop stops the transaction processing causing Run to return.
 func (mgr *TransactionManager) Stop() { mgr.stop() }
 
+// TailLog streams the write-ahead log entries to the handler in order, starting at the log entry with the given
+// index. The first log entry of a repository has index 1. Log entries are streamed once they have been appended
+// to the log, which may be before they have been applied to the repository. Once all appended log entries have
+// been streamed, TailLog waits for further log entries to be appended.
+//
+// TailLog returns when the context is canceled, when the handler returns an error or with
+// ErrTransactionProcessingStopped when the TransactionManager stops. A LogEntryPrunedError is returned in case a
+// log entry that is to be streamed has already been pruned from the log.
+func (mgr *TransactionManager) TailLog(ctx context.Context, startIndex LogIndex, handler func(LogIndex, *gitalypb.LogEntry) error) error {
+	select {
+	case <-mgr.initialized:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-mgr.runDone:
+		return ErrTransactionProcessingStopped
+	}
+
+	logIndex := startIndex
+	if logIndex == 0 {
+		logIndex = 1
+	}
+
+	for {
+		mgr.mutex.Lock()
+		appendedLogIndex := mgr.appendedLogIndex
+		appendedNotification := mgr.appendedNotification
+		mgr.mutex.Unlock()
+
+		for ; logIndex <= appendedLogIndex; logIndex++ {
+			var logEntry gitalypb.LogEntry
+			if err := mgr.readKey(keyLogEntry(getRepositoryID(mgr.repository), logIndex), &logEntry); err != nil {
+				if errors.Is(err, badger.ErrKeyNotFound) {
+					return LogEntryPrunedError{LogIndex: logIndex}
+				}
+
+				return fmt.Errorf("read log entry: %w", err)
+			}
+
+			if err := handler(logIndex, &logEntry); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-appendedNotification:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-mgr.runDone:
+			return ErrTransactionProcessingStopped
+		}
+	}
+}
+
 // initialize initializes the TransactionManager by loading the applied log index and determining the
 // appended log index from the database.
 func (mgr *TransactionManager) initialize() error {
@@ -298,6 +413,9 @@ func (mgr *TransactionManager) initialize() error {
 		return fmt.Errorf("read applied log index: %w", err)
 	}
 
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+
 	mgr.appliedLogIndex = LogIndex(appliedLogIndex.LogIndex)
 
 	// The index of the last appended log entry is determined from the indexes of the latest entry in the log and
@@ -307,7 +425,7 @@ func (mgr *TransactionManager) initialize() error {
 	//
 	// As the log indexes in the keys are encoded in big endian, the latest log entry can be found by taking
 	// the first key when iterating the log entry key space in reverse.
-	return mgr.db.View(func(txn databaseTransaction) error {
+	if err := mgr.db.View(func(txn databaseTransaction) error {
 		logPrefix := keyPrefixLogEntries(getRepositoryID(mgr.repository))
 
 		iterator := txn.NewIterator(badger.IteratorOptions{Reverse: true, Prefix: logPrefix})
@@ -322,7 +440,53 @@ func (mgr *TransactionManager) initialize() error {
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	// The log retention may have been lowered since the log entries were applied, so we prune
+	// all applied log entries which have fallen out of the retention window.
+	if uint64(mgr.appliedLogIndex) > mgr.logRetention {
+		if err := mgr.pruneLogEntries(mgr.appliedLogIndex - LogIndex(mgr.logRetention)); err != nil {
+			return fmt.Errorf("prune log entries: %w", err)
+		}
+	}
+
+	close(mgr.initialized)
+
+	return nil
+}
+
+// pruneLogEntries deletes all log entries up to and including the log entry with the given index.
+func (mgr *TransactionManager) pruneLogEntries(lastPrunedIndex LogIndex) error {
+	logPrefix := keyPrefixLogEntries(getRepositoryID(mgr.repository))
+
+	var keys [][]byte
+	if err := mgr.db.View(func(txn databaseTransaction) error {
+		iterator := txn.NewIterator(badger.IteratorOptions{Prefix: logPrefix})
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			key := iterator.Item().KeyCopy(nil)
+			if LogIndex(binary.BigEndian.Uint64(bytes.TrimPrefix(key, logPrefix))) > lastPrunedIndex {
+				break
+			}
+
+			keys = append(keys, key)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := mgr.deleteKey(key); err != nil {
+			return fmt.Errorf("deleting log entry: %w", err)
+		}
+	}
+
+	return nil
 }
 
 // verifyReferences verifies that the references in the transaction apply on top of the already accepted
@@ -439,7 +603,12 @@ func (mgr *TransactionManager) appendLogEntry(logEntry *gitalypb.LogEntry) error
 		return fmt.Errorf("set log entry: %w", err)
 	}
 
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+
 	mgr.appendedLogIndex = nextLogIndex
+	close(mgr.appendedNotification)
+	mgr.appendedNotification = make(chan struct{})
 
 	return nil
 }
@@ -466,8 +635,12 @@ func (mgr *TransactionManager) applyLogEntry(ctx context.Context, logIndex LogIn
 		return fmt.Errorf("set applied log index: %w", err)
 	}
 
-	if err := mgr.deleteKey(key); err != nil {
-		return fmt.Errorf("deleting log entry: %w", err)
+	// Prune the log entry which has fallen out of the retention window. Without retention, this
+	// is the log entry that has just been applied.
+	if uint64(logIndex) > mgr.logRetention {
+		if err := mgr.deleteKey(keyLogEntry(getRepositoryID(mgr.repository), logIndex-LogIndex(mgr.logRetention))); err != nil {
+			return fmt.Errorf("deleting log entry: %w", err)
+		}
 	}
 
 	mgr.appliedLogIndex = logIndex
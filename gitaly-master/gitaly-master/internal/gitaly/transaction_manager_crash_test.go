@@ -0,0 +1,194 @@
+package gitaly
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/catfile"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/gittest"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/git/localrepo"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper/testcfg"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+const (
+	// crashPointEnv is set when the test binary is executed as a TransactionManager process which
+	// kills itself at the crash point given as the value.
+	crashPointEnv = "GITALY_TEST_CRASH_POINT"
+	// crashDatabaseEnv is the path of the database used by the crashing process.
+	crashDatabaseEnv = "GITALY_TEST_CRASH_DATABASE"
+	// crashStorageEnv is the path of the storage containing the repository of the crashing process.
+	crashStorageEnv = "GITALY_TEST_CRASH_STORAGE"
+	// crashRelativePathEnv is the relative path of the repository of the crashing process.
+	crashRelativePathEnv = "GITALY_TEST_CRASH_RELATIVE_PATH"
+	// crashCommitEnv is the commit the crashing process points refs/heads/main to.
+	crashCommitEnv = "GITALY_TEST_CRASH_COMMIT"
+
+	// crashAfterAppend kills the process after the log entry has been appended to the log but before
+	// it has been applied to the repository.
+	crashAfterAppend = "after-append"
+	// crashAfterApply kills the process after the log entry has been applied to the repository but
+	// before the applied log index has been stored.
+	crashAfterApply = "after-apply"
+)
+
+func TestTransactionManager_crashRecovery(t *testing.T) {
+	if crashPoint := os.Getenv(crashPointEnv); crashPoint != "" {
+		runCrashingTransactionManager(t, crashPoint)
+		return
+	}
+
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc                      string
+		crashPoint                string
+		expectedReferencesOnCrash func(git.ObjectID) []git.Reference
+	}{
+		{
+			desc:       "crash after appending log entry",
+			crashPoint: crashAfterAppend,
+			expectedReferencesOnCrash: func(git.ObjectID) []git.Reference {
+				return nil
+			},
+		},
+		{
+			desc:       "crash after applying log entry",
+			crashPoint: crashAfterApply,
+			expectedReferencesOnCrash: func(commitOID git.ObjectID) []git.Reference {
+				return []git.Reference{{Name: "refs/heads/main", Target: commitOID.String()}}
+			},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := testhelper.Context(t)
+			cfg := testcfg.Build(t)
+
+			repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+				SkipCreationViaService: true,
+			})
+			commitOID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents())
+
+			catfileCache := catfile.NewCache(cfg)
+			t.Cleanup(catfileCache.Stop)
+
+			localRepo := localrepo.New(config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), catfileCache, repo)
+
+			databasePath := t.TempDir()
+
+			// Run the transaction in a separate process which kills itself at the crash point. This
+			// verifies that the log entry has been persisted before the transaction is applied.
+			cmd := exec.Command(os.Args[0], "-test.run=^TestTransactionManager_crashRecovery$")
+			cmd.Env = append(os.Environ(),
+				crashPointEnv+"="+tc.crashPoint,
+				crashDatabaseEnv+"="+databasePath,
+				crashStorageEnv+"="+cfg.Storages[0].Path,
+				crashRelativePathEnv+"="+repo.GetRelativePath(),
+				crashCommitEnv+"="+commitOID.String(),
+			)
+
+			output, err := cmd.CombinedOutput()
+			var exitErr *exec.ExitError
+			require.True(t, errors.As(err, &exitErr), "expected process to be killed: %v: %s", err, output)
+			status, ok := exitErr.Sys().(syscall.WaitStatus)
+			require.True(t, ok)
+			require.True(t, status.Signaled(), "expected process to be killed: %s", output)
+			require.Equal(t, syscall.SIGKILL, status.Signal())
+
+			database, err := OpenDatabase(databasePath)
+			require.NoError(t, err)
+			defer testhelper.MustClose(t, database)
+
+			// The log entry has been persisted, but it has not been recorded as applied.
+			RequireReferences(t, ctx, localRepo, tc.expectedReferencesOnCrash(commitOID))
+			RequireDatabase(t, ctx, database, DatabaseState{
+				string(keyLogEntry(getRepositoryID(localRepo), 1)): &gitalypb.LogEntry{
+					ReferenceUpdates: []*gitalypb.LogEntry_ReferenceUpdate{
+						{ReferenceName: []byte("refs/heads/main"), NewOid: []byte(commitOID)},
+					},
+				},
+			})
+
+			// Restarting the manager recovers the logged transaction before admitting new
+			// transactions.
+			manager := NewTransactionManager(database, localRepo)
+			managerErr := make(chan error, 1)
+			go func() { managerErr <- manager.Run() }()
+
+			require.NoError(t, manager.Propose(ctx, Transaction{
+				ReferenceUpdates: ReferenceUpdates{
+					"refs/heads/feature": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: commitOID},
+				},
+			}))
+
+			manager.Stop()
+			require.NoError(t, <-managerErr)
+
+			RequireReferences(t, ctx, localRepo, []git.Reference{
+				{Name: "refs/heads/feature", Target: commitOID.String()},
+				{Name: "refs/heads/main", Target: commitOID.String()},
+			})
+			RequireDatabase(t, ctx, database, DatabaseState{
+				string(keyAppliedLogIndex(getRepositoryID(localRepo))): LogIndex(2).toProto(),
+			})
+		})
+	}
+}
+
+// runCrashingTransactionManager proposes a transaction and kills the process at the given crash
+// point. It is executed in a separate process by TestTransactionManager_crashRecovery.
+func runCrashingTransactionManager(t *testing.T, crashPoint string) {
+	ctx := testhelper.Context(t)
+
+	cfg := testcfg.Build(t)
+	cfg.Storages[0].Path = os.Getenv(crashStorageEnv)
+
+	catfileCache := catfile.NewCache(cfg)
+	t.Cleanup(catfileCache.Stop)
+
+	localRepo := localrepo.New(config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), catfileCache, &gitalypb.Repository{
+		StorageName:  cfg.Storages[0].Name,
+		RelativePath: os.Getenv(crashRelativePathEnv),
+	})
+
+	database, err := OpenDatabase(os.Getenv(crashDatabaseEnv))
+	require.NoError(t, err)
+
+	crash := func(hookContext) {
+		require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGKILL))
+		select {}
+	}
+
+	manager := NewTransactionManager(database, localRepo)
+
+	var crashHooks hooks
+	switch crashPoint {
+	case crashAfterAppend:
+		crashHooks.beforeReadLogEntry = crash
+	case crashAfterApply:
+		crashHooks.beforeStoreAppliedLogIndex = crash
+	default:
+		require.FailNow(t, "unknown crash point", crashPoint)
+	}
+	installHooks(t, manager, database, localRepo, crashHooks)
+
+	go func() { _ = manager.Run() }()
+
+	err = manager.Propose(ctx, Transaction{
+		ReferenceUpdates: ReferenceUpdates{
+			"refs/heads/main": {OldOID: gittest.DefaultObjectHash.ZeroOID, NewOID: git.ObjectID(os.Getenv(crashCommitEnv))},
+		},
+	})
+	require.FailNow(t, "process has not been killed", "propose returned: %v", err)
+}
@@ -3,6 +3,7 @@ package gitaly
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 	"testing"
@@ -1709,6 +1710,163 @@ func checkManagerError(t *testing.T, managerErrChannel chan error, mgr *Transact
 	return false, managerErr
 }
 
+func TestTransactionManager_TailLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	cfg := testcfg.Build(t)
+
+	repo, repoPath := gittest.CreateRepository(t, ctx, cfg, gittest.CreateRepositoryConfig{
+		SkipCreationViaService: true,
+	})
+	rootCommitOID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents())
+	secondCommitOID := gittest.WriteCommit(t, cfg, repoPath, gittest.WithParents(rootCommitOID))
+
+	catfileCache := catfile.NewCache(cfg)
+	t.Cleanup(catfileCache.Stop)
+
+	localRepo := localrepo.New(config.NewLocator(cfg), gittest.NewCommandFactory(t, cfg), catfileCache, repo)
+
+	database, err := OpenDatabase(t.TempDir())
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, database)
+
+	startManager := func(logRetention uint64) (*TransactionManager, <-chan error) {
+		manager := NewTransactionManager(database, localRepo, WithLogRetention(logRetention))
+
+		managerErr := make(chan error, 1)
+		go func() { managerErr <- manager.Run() }()
+
+		return manager, managerErr
+	}
+
+	type tailedEntry struct {
+		index LogIndex
+		entry *gitalypb.LogEntry
+	}
+
+	tailLog := func(ctx context.Context, manager *TransactionManager, startIndex LogIndex) (<-chan tailedEntry, <-chan error) {
+		entries := make(chan tailedEntry)
+		tailErr := make(chan error, 1)
+		go func() {
+			tailErr <- manager.TailLog(ctx, startIndex, func(index LogIndex, entry *gitalypb.LogEntry) error {
+				entries <- tailedEntry{index: index, entry: entry}
+				return nil
+			})
+		}()
+
+		return entries, tailErr
+	}
+
+	requireEntry := func(t *testing.T, entries <-chan tailedEntry, expectedIndex LogIndex, expectedReference git.ReferenceName, expectedOID git.ObjectID) {
+		t.Helper()
+
+		entry := <-entries
+		require.Equal(t, expectedIndex, entry.index)
+		testhelper.ProtoEqual(t, &gitalypb.LogEntry{
+			ReferenceUpdates: []*gitalypb.LogEntry_ReferenceUpdate{
+				{ReferenceName: []byte(expectedReference), NewOid: []byte(expectedOID)},
+			},
+		}, entry.entry)
+	}
+
+	manager, managerErr := startManager(2)
+
+	for _, update := range []struct {
+		reference git.ReferenceName
+		oldOID    git.ObjectID
+		newOID    git.ObjectID
+	}{
+		{reference: "refs/heads/main", oldOID: gittest.DefaultObjectHash.ZeroOID, newOID: rootCommitOID},
+		{reference: "refs/heads/main", oldOID: rootCommitOID, newOID: secondCommitOID},
+		{reference: "refs/heads/feature", oldOID: gittest.DefaultObjectHash.ZeroOID, newOID: rootCommitOID},
+	} {
+		require.NoError(t, manager.Propose(ctx, Transaction{
+			ReferenceUpdates: ReferenceUpdates{update.reference: {OldOID: update.oldOID, NewOID: update.newOID}},
+		}))
+	}
+
+	// The first log entry has fallen out of the retention window.
+	require.Equal(t, LogEntryPrunedError{LogIndex: 1}, manager.TailLog(ctx, 1, func(LogIndex, *gitalypb.LogEntry) error {
+		return errors.New("unexpected log entry")
+	}))
+	RequireDatabase(t, ctx, database, DatabaseState{
+		string(keyAppliedLogIndex(getRepositoryID(localRepo))): LogIndex(3).toProto(),
+		string(keyLogEntry(getRepositoryID(localRepo), 2)): &gitalypb.LogEntry{
+			ReferenceUpdates: []*gitalypb.LogEntry_ReferenceUpdate{
+				{ReferenceName: []byte("refs/heads/main"), NewOid: []byte(secondCommitOID)},
+			},
+		},
+		string(keyLogEntry(getRepositoryID(localRepo), 3)): &gitalypb.LogEntry{
+			ReferenceUpdates: []*gitalypb.LogEntry_ReferenceUpdate{
+				{ReferenceName: []byte("refs/heads/feature"), NewOid: []byte(rootCommitOID)},
+			},
+		},
+	})
+
+	// Errors returned by the handler abort tailing.
+	handlerErr := errors.New("handler error")
+	require.Equal(t, handlerErr, manager.TailLog(ctx, 2, func(LogIndex, *gitalypb.LogEntry) error {
+		return handlerErr
+	}))
+
+	tailCtx, cancelTail := context.WithCancel(ctx)
+	entries, tailErr := tailLog(tailCtx, manager, 2)
+	requireEntry(t, entries, 2, "refs/heads/main", secondCommitOID)
+	requireEntry(t, entries, 3, "refs/heads/feature", rootCommitOID)
+
+	// Tailing waits for new log entries to be appended.
+	proposeErr := make(chan error, 1)
+	go func() {
+		proposeErr <- manager.Propose(ctx, Transaction{
+			ReferenceUpdates: ReferenceUpdates{"refs/heads/feature": {OldOID: rootCommitOID, NewOID: secondCommitOID}},
+		})
+	}()
+	requireEntry(t, entries, 4, "refs/heads/feature", secondCommitOID)
+	require.NoError(t, <-proposeErr)
+
+	cancelTail()
+	require.Equal(t, context.Canceled, <-tailErr)
+
+	// Tailing stops when the manager is stopped.
+	entries, tailErr = tailLog(ctx, manager, 4)
+	requireEntry(t, entries, 4, "refs/heads/feature", secondCommitOID)
+	manager.Stop()
+	require.Equal(t, ErrTransactionProcessingStopped, <-tailErr)
+	require.NoError(t, <-managerErr)
+
+	// Retained log entries are still available after restarting.
+	manager, managerErr = startManager(2)
+	entries, tailErr = tailLog(ctx, manager, 3)
+	requireEntry(t, entries, 3, "refs/heads/feature", rootCommitOID)
+	requireEntry(t, entries, 4, "refs/heads/feature", secondCommitOID)
+	manager.Stop()
+	require.Equal(t, ErrTransactionProcessingStopped, <-tailErr)
+	require.NoError(t, <-managerErr)
+
+	// Log entries which have fallen out of a lowered retention window are pruned on start.
+	manager, managerErr = startManager(1)
+	require.Equal(t, LogEntryPrunedError{LogIndex: 3}, manager.TailLog(ctx, 3, func(LogIndex, *gitalypb.LogEntry) error {
+		return errors.New("unexpected log entry")
+	}))
+	manager.Stop()
+	require.NoError(t, <-managerErr)
+
+	RequireDatabase(t, ctx, database, DatabaseState{
+		string(keyAppliedLogIndex(getRepositoryID(localRepo))): LogIndex(4).toProto(),
+		string(keyLogEntry(getRepositoryID(localRepo), 4)): &gitalypb.LogEntry{
+			ReferenceUpdates: []*gitalypb.LogEntry_ReferenceUpdate{
+				{ReferenceName: []byte("refs/heads/feature"), NewOid: []byte(secondCommitOID)},
+			},
+		},
+	})
+
+	RequireReferences(t, ctx, localRepo, []git.Reference{
+		{Name: "refs/heads/feature", Target: secondCommitOID.String()},
+		{Name: "refs/heads/main", Target: secondCommitOID.String()},
+	})
+}
+
 // BenchmarkTransactionManager benchmarks the transaction throughput of the TransactionManager at various levels
 // of concurrency and transaction sizes.
 func BenchmarkTransactionManager(b *testing.B) {
@@ -0,0 +1,10 @@
+package featureflag
+
+// MergeTreeCherryPick enables implementation of UserCherryPick using
+// `git merge-tree` instead of s.git2goExecutor.CherryPick()
+var MergeTreeCherryPick = NewFeatureFlag(
+	"merge_tree_cherry_pick",
+	"15.9.0",
+	"https://gitlab.com/gitlab-org/gitaly/-/issues/4600",
+	false,
+)
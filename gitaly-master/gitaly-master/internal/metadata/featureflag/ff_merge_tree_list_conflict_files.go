@@ -0,0 +1,10 @@
+package featureflag
+
+// MergeTreeListConflictFiles enables implementation of ListConflictFiles using
+// `git merge-tree` instead of s.git2goExecutor.Conflicts()
+var MergeTreeListConflictFiles = NewFeatureFlag(
+	"merge_tree_list_conflict_files",
+	"15.9.0",
+	"https://gitlab.com/gitlab-org/gitaly/-/issues/4600",
+	false,
+)
@@ -0,0 +1,10 @@
+package featureflag
+
+// MergeTreeResolveConflicts enables implementation of ResolveConflicts using
+// `git merge-tree` instead of s.git2goExecutor.Resolve()
+var MergeTreeResolveConflicts = NewFeatureFlag(
+	"merge_tree_resolve_conflicts",
+	"15.9.0",
+	"https://gitlab.com/gitlab-org/gitaly/-/issues/4600",
+	false,
+)
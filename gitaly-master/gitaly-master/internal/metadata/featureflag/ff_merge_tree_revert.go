@@ -0,0 +1,10 @@
+package featureflag
+
+// MergeTreeRevert enables implementation of UserRevert using
+// `git merge-tree` instead of s.git2goExecutor.Revert()
+var MergeTreeRevert = NewFeatureFlag(
+	"merge_tree_revert",
+	"15.9.0",
+	"https://gitlab.com/gitlab-org/gitaly/-/issues/4600",
+	false,
+)
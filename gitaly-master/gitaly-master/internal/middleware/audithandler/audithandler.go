@@ -0,0 +1,144 @@
+// Package audithandler records all mutator RPCs in the audit log. Reference updates performed by
+// the RPCs are recorded separately by the reference-transaction hook and can be linked to the RPC
+// via the correlation ID.
+package audithandler
+
+import (
+	"context"
+	"sync"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/audit"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/server/auth"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/protoregistry"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"gitlab.com/gitlab-org/labkit/correlation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryInterceptor returns a unary interceptor that records mutator RPCs in the audit log.
+func UnaryInterceptor(logger *audit.Logger, reg *protoregistry.Registry) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		methodInfo, ok := lookupMutator(reg, info.FullMethod)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+		record(ctx, logger, methodInfo, req, err)
+
+		return resp, err
+	}
+}
+
+// StreamInterceptor returns a stream interceptor that records mutator RPCs in the audit log. The
+// repository and user are extracted from the first request message.
+func StreamInterceptor(logger *audit.Logger, reg *protoregistry.Registry) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		methodInfo, ok := lookupMutator(reg, info.FullMethod)
+		if !ok {
+			return handler(srv, stream)
+		}
+
+		peeker := &peekingStream{ServerStream: stream}
+		err := handler(srv, peeker)
+		record(stream.Context(), logger, methodInfo, peeker.firstRequest, err)
+
+		return err
+	}
+}
+
+type peekingStream struct {
+	grpc.ServerStream
+	once         sync.Once
+	firstRequest interface{}
+}
+
+func (s *peekingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.once.Do(func() {
+		// The message may be reused by the handler for subsequent requests, so we need to
+		// keep a copy of it.
+		if msg, ok := m.(proto.Message); ok {
+			s.firstRequest = proto.Clone(msg)
+		}
+	})
+
+	return nil
+}
+
+func lookupMutator(reg *protoregistry.Registry, fullMethod string) (protoregistry.MethodInfo, bool) {
+	methodInfo, err := reg.LookupMethod(fullMethod)
+	if err != nil || methodInfo.Operation != protoregistry.OpMutator {
+		return protoregistry.MethodInfo{}, false
+	}
+
+	return methodInfo, true
+}
+
+// record appends an entry for the RPC to the audit log. Failing to record the entry does not
+// cause the RPC to fail given that its changes have already been performed at this point.
+func record(ctx context.Context, logger *audit.Logger, methodInfo protoregistry.MethodInfo, req interface{}, rpcErr error) {
+	entry := audit.Entry{
+		Type:          audit.EntryTypeRPC,
+		Method:        methodInfo.FullMethodName(),
+		CorrelationID: correlation.ExtractFromContext(ctx),
+		Result:        status.Code(rpcErr).String(),
+	}
+
+	if rpcErr != nil {
+		entry.Error = rpcErr.Error()
+	}
+
+	if client, ok := auth.ClientFromContext(ctx); ok {
+		entry.Client = client.Name
+	}
+
+	if msg, ok := req.(proto.Message); ok {
+		entry.Repository = requestRepository(methodInfo, msg)
+		entry.UserID, entry.Username = requestUser(msg)
+	}
+
+	if err := logger.Log(entry); err != nil {
+		ctxlogrus.Extract(ctx).WithError(err).Error("recording audit entry")
+	}
+}
+
+func requestRepository(methodInfo protoregistry.MethodInfo, msg proto.Message) audit.Repository {
+	switch methodInfo.Scope {
+	case protoregistry.ScopeRepository:
+		if repo, err := methodInfo.TargetRepo(msg); err == nil {
+			return audit.NewRepository(repo)
+		}
+	case protoregistry.ScopeStorage:
+		if storage, err := methodInfo.Storage(msg); err == nil {
+			return audit.Repository{StorageName: storage}
+		}
+	}
+
+	return audit.Repository{}
+}
+
+// requestUser extracts the GL_ID and username of the user on whose behalf the RPC is executed.
+// Operations carry the user in a `user` field while pushes carry it in `gl_id` and `gl_username`
+// fields.
+func requestUser(msg proto.Message) (string, string) {
+	if req, ok := msg.(interface{ GetUser() *gitalypb.User }); ok {
+		return req.GetUser().GetGlId(), req.GetUser().GetGlUsername()
+	}
+
+	var userID, username string
+	if req, ok := msg.(interface{ GetGlId() string }); ok {
+		userID = req.GetGlId()
+	}
+	if req, ok := msg.(interface{ GetGlUsername() string }); ok {
+		username = req.GetGlUsername()
+	}
+
+	return userID, username
+}
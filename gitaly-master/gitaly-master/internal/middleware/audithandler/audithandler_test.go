@@ -0,0 +1,146 @@
+package audithandler
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/audit"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/protoregistry"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type mockOperationService struct {
+	gitalypb.UnimplementedOperationServiceServer
+}
+
+func (mockOperationService) UserDeleteBranch(context.Context, *gitalypb.UserDeleteBranchRequest) (*gitalypb.UserDeleteBranchResponse, error) {
+	return nil, structerr.NewFailedPrecondition("branch not found")
+}
+
+type mockRefService struct {
+	gitalypb.UnimplementedRefServiceServer
+}
+
+func (mockRefService) RefExists(context.Context, *gitalypb.RefExistsRequest) (*gitalypb.RefExistsResponse, error) {
+	return &gitalypb.RefExistsResponse{}, nil
+}
+
+type mockSSHService struct {
+	gitalypb.UnimplementedSSHServiceServer
+}
+
+func (mockSSHService) SSHReceivePack(stream gitalypb.SSHService_SSHReceivePackServer) error {
+	for {
+		request, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+
+		// Modify the received message to verify that the interceptor records the first
+		// request as it was received.
+		request.GlId = "modified"
+	}
+}
+
+func TestInterceptors(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	path := filepath.Join(testhelper.TempDir(t), "audit.log")
+
+	logger, err := audit.NewLogger(config.Audit{Path: path, Secret: "secret"}, testhelper.NewDiscardingLogEntry(t))
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, logger)
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryInterceptor(logger, protoregistry.GitalyProtoPreregistered)),
+		grpc.StreamInterceptor(StreamInterceptor(logger, protoregistry.GitalyProtoPreregistered)),
+	)
+	gitalypb.RegisterOperationServiceServer(server, mockOperationService{})
+	gitalypb.RegisterRefServiceServer(server, mockRefService{})
+	gitalypb.RegisterSSHServiceServer(server, mockSSHService{})
+
+	go testhelper.MustServe(t, server, listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, conn)
+
+	repo := &gitalypb.Repository{StorageName: "default", RelativePath: "repo.git", GlProjectPath: "group/project"}
+
+	// Accessors are not recorded.
+	_, err = gitalypb.NewRefServiceClient(conn).RefExists(ctx, &gitalypb.RefExistsRequest{
+		Repository: repo,
+		Ref:        []byte("refs/heads/main"),
+	})
+	require.NoError(t, err)
+
+	_, err = gitalypb.NewOperationServiceClient(conn).UserDeleteBranch(ctx, &gitalypb.UserDeleteBranchRequest{
+		Repository: repo,
+		BranchName: []byte("main"),
+		User:       &gitalypb.User{GlId: "user-1", GlUsername: "jane"},
+	})
+	testhelper.RequireGrpcError(t, structerr.NewFailedPrecondition("branch not found"), err)
+
+	stream, err := gitalypb.NewSSHServiceClient(conn).SSHReceivePack(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&gitalypb.SSHReceivePackRequest{
+		Repository: repo,
+		GlId:       "user-2",
+		GlUsername: "john",
+	}))
+	require.NoError(t, stream.Send(&gitalypb.SSHReceivePackRequest{Stdin: []byte("data")}))
+	require.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	require.Error(t, err)
+
+	var entries []audit.Entry
+	for _, line := range strings.Split(strings.TrimSpace(string(testhelper.MustReadFile(t, path))), "\n") {
+		var entry audit.Entry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+
+		// The time and hash chain are verified by the audit package's tests.
+		require.False(t, entry.Time.IsZero())
+		entry.Time = time.Time{}
+		entry.Sequence = 0
+		entry.PreviousHash = ""
+
+		entries = append(entries, entry)
+	}
+
+	expectedRepo := audit.Repository{StorageName: "default", RelativePath: "repo.git", GlProjectPath: "group/project"}
+	require.Equal(t, []audit.Entry{
+		{
+			Type:       audit.EntryTypeRPC,
+			Method:     "/gitaly.OperationService/UserDeleteBranch",
+			Repository: expectedRepo,
+			UserID:     "user-1",
+			Username:   "jane",
+			Result:     "FailedPrecondition",
+			Error:      "branch not found",
+		},
+		{
+			Type:       audit.EntryTypeRPC,
+			Method:     "/gitaly.SSHService/SSHReceivePack",
+			Repository: expectedRepo,
+			UserID:     "user-2",
+			Username:   "john",
+			Result:     "OK",
+		},
+	}, entries)
+}
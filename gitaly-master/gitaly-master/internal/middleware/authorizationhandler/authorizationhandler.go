@@ -0,0 +1,212 @@
+// Package authorizationhandler enforces the per-client authorization policies configured via
+// `auth.clients`. Requests which have been authenticated with the shared token are not subject
+// to any restrictions.
+package authorizationhandler
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	gitalycfgauth "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/auth"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/server/auth"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/protoregistry"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+var deniedTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "gitaly_authorization_denied_total",
+		Help: "Number of requests denied by the authorization policy of the client",
+	},
+	[]string{"client", "reason"},
+)
+
+// UnaryInterceptor returns a unary interceptor that enforces the authorization policy of the
+// client a request has been authenticated as.
+func UnaryInterceptor(reg *protoregistry.Registry) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		client, ok := auth.ClientFromContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		methodInfo, err := authorizeMethod(reg, client, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := authorizeStorages(client, methodInfo, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns a stream interceptor that enforces the authorization policy of the
+// client a request has been authenticated as. Storages are authorized when receiving the first
+// request message.
+func StreamInterceptor(reg *protoregistry.Registry) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		client, ok := auth.ClientFromContext(stream.Context())
+		if !ok {
+			return handler(srv, stream)
+		}
+
+		methodInfo, err := authorizeMethod(reg, client, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		if len(client.Storages) == 0 {
+			return handler(srv, stream)
+		}
+
+		return handler(srv, &authorizedStream{
+			ServerStream: stream,
+			client:       client,
+			methodInfo:   methodInfo,
+		})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	client     gitalycfgauth.Client
+	methodInfo protoregistry.MethodInfo
+	once       sync.Once
+	err        error
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	// Only the first request of a stream is expected to carry the target repository or
+	// storage.
+	s.once.Do(func() {
+		s.err = authorizeStorages(s.client, s.methodInfo, m)
+	})
+
+	return s.err
+}
+
+// authorizeMethod verifies that the client may call the given method based on its service and
+// category.
+func authorizeMethod(reg *protoregistry.Registry, client gitalycfgauth.Client, fullMethod string) (protoregistry.MethodInfo, error) {
+	// Health checks are required to determine whether the server is reachable at all.
+	if strings.HasPrefix(fullMethod, "/grpc.health") {
+		return protoregistry.MethodInfo{}, nil
+	}
+
+	service := serviceName(fullMethod)
+	serviceAllowed := contains(client.Services, service)
+	if len(client.Services) > 0 && !serviceAllowed {
+		return protoregistry.MethodInfo{}, deny(client, "service", "client %q is not allowed to call service %q", client.Name, service)
+	}
+
+	methodInfo, err := reg.LookupMethod(fullMethod)
+	if err != nil || methodInfo.Operation == protoregistry.OpUnknown {
+		// Methods without a category, like for example the methods of services which are
+		// intercepted by Praefect, must be explicitly allowed if categories are restricted.
+		if len(client.Categories) > 0 && !serviceAllowed {
+			return protoregistry.MethodInfo{}, deny(client, "category", "client %q is not allowed to call %q", client.Name, fullMethod)
+		}
+
+		return protoregistry.MethodInfo{}, nil
+	}
+
+	category := operationCategory(methodInfo.Operation)
+	if len(client.Categories) > 0 && !contains(client.Categories, category) {
+		return protoregistry.MethodInfo{}, deny(client, "category", "client %q is not allowed to call %s RPC %q", client.Name, category, fullMethod)
+	}
+
+	return methodInfo, nil
+}
+
+// authorizeStorages verifies that all storages accessed by the request are allowed for the
+// client.
+func authorizeStorages(client gitalycfgauth.Client, methodInfo protoregistry.MethodInfo, req interface{}) error {
+	if len(client.Storages) == 0 {
+		return nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return deny(client, "storage", "client %q: cannot determine storage of request", client.Name)
+	}
+
+	var storages []string
+	switch methodInfo.Scope {
+	case protoregistry.ScopeRepository:
+		target, err := methodInfo.TargetRepo(msg)
+		if err != nil {
+			return deny(client, "storage", "client %q: cannot determine storage of request: %w", client.Name, err)
+		}
+		storages = append(storages, target.GetStorageName())
+
+		additional, ok, err := methodInfo.AdditionalRepo(msg)
+		if err != nil {
+			return deny(client, "storage", "client %q: cannot determine storage of request: %w", client.Name, err)
+		} else if ok {
+			storages = append(storages, additional.GetStorageName())
+		}
+	case protoregistry.ScopeStorage:
+		storage, err := methodInfo.Storage(msg)
+		if err != nil {
+			return deny(client, "storage", "client %q: cannot determine storage of request: %w", client.Name, err)
+		}
+		storages = append(storages, storage)
+	}
+
+	for _, storage := range storages {
+		if !contains(client.Storages, storage) {
+			return deny(client, "storage", "client %q is not allowed to access storage %q", client.Name, storage)
+		}
+	}
+
+	return nil
+}
+
+func deny(client gitalycfgauth.Client, reason, format string, a ...any) error {
+	deniedTotal.WithLabelValues(client.Name, reason).Inc()
+	return structerr.NewPermissionDenied(format, a...)
+}
+
+func operationCategory(op protoregistry.OpType) string {
+	switch op {
+	case protoregistry.OpAccessor:
+		return gitalycfgauth.CategoryReadOnly
+	case protoregistry.OpMutator:
+		return gitalycfgauth.CategoryMutator
+	case protoregistry.OpMaintenance:
+		return gitalycfgauth.CategoryMaintenance
+	default:
+		return ""
+	}
+}
+
+// serviceName extracts the fully qualified service name from a full method name of the form
+// "/gitaly.RepositoryService/RepositoryExists".
+func serviceName(fullMethod string) string {
+	service := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+	return service
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package authorizationhandler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gitalycfgauth "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/auth"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/protoregistry"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
+)
+
+func TestAuthorizeMethod(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		desc        string
+		client      gitalycfgauth.Client
+		fullMethod  string
+		expectedErr error
+	}{
+		{
+			desc:       "unrestricted client",
+			client:     gitalycfgauth.Client{Name: "client"},
+			fullMethod: "/gitaly.RepositoryService/CreateRepository",
+		},
+		{
+			desc: "health check",
+			client: gitalycfgauth.Client{
+				Name:       "client",
+				Services:   []string{"gitaly.RefService"},
+				Categories: []string{gitalycfgauth.CategoryReadOnly},
+			},
+			fullMethod: "/grpc.health.v1.Health/Check",
+		},
+		{
+			desc:       "allowed service",
+			client:     gitalycfgauth.Client{Name: "client", Services: []string{"gitaly.RefService"}},
+			fullMethod: "/gitaly.RefService/FindAllBranches",
+		},
+		{
+			desc:        "disallowed service",
+			client:      gitalycfgauth.Client{Name: "client", Services: []string{"gitaly.RefService"}},
+			fullMethod:  "/gitaly.RepositoryService/RepositoryExists",
+			expectedErr: structerr.NewPermissionDenied(`client "client" is not allowed to call service "gitaly.RepositoryService"`),
+		},
+		{
+			desc:       "allowed category",
+			client:     gitalycfgauth.Client{Name: "client", Categories: []string{gitalycfgauth.CategoryMaintenance}},
+			fullMethod: "/gitaly.RepositoryService/OptimizeRepository",
+		},
+		{
+			desc:        "disallowed category",
+			client:      gitalycfgauth.Client{Name: "client", Categories: []string{gitalycfgauth.CategoryReadOnly}},
+			fullMethod:  "/gitaly.RepositoryService/OptimizeRepository",
+			expectedErr: structerr.NewPermissionDenied(`client "client" is not allowed to call maintenance RPC "/gitaly.RepositoryService/OptimizeRepository"`),
+		},
+		{
+			desc:        "uncategorized method with restricted categories",
+			client:      gitalycfgauth.Client{Name: "client", Categories: []string{gitalycfgauth.CategoryReadOnly}},
+			fullMethod:  "/gitaly.ServerService/ServerInfo",
+			expectedErr: structerr.NewPermissionDenied(`client "client" is not allowed to call "/gitaly.ServerService/ServerInfo"`),
+		},
+		{
+			desc: "uncategorized method of allowed service",
+			client: gitalycfgauth.Client{
+				Name:       "client",
+				Services:   []string{"gitaly.ServerService"},
+				Categories: []string{gitalycfgauth.CategoryReadOnly},
+			},
+			fullMethod: "/gitaly.ServerService/ServerInfo",
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := authorizeMethod(protoregistry.GitalyProtoPreregistered, tc.client, tc.fullMethod)
+			require.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAuthorizeStorages(t *testing.T) {
+	t.Parallel()
+
+	client := gitalycfgauth.Client{Name: "client", Storages: []string{"default"}}
+
+	for _, tc := range []struct {
+		desc        string
+		fullMethod  string
+		req         interface{}
+		expectedErr error
+	}{
+		{
+			desc:       "allowed repository",
+			fullMethod: "/gitaly.RepositoryService/RepositoryExists",
+			req: &gitalypb.RepositoryExistsRequest{
+				Repository: &gitalypb.Repository{StorageName: "default", RelativePath: "repo.git"},
+			},
+		},
+		{
+			desc:       "disallowed repository",
+			fullMethod: "/gitaly.RepositoryService/RepositoryExists",
+			req: &gitalypb.RepositoryExistsRequest{
+				Repository: &gitalypb.Repository{StorageName: "other", RelativePath: "repo.git"},
+			},
+			expectedErr: structerr.NewPermissionDenied(`client "client" is not allowed to access storage "other"`),
+		},
+		{
+			desc:       "disallowed additional repository",
+			fullMethod: "/gitaly.ObjectPoolService/LinkRepositoryToObjectPool",
+			req: &gitalypb.LinkRepositoryToObjectPoolRequest{
+				ObjectPool: &gitalypb.ObjectPool{
+					Repository: &gitalypb.Repository{StorageName: "other", RelativePath: "pool.git"},
+				},
+				Repository: &gitalypb.Repository{StorageName: "default", RelativePath: "repo.git"},
+			},
+			expectedErr: structerr.NewPermissionDenied(`client "client" is not allowed to access storage "other"`),
+		},
+		{
+			desc:        "disallowed storage",
+			fullMethod:  "/gitaly.InternalGitaly/WalkRepos",
+			req:         &gitalypb.WalkReposRequest{StorageName: "other"},
+			expectedErr: structerr.NewPermissionDenied(`client "client" is not allowed to access storage "other"`),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			methodInfo, err := protoregistry.GitalyProtoPreregistered.LookupMethod(tc.fullMethod)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedErr, authorizeStorages(client, methodInfo, tc.req))
+		})
+	}
+}
@@ -167,11 +167,10 @@ func (c *ConcurrencyLimiter) Limit(ctx context.Context, limitingKey string, f Li
 	)
 	defer span.Finish()
 
-	if c.maxConcurrencyLimit <= 0 {
+	sem := c.getConcurrencyLimit(limitingKey)
+	if sem == nil {
 		return f()
 	}
-
-	sem := c.getConcurrencyLimit(limitingKey)
 	defer c.putConcurrencyLimit(limitingKey)
 
 	start := time.Now()
@@ -200,12 +199,27 @@ func (c *ConcurrencyLimiter) Limit(ctx context.Context, limitingKey string, f Li
 	return f()
 }
 
+// SetLimits updates the limits of the concurrency limiter. Keys which are currently being limited
+// keep their previous limits until all of their calls have finished.
+func (c *ConcurrencyLimiter) SetLimits(maxConcurrencyLimit, maxQueueLength int, maxQueuedTickerCreator QueueTickerCreator) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.maxConcurrencyLimit = int64(maxConcurrencyLimit)
+	c.maxQueueLength = int64(maxQueueLength)
+	c.maxQueuedTickerCreator = maxQueuedTickerCreator
+}
+
 // getConcurrencyLimit retrieves the concurrency limit for the given key. If no such limiter exists
-// it will be lazily constructed.
+// it will be lazily constructed. Returns nil in case concurrency is not limited.
 func (c *ConcurrencyLimiter) getConcurrencyLimit(limitingKey string) *keyedConcurrencyLimiter {
 	c.m.Lock()
 	defer c.m.Unlock()
 
+	if c.maxConcurrencyLimit <= 0 {
+		return nil
+	}
+
 	if c.limitsByKey[limitingKey] == nil {
 		// Set up the queue tokens in case a maximum queue length was requested. As the
 		// queue tokens are kept during the whole lifetime of the concurrency-limited
@@ -257,37 +271,56 @@ func (c *ConcurrencyLimiter) countSemaphores() int {
 	return len(c.limitsByKey)
 }
 
+// concurrencyMetrics are the metrics exposed by concurrency limiters.
+type concurrencyMetrics struct {
+	acquiringSeconds *prometheus.HistogramVec
+	inProgress       *prometheus.GaugeVec
+	queued           *prometheus.GaugeVec
+}
+
+func newConcurrencyMetrics(latencyBuckets []float64) *concurrencyMetrics {
+	return &concurrencyMetrics{
+		acquiringSeconds: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: "gitaly",
+				Subsystem: "concurrency_limiting",
+				Name:      "acquiring_seconds",
+				Help:      "Histogram of time calls are rate limited (in seconds)",
+				Buckets:   latencyBuckets,
+			},
+			[]string{"system", "grpc_service", "grpc_method"},
+		),
+		inProgress: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "gitaly",
+				Subsystem: "concurrency_limiting",
+				Name:      "in_progress",
+				Help:      "Gauge of number of concurrent in-progress calls",
+			},
+			[]string{"system", "grpc_service", "grpc_method"},
+		),
+		queued: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "gitaly",
+				Subsystem: "concurrency_limiting",
+				Name:      "queued",
+				Help:      "Gauge of number of queued calls",
+			},
+			[]string{"system", "grpc_service", "grpc_method"},
+		),
+	}
+}
+
 // WithConcurrencyLimiters sets up middleware to limit the concurrency of
 // requests based on RPC and repository
 func WithConcurrencyLimiters(cfg config.Cfg, middleware *LimiterMiddleware) {
-	acquiringSecondsMetric := prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{
-			Namespace: "gitaly",
-			Subsystem: "concurrency_limiting",
-			Name:      "acquiring_seconds",
-			Help:      "Histogram of time calls are rate limited (in seconds)",
-			Buckets:   cfg.Prometheus.GRPCLatencyBuckets,
-		},
-		[]string{"system", "grpc_service", "grpc_method"},
-	)
-	inProgressMetric := prometheus.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Namespace: "gitaly",
-			Subsystem: "concurrency_limiting",
-			Name:      "in_progress",
-			Help:      "Gauge of number of concurrent in-progress calls",
-		},
-		[]string{"system", "grpc_service", "grpc_method"},
-	)
-	queuedMetric := prometheus.NewGaugeVec(
-		prometheus.GaugeOpts{
-			Namespace: "gitaly",
-			Subsystem: "concurrency_limiting",
-			Name:      "queued",
-			Help:      "Gauge of number of queued calls",
-		},
-		[]string{"system", "grpc_service", "grpc_method"},
-	)
+	if middleware.concurrencyMetrics == nil {
+		middleware.concurrencyMetrics = newConcurrencyMetrics(cfg.Prometheus.GRPCLatencyBuckets)
+	}
+
+	acquiringSecondsMetric := middleware.concurrencyMetrics.acquiringSeconds
+	inProgressMetric := middleware.concurrencyMetrics.inProgress
+	queuedMetric := middleware.concurrencyMetrics.queued
 
 	middleware.collect = func(metrics chan<- prometheus.Metric) {
 		acquiringSecondsMetric.Collect(metrics)
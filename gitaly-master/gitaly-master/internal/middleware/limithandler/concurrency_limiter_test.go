@@ -311,6 +311,60 @@ func TestConcurrencyLimiter_queueLimit(t *testing.T) {
 	wg.Wait()
 }
 
+func TestConcurrencyLimiter_setLimits(t *testing.T) {
+	ctx := testhelper.Context(t)
+
+	limiter := NewConcurrencyLimiter(0, 0, nil, nil)
+
+	// Concurrency is not limited at all, so no semaphore gets created.
+	_, err := limiter.Limit(ctx, "key", func() (interface{}, error) {
+		require.Equal(t, 0, limiter.countSemaphores())
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	monitorCh := make(chan struct{})
+	limiter.monitor = &blockingQueueCounter{queuedCh: monitorCh}
+	limiter.SetLimits(1, 1, nil)
+
+	ch := make(chan struct{})
+	go func() {
+		_, err := limiter.Limit(ctx, "key", func() (interface{}, error) {
+			ch <- struct{}{}
+			<-ch
+			return nil, nil
+		})
+		assert.NoError(t, err)
+	}()
+	<-monitorCh
+	<-ch
+	require.Equal(t, 1, limiter.countSemaphores())
+
+	// The second call fills up the queue.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := limiter.Limit(ctx, "key", func() (interface{}, error) {
+			return nil, nil
+		})
+		assert.NoError(t, err)
+	}()
+	<-monitorCh
+
+	// And the third call is thus rejected.
+	_, err = limiter.Limit(ctx, "key", func() (interface{}, error) {
+		return nil, nil
+	})
+	require.Equal(t, structerr.NewResourceExhausted("%w", ErrMaxQueueSize).WithDetail(&gitalypb.LimitError{
+		ErrorMessage: ErrMaxQueueSize.Error(),
+		RetryAfter:   durationpb.New(0),
+	}), err)
+
+	close(ch)
+	wg.Wait()
+}
+
 type blockingDequeueCounter struct {
 	counter
 
@@ -2,6 +2,7 @@ package limithandler
 
 import (
 	"context"
+	"sync"
 
 	grpcmwtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"github.com/prometheus/client_golang/prometheus"
@@ -38,10 +39,17 @@ type LimitedFunc func() (resp interface{}, err error)
 
 // LimiterMiddleware contains rate limiter state
 type LimiterMiddleware struct {
+	m                     sync.RWMutex
 	methodLimiters        map[string]Limiter
 	getLockKey            GetLockKey
 	requestsDroppedMetric *prometheus.CounterVec
 	collect               func(metrics chan<- prometheus.Metric)
+	setupMiddleware       SetupFunc
+	// stop stops background goroutines of the current set of limiters, if any.
+	stop func()
+	// concurrencyMetrics are the metrics shared by all concurrency limiters. They are kept
+	// across reloads so that the metrics don't get reset.
+	concurrencyMetrics *concurrencyMetrics
 }
 
 // New creates a new middleware that limits requests. SetupFunc sets up the
@@ -63,11 +71,36 @@ func New(cfg config.Cfg, getLockKey GetLockKey, setupMiddleware SetupFunc) *Limi
 		),
 	}
 
+	middleware.setupMiddleware = setupMiddleware
 	setupMiddleware(cfg, middleware)
 
 	return middleware
 }
 
+// Reload sets up the limiters anew with the given configuration. Calls which are being limited
+// already continue to be limited by the previous limiters, whereas all new calls will be limited
+// by the reloaded limiters.
+func (c *LimiterMiddleware) Reload(cfg config.Cfg) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	stop := c.stop
+	c.stop = nil
+
+	c.setupMiddleware(cfg, c)
+
+	if stop != nil {
+		stop()
+	}
+}
+
+// limiter returns the limiter for the given method, if any.
+func (c *LimiterMiddleware) limiter(fullMethod string) Limiter {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	return c.methodLimiters[fullMethod]
+}
+
 // Describe is used to describe Prometheus metrics.
 func (c *LimiterMiddleware) Describe(descs chan<- *prometheus.Desc) {
 	prometheus.DescribeByCollect(c, descs)
@@ -76,8 +109,13 @@ func (c *LimiterMiddleware) Describe(descs chan<- *prometheus.Desc) {
 // Collect is used to collect Prometheus metrics.
 func (c *LimiterMiddleware) Collect(metrics chan<- prometheus.Metric) {
 	c.requestsDroppedMetric.Collect(metrics)
-	if c.collect != nil {
-		c.collect(metrics)
+
+	c.m.RLock()
+	collect := c.collect
+	c.m.RUnlock()
+
+	if collect != nil {
+		collect(metrics)
 	}
 }
 
@@ -89,7 +127,7 @@ func (c *LimiterMiddleware) UnaryInterceptor() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		limiter := c.methodLimiters[info.FullMethod]
+		limiter := c.limiter(info.FullMethod)
 		if limiter == nil {
 			// No concurrency limiting
 			return handler(ctx, req)
@@ -138,7 +176,7 @@ func (w *wrappedStream) RecvMsg(m interface{}) error {
 		return nil
 	}
 
-	limiter := w.limiterMiddleware.methodLimiters[w.info.FullMethod]
+	limiter := w.limiterMiddleware.limiter(w.info.FullMethod)
 	if limiter == nil {
 		// No concurrency limiting
 		return nil
@@ -748,6 +748,52 @@ gitaly_requests_dropped_total{grpc_method="UnaryCall",grpc_service="grpc.testing
 	})
 }
 
+func TestRateLimitHandler_reload(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+
+	methodName := "/grpc.testing.TestService/UnaryCall"
+	cfg := config.Cfg{
+		RateLimiting: []config.RateLimiting{
+			{RPC: methodName, Interval: duration.Duration(1 * time.Hour), Burst: 1},
+		},
+	}
+
+	s := &server{blockCh: make(chan struct{})}
+	close(s.blockCh)
+
+	lh := limithandler.New(cfg, fixedLockKey, limithandler.WithRateLimiters(ctx))
+	srv, serverSocketPath := runServer(t, s, grpc.UnaryInterceptor(lh.UnaryInterceptor()))
+	defer srv.Stop()
+
+	client, conn := newClient(t, serverSocketPath)
+	defer testhelper.MustClose(t, conn)
+
+	_, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+	require.NoError(t, err)
+
+	_, err = client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+	testhelper.RequireGrpcCode(t, err, codes.Unavailable)
+
+	// Lifting the rate limit allows calls to pass again.
+	lh.Reload(config.Cfg{})
+
+	for i := 0; i < 5; i++ {
+		_, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+		require.NoError(t, err)
+	}
+
+	// Reinstating the rate limit creates fresh limiters, so a single call is allowed again.
+	lh.Reload(cfg)
+
+	_, err = client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+	require.NoError(t, err)
+
+	_, err = client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+	testhelper.RequireGrpcCode(t, err, codes.Unavailable)
+}
+
 func runServer(t *testing.T, s grpc_testing.TestServiceServer, opt ...grpc.ServerOption) (*grpc.Server, string) {
 	serverSocketPath := testhelper.GetTemporaryGitalySocketFileName(t)
 	grpcServer := grpc.NewServer(opt...)
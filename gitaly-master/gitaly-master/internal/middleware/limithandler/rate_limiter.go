@@ -109,6 +109,11 @@ func NewRateLimiter(
 // based on its rate per second per RPC
 func WithRateLimiters(ctx context.Context) SetupFunc {
 	return func(cfg config.Cfg, middleware *LimiterMiddleware) {
+		// The limiters are pruned in the background until they are replaced on reload or
+		// until the context is cancelled.
+		ctx, cancel := context.WithCancel(ctx)
+		middleware.stop = cancel
+
 		result := make(map[string]Limiter)
 
 		for _, limitCfg := range cfg.RateLimiting {
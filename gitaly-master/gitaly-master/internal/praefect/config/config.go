@@ -14,6 +14,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/log"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/sentry"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/telemetry"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
 )
 
@@ -160,6 +161,7 @@ type Config struct {
 	GracefulStopTimeout duration.Duration   `toml:"graceful_stop_timeout,omitempty"`
 	RepositoriesCleanup RepositoriesCleanup `toml:"repositories_cleanup,omitempty"`
 	Yamux               Yamux               `toml:"yamux,omitempty"`
+	Telemetry           telemetry.Config    `toml:"telemetry,omitempty"`
 }
 
 // Yamux contains Yamux related configuration values.
@@ -224,6 +226,7 @@ func FromFile(filePath string) (Config, error) {
 		Failover:            Failover{Enabled: true, ElectionStrategy: ElectionStrategyPerRepository},
 		RepositoriesCleanup: DefaultRepositoriesCleanup(),
 		Yamux:               DefaultYamuxConfig(),
+		Telemetry:           telemetry.DefaultConfig(),
 	}
 	if err := toml.Unmarshal(b, conf); err != nil {
 		return Config{}, err
@@ -249,6 +252,8 @@ var (
 	errNoVirtualStorages        = errors.New("no virtual storages configured")
 	errVirtualStoragesNotUnique = errors.New("virtual storages must have unique names")
 	errVirtualStorageUnnamed    = errors.New("virtual storages must have a name")
+	errAuthClientsUnsupported   = errors.New("auth.clients is not supported by Praefect")
+	errTLSClientCAUnsupported   = errors.New("tls.client_ca_path is not supported by Praefect")
 )
 
 // Validate establishes if the config is valid
@@ -265,6 +270,14 @@ func (c *Config) Validate() error {
 		return errNoVirtualStorages
 	}
 
+	if len(c.Auth.Clients) > 0 {
+		return errAuthClientsUnsupported
+	}
+
+	if c.TLS.ClientCAPath != "" || c.TLS.RequireClientCertificate {
+		return errTLSClientCAUnsupported
+	}
+
 	if c.Replication.BatchSize < 1 {
 		return fmt.Errorf("replication batch size was %d but must be >=1", c.Replication.BatchSize)
 	}
@@ -322,6 +335,10 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Telemetry.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
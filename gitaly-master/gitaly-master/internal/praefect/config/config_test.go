@@ -12,9 +12,11 @@ import (
 	"github.com/pelletier/go-toml/v2"
 	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/auth"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/log"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/prometheus"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/sentry"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/telemetry"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
 )
 
@@ -221,6 +223,21 @@ func TestConfigValidation(t *testing.T) {
 			},
 			errMsg: `yamux.accept_backlog must be at least 1 but it was 0`,
 		},
+		{
+			desc: "auth clients are not supported",
+			changeConfig: func(cfg *Config) {
+				cfg.Auth.Token = "secret"
+				cfg.Auth.Clients = []auth.Client{{Name: "analytics", Token: "analytics-secret"}}
+			},
+			errMsg: `auth.clients is not supported by Praefect`,
+		},
+		{
+			desc: "client certificate authorities are not supported",
+			changeConfig: func(cfg *Config) {
+				cfg.TLS.ClientCAPath = "/path/to/ca.pem"
+			},
+			errMsg: `tls.client_ca_path is not supported by Praefect`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -351,6 +368,7 @@ func TestConfigParsing(t *testing.T) {
 					MaximumStreamWindowSizeBytes: 1000,
 					AcceptBacklog:                2000,
 				},
+				Telemetry: telemetry.DefaultConfig(),
 			},
 		},
 		{
@@ -378,6 +396,7 @@ func TestConfigParsing(t *testing.T) {
 				},
 				BackgroundVerification: DefaultBackgroundVerificationConfig(),
 				Yamux:                  DefaultYamuxConfig(),
+				Telemetry:              telemetry.DefaultConfig(),
 			},
 		},
 		{
@@ -402,6 +421,7 @@ func TestConfigParsing(t *testing.T) {
 				},
 				BackgroundVerification: DefaultBackgroundVerificationConfig(),
 				Yamux:                  DefaultYamuxConfig(),
+				Telemetry:              telemetry.DefaultConfig(),
 			},
 		},
 		{
@@ -2,6 +2,7 @@ package praefect
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"sync"
@@ -58,6 +59,7 @@ var transactionRPCs = map[string]transactionsCondition{
 	"/gitaly.OperationService/UserApplyPatch":                transactionsEnabled,
 	"/gitaly.OperationService/UserCherryPick":                transactionsEnabled,
 	"/gitaly.OperationService/UserCommitFiles":               transactionsEnabled,
+	"/gitaly.OperationService/UserCommitPatches":             transactionsEnabled,
 	"/gitaly.OperationService/UserCreateBranch":              transactionsEnabled,
 	"/gitaly.OperationService/UserCreateTag":                 transactionsEnabled,
 	"/gitaly.OperationService/UserDeleteBranch":              transactionsEnabled,
@@ -83,6 +85,7 @@ var transactionRPCs = map[string]transactionsCondition{
 	"/gitaly.RepositoryService/RemoveRepository":             transactionsEnabled,
 	"/gitaly.RepositoryService/ReplicateRepository":          transactionsEnabled,
 	"/gitaly.RepositoryService/SetFullPath":                  transactionsEnabled,
+	"/gitaly.RepositoryService/SetPushRules":                 transactionsEnabled,
 	"/gitaly.RepositoryService/WriteRef":                     transactionsEnabled,
 	"/gitaly.SSHService/SSHReceivePack":                      transactionsEnabled,
 	"/gitaly.SmartHTTPService/PostReceivePack":               transactionsEnabled,
@@ -96,6 +99,11 @@ var transactionRPCs = map[string]transactionsCondition{
 	"/gitaly.ObjectPoolService/ReduplicateRepository":      transactionsDisabled,
 	"/gitaly.RepositoryService/RenameRepository":           transactionsDisabled,
 
+	// `ConvertObjectFormat` rewrites all objects of the repository, which would require us
+	// to vote on every single object. Instead, secondaries get the converted repository via
+	// replication.
+	"/gitaly.RepositoryService/ConvertObjectFormat": transactionsDisabled,
+
 	// The `RestoreCustomHooks` RPC can be make transactional by enabling the
 	// `TransactionalRestoreCustomHooks` feature flag.
 	"/gitaly.RepositoryService/RestoreCustomHooks": transactionsFlag(featureflag.TransactionalRestoreCustomHooks),
@@ -265,7 +273,14 @@ func (c *Coordinator) directRepositoryScopedMessage(ctx context.Context, call gr
 	case protoregistry.OpAccessor:
 		ps, err = c.accessorStreamParameters(ctx, call)
 	case protoregistry.OpMutator:
-		ps, err = c.mutatorStreamParameters(ctx, call)
+		// Dry runs of mutators don't modify the repository and thus don't cast any votes. If
+		// they were proxied like mutators, we'd consider all secondaries to be outdated and
+		// replicate to them after every dry run. We thus route them like accessors instead.
+		if isDryRun(call.msg) {
+			ps, err = c.accessorStreamParameters(ctx, call)
+		} else {
+			ps, err = c.mutatorStreamParameters(ctx, call)
+		}
 	case protoregistry.OpMaintenance:
 		ps, err = c.maintenanceStreamParameters(ctx, call)
 	default:
@@ -287,7 +302,24 @@ func (c *Coordinator) directRepositoryScopedMessage(ctx context.Context, call gr
 	return ps, nil
 }
 
+// dryRunRequest is implemented by requests of mutators which support dry runs.
+type dryRunRequest interface {
+	GetDryRun() bool
+}
+
+// isDryRun determines whether the request is a dry run of a mutator.
+func isDryRun(msg proto.Message) bool {
+	request, ok := msg.(dryRunRequest)
+	return ok && request.GetDryRun()
+}
+
 func shouldRouteRepositoryAccessorToPrimary(ctx context.Context, call grpcCall) bool {
+	// Dry runs of mutators are expected to compute the same result as the mutator itself would,
+	// so they need to be served by the primary just like the mutator.
+	if call.methodInfo.Operation == protoregistry.OpMutator {
+		return true
+	}
+
 	forcePrimary := forcePrimaryRPCs[call.fullMethodName]
 
 	// In case the call's metadata tells us to force-route to the primary, then we must abide
@@ -855,12 +887,37 @@ func (c *Coordinator) createTransactionFinalizer(
 			return nil
 		}
 
+		// The reference updates committed by the primary allow outdated secondaries to be
+		// replicated incrementally in case they have been up to date before the transaction.
+		if change == datastore.UpdateRepo {
+			params = withReferenceUpdates(params, transaction.ReferenceUpdates(route.Primary.Storage))
+		}
+
 		return c.newRequestFinalizer(
 			ctx, route.RepositoryID, virtualStorage, targetRepo, route.ReplicaPath, route.Primary.Storage,
 			updated, outdated, change, params, cause)()
 	}
 }
 
+// withReferenceUpdates returns a copy of the replication job parameters which additionally
+// contain the given reference updates. The parameters are returned unmodified if there are no
+// reference updates.
+func withReferenceUpdates(params datastore.Params, updates []byte) datastore.Params {
+	if len(updates) == 0 {
+		return params
+	}
+
+	result := make(datastore.Params, len(params)+1)
+	for key, value := range params {
+		result[key] = value
+	}
+	// The parameters are serialized as JSON, which cannot represent reference names which
+	// aren't valid UTF-8. We thus encode the updates.
+	result[replicationParamReferenceUpdates] = base64.StdEncoding.EncodeToString(updates)
+
+	return result
+}
+
 // getUpdatedAndOutdatedSecondaries returns all nodes which can be considered up-to-date or outdated
 // after the given transaction. A node is considered outdated, if one of the following is true:
 //
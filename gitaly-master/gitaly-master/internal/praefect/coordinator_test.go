@@ -2193,9 +2193,10 @@ func TestCoordinator_grpcErrorHandling(t *testing.T) {
 }
 
 type mockTransaction struct {
-	nodeStates      map[string]transactions.VoteResult
-	subtransactions int
-	didVote         map[string]bool
+	nodeStates       map[string]transactions.VoteResult
+	subtransactions  int
+	didVote          map[string]bool
+	referenceUpdates map[string][]byte
 }
 
 func (t mockTransaction) ID() uint64 {
@@ -2214,6 +2215,10 @@ func (t mockTransaction) State() (map[string]transactions.VoteResult, error) {
 	return t.nodeStates, nil
 }
 
+func (t mockTransaction) ReferenceUpdates(node string) []byte {
+	return t.referenceUpdates[node]
+}
+
 func TestGetUpdatedAndOutdatedSecondaries(t *testing.T) {
 	type node struct {
 		name  string
@@ -3144,3 +3149,108 @@ func TestStreamParametersContext(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamDirector_dryRun(t *testing.T) {
+	t.Parallel()
+
+	gitalySocket := testhelper.GetTemporaryGitalySocketFileName(t)
+	testhelper.NewServerWithHealth(t, gitalySocket)
+	gitalyAddress := "unix://" + gitalySocket
+
+	conf := config.Config{
+		VirtualStorages: []*config.VirtualStorage{
+			{
+				Name: "praefect",
+				Nodes: []*config.Node{
+					{Address: gitalyAddress, Storage: "primary"},
+					{Address: gitalyAddress, Storage: "secondary"},
+				},
+			},
+		},
+	}
+
+	ctx := testhelper.Context(t)
+
+	conn, err := client.Dial(gitalyAddress, nil)
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, conn)
+
+	targetRepo := &gitalypb.Repository{
+		StorageName:  "praefect",
+		RelativePath: "/path/to/hashed/storage",
+	}
+
+	for _, tc := range []struct {
+		desc       string
+		fullMethod string
+		request    proto.Message
+	}{
+		{
+			desc:       "UserMergeBranch",
+			fullMethod: "/gitaly.OperationService/UserMergeBranch",
+			request:    &gitalypb.UserMergeBranchRequest{Repository: targetRepo, DryRun: true},
+		},
+		{
+			desc:       "UserCherryPick",
+			fullMethod: "/gitaly.OperationService/UserCherryPick",
+			request:    &gitalypb.UserCherryPickRequest{Repository: targetRepo, DryRun: true},
+		},
+		{
+			desc:       "UserRevert",
+			fullMethod: "/gitaly.OperationService/UserRevert",
+			request:    &gitalypb.UserRevertRequest{Repository: targetRepo, DryRun: true},
+		},
+		{
+			desc:       "UserSquash",
+			fullMethod: "/gitaly.OperationService/UserSquash",
+			request:    &gitalypb.UserSquashRequest{Repository: targetRepo, DryRun: true},
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var routedToPrimary bool
+			coordinator := NewCoordinator(
+				&datastore.MockReplicationEventQueue{
+					EnqueueFunc: func(context.Context, datastore.ReplicationEvent) (datastore.ReplicationEvent, error) {
+						require.FailNow(t, "dry run must not create replication jobs")
+						return datastore.ReplicationEvent{}, nil
+					},
+				},
+				datastore.MockRepositoryStore{
+					IncrementGenerationFunc: func(context.Context, int64, string, []string) error {
+						require.FailNow(t, "dry run must not increment the generation")
+						return nil
+					},
+				},
+				mockRouter{
+					routeRepositoryAccessorFunc: func(_ context.Context, _, relativePath string, forcePrimary bool) (RepositoryAccessorRoute, error) {
+						routedToPrimary = forcePrimary
+						return RepositoryAccessorRoute{
+							ReplicaPath: relativePath,
+							Node:        RouterNode{Storage: "primary", Connection: conn},
+						}, nil
+					},
+					routeRepositoryMutator: func(context.Context, string, string, string) (RepositoryMutatorRoute, error) {
+						require.FailNow(t, "dry run must not be routed as mutator")
+						return RepositoryMutatorRoute{}, nil
+					},
+				},
+				transactions.NewManager(conf),
+				conf,
+				protoregistry.GitalyProtoPreregistered,
+			)
+
+			frame, err := proto.Marshal(tc.request)
+			require.NoError(t, err)
+
+			streamParams, err := coordinator.StreamDirector(ctx, tc.fullMethod, &mockPeeker{frame: frame})
+			require.NoError(t, err)
+			require.True(t, routedToPrimary)
+			require.Empty(t, streamParams.Secondaries())
+			require.NoError(t, streamParams.RequestFinalizer())
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package praefect
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"sync"
@@ -32,6 +34,50 @@ type Replicator interface {
 	Rename(ctx context.Context, event datastore.ReplicationEvent, target *grpc.ClientConn) error
 }
 
+// replicationParamReferenceUpdates is the key of the replication job parameter which contains the
+// base64-encoded reference updates which have been committed on the source node. The updates are in
+// the format Git passes them to the reference-transaction hook.
+const replicationParamReferenceUpdates = "ReferenceUpdates"
+
+// referenceUpdatesFromParams extracts the reference updates from the replication job parameters.
+// Returns no updates in case the parameters don't contain any.
+func referenceUpdatesFromParams(params datastore.Params) ([]*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate, error) {
+	value, ok := params[replicationParamReferenceUpdates]
+	if !ok {
+		return nil, nil
+	}
+
+	encoded, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", value)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decoding: %w", err)
+	}
+
+	var updates []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate
+	for _, line := range bytes.Split(decoded, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		fields := bytes.SplitN(line, []byte(" "), 3)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid reference update %q", line)
+		}
+
+		updates = append(updates, &gitalypb.ReplicateRepositoryRequest_ReferenceUpdate{
+			Reference: fields[2],
+			OldOid:    string(fields[0]),
+			NewOid:    string(fields[1]),
+		})
+	}
+
+	return updates, nil
+}
+
 type defaultReplicator struct {
 	rs  datastore.RepositoryStore
 	log logrus.FieldLogger
@@ -73,11 +119,20 @@ func (dr defaultReplicator) Replicate(ctx context.Context, event datastore.Repli
 		return fmt.Errorf("get replicated generation: %w", err)
 	}
 
+	// Reference updates are only an optimization which allows the target to replicate the
+	// repository incrementally, so we simply replicate the full repository if they are broken.
+	referenceUpdates, err := referenceUpdatesFromParams(event.Job.Params)
+	if err != nil {
+		logger.WithError(err).Warn("invalid reference updates, replicating full repository")
+		referenceUpdates = nil
+	}
+
 	targetRepositoryClient := gitalypb.NewRepositoryServiceClient(targetCC)
 
 	if _, err := targetRepositoryClient.ReplicateRepository(ctx, &gitalypb.ReplicateRepositoryRequest{
-		Source:     sourceRepository,
-		Repository: targetRepository,
+		Source:           sourceRepository,
+		Repository:       targetRepository,
+		ReferenceUpdates: referenceUpdates,
 	}); err != nil {
 		if errors.Is(err, repository.ErrInvalidSourceRepository) {
 			if err := dr.rs.DeleteInvalidRepository(ctx, event.Job.RepositoryID, event.Job.SourceNodeStorage); err != nil {
@@ -4,6 +4,9 @@ package praefect
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -857,3 +860,83 @@ func TestReplMgr_ProcessStale(t *testing.T) {
 	require.Equal(t, "replication_manager", hook.LastEntry().Data["component"])
 	require.Equal(t, assert.AnError, hook.LastEntry().Data["error"])
 }
+
+func TestReferenceUpdatesFromParams(t *testing.T) {
+	t.Parallel()
+
+	zeroOID := gittest.DefaultObjectHash.ZeroOID.String()
+	oid := strings.Repeat("1", gittest.DefaultObjectHash.EncodedLen())
+
+	for _, tc := range []struct {
+		desc            string
+		params          datastore.Params
+		expectedUpdates []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate
+		expectedErr     error
+	}{
+		{
+			desc: "no reference updates",
+		},
+		{
+			desc: "empty reference updates are not recorded",
+			params: withReferenceUpdates(datastore.Params{
+				"RelativePath": "path",
+			}, nil),
+		},
+		{
+			desc: "reference updates",
+			params: withReferenceUpdates(datastore.Params{
+				"RelativePath": "path",
+			}, []byte(fmt.Sprintf("%[1]s %[2]s refs/heads/main\n%[2]s %[1]s refs/heads/with space\n", zeroOID, oid))),
+			expectedUpdates: []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate{
+				{Reference: []byte("refs/heads/main"), OldOid: zeroOID, NewOid: oid},
+				{Reference: []byte("refs/heads/with space"), OldOid: oid, NewOid: zeroOID},
+			},
+		},
+		{
+			desc: "non-UTF-8 reference",
+			params: withReferenceUpdates(nil,
+				[]byte(fmt.Sprintf("%s %s refs/heads/\xff\n", zeroOID, oid)),
+			),
+			expectedUpdates: []*gitalypb.ReplicateRepositoryRequest_ReferenceUpdate{
+				{Reference: []byte("refs/heads/\xff"), OldOid: zeroOID, NewOid: oid},
+			},
+		},
+		{
+			desc:        "unexpected type",
+			params:      datastore.Params{replicationParamReferenceUpdates: 1},
+			expectedErr: errors.New("unexpected type float64"),
+		},
+		{
+			desc:        "invalid encoding",
+			params:      datastore.Params{replicationParamReferenceUpdates: "%"},
+			expectedErr: fmt.Errorf("decoding: %w", base64.CorruptInputError(0)),
+		},
+		{
+			desc:        "invalid update",
+			params:      withReferenceUpdates(nil, []byte("invalid\n")),
+			expectedErr: errors.New("invalid reference update \"invalid\""),
+		},
+	} {
+		tc := tc
+
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			// The parameters are persisted as JSON, so we need to make sure that they survive the
+			// round trip.
+			var params datastore.Params
+			if tc.params != nil {
+				job, err := datastore.ReplicationJob{Params: tc.params}.Value()
+				require.NoError(t, err)
+
+				var decodedJob datastore.ReplicationJob
+				require.NoError(t, decodedJob.Scan([]byte(job.(string))))
+				params = decodedJob.Params
+			}
+
+			updates, err := referenceUpdatesFromParams(params)
+			require.Equal(t, tc.expectedErr, err)
+			testhelper.ProtoEqual(t, tc.expectedUpdates, updates)
+		})
+	}
+}
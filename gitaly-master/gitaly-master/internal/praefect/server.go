@@ -32,6 +32,7 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/service/transaction"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/praefect/transactions"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/sidechannel"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/tracing"
 	"gitlab.com/gitlab-org/gitaly/v15/proto/go/gitalypb"
 	grpccorrelation "gitlab.com/gitlab-org/labkit/correlation/grpc"
 	grpctracing "gitlab.com/gitlab-org/labkit/tracing/grpc"
@@ -71,6 +72,7 @@ func commonUnaryServerInterceptors(logger *logrus.Entry) []grpc.UnaryServerInter
 		sentryhandler.UnaryLogHandler,
 		statushandler.Unary, // Should be below LogHandler
 		grpctracing.UnaryServerTracingInterceptor(),
+		tracing.UnaryServerInterceptor(),
 		// Panic handler should remain last so that application panics will be
 		// converted to errors and logged
 		panichandler.UnaryPanicHandler,
@@ -108,6 +110,7 @@ func NewGRPCServer(
 		sentryhandler.StreamLogHandler,
 		statushandler.Stream, // Should be below LogHandler
 		grpctracing.StreamServerTracingInterceptor(),
+		tracing.StreamServerInterceptor(),
 		auth.StreamServerInterceptor(conf.Auth),
 		// Panic handler should remain last so that application panics will be
 		// converted to errors and logged
@@ -51,6 +51,18 @@ func (s *Server) VoteTransaction(ctx context.Context, in *gitalypb.VoteTransacti
 		}
 	}
 
+	// Reference updates are only recorded after the node has successfully committed them so
+	// that the recorded updates reflect what has actually been written to disk.
+	if in.GetPhase() == gitalypb.VoteTransactionRequest_COMMITTED_PHASE && len(in.GetReferenceUpdates()) > 0 {
+		if err := s.txMgr.RecordReferenceUpdates(in.TransactionId, in.Node, in.GetReferenceUpdates()); err != nil {
+			if errors.Is(err, transactions.ErrNotFound) {
+				return nil, structerr.NewNotFound("%w", err)
+			}
+
+			return nil, structerr.NewInternal("%w", err)
+		}
+	}
+
 	return &gitalypb.VoteTransactionResponse{
 		State: gitalypb.VoteTransactionResponse_COMMIT,
 	}, nil
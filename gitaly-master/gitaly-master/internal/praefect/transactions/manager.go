@@ -209,6 +209,23 @@ func (mgr *Manager) VoteTransaction(ctx context.Context, transactionID uint64, n
 	return nil
 }
 
+// RecordReferenceUpdates records reference updates which the given node has committed as part of
+// the transaction. The updates can then be used to replicate the changes incrementally to replicas
+// which have not been part of the transaction.
+func (mgr *Manager) RecordReferenceUpdates(transactionID uint64, node string, updates []byte) error {
+	mgr.lock.Lock()
+	transaction, ok := mgr.transactions[transactionID]
+	mgr.lock.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrNotFound, transactionID)
+	}
+
+	transaction.recordReferenceUpdates(node, updates)
+
+	return nil
+}
+
 // StopTransaction will gracefully stop a transaction.
 func (mgr *Manager) StopTransaction(ctx context.Context, transactionID uint64) error {
 	mgr.lock.Lock()
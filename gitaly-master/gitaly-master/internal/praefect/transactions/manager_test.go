@@ -63,3 +63,38 @@ func TestManager_CancelTransactionNodeVoter(t *testing.T) {
 		})
 	}
 }
+
+func TestManager_RecordReferenceUpdates(t *testing.T) {
+	t.Parallel()
+
+	ctx := testhelper.Context(t)
+	manager := NewManager(config.Config{})
+
+	require.Equal(t, "transaction not found: 0", manager.RecordReferenceUpdates(0, "1", []byte("update\n")).Error())
+
+	transaction, cleanup, err := manager.RegisterTransaction(ctx, []Voter{
+		{Name: "1", Votes: 1},
+		{Name: "2", Votes: 1},
+	}, 2)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, cleanup())
+	}()
+
+	require.Nil(t, transaction.ReferenceUpdates("1"))
+
+	// Updates are accumulated per node in the order they have been recorded.
+	require.NoError(t, manager.RecordReferenceUpdates(transaction.ID(), "1", []byte("first\n")))
+	require.NoError(t, manager.RecordReferenceUpdates(transaction.ID(), "1", []byte("second\n")))
+	require.NoError(t, manager.RecordReferenceUpdates(transaction.ID(), "2", []byte("other\n")))
+	require.Equal(t, []byte("first\nsecond\n"), transaction.ReferenceUpdates("1"))
+	require.Equal(t, []byte("other\n"), transaction.ReferenceUpdates("2"))
+
+	// Updates exceeding the size limit are discarded completely, including any updates
+	// recorded afterwards.
+	require.NoError(t, manager.RecordReferenceUpdates(transaction.ID(), "1", make([]byte, maxReferenceUpdatesSize)))
+	require.Nil(t, transaction.ReferenceUpdates("1"))
+	require.NoError(t, manager.RecordReferenceUpdates(transaction.ID(), "1", []byte("third\n")))
+	require.Nil(t, transaction.ReferenceUpdates("1"))
+	require.Equal(t, []byte("other\n"), transaction.ReferenceUpdates("2"))
+}
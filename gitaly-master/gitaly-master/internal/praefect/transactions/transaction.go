@@ -59,8 +59,15 @@ type Transaction interface {
 	State() (map[string]VoteResult, error)
 	// DidVote returns whether the given node has cast a vote.
 	DidVote(string) bool
+	// ReferenceUpdates returns the reference updates the given node has committed as part of
+	// the transaction. Returns `nil` if the updates are not known.
+	ReferenceUpdates(string) []byte
 }
 
+// maxReferenceUpdatesSize is the maximum size of reference updates recorded per node. Updates of
+// transactions exceeding this limit are discarded so that huge pushes don't bloat memory usage.
+const maxReferenceUpdatesSize = 64 * 1024
+
 // transaction is a session where a set of voters votes on one or more
 // subtransactions. Subtransactions are a sequence of sessions, where each node
 // needs to go through the same sequence and agree on the same thing in the end
@@ -73,6 +80,9 @@ type transaction struct {
 	lock            sync.Mutex
 	state           transactionState
 	subtransactions []*subtransaction
+	// referenceUpdates are the reference updates committed by each node. A `nil` value
+	// indicates that the updates have been discarded because they exceeded the size limit.
+	referenceUpdates map[string][]byte
 }
 
 func newTransaction(id uint64, voters []Voter, threshold uint) (*transaction, error) {
@@ -104,10 +114,11 @@ func newTransaction(id uint64, voters []Voter, threshold uint) (*transaction, er
 	}
 
 	return &transaction{
-		id:        id,
-		threshold: threshold,
-		voters:    voters,
-		state:     transactionOpen,
+		id:               id,
+		threshold:        threshold,
+		voters:           voters,
+		state:            transactionOpen,
+		referenceUpdates: make(map[string][]byte, len(voters)),
 	}, nil
 }
 
@@ -208,6 +219,35 @@ func (t *transaction) DidVote(node string) bool {
 	return vote != nil
 }
 
+// ReferenceUpdates returns the reference updates the given node has committed as part of the
+// transaction in the order they have been committed. Returns `nil` if the node didn't record any
+// reference updates or if they exceeded the size limit.
+func (t *transaction) ReferenceUpdates(node string) []byte {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.referenceUpdates[node]
+}
+
+// recordReferenceUpdates records reference updates committed by the given node.
+func (t *transaction) recordReferenceUpdates(node string, updates []byte) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	recorded, ok := t.referenceUpdates[node]
+	if ok && recorded == nil {
+		// The updates have been discarded already.
+		return
+	}
+
+	if len(recorded)+len(updates) > maxReferenceUpdatesSize {
+		t.referenceUpdates[node] = nil
+		return
+	}
+
+	t.referenceUpdates[node] = append(recorded, updates...)
+}
+
 // getOrCreateSubtransaction gets an ongoing subtransaction on which the given
 // node hasn't yet voted on or creates a new one if the node has succeeded on
 // all subtransactions. In case the node has failed on any of the
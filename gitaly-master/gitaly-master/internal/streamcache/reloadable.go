@@ -0,0 +1,59 @@
+package streamcache
+
+import (
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+)
+
+var _ = Cache(&ReloadableCache{})
+
+// ReloadableCache is a Cache whose underlying cache can be replaced at runtime when its
+// configuration changes. Streams which have been handed out by the previous cache stay valid
+// after a reload.
+type ReloadableCache struct {
+	m      sync.RWMutex
+	cache  Cache
+	logger logrus.FieldLogger
+}
+
+// NewReloadable returns a new cache instance that can be reconfigured via Reload.
+func NewReloadable(cfg config.StreamCacheConfig, logger logrus.FieldLogger) *ReloadableCache {
+	return &ReloadableCache{
+		cache:  New(cfg, logger),
+		logger: logger,
+	}
+}
+
+// FindOrCreate calls FindOrCreate on the currently active cache.
+func (rc *ReloadableCache) FindOrCreate(key string, create func(io.Writer) error) (*Stream, bool, error) {
+	rc.m.RLock()
+	cache := rc.cache
+	rc.m.RUnlock()
+
+	return cache.FindOrCreate(key, create)
+}
+
+// Reload replaces the active cache with a new one created from the given configuration. The
+// previous cache is stopped after it has been swapped out.
+func (rc *ReloadableCache) Reload(cfg config.StreamCacheConfig) {
+	packObjectsCacheEnabled.Reset()
+	cache := New(cfg, rc.logger)
+
+	rc.m.Lock()
+	previous := rc.cache
+	rc.cache = cache
+	rc.m.Unlock()
+
+	previous.Stop()
+}
+
+// Stop stops the currently active cache.
+func (rc *ReloadableCache) Stop() {
+	rc.m.RLock()
+	defer rc.m.RUnlock()
+
+	rc.cache.Stop()
+}
@@ -0,0 +1,63 @@
+package streamcache
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/helper/duration"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/log"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+)
+
+func TestReloadableCache(t *testing.T) {
+	ctx := testhelper.Context(t)
+
+	tmp := testhelper.TempDir(t)
+	firstDir := filepath.Join(tmp, "first")
+	secondDir := filepath.Join(tmp, "second")
+
+	c := NewReloadable(config.StreamCacheConfig{
+		Enabled: true,
+		Dir:     firstDir,
+		MaxAge:  duration.Duration(time.Hour),
+	}, log.Default())
+	defer c.Stop()
+
+	r, created, err := c.FindOrCreate("key", writeString("first"))
+	require.NoError(t, err)
+	require.True(t, created)
+
+	c.Reload(config.StreamCacheConfig{
+		Enabled: true,
+		Dir:     secondDir,
+		MaxAge:  duration.Duration(time.Hour),
+	})
+
+	// Streams handed out before the reload must still be readable.
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Wait(ctx))
+	require.NoError(t, r.Close())
+	require.Equal(t, "first", string(out))
+	requireCacheFiles(t, firstDir, 1)
+
+	r, created, err = c.FindOrCreate("key", writeString("second"))
+	require.NoError(t, err)
+	require.True(t, created, "reloaded cache should not know about previous entries")
+	out, err = io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Wait(ctx))
+	require.NoError(t, r.Close())
+	require.Equal(t, "second", string(out))
+	requireCacheFiles(t, secondDir, 1)
+
+	c.Reload(config.StreamCacheConfig{})
+
+	c.m.RLock()
+	require.Equal(t, NullCache{}, c.cache)
+	c.m.RUnlock()
+}
@@ -19,11 +19,13 @@ import (
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/housekeeping"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git/updateref"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/git2go"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly"
 	internalclient "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/client"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/auth"
 	gitalylog "gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/log"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/hook"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/refbarrier"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/rubyserver"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/server"
 	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/service"
@@ -268,6 +270,8 @@ type gitalyServerDeps struct {
 	git2goExecutor                *git2go.Executor
 	updaterWithHooks              *updateref.UpdaterWithHooks
 	housekeepingManager           housekeeping.Manager
+	refBarrier                    *refbarrier.Barrier
+	partitionManager              *gitaly.PartitionManager
 }
 
 func (gsd *gitalyServerDeps) createDependencies(tb testing.TB, cfg config.Cfg, rubyServer *rubyserver.Server) *service.Dependencies {
@@ -301,8 +305,12 @@ func (gsd *gitalyServerDeps) createDependencies(tb testing.TB, cfg config.Cfg, r
 		gsd.gitCmdFactory = gittest.NewCommandFactory(tb, cfg)
 	}
 
+	if gsd.refBarrier == nil {
+		gsd.refBarrier = refbarrier.New()
+	}
+
 	if gsd.hookMgr == nil {
-		gsd.hookMgr = hook.NewManager(cfg, gsd.locator, gsd.gitCmdFactory, gsd.txMgr, gsd.gitlabClient)
+		gsd.hookMgr = hook.NewManager(cfg, gsd.locator, gsd.gitCmdFactory, gsd.txMgr, gsd.gitlabClient, nil, gsd.refBarrier)
 	}
 
 	if gsd.catfileCache == nil {
@@ -368,6 +376,8 @@ func (gsd *gitalyServerDeps) createDependencies(tb testing.TB, cfg config.Cfg, r
 		Git2goExecutor:                gsd.git2goExecutor,
 		UpdaterWithHooks:              gsd.updaterWithHooks,
 		HousekeepingManager:           gsd.housekeepingManager,
+		RefBarrier:                    gsd.refBarrier,
+		PartitionManager:              gsd.partitionManager,
 	}
 }
 
@@ -473,3 +483,12 @@ func WithHousekeepingManager(manager housekeeping.Manager) GitalyServerOpt {
 		return deps
 	}
 }
+
+// WithPartitionManager sets the gitaly.PartitionManager that will be used for Gitaly services
+// initialization. The write-ahead log is disabled by default.
+func WithPartitionManager(partitionManager *gitaly.PartitionManager) GitalyServerOpt {
+	return func(deps gitalyServerDeps) gitalyServerDeps {
+		deps.partitionManager = partitionManager
+		return deps
+	}
+}
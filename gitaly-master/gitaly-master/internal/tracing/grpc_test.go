@@ -0,0 +1,126 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/structerr"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/grpc_testing"
+)
+
+type mockService struct {
+	grpc_testing.UnimplementedTestServiceServer
+	spanContext trace.SpanContext
+	err         error
+}
+
+func (m *mockService) UnaryCall(ctx context.Context, _ *grpc_testing.SimpleRequest) (*grpc_testing.SimpleResponse, error) {
+	m.spanContext = trace.SpanContextFromContext(ctx)
+	return &grpc_testing.SimpleResponse{}, m.err
+}
+
+func (m *mockService) StreamingOutputCall(_ *grpc_testing.StreamingOutputCallRequest, stream grpc_testing.TestService_StreamingOutputCallServer) error {
+	m.spanContext = trace.SpanContextFromContext(stream.Context())
+	return m.err
+}
+
+func setupInterceptedService(t *testing.T) (*mockService, grpc_testing.TestServiceClient) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	service := &mockService{}
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryServerInterceptor()),
+		grpc.StreamInterceptor(StreamServerInterceptor()),
+	)
+	grpc_testing.RegisterTestServiceServer(server, service)
+
+	go testhelper.MustServe(t, server, listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(StreamClientInterceptor()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { testhelper.MustClose(t, conn) })
+
+	return service, grpc_testing.NewTestServiceClient(conn)
+}
+
+func TestInterceptors(t *testing.T) {
+	service, client := setupInterceptedService(t)
+	ctx := testhelper.Context(t)
+
+	t.Run("unary call without parent", func(t *testing.T) {
+		recorder := stubTracerProvider(t)
+
+		_, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+		require.NoError(t, err)
+
+		ended := recorder.Ended()
+		require.Len(t, ended, 1)
+		require.Equal(t, "/grpc.testing.TestService/UnaryCall", ended[0].Name())
+		require.Equal(t, trace.SpanKindServer, ended[0].SpanKind())
+		require.False(t, ended[0].Parent().IsValid())
+		require.Equal(t, ended[0].SpanContext(), service.spanContext)
+	})
+
+	t.Run("unary call with parent", func(t *testing.T) {
+		recorder := stubTracerProvider(t)
+
+		ctx, parent := Tracer().Start(ctx, "client")
+		_, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+		require.NoError(t, err)
+		parent.End()
+
+		ended := recorder.Ended()
+		require.Len(t, ended, 2)
+		require.Equal(t, parent.SpanContext().TraceID(), ended[0].SpanContext().TraceID())
+		require.Equal(t, parent.SpanContext().SpanID(), ended[0].Parent().SpanID())
+		require.True(t, ended[0].Parent().IsRemote())
+	})
+
+	t.Run("streaming call with parent", func(t *testing.T) {
+		recorder := stubTracerProvider(t)
+
+		ctx, parent := Tracer().Start(ctx, "client")
+		stream, err := client.StreamingOutputCall(ctx, &grpc_testing.StreamingOutputCallRequest{})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		require.Error(t, err)
+		parent.End()
+
+		require.Equal(t, parent.SpanContext().TraceID(), service.spanContext.TraceID())
+
+		ended := recorder.Ended()
+		require.Len(t, ended, 2)
+		require.Equal(t, "/grpc.testing.TestService/StreamingOutputCall", ended[0].Name())
+		require.Equal(t, parent.SpanContext().SpanID(), ended[0].Parent().SpanID())
+	})
+
+	t.Run("failing call", func(t *testing.T) {
+		recorder := stubTracerProvider(t)
+		service.err = structerr.NewNotFound("not found")
+		defer func() { service.err = nil }()
+
+		_, err := client.UnaryCall(ctx, &grpc_testing.SimpleRequest{})
+		testhelper.RequireGrpcError(t, structerr.NewNotFound("not found"), err)
+
+		ended := recorder.Ended()
+		require.Len(t, ended, 1)
+		require.Equal(t, codes.Error, ended[0].Status().Code)
+		require.Contains(t, ended[0].Attributes(), attribute.String("rpc.grpc.status_code", "NotFound"))
+	})
+}
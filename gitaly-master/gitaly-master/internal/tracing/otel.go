@@ -0,0 +1,199 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/telemetry"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const instrumentationName = "gitlab.com/gitlab-org/gitaly"
+
+// propagator is the propagator used to transfer trace context across process boundaries, both
+// via gRPC metadata and via environment variables of spawned subprocesses.
+var propagator propagation.TextMapPropagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// Initialize sets up the global OpenTelemetry tracer provider of the process so that spans are
+// exported as configured. The returned function flushes all pending spans and must be called
+// before the process exits. If exporting is disabled, spans are not recorded but trace context
+// is still propagated.
+func Initialize(ctx context.Context, serviceName, version string, cfg telemetry.Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagator)
+
+	if !cfg.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var client otlptrace.Client
+	if cfg.File != "" {
+		client = newFileClient(cfg.File)
+	} else {
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		client = otlptracegrpc.NewClient(opts...)
+	}
+
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("creating trace exporter: %w", err)
+	}
+
+	tracerProvider := NewTracerProvider(serviceName, version, cfg.SampleRatio, sdktrace.WithBatcher(exporter))
+	otel.SetTracerProvider(tracerProvider)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// NewTracerProvider creates a new tracer provider for the given service. Traces started by the
+// service are sampled with the given ratio, where a ratio of 0 never samples and a ratio of 1
+// always samples. Traces continued from a remote parent follow the parent's sampling decision.
+func NewTracerProvider(serviceName, version string, sampleRatio float64, opts ...sdktrace.TracerProviderOption) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+			semconv.ServiceVersionKey.String(version),
+		)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	}, opts...)...)
+}
+
+// Tracer returns the OpenTelemetry tracer spans of Gitaly are created with.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartChildSpan creates a new OpenTelemetry span if the context already has a valid span
+// context. Like StartSpanIfHasParent, this prevents orphaned spans from code paths that are not
+// part of a request. Otherwise, a non-recording span is returned together with the unmodified
+// context.
+func StartChildSpan(ctx context.Context, spanName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+
+	return Tracer().Start(ctx, spanName, trace.WithAttributes(attrs...))
+}
+
+// InjectIntoEnv injects the trace context of the span in ctx into the environment variables of a
+// subprocess. Variables are named after the upper-cased propagation fields, e.g. TRACEPARENT.
+// Previously set values are replaced.
+func InjectIntoEnv(ctx context.Context, env []string) []string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	for _, key := range propagator.Fields() {
+		envKey := strings.ToUpper(key)
+
+		filtered := make([]string, 0, len(env))
+		for _, kv := range env {
+			if !strings.HasPrefix(kv, envKey+"=") {
+				filtered = append(filtered, kv)
+			}
+		}
+		env = filtered
+
+		if value := carrier.Get(key); value != "" {
+			env = append(env, envKey+"="+value)
+		}
+	}
+
+	return env
+}
+
+// ExtractFromEnv extracts trace context injected into the environment via InjectIntoEnv and
+// returns a context with the remote span as parent.
+func ExtractFromEnv(ctx context.Context, env []string) context.Context {
+	carrier := propagation.MapCarrier{}
+	for _, key := range propagator.Fields() {
+		envKey := strings.ToUpper(key)
+
+		for _, kv := range env {
+			if strings.HasPrefix(kv, envKey+"=") {
+				carrier.Set(key, kv[len(envKey)+1:])
+			}
+		}
+	}
+
+	return propagator.Extract(ctx, carrier)
+}
+
+// fileClient is an OTLP client which appends exported spans to a file. Each upload is written as
+// a single line containing a JSON-encoded TracesData message.
+type fileClient struct {
+	path string
+
+	mutex sync.Mutex
+	file  *os.File
+}
+
+func newFileClient(path string) *fileClient {
+	return &fileClient{path: path}
+}
+
+// Start opens the file spans are appended to.
+func (c *fileClient) Start(context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	file, err := os.OpenFile(c.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening trace file: %w", err)
+	}
+	c.file = file
+
+	return nil
+}
+
+// Stop closes the file spans are appended to.
+func (c *fileClient) Stop(context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.file == nil {
+		return nil
+	}
+
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+// UploadTraces appends the spans to the file.
+func (c *fileClient) UploadTraces(_ context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	encoded, err := protojson.Marshal(&tracepb.TracesData{ResourceSpans: protoSpans})
+	if err != nil {
+		return fmt.Errorf("encoding spans: %w", err)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.file == nil {
+		return fmt.Errorf("trace file is not open")
+	}
+
+	if _, err := c.file.Write(append(encoded, '\n')); err != nil {
+		return fmt.Errorf("writing spans: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package tracing
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/gitaly/config/telemetry"
+	"gitlab.com/gitlab-org/gitaly/v15/internal/testhelper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestInitialize_file(t *testing.T) {
+	resetTracerProvider(t)
+
+	ctx := testhelper.Context(t)
+	path := filepath.Join(testhelper.TempDir(t), "traces.json")
+
+	shutdown, err := Initialize(ctx, "gitaly", "1.0.0", telemetry.Config{File: path, SampleRatio: 1})
+	require.NoError(t, err)
+
+	ctx, root := Tracer().Start(ctx, "root")
+	_, child := StartChildSpan(ctx, "child", attribute.String("key", "value"))
+	child.End()
+	root.End()
+
+	require.NoError(t, shutdown(ctx))
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer testhelper.MustClose(t, file)
+
+	var spanNames []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var traces tracepb.TracesData
+		require.NoError(t, protojson.Unmarshal(scanner.Bytes(), &traces))
+
+		for _, resourceSpans := range traces.GetResourceSpans() {
+			require.Contains(t, protojson.Format(resourceSpans.GetResource()), `"gitaly"`)
+
+			for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+				for _, span := range scopeSpans.GetSpans() {
+					require.Equal(t, root.SpanContext().TraceID().String(), traceIDString(span.GetTraceId()))
+					spanNames = append(spanNames, span.GetName())
+				}
+			}
+		}
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []string{"child", "root"}, spanNames)
+}
+
+func TestInitialize_disabled(t *testing.T) {
+	resetTracerProvider(t)
+
+	ctx := testhelper.Context(t)
+
+	shutdown, err := Initialize(ctx, "gitaly", "1.0.0", telemetry.Config{})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(ctx))
+
+	_, span := Tracer().Start(ctx, "root")
+	require.False(t, span.IsRecording())
+}
+
+func TestStartChildSpan(t *testing.T) {
+	recorder := stubTracerProvider(t)
+	ctx := testhelper.Context(t)
+
+	_, span := StartChildSpan(ctx, "orphan")
+	span.End()
+	require.Empty(t, recorder.Ended())
+
+	ctx, root := Tracer().Start(ctx, "root")
+	_, child := StartChildSpan(ctx, "child", attribute.String("key", "value"))
+	child.End()
+	root.End()
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 2)
+	require.Equal(t, "child", ended[0].Name())
+	require.Equal(t, root.SpanContext().SpanID(), ended[0].Parent().SpanID())
+	require.Equal(t, []attribute.KeyValue{attribute.String("key", "value")}, ended[0].Attributes())
+}
+
+func TestEnvPropagation(t *testing.T) {
+	stubTracerProvider(t)
+	ctx := testhelper.Context(t)
+
+	t.Run("without span", func(t *testing.T) {
+		env := InjectIntoEnv(ctx, []string{"FOO=bar"})
+		require.Equal(t, []string{"FOO=bar"}, env)
+		require.False(t, trace.SpanContextFromContext(ExtractFromEnv(ctx, env)).IsValid())
+	})
+
+	t.Run("with span", func(t *testing.T) {
+		ctx, span := Tracer().Start(ctx, "root")
+		defer span.End()
+
+		env := InjectIntoEnv(ctx, []string{"FOO=bar", "TRACEPARENT=stale"})
+		require.Len(t, env, 2)
+		require.Equal(t, "FOO=bar", env[0])
+		require.Regexp(t, "^TRACEPARENT=00-"+span.SpanContext().TraceID().String()+"-"+span.SpanContext().SpanID().String()+"-01$", env[1])
+
+		extracted := trace.SpanContextFromContext(ExtractFromEnv(testhelper.Context(t), env))
+		require.True(t, extracted.IsRemote())
+		require.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+		require.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+	})
+}
+
+func traceIDString(id []byte) string {
+	var traceID trace.TraceID
+	copy(traceID[:], id)
+	return traceID.String()
+}
+
+// resetTracerProvider resets the global tracer provider to a no-op provider when the test
+// finishes.
+func resetTracerProvider(t *testing.T) {
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+}
+
+func stubTracerProvider(t *testing.T) *tracetest.SpanRecorder {
+	resetTracerProvider(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := NewTracerProvider("gitaly", "1.0.0", 1, sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(tracerProvider)
+
+	return recorder
+}
@@ -157,6 +157,60 @@ func (CustomHookError_HookType) EnumDescriptor() ([]byte, []int) {
 	return file_errors_proto_rawDescGZIP(), []int{12, 0}
 }
 
+// Scope is the scope of the quota that has been exceeded.
+type QuotaExceededError_Scope int32
+
+const (
+	// SCOPE_UNSPECIFIED is the default scope and should never be set.
+	QuotaExceededError_SCOPE_UNSPECIFIED QuotaExceededError_Scope = 0
+	// SCOPE_REPOSITORY indicates that the quota of the repository has been exceeded.
+	QuotaExceededError_SCOPE_REPOSITORY QuotaExceededError_Scope = 1
+	// SCOPE_STORAGE indicates that the quota of the storage the repository is located in has been
+	// exceeded.
+	QuotaExceededError_SCOPE_STORAGE QuotaExceededError_Scope = 2
+)
+
+// Enum value maps for QuotaExceededError_Scope.
+var (
+	QuotaExceededError_Scope_name = map[int32]string{
+		0: "SCOPE_UNSPECIFIED",
+		1: "SCOPE_REPOSITORY",
+		2: "SCOPE_STORAGE",
+	}
+	QuotaExceededError_Scope_value = map[string]int32{
+		"SCOPE_UNSPECIFIED": 0,
+		"SCOPE_REPOSITORY":  1,
+		"SCOPE_STORAGE":     2,
+	}
+)
+
+func (x QuotaExceededError_Scope) Enum() *QuotaExceededError_Scope {
+	p := new(QuotaExceededError_Scope)
+	*p = x
+	return p
+}
+
+func (x QuotaExceededError_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuotaExceededError_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_errors_proto_enumTypes[2].Descriptor()
+}
+
+func (QuotaExceededError_Scope) Type() protoreflect.EnumType {
+	return &file_errors_proto_enumTypes[2]
+}
+
+func (x QuotaExceededError_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuotaExceededError_Scope.Descriptor instead.
+func (QuotaExceededError_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_errors_proto_rawDescGZIP(), []int{13, 0}
+}
+
 // AccessCheckError is an error returned by GitLab's `/internal/allowed`
 // endpoint.
 type AccessCheckError struct {
@@ -908,6 +962,93 @@ func (x *CustomHookError) GetHookType() CustomHookError_HookType {
 	return CustomHookError_HOOK_TYPE_UNSPECIFIED
 }
 
+// QuotaExceededError is an error returned when a write would cause the on-disk size of a repository
+// or storage to exceed its configured quota.
+type QuotaExceededError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Scope is the scope of the quota that has been exceeded.
+	Scope QuotaExceededError_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=gitaly.QuotaExceededError_Scope" json:"scope,omitempty"`
+	// LimitBytes is the configured quota in bytes.
+	LimitBytes int64 `protobuf:"varint,2,opt,name=limit_bytes,json=limitBytes,proto3" json:"limit_bytes,omitempty"`
+	// UsedBytes is the on-disk size in bytes of the repository or storage before the write.
+	UsedBytes int64 `protobuf:"varint,3,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	// IncomingBytes is the size in bytes of the objects which would have been written.
+	IncomingBytes int64 `protobuf:"varint,4,opt,name=incoming_bytes,json=incomingBytes,proto3" json:"incoming_bytes,omitempty"`
+	// ReadOnly is set if the repository or storage already exceeds its quota without the write. Only
+	// writes which delete references are accepted in this state.
+	ReadOnly bool `protobuf:"varint,5,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+}
+
+func (x *QuotaExceededError) Reset() {
+	*x = QuotaExceededError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_errors_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaExceededError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaExceededError) ProtoMessage() {}
+
+func (x *QuotaExceededError) ProtoReflect() protoreflect.Message {
+	mi := &file_errors_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaExceededError.ProtoReflect.Descriptor instead.
+func (*QuotaExceededError) Descriptor() ([]byte, []int) {
+	return file_errors_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QuotaExceededError) GetScope() QuotaExceededError_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return QuotaExceededError_SCOPE_UNSPECIFIED
+}
+
+func (x *QuotaExceededError) GetLimitBytes() int64 {
+	if x != nil {
+		return x.LimitBytes
+	}
+	return 0
+}
+
+func (x *QuotaExceededError) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *QuotaExceededError) GetIncomingBytes() int64 {
+	if x != nil {
+		return x.IncomingBytes
+	}
+	return 0
+}
+
+func (x *QuotaExceededError) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
 var File_errors_proto protoreflect.FileDescriptor
 
 var file_errors_proto_rawDesc = []byte{
@@ -1006,10 +1147,28 @@ var file_errors_proto_rawDesc = []byte{
 	0x12, 0x14, 0x0a, 0x10, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50,
 	0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x54,
 	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10,
-	0x03, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2d, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c,
-	0x79, 0x2f, 0x76, 0x31, 0x35, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67,
-	0x69, 0x74, 0x61, 0x6c, 0x79, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x22, 0x99, 0x02, 0x0a, 0x12, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x45, 0x78, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79,
+	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x47, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x15, 0x0a,
+	0x11, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x43,
+	0x4f, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x10, 0x02, 0x42, 0x34, 0x5a,
+	0x32, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x74, 0x6c,
+	0x61, 0x62, 0x2d, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2f, 0x76, 0x31,
+	0x35, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x79, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1024,35 +1183,38 @@ func file_errors_proto_rawDescGZIP() []byte {
 	return file_errors_proto_rawDescData
 }
 
-var file_errors_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_errors_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_errors_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_errors_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_errors_proto_goTypes = []interface{}{
 	(IndexError_ErrorType)(0),          // 0: gitaly.IndexError.ErrorType
 	(CustomHookError_HookType)(0),      // 1: gitaly.CustomHookError.HookType
-	(*AccessCheckError)(nil),           // 2: gitaly.AccessCheckError
-	(*IndexError)(nil),                 // 3: gitaly.IndexError
-	(*InvalidRefFormatError)(nil),      // 4: gitaly.InvalidRefFormatError
-	(*NotAncestorError)(nil),           // 5: gitaly.NotAncestorError
-	(*ChangesAlreadyAppliedError)(nil), // 6: gitaly.ChangesAlreadyAppliedError
-	(*MergeConflictError)(nil),         // 7: gitaly.MergeConflictError
-	(*ReferencesLockedError)(nil),      // 8: gitaly.ReferencesLockedError
-	(*ReferenceExistsError)(nil),       // 9: gitaly.ReferenceExistsError
-	(*ReferenceNotFoundError)(nil),     // 10: gitaly.ReferenceNotFoundError
-	(*ReferenceUpdateError)(nil),       // 11: gitaly.ReferenceUpdateError
-	(*ResolveRevisionError)(nil),       // 12: gitaly.ResolveRevisionError
-	(*LimitError)(nil),                 // 13: gitaly.LimitError
-	(*CustomHookError)(nil),            // 14: gitaly.CustomHookError
-	(*durationpb.Duration)(nil),        // 15: google.protobuf.Duration
+	(QuotaExceededError_Scope)(0),      // 2: gitaly.QuotaExceededError.Scope
+	(*AccessCheckError)(nil),           // 3: gitaly.AccessCheckError
+	(*IndexError)(nil),                 // 4: gitaly.IndexError
+	(*InvalidRefFormatError)(nil),      // 5: gitaly.InvalidRefFormatError
+	(*NotAncestorError)(nil),           // 6: gitaly.NotAncestorError
+	(*ChangesAlreadyAppliedError)(nil), // 7: gitaly.ChangesAlreadyAppliedError
+	(*MergeConflictError)(nil),         // 8: gitaly.MergeConflictError
+	(*ReferencesLockedError)(nil),      // 9: gitaly.ReferencesLockedError
+	(*ReferenceExistsError)(nil),       // 10: gitaly.ReferenceExistsError
+	(*ReferenceNotFoundError)(nil),     // 11: gitaly.ReferenceNotFoundError
+	(*ReferenceUpdateError)(nil),       // 12: gitaly.ReferenceUpdateError
+	(*ResolveRevisionError)(nil),       // 13: gitaly.ResolveRevisionError
+	(*LimitError)(nil),                 // 14: gitaly.LimitError
+	(*CustomHookError)(nil),            // 15: gitaly.CustomHookError
+	(*QuotaExceededError)(nil),         // 16: gitaly.QuotaExceededError
+	(*durationpb.Duration)(nil),        // 17: google.protobuf.Duration
 }
 var file_errors_proto_depIdxs = []int32{
 	0,  // 0: gitaly.IndexError.error_type:type_name -> gitaly.IndexError.ErrorType
-	15, // 1: gitaly.LimitError.retry_after:type_name -> google.protobuf.Duration
+	17, // 1: gitaly.LimitError.retry_after:type_name -> google.protobuf.Duration
 	1,  // 2: gitaly.CustomHookError.hook_type:type_name -> gitaly.CustomHookError.HookType
-	3,  // [3:3] is the sub-list for method output_type
-	3,  // [3:3] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	2,  // 3: gitaly.QuotaExceededError.scope:type_name -> gitaly.QuotaExceededError.Scope
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_errors_proto_init() }
@@ -1217,14 +1379,26 @@ func file_errors_proto_init() {
 				return nil
 			}
 		}
+		file_errors_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaExceededError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_errors_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   13,
+			NumEnums:      3,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -74,6 +74,16 @@ type OperationServiceClient interface {
 	// Following that, a variable number of actions can be sent to build a new commit. Each action consists of
 	// a header followed by content if used by the action.
 	UserCommitFiles(ctx context.Context, opts ...grpc.CallOption) (OperationService_UserCommitFilesClient, error)
+	// UserCommitPatches builds a commit by applying unified diffs to individual files of a branch
+	// and updates the branch to point to it. In contrast to UserCommitFiles, clients only need to
+	// send the changes to a file instead of its full contents. A UserCommitPatchesRequest with a
+	// UserCommitPatchesRequestHeader must be sent as the first message of the stream. Following
+	// that, a variable number of patches can be sent. Each patch consists of a header followed by
+	// the unified diff, which may be split across multiple messages.
+	//
+	// Hunks are applied with offset and fuzz handling. If any hunk fails to apply, no commit is
+	// created and all failed hunks are reported via a UserCommitPatchesError.
+	UserCommitPatches(ctx context.Context, opts ...grpc.CallOption) (OperationService_UserCommitPatchesClient, error)
 	// UserRebaseConfirmable rebases the given remote branch onto a target
 	// branch. The remote branch may be part of another repository.
 	//
@@ -242,8 +252,42 @@ func (x *operationServiceUserCommitFilesClient) CloseAndRecv() (*UserCommitFiles
 	return m, nil
 }
 
+func (c *operationServiceClient) UserCommitPatches(ctx context.Context, opts ...grpc.CallOption) (OperationService_UserCommitPatchesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OperationService_ServiceDesc.Streams[2], "/gitaly.OperationService/UserCommitPatches", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operationServiceUserCommitPatchesClient{stream}
+	return x, nil
+}
+
+type OperationService_UserCommitPatchesClient interface {
+	Send(*UserCommitPatchesRequest) error
+	CloseAndRecv() (*UserCommitPatchesResponse, error)
+	grpc.ClientStream
+}
+
+type operationServiceUserCommitPatchesClient struct {
+	grpc.ClientStream
+}
+
+func (x *operationServiceUserCommitPatchesClient) Send(m *UserCommitPatchesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *operationServiceUserCommitPatchesClient) CloseAndRecv() (*UserCommitPatchesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UserCommitPatchesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *operationServiceClient) UserRebaseConfirmable(ctx context.Context, opts ...grpc.CallOption) (OperationService_UserRebaseConfirmableClient, error) {
-	stream, err := c.cc.NewStream(ctx, &OperationService_ServiceDesc.Streams[2], "/gitaly.OperationService/UserRebaseConfirmable", opts...)
+	stream, err := c.cc.NewStream(ctx, &OperationService_ServiceDesc.Streams[3], "/gitaly.OperationService/UserRebaseConfirmable", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -292,7 +336,7 @@ func (c *operationServiceClient) UserSquash(ctx context.Context, in *UserSquashR
 }
 
 func (c *operationServiceClient) UserApplyPatch(ctx context.Context, opts ...grpc.CallOption) (OperationService_UserApplyPatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &OperationService_ServiceDesc.Streams[3], "/gitaly.OperationService/UserApplyPatch", opts...)
+	stream, err := c.cc.NewStream(ctx, &OperationService_ServiceDesc.Streams[4], "/gitaly.OperationService/UserApplyPatch", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -390,6 +434,16 @@ type OperationServiceServer interface {
 	// Following that, a variable number of actions can be sent to build a new commit. Each action consists of
 	// a header followed by content if used by the action.
 	UserCommitFiles(OperationService_UserCommitFilesServer) error
+	// UserCommitPatches builds a commit by applying unified diffs to individual files of a branch
+	// and updates the branch to point to it. In contrast to UserCommitFiles, clients only need to
+	// send the changes to a file instead of its full contents. A UserCommitPatchesRequest with a
+	// UserCommitPatchesRequestHeader must be sent as the first message of the stream. Following
+	// that, a variable number of patches can be sent. Each patch consists of a header followed by
+	// the unified diff, which may be split across multiple messages.
+	//
+	// Hunks are applied with offset and fuzz handling. If any hunk fails to apply, no commit is
+	// created and all failed hunks are reported via a UserCommitPatchesError.
+	UserCommitPatches(OperationService_UserCommitPatchesServer) error
 	// UserRebaseConfirmable rebases the given remote branch onto a target
 	// branch. The remote branch may be part of another repository.
 	//
@@ -448,6 +502,9 @@ func (UnimplementedOperationServiceServer) UserCherryPick(context.Context, *User
 func (UnimplementedOperationServiceServer) UserCommitFiles(OperationService_UserCommitFilesServer) error {
 	return status.Errorf(codes.Unimplemented, "method UserCommitFiles not implemented")
 }
+func (UnimplementedOperationServiceServer) UserCommitPatches(OperationService_UserCommitPatchesServer) error {
+	return status.Errorf(codes.Unimplemented, "method UserCommitPatches not implemented")
+}
 func (UnimplementedOperationServiceServer) UserRebaseConfirmable(OperationService_UserRebaseConfirmableServer) error {
 	return status.Errorf(codes.Unimplemented, "method UserRebaseConfirmable not implemented")
 }
@@ -672,6 +729,32 @@ func (x *operationServiceUserCommitFilesServer) Recv() (*UserCommitFilesRequest,
 	return m, nil
 }
 
+func _OperationService_UserCommitPatches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OperationServiceServer).UserCommitPatches(&operationServiceUserCommitPatchesServer{stream})
+}
+
+type OperationService_UserCommitPatchesServer interface {
+	SendAndClose(*UserCommitPatchesResponse) error
+	Recv() (*UserCommitPatchesRequest, error)
+	grpc.ServerStream
+}
+
+type operationServiceUserCommitPatchesServer struct {
+	grpc.ServerStream
+}
+
+func (x *operationServiceUserCommitPatchesServer) SendAndClose(m *UserCommitPatchesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *operationServiceUserCommitPatchesServer) Recv() (*UserCommitPatchesRequest, error) {
+	m := new(UserCommitPatchesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _OperationService_UserRebaseConfirmable_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(OperationServiceServer).UserRebaseConfirmable(&operationServiceUserRebaseConfirmableServer{stream})
 }
@@ -842,6 +925,11 @@ var OperationService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _OperationService_UserCommitFiles_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "UserCommitPatches",
+			Handler:       _OperationService_UserCommitPatches_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "UserRebaseConfirmable",
 			Handler:       _OperationService_UserRebaseConfirmable_Handler,
@@ -20,6 +20,128 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Action is the kind of update of the reference in the mirror.
+type UpdateRemoteMirrorResponse_ReferenceStatus_Action int32
+
+const (
+	// ACTION_UNSPECIFIED is the default value and should never be set.
+	UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_UNSPECIFIED UpdateRemoteMirrorResponse_ReferenceStatus_Action = 0
+	// ACTION_CREATE creates the reference in the mirror.
+	UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_CREATE UpdateRemoteMirrorResponse_ReferenceStatus_Action = 1
+	// ACTION_UPDATE updates the reference in the mirror.
+	UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_UPDATE UpdateRemoteMirrorResponse_ReferenceStatus_Action = 2
+	// ACTION_DELETE deletes the reference from the mirror.
+	UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_DELETE UpdateRemoteMirrorResponse_ReferenceStatus_Action = 3
+)
+
+// Enum value maps for UpdateRemoteMirrorResponse_ReferenceStatus_Action.
+var (
+	UpdateRemoteMirrorResponse_ReferenceStatus_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ACTION_CREATE",
+		2: "ACTION_UPDATE",
+		3: "ACTION_DELETE",
+	}
+	UpdateRemoteMirrorResponse_ReferenceStatus_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ACTION_CREATE":      1,
+		"ACTION_UPDATE":      2,
+		"ACTION_DELETE":      3,
+	}
+)
+
+func (x UpdateRemoteMirrorResponse_ReferenceStatus_Action) Enum() *UpdateRemoteMirrorResponse_ReferenceStatus_Action {
+	p := new(UpdateRemoteMirrorResponse_ReferenceStatus_Action)
+	*p = x
+	return p
+}
+
+func (x UpdateRemoteMirrorResponse_ReferenceStatus_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateRemoteMirrorResponse_ReferenceStatus_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_remote_proto_enumTypes[0].Descriptor()
+}
+
+func (UpdateRemoteMirrorResponse_ReferenceStatus_Action) Type() protoreflect.EnumType {
+	return &file_remote_proto_enumTypes[0]
+}
+
+func (x UpdateRemoteMirrorResponse_ReferenceStatus_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateRemoteMirrorResponse_ReferenceStatus_Action.Descriptor instead.
+func (UpdateRemoteMirrorResponse_ReferenceStatus_Action) EnumDescriptor() ([]byte, []int) {
+	return file_remote_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+// Status is the result of the update.
+type UpdateRemoteMirrorResponse_ReferenceStatus_Status int32
+
+const (
+	// STATUS_UNSPECIFIED is the default value and should never be set.
+	UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_UNSPECIFIED UpdateRemoteMirrorResponse_ReferenceStatus_Status = 0
+	// STATUS_PLANNED indicates that the update would have been performed if
+	// this wasn't a dry run.
+	UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_PLANNED UpdateRemoteMirrorResponse_ReferenceStatus_Status = 1
+	// STATUS_SUCCEEDED indicates that the update has been performed.
+	UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SUCCEEDED UpdateRemoteMirrorResponse_ReferenceStatus_Status = 2
+	// STATUS_FAILED indicates that the mirror rejected the update. The
+	// reason contains the mirror's explanation.
+	UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_FAILED UpdateRemoteMirrorResponse_ReferenceStatus_Status = 3
+	// STATUS_SKIPPED indicates that the update has been skipped to avoid
+	// losing data in the mirror, e.g. because the reference has diverged.
+	// The reason explains why the update has been skipped.
+	UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_SKIPPED UpdateRemoteMirrorResponse_ReferenceStatus_Status = 4
+)
+
+// Enum value maps for UpdateRemoteMirrorResponse_ReferenceStatus_Status.
+var (
+	UpdateRemoteMirrorResponse_ReferenceStatus_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PLANNED",
+		2: "STATUS_SUCCEEDED",
+		3: "STATUS_FAILED",
+		4: "STATUS_SKIPPED",
+	}
+	UpdateRemoteMirrorResponse_ReferenceStatus_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PLANNED":     1,
+		"STATUS_SUCCEEDED":   2,
+		"STATUS_FAILED":      3,
+		"STATUS_SKIPPED":     4,
+	}
+)
+
+func (x UpdateRemoteMirrorResponse_ReferenceStatus_Status) Enum() *UpdateRemoteMirrorResponse_ReferenceStatus_Status {
+	p := new(UpdateRemoteMirrorResponse_ReferenceStatus_Status)
+	*p = x
+	return p
+}
+
+func (x UpdateRemoteMirrorResponse_ReferenceStatus_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateRemoteMirrorResponse_ReferenceStatus_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_remote_proto_enumTypes[1].Descriptor()
+}
+
+func (UpdateRemoteMirrorResponse_ReferenceStatus_Status) Type() protoreflect.EnumType {
+	return &file_remote_proto_enumTypes[1]
+}
+
+func (x UpdateRemoteMirrorResponse_ReferenceStatus_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateRemoteMirrorResponse_ReferenceStatus_Status.Descriptor instead.
+func (UpdateRemoteMirrorResponse_ReferenceStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return file_remote_proto_rawDescGZIP(), []int{1, 0, 1}
+}
+
 // This comment is left unintentionally blank.
 type UpdateRemoteMirrorRequest struct {
 	state         protoimpl.MessageState
@@ -48,6 +170,22 @@ type UpdateRemoteMirrorRequest struct {
 	// KeepDivergentRefs specifies whether or not to update diverged references
 	// in the mirror repository.
 	KeepDivergentRefs bool `protobuf:"varint,6,opt,name=keep_divergent_refs,json=keepDivergentRefs,proto3" json:"keep_divergent_refs,omitempty"`
+	// IncludeRefs contains patterns of fully-qualified references which should
+	// be mirrored, e.g. "refs/tags/v*" or "refs/merge-requests/*". "*" can be
+	// used as a wildcard to match anything. If no patterns are specified, all
+	// branches and tags are mirrored. Branches are additionally filtered by
+	// only_branches_matching. include_refs can be streamed to the server over
+	// multiple messages. Optional.
+	IncludeRefs [][]byte `protobuf:"bytes,8,rep,name=include_refs,json=includeRefs,proto3" json:"include_refs,omitempty"`
+	// ExcludeRefs contains patterns of fully-qualified references which should
+	// not be mirrored, using the same syntax as include_refs. Excluded
+	// references are neither created, updated nor deleted in the mirror.
+	// exclude_refs can be streamed to the server over multiple messages.
+	// Optional.
+	ExcludeRefs [][]byte `protobuf:"bytes,9,rep,name=exclude_refs,json=excludeRefs,proto3" json:"exclude_refs,omitempty"`
+	// DryRun specifies whether only the planned reference updates should be
+	// computed and returned without pushing anything to the mirror.
+	DryRun bool `protobuf:"varint,10,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *UpdateRemoteMirrorRequest) Reset() {
@@ -124,6 +262,27 @@ func (x *UpdateRemoteMirrorRequest) GetKeepDivergentRefs() bool {
 	return false
 }
 
+func (x *UpdateRemoteMirrorRequest) GetIncludeRefs() [][]byte {
+	if x != nil {
+		return x.IncludeRefs
+	}
+	return nil
+}
+
+func (x *UpdateRemoteMirrorRequest) GetExcludeRefs() [][]byte {
+	if x != nil {
+		return x.ExcludeRefs
+	}
+	return nil
+}
+
+func (x *UpdateRemoteMirrorRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 // This comment is left unintentionally blank.
 type UpdateRemoteMirrorResponse struct {
 	state         protoimpl.MessageState
@@ -133,6 +292,12 @@ type UpdateRemoteMirrorResponse struct {
 	// DivergentRefs contains a list of references that had diverged in the
 	// mirror from the source repository.
 	DivergentRefs [][]byte `protobuf:"bytes,1,rep,name=divergent_refs,json=divergentRefs,proto3" json:"divergent_refs,omitempty"`
+	// ReferenceStatuses contains the status of each reference which has been
+	// or would have been updated in the mirror, sorted by reference name.
+	ReferenceStatuses []*UpdateRemoteMirrorResponse_ReferenceStatus `protobuf:"bytes,2,rep,name=reference_statuses,json=referenceStatuses,proto3" json:"reference_statuses,omitempty"`
+	// ReferenceStatusesTruncated is set if there were more reference statuses
+	// than could be returned.
+	ReferenceStatusesTruncated bool `protobuf:"varint,3,opt,name=reference_statuses_truncated,json=referenceStatusesTruncated,proto3" json:"reference_statuses_truncated,omitempty"`
 }
 
 func (x *UpdateRemoteMirrorResponse) Reset() {
@@ -174,6 +339,82 @@ func (x *UpdateRemoteMirrorResponse) GetDivergentRefs() [][]byte {
 	return nil
 }
 
+func (x *UpdateRemoteMirrorResponse) GetReferenceStatuses() []*UpdateRemoteMirrorResponse_ReferenceStatus {
+	if x != nil {
+		return x.ReferenceStatuses
+	}
+	return nil
+}
+
+func (x *UpdateRemoteMirrorResponse) GetReferenceStatusesTruncated() bool {
+	if x != nil {
+		return x.ReferenceStatusesTruncated
+	}
+	return false
+}
+
+// UpdateRemoteMirrorError is returned as error detail by UpdateRemoteMirror
+// when the mirror rejected updates of some references. All other references
+// have been updated nevertheless.
+type UpdateRemoteMirrorError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ReferenceStatuses contains the status of each reference which has been
+	// updated in the mirror, including the rejected ones.
+	ReferenceStatuses []*UpdateRemoteMirrorResponse_ReferenceStatus `protobuf:"bytes,1,rep,name=reference_statuses,json=referenceStatuses,proto3" json:"reference_statuses,omitempty"`
+	// ReferenceStatusesTruncated is set if there were more reference statuses
+	// than could be returned.
+	ReferenceStatusesTruncated bool `protobuf:"varint,2,opt,name=reference_statuses_truncated,json=referenceStatusesTruncated,proto3" json:"reference_statuses_truncated,omitempty"`
+}
+
+func (x *UpdateRemoteMirrorError) Reset() {
+	*x = UpdateRemoteMirrorError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remote_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRemoteMirrorError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRemoteMirrorError) ProtoMessage() {}
+
+func (x *UpdateRemoteMirrorError) ProtoReflect() protoreflect.Message {
+	mi := &file_remote_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRemoteMirrorError.ProtoReflect.Descriptor instead.
+func (*UpdateRemoteMirrorError) Descriptor() ([]byte, []int) {
+	return file_remote_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateRemoteMirrorError) GetReferenceStatuses() []*UpdateRemoteMirrorResponse_ReferenceStatus {
+	if x != nil {
+		return x.ReferenceStatuses
+	}
+	return nil
+}
+
+func (x *UpdateRemoteMirrorError) GetReferenceStatusesTruncated() bool {
+	if x != nil {
+		return x.ReferenceStatusesTruncated
+	}
+	return false
+}
+
 // This comment is left unintentionally blank.
 type FindRemoteRepositoryRequest struct {
 	state         protoimpl.MessageState
@@ -191,7 +432,7 @@ type FindRemoteRepositoryRequest struct {
 func (x *FindRemoteRepositoryRequest) Reset() {
 	*x = FindRemoteRepositoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_remote_proto_msgTypes[2]
+		mi := &file_remote_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -204,7 +445,7 @@ func (x *FindRemoteRepositoryRequest) String() string {
 func (*FindRemoteRepositoryRequest) ProtoMessage() {}
 
 func (x *FindRemoteRepositoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_remote_proto_msgTypes[2]
+	mi := &file_remote_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,7 +458,7 @@ func (x *FindRemoteRepositoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindRemoteRepositoryRequest.ProtoReflect.Descriptor instead.
 func (*FindRemoteRepositoryRequest) Descriptor() ([]byte, []int) {
-	return file_remote_proto_rawDescGZIP(), []int{2}
+	return file_remote_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *FindRemoteRepositoryRequest) GetRemote() string {
@@ -248,7 +489,7 @@ type FindRemoteRepositoryResponse struct {
 func (x *FindRemoteRepositoryResponse) Reset() {
 	*x = FindRemoteRepositoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_remote_proto_msgTypes[3]
+		mi := &file_remote_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -261,7 +502,7 @@ func (x *FindRemoteRepositoryResponse) String() string {
 func (*FindRemoteRepositoryResponse) ProtoMessage() {}
 
 func (x *FindRemoteRepositoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_remote_proto_msgTypes[3]
+	mi := &file_remote_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +515,7 @@ func (x *FindRemoteRepositoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindRemoteRepositoryResponse.ProtoReflect.Descriptor instead.
 func (*FindRemoteRepositoryResponse) Descriptor() ([]byte, []int) {
-	return file_remote_proto_rawDescGZIP(), []int{3}
+	return file_remote_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *FindRemoteRepositoryResponse) GetExists() bool {
@@ -318,7 +559,7 @@ type FindRemoteRootRefRequest struct {
 func (x *FindRemoteRootRefRequest) Reset() {
 	*x = FindRemoteRootRefRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_remote_proto_msgTypes[4]
+		mi := &file_remote_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -331,7 +572,7 @@ func (x *FindRemoteRootRefRequest) String() string {
 func (*FindRemoteRootRefRequest) ProtoMessage() {}
 
 func (x *FindRemoteRootRefRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_remote_proto_msgTypes[4]
+	mi := &file_remote_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +585,7 @@ func (x *FindRemoteRootRefRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindRemoteRootRefRequest.ProtoReflect.Descriptor instead.
 func (*FindRemoteRootRefRequest) Descriptor() ([]byte, []int) {
-	return file_remote_proto_rawDescGZIP(), []int{4}
+	return file_remote_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FindRemoteRootRefRequest) GetRepository() *Repository {
@@ -397,7 +638,7 @@ type FindRemoteRootRefResponse struct {
 func (x *FindRemoteRootRefResponse) Reset() {
 	*x = FindRemoteRootRefResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_remote_proto_msgTypes[5]
+		mi := &file_remote_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -410,7 +651,7 @@ func (x *FindRemoteRootRefResponse) String() string {
 func (*FindRemoteRootRefResponse) ProtoMessage() {}
 
 func (x *FindRemoteRootRefResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_remote_proto_msgTypes[5]
+	mi := &file_remote_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,7 +664,7 @@ func (x *FindRemoteRootRefResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindRemoteRootRefResponse.ProtoReflect.Descriptor instead.
 func (*FindRemoteRootRefResponse) Descriptor() ([]byte, []int) {
-	return file_remote_proto_rawDescGZIP(), []int{5}
+	return file_remote_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *FindRemoteRootRefResponse) GetRef() string {
@@ -455,15 +696,26 @@ type UpdateRemoteMirrorRequest_Remote struct {
 	// used to avoid DNS rebinding by mapping the url to the resolved address.
 	// Only IPv4 dotted decimal ("192.0.2.1"), IPv6 ("2001:db8::68"), or IPv4-mapped
 	// IPv6 ("::ffff:192.0.2.1") forms are supported.
-	// Works with HTTP/HTTPS/Git/SSH protocols.
+	// Works with HTTP/HTTPS/Git/SSH protocols. For SSH remotes, host keys are
+	// still verified against the host name of the URL.
 	// Optional.
 	ResolvedAddress string `protobuf:"bytes,4,opt,name=resolved_address,json=resolvedAddress,proto3" json:"resolved_address,omitempty"`
+	// SshKey is the private SSH key used to authenticate against the remote
+	// repository if it is accessed via SSH. This must not be set if the
+	// request's ssh_key is set.
+	// Optional.
+	SshKey string `protobuf:"bytes,5,opt,name=ssh_key,json=sshKey,proto3" json:"ssh_key,omitempty"`
+	// KnownHosts contains the known_hosts entries used for strict host key
+	// checking if the remote repository is accessed via SSH. This must not be
+	// set if the request's known_hosts is set.
+	// Optional.
+	KnownHosts string `protobuf:"bytes,6,opt,name=known_hosts,json=knownHosts,proto3" json:"known_hosts,omitempty"`
 }
 
 func (x *UpdateRemoteMirrorRequest_Remote) Reset() {
 	*x = UpdateRemoteMirrorRequest_Remote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_remote_proto_msgTypes[6]
+		mi := &file_remote_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -476,7 +728,7 @@ func (x *UpdateRemoteMirrorRequest_Remote) String() string {
 func (*UpdateRemoteMirrorRequest_Remote) ProtoMessage() {}
 
 func (x *UpdateRemoteMirrorRequest_Remote) ProtoReflect() protoreflect.Message {
-	mi := &file_remote_proto_msgTypes[6]
+	mi := &file_remote_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,13 +773,124 @@ func (x *UpdateRemoteMirrorRequest_Remote) GetResolvedAddress() string {
 	return ""
 }
 
+func (x *UpdateRemoteMirrorRequest_Remote) GetSshKey() string {
+	if x != nil {
+		return x.SshKey
+	}
+	return ""
+}
+
+func (x *UpdateRemoteMirrorRequest_Remote) GetKnownHosts() string {
+	if x != nil {
+		return x.KnownHosts
+	}
+	return ""
+}
+
+// ReferenceStatus describes the planned or performed update of a single
+// reference in the mirror.
+type UpdateRemoteMirrorResponse_ReferenceStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Reference is the fully-qualified name of the reference.
+	Reference []byte `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Action is the kind of update of the reference.
+	Action UpdateRemoteMirrorResponse_ReferenceStatus_Action `protobuf:"varint,2,opt,name=action,proto3,enum=gitaly.UpdateRemoteMirrorResponse_ReferenceStatus_Action" json:"action,omitempty"`
+	// Status is the result of the update.
+	Status UpdateRemoteMirrorResponse_ReferenceStatus_Status `protobuf:"varint,3,opt,name=status,proto3,enum=gitaly.UpdateRemoteMirrorResponse_ReferenceStatus_Status" json:"status,omitempty"`
+	// OldOid is the object ID the reference points to in the mirror. It is
+	// empty if the reference is created.
+	OldOid string `protobuf:"bytes,4,opt,name=old_oid,json=oldOid,proto3" json:"old_oid,omitempty"`
+	// NewOid is the object ID the reference points to in the source
+	// repository. It is empty if the reference is deleted.
+	NewOid string `protobuf:"bytes,5,opt,name=new_oid,json=newOid,proto3" json:"new_oid,omitempty"`
+	// Reason explains why the update has failed or has been skipped.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *UpdateRemoteMirrorResponse_ReferenceStatus) Reset() {
+	*x = UpdateRemoteMirrorResponse_ReferenceStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remote_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRemoteMirrorResponse_ReferenceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRemoteMirrorResponse_ReferenceStatus) ProtoMessage() {}
+
+func (x *UpdateRemoteMirrorResponse_ReferenceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_remote_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRemoteMirrorResponse_ReferenceStatus.ProtoReflect.Descriptor instead.
+func (*UpdateRemoteMirrorResponse_ReferenceStatus) Descriptor() ([]byte, []int) {
+	return file_remote_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *UpdateRemoteMirrorResponse_ReferenceStatus) GetReference() []byte {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *UpdateRemoteMirrorResponse_ReferenceStatus) GetAction() UpdateRemoteMirrorResponse_ReferenceStatus_Action {
+	if x != nil {
+		return x.Action
+	}
+	return UpdateRemoteMirrorResponse_ReferenceStatus_ACTION_UNSPECIFIED
+}
+
+func (x *UpdateRemoteMirrorResponse_ReferenceStatus) GetStatus() UpdateRemoteMirrorResponse_ReferenceStatus_Status {
+	if x != nil {
+		return x.Status
+	}
+	return UpdateRemoteMirrorResponse_ReferenceStatus_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateRemoteMirrorResponse_ReferenceStatus) GetOldOid() string {
+	if x != nil {
+		return x.OldOid
+	}
+	return ""
+}
+
+func (x *UpdateRemoteMirrorResponse_ReferenceStatus) GetNewOid() string {
+	if x != nil {
+		return x.NewOid
+	}
+	return ""
+}
+
+func (x *UpdateRemoteMirrorResponse_ReferenceStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_remote_proto protoreflect.FileDescriptor
 
 var file_remote_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
 	0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x1a, 0x0a, 0x6c, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xec, 0x03, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x22, 0x85, 0x05, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74,
 	0x65, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38,
 	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f,
@@ -546,23 +909,86 @@ var file_remote_proto_rawDesc = []byte{
 	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6b, 0x65,
 	0x65, 0x70, 0x5f, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66,
 	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x6b, 0x65, 0x65, 0x70, 0x44, 0x69, 0x76,
-	0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x73, 0x1a, 0xa2, 0x01, 0x0a, 0x06, 0x52,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x3a, 0x0a, 0x19, 0x68, 0x74, 0x74, 0x70, 0x5f,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x68, 0x74, 0x74, 0x70,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x68, 0x6f, 0x73, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70,
-	0x48, 0x6f, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4a,
-	0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x43, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d,
-	0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
-	0x0e, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x66, 0x73, 0x22, 0x5e, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x6f,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x66, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0b, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x66, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x1a, 0xdc, 0x01, 0x0a, 0x06, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x3a, 0x0a, 0x19, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x68, 0x74, 0x74, 0x70, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x48,
+	0x6f, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x08,
+	0x72, 0x65, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xd8, 0x05, 0x0a, 0x1a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x76, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0d, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x61,
+	0x0a, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69, 0x74,
+	0x61, 0x6c, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x12, 0x40, 0x0a, 0x1c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x1a, 0xed, 0x03, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x69, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x67, 0x69, 0x74, 0x61, 0x6c,
+	0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6f,
+	0x6c, 0x64, 0x5f, 0x6f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x6c,
+	0x64, 0x4f, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x4f, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x59, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x11, 0x0a,
+	0x0d, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03,
+	0x22, 0x71, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x4c, 0x41,
+	0x4e, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45,
+	0x44, 0x10, 0x04, 0x22, 0xbe, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x61, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x69,
+	0x74, 0x61, 0x6c, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0x5e, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x6f,
 	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x73,
@@ -630,32 +1056,41 @@ func file_remote_proto_rawDescGZIP() []byte {
 	return file_remote_proto_rawDescData
 }
 
-var file_remote_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_remote_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_remote_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_remote_proto_goTypes = []interface{}{
-	(*UpdateRemoteMirrorRequest)(nil),        // 0: gitaly.UpdateRemoteMirrorRequest
-	(*UpdateRemoteMirrorResponse)(nil),       // 1: gitaly.UpdateRemoteMirrorResponse
-	(*FindRemoteRepositoryRequest)(nil),      // 2: gitaly.FindRemoteRepositoryRequest
-	(*FindRemoteRepositoryResponse)(nil),     // 3: gitaly.FindRemoteRepositoryResponse
-	(*FindRemoteRootRefRequest)(nil),         // 4: gitaly.FindRemoteRootRefRequest
-	(*FindRemoteRootRefResponse)(nil),        // 5: gitaly.FindRemoteRootRefResponse
-	(*UpdateRemoteMirrorRequest_Remote)(nil), // 6: gitaly.UpdateRemoteMirrorRequest.Remote
-	(*Repository)(nil),                       // 7: gitaly.Repository
+	(UpdateRemoteMirrorResponse_ReferenceStatus_Action)(0), // 0: gitaly.UpdateRemoteMirrorResponse.ReferenceStatus.Action
+	(UpdateRemoteMirrorResponse_ReferenceStatus_Status)(0), // 1: gitaly.UpdateRemoteMirrorResponse.ReferenceStatus.Status
+	(*UpdateRemoteMirrorRequest)(nil),                      // 2: gitaly.UpdateRemoteMirrorRequest
+	(*UpdateRemoteMirrorResponse)(nil),                     // 3: gitaly.UpdateRemoteMirrorResponse
+	(*UpdateRemoteMirrorError)(nil),                        // 4: gitaly.UpdateRemoteMirrorError
+	(*FindRemoteRepositoryRequest)(nil),                    // 5: gitaly.FindRemoteRepositoryRequest
+	(*FindRemoteRepositoryResponse)(nil),                   // 6: gitaly.FindRemoteRepositoryResponse
+	(*FindRemoteRootRefRequest)(nil),                       // 7: gitaly.FindRemoteRootRefRequest
+	(*FindRemoteRootRefResponse)(nil),                      // 8: gitaly.FindRemoteRootRefResponse
+	(*UpdateRemoteMirrorRequest_Remote)(nil),               // 9: gitaly.UpdateRemoteMirrorRequest.Remote
+	(*UpdateRemoteMirrorResponse_ReferenceStatus)(nil),     // 10: gitaly.UpdateRemoteMirrorResponse.ReferenceStatus
+	(*Repository)(nil),                                     // 11: gitaly.Repository
 }
 var file_remote_proto_depIdxs = []int32{
-	7, // 0: gitaly.UpdateRemoteMirrorRequest.repository:type_name -> gitaly.Repository
-	6, // 1: gitaly.UpdateRemoteMirrorRequest.remote:type_name -> gitaly.UpdateRemoteMirrorRequest.Remote
-	7, // 2: gitaly.FindRemoteRootRefRequest.repository:type_name -> gitaly.Repository
-	0, // 3: gitaly.RemoteService.UpdateRemoteMirror:input_type -> gitaly.UpdateRemoteMirrorRequest
-	2, // 4: gitaly.RemoteService.FindRemoteRepository:input_type -> gitaly.FindRemoteRepositoryRequest
-	4, // 5: gitaly.RemoteService.FindRemoteRootRef:input_type -> gitaly.FindRemoteRootRefRequest
-	1, // 6: gitaly.RemoteService.UpdateRemoteMirror:output_type -> gitaly.UpdateRemoteMirrorResponse
-	3, // 7: gitaly.RemoteService.FindRemoteRepository:output_type -> gitaly.FindRemoteRepositoryResponse
-	5, // 8: gitaly.RemoteService.FindRemoteRootRef:output_type -> gitaly.FindRemoteRootRefResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	11, // 0: gitaly.UpdateRemoteMirrorRequest.repository:type_name -> gitaly.Repository
+	9,  // 1: gitaly.UpdateRemoteMirrorRequest.remote:type_name -> gitaly.UpdateRemoteMirrorRequest.Remote
+	10, // 2: gitaly.UpdateRemoteMirrorResponse.reference_statuses:type_name -> gitaly.UpdateRemoteMirrorResponse.ReferenceStatus
+	10, // 3: gitaly.UpdateRemoteMirrorError.reference_statuses:type_name -> gitaly.UpdateRemoteMirrorResponse.ReferenceStatus
+	11, // 4: gitaly.FindRemoteRootRefRequest.repository:type_name -> gitaly.Repository
+	0,  // 5: gitaly.UpdateRemoteMirrorResponse.ReferenceStatus.action:type_name -> gitaly.UpdateRemoteMirrorResponse.ReferenceStatus.Action
+	1,  // 6: gitaly.UpdateRemoteMirrorResponse.ReferenceStatus.status:type_name -> gitaly.UpdateRemoteMirrorResponse.ReferenceStatus.Status
+	2,  // 7: gitaly.RemoteService.UpdateRemoteMirror:input_type -> gitaly.UpdateRemoteMirrorRequest
+	5,  // 8: gitaly.RemoteService.FindRemoteRepository:input_type -> gitaly.FindRemoteRepositoryRequest
+	7,  // 9: gitaly.RemoteService.FindRemoteRootRef:input_type -> gitaly.FindRemoteRootRefRequest
+	3,  // 10: gitaly.RemoteService.UpdateRemoteMirror:output_type -> gitaly.UpdateRemoteMirrorResponse
+	6,  // 11: gitaly.RemoteService.FindRemoteRepository:output_type -> gitaly.FindRemoteRepositoryResponse
+	8,  // 12: gitaly.RemoteService.FindRemoteRootRef:output_type -> gitaly.FindRemoteRootRefResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_remote_proto_init() }
@@ -691,7 +1126,7 @@ func file_remote_proto_init() {
 			}
 		}
 		file_remote_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindRemoteRepositoryRequest); i {
+			switch v := v.(*UpdateRemoteMirrorError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -703,7 +1138,7 @@ func file_remote_proto_init() {
 			}
 		}
 		file_remote_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindRemoteRepositoryResponse); i {
+			switch v := v.(*FindRemoteRepositoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -715,7 +1150,7 @@ func file_remote_proto_init() {
 			}
 		}
 		file_remote_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindRemoteRootRefRequest); i {
+			switch v := v.(*FindRemoteRepositoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -727,7 +1162,7 @@ func file_remote_proto_init() {
 			}
 		}
 		file_remote_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindRemoteRootRefResponse); i {
+			switch v := v.(*FindRemoteRootRefRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -739,6 +1174,18 @@ func file_remote_proto_init() {
 			}
 		}
 		file_remote_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindRemoteRootRefResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remote_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateRemoteMirrorRequest_Remote); i {
 			case 0:
 				return &v.state
@@ -750,19 +1197,32 @@ func file_remote_proto_init() {
 				return nil
 			}
 		}
+		file_remote_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRemoteMirrorResponse_ReferenceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_remote_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_remote_proto_goTypes,
 		DependencyIndexes: file_remote_proto_depIdxs,
+		EnumInfos:         file_remote_proto_enumTypes,
 		MessageInfos:      file_remote_proto_msgTypes,
 	}.Build()
 	File_remote_proto = out.File
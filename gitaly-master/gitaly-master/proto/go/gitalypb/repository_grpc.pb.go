@@ -43,6 +43,16 @@ type RepositoryServiceClient interface {
 	RepositorySize(ctx context.Context, in *RepositorySizeRequest, opts ...grpc.CallOption) (*RepositorySizeResponse, error)
 	// ObjectFormat determines the object format that is being used by the repository.
 	ObjectFormat(ctx context.Context, in *ObjectFormatRequest, opts ...grpc.CallOption) (*ObjectFormatResponse, error)
+	// ReferenceBackend determines the reference backend that is being used by the repository.
+	ReferenceBackend(ctx context.Context, in *ReferenceBackendRequest, opts ...grpc.CallOption) (*ReferenceBackendResponse, error)
+	// ConvertObjectFormat rewrites the repository so that it uses the requested object format.
+	// All objects and references are translated into the new object format and a mapping from
+	// legacy object IDs to the new object IDs is kept so that lookups of commits via their
+	// legacy object ID keep working. The repository is replaced as a whole. Reference updates
+	// block while the conversion is in progress and objects are locked against concurrent
+	// housekeeping, so callers don't need to stop concurrent writes themselves.
+	// Repositories connected to an object pool cannot be converted.
+	ConvertObjectFormat(ctx context.Context, in *ConvertObjectFormatRequest, opts ...grpc.CallOption) (*ConvertObjectFormatResponse, error)
 	// This comment is left unintentionally blank.
 	ApplyGitattributes(ctx context.Context, in *ApplyGitattributesRequest, opts ...grpc.CallOption) (*ApplyGitattributesResponse, error)
 	// FetchRemote fetches references from a remote repository into the local
@@ -94,6 +104,14 @@ type RepositoryServiceClient interface {
 	Cleanup(ctx context.Context, in *CleanupRequest, opts ...grpc.CallOption) (*CleanupResponse, error)
 	// This comment is left unintentionally blank.
 	GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (RepositoryService_GetSnapshotClient, error)
+	// GetStorageSnapshot creates a consistent snapshot of multiple repositories of the same storage.
+	// References of all repositories are captured at a single logical point in time while reference
+	// updates of the snapshotted repositories are blocked. Afterwards, the manifest of the captured
+	// references is streamed, followed by a tarball per repository which can be restored via
+	// CreateRepositoryFromSnapshot. When routed via Praefect, the request is proxied to a single
+	// node of the virtual storage without rewriting the relative paths, so the paths must be the
+	// replica paths of the repositories on that node.
+	GetStorageSnapshot(ctx context.Context, in *GetStorageSnapshotRequest, opts ...grpc.CallOption) (RepositoryService_GetStorageSnapshotClient, error)
 	// This comment is left unintentionally blank.
 	CreateRepositoryFromSnapshot(ctx context.Context, in *CreateRepositoryFromSnapshotRequest, opts ...grpc.CallOption) (*CreateRepositoryFromSnapshotResponse, error)
 	// This comment is left unintentionally blank.
@@ -128,7 +146,17 @@ type RepositoryServiceClient interface {
 	RemoveRepository(ctx context.Context, in *RemoveRepositoryRequest, opts ...grpc.CallOption) (*RemoveRepositoryResponse, error)
 	// This comment is left unintentionally blank.
 	RenameRepository(ctx context.Context, in *RenameRepositoryRequest, opts ...grpc.CallOption) (*RenameRepositoryResponse, error)
-	// This comment is left unintentionally blank.
+	// ReplicateRepository replicates the source repository into the target
+	// repository. If the target repository does not exist it is created from a
+	// snapshot of the source, otherwise all references are fetched from the
+	// source.
+	//
+	// If the request carries the reference updates which have been performed on
+	// the source since the target was last replicated, then only objects
+	// reachable from the updated references are fetched and the references are
+	// updated in place. The checksums of both repositories are compared
+	// afterwards and the RPC falls back to replicating all references in case
+	// they don't match.
 	ReplicateRepository(ctx context.Context, in *ReplicateRepositoryRequest, opts ...grpc.CallOption) (*ReplicateRepositoryResponse, error)
 	// OptimizeRepository performs all maintenance tasks in a repository to keep
 	// it in an efficient state. It cleans up stale data, repacks objects,
@@ -161,6 +189,20 @@ type RepositoryServiceClient interface {
 	FullPath(ctx context.Context, in *FullPathRequest, opts ...grpc.CallOption) (*FullPathResponse, error)
 	// RemoveAll deletes all repositories on a specified storage.
 	RemoveAll(ctx context.Context, in *RemoveAllRequest, opts ...grpc.CallOption) (*RemoveAllResponse, error)
+	// SetPushRules configures the push rules of a repository. Push rules are evaluated by the
+	// pre-receive hook against the quarantined objects of a push before any access checks are
+	// performed via the GitLab API. Setting an empty set of push rules disables evaluation.
+	SetPushRules(ctx context.Context, in *SetPushRulesRequest, opts ...grpc.CallOption) (*SetPushRulesResponse, error)
+	// GetPushRules returns the push rules configured for a repository. Returns an empty set of
+	// push rules in case none have been configured.
+	GetPushRules(ctx context.Context, in *GetPushRulesRequest, opts ...grpc.CallOption) (*GetPushRulesResponse, error)
+	// TailLog streams the entries of the repository's write-ahead log, starting at the given log
+	// index. Once all appended log entries have been streamed, the RPC waits for further entries
+	// to be appended until it is canceled. This allows replicas to apply the same reference
+	// updates without replicating the whole repository. Returns FailedPrecondition if the
+	// write-ahead log is not enabled and OutOfRange if a log entry to be streamed has already
+	// been pruned from the log.
+	TailLog(ctx context.Context, in *TailLogRequest, opts ...grpc.CallOption) (RepositoryService_TailLogClient, error)
 }
 
 type repositoryServiceClient struct {
@@ -248,6 +290,24 @@ func (c *repositoryServiceClient) ObjectFormat(ctx context.Context, in *ObjectFo
 	return out, nil
 }
 
+func (c *repositoryServiceClient) ReferenceBackend(ctx context.Context, in *ReferenceBackendRequest, opts ...grpc.CallOption) (*ReferenceBackendResponse, error) {
+	out := new(ReferenceBackendResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.RepositoryService/ReferenceBackend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) ConvertObjectFormat(ctx context.Context, in *ConvertObjectFormatRequest, opts ...grpc.CallOption) (*ConvertObjectFormatResponse, error) {
+	out := new(ConvertObjectFormatResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.RepositoryService/ConvertObjectFormat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) ApplyGitattributes(ctx context.Context, in *ApplyGitattributesRequest, opts ...grpc.CallOption) (*ApplyGitattributesResponse, error) {
 	out := new(ApplyGitattributesResponse)
 	err := c.cc.Invoke(ctx, "/gitaly.RepositoryService/ApplyGitattributes", in, out, opts...)
@@ -625,6 +685,38 @@ func (x *repositoryServiceGetSnapshotClient) Recv() (*GetSnapshotResponse, error
 	return m, nil
 }
 
+func (c *repositoryServiceClient) GetStorageSnapshot(ctx context.Context, in *GetStorageSnapshotRequest, opts ...grpc.CallOption) (RepositoryService_GetStorageSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[8], "/gitaly.RepositoryService/GetStorageSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryServiceGetStorageSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepositoryService_GetStorageSnapshotClient interface {
+	Recv() (*GetStorageSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type repositoryServiceGetStorageSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryServiceGetStorageSnapshotClient) Recv() (*GetStorageSnapshotResponse, error) {
+	m := new(GetStorageSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *repositoryServiceClient) CreateRepositoryFromSnapshot(ctx context.Context, in *CreateRepositoryFromSnapshotRequest, opts ...grpc.CallOption) (*CreateRepositoryFromSnapshotResponse, error) {
 	out := new(CreateRepositoryFromSnapshotResponse)
 	err := c.cc.Invoke(ctx, "/gitaly.RepositoryService/CreateRepositoryFromSnapshot", in, out, opts...)
@@ -635,7 +727,7 @@ func (c *repositoryServiceClient) CreateRepositoryFromSnapshot(ctx context.Conte
 }
 
 func (c *repositoryServiceClient) GetRawChanges(ctx context.Context, in *GetRawChangesRequest, opts ...grpc.CallOption) (RepositoryService_GetRawChangesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[8], "/gitaly.RepositoryService/GetRawChanges", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[9], "/gitaly.RepositoryService/GetRawChanges", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -667,7 +759,7 @@ func (x *repositoryServiceGetRawChangesClient) Recv() (*GetRawChangesResponse, e
 }
 
 func (c *repositoryServiceClient) SearchFilesByContent(ctx context.Context, in *SearchFilesByContentRequest, opts ...grpc.CallOption) (RepositoryService_SearchFilesByContentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[9], "/gitaly.RepositoryService/SearchFilesByContent", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[10], "/gitaly.RepositoryService/SearchFilesByContent", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -699,7 +791,7 @@ func (x *repositoryServiceSearchFilesByContentClient) Recv() (*SearchFilesByCont
 }
 
 func (c *repositoryServiceClient) SearchFilesByName(ctx context.Context, in *SearchFilesByNameRequest, opts ...grpc.CallOption) (RepositoryService_SearchFilesByNameClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[10], "/gitaly.RepositoryService/SearchFilesByName", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[11], "/gitaly.RepositoryService/SearchFilesByName", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -732,7 +824,7 @@ func (x *repositoryServiceSearchFilesByNameClient) Recv() (*SearchFilesByNameRes
 
 // Deprecated: Do not use.
 func (c *repositoryServiceClient) RestoreCustomHooks(ctx context.Context, opts ...grpc.CallOption) (RepositoryService_RestoreCustomHooksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[11], "/gitaly.RepositoryService/RestoreCustomHooks", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[12], "/gitaly.RepositoryService/RestoreCustomHooks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -766,7 +858,7 @@ func (x *repositoryServiceRestoreCustomHooksClient) CloseAndRecv() (*RestoreCust
 }
 
 func (c *repositoryServiceClient) SetCustomHooks(ctx context.Context, opts ...grpc.CallOption) (RepositoryService_SetCustomHooksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[12], "/gitaly.RepositoryService/SetCustomHooks", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[13], "/gitaly.RepositoryService/SetCustomHooks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -801,7 +893,7 @@ func (x *repositoryServiceSetCustomHooksClient) CloseAndRecv() (*SetCustomHooksR
 
 // Deprecated: Do not use.
 func (c *repositoryServiceClient) BackupCustomHooks(ctx context.Context, in *BackupCustomHooksRequest, opts ...grpc.CallOption) (RepositoryService_BackupCustomHooksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[13], "/gitaly.RepositoryService/BackupCustomHooks", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[14], "/gitaly.RepositoryService/BackupCustomHooks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -833,7 +925,7 @@ func (x *repositoryServiceBackupCustomHooksClient) Recv() (*BackupCustomHooksRes
 }
 
 func (c *repositoryServiceClient) GetCustomHooks(ctx context.Context, in *GetCustomHooksRequest, opts ...grpc.CallOption) (RepositoryService_GetCustomHooksClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[14], "/gitaly.RepositoryService/GetCustomHooks", opts...)
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[15], "/gitaly.RepositoryService/GetCustomHooks", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -947,6 +1039,56 @@ func (c *repositoryServiceClient) RemoveAll(ctx context.Context, in *RemoveAllRe
 	return out, nil
 }
 
+func (c *repositoryServiceClient) SetPushRules(ctx context.Context, in *SetPushRulesRequest, opts ...grpc.CallOption) (*SetPushRulesResponse, error) {
+	out := new(SetPushRulesResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.RepositoryService/SetPushRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) GetPushRules(ctx context.Context, in *GetPushRulesRequest, opts ...grpc.CallOption) (*GetPushRulesResponse, error) {
+	out := new(GetPushRulesResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.RepositoryService/GetPushRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) TailLog(ctx context.Context, in *TailLogRequest, opts ...grpc.CallOption) (RepositoryService_TailLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RepositoryService_ServiceDesc.Streams[16], "/gitaly.RepositoryService/TailLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryServiceTailLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepositoryService_TailLogClient interface {
+	Recv() (*TailLogResponse, error)
+	grpc.ClientStream
+}
+
+type repositoryServiceTailLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryServiceTailLogClient) Recv() (*TailLogResponse, error) {
+	m := new(TailLogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RepositoryServiceServer is the server API for RepositoryService service.
 // All implementations must embed UnimplementedRepositoryServiceServer
 // for forward compatibility
@@ -972,6 +1114,16 @@ type RepositoryServiceServer interface {
 	RepositorySize(context.Context, *RepositorySizeRequest) (*RepositorySizeResponse, error)
 	// ObjectFormat determines the object format that is being used by the repository.
 	ObjectFormat(context.Context, *ObjectFormatRequest) (*ObjectFormatResponse, error)
+	// ReferenceBackend determines the reference backend that is being used by the repository.
+	ReferenceBackend(context.Context, *ReferenceBackendRequest) (*ReferenceBackendResponse, error)
+	// ConvertObjectFormat rewrites the repository so that it uses the requested object format.
+	// All objects and references are translated into the new object format and a mapping from
+	// legacy object IDs to the new object IDs is kept so that lookups of commits via their
+	// legacy object ID keep working. The repository is replaced as a whole. Reference updates
+	// block while the conversion is in progress and objects are locked against concurrent
+	// housekeeping, so callers don't need to stop concurrent writes themselves.
+	// Repositories connected to an object pool cannot be converted.
+	ConvertObjectFormat(context.Context, *ConvertObjectFormatRequest) (*ConvertObjectFormatResponse, error)
 	// This comment is left unintentionally blank.
 	ApplyGitattributes(context.Context, *ApplyGitattributesRequest) (*ApplyGitattributesResponse, error)
 	// FetchRemote fetches references from a remote repository into the local
@@ -1023,6 +1175,14 @@ type RepositoryServiceServer interface {
 	Cleanup(context.Context, *CleanupRequest) (*CleanupResponse, error)
 	// This comment is left unintentionally blank.
 	GetSnapshot(*GetSnapshotRequest, RepositoryService_GetSnapshotServer) error
+	// GetStorageSnapshot creates a consistent snapshot of multiple repositories of the same storage.
+	// References of all repositories are captured at a single logical point in time while reference
+	// updates of the snapshotted repositories are blocked. Afterwards, the manifest of the captured
+	// references is streamed, followed by a tarball per repository which can be restored via
+	// CreateRepositoryFromSnapshot. When routed via Praefect, the request is proxied to a single
+	// node of the virtual storage without rewriting the relative paths, so the paths must be the
+	// replica paths of the repositories on that node.
+	GetStorageSnapshot(*GetStorageSnapshotRequest, RepositoryService_GetStorageSnapshotServer) error
 	// This comment is left unintentionally blank.
 	CreateRepositoryFromSnapshot(context.Context, *CreateRepositoryFromSnapshotRequest) (*CreateRepositoryFromSnapshotResponse, error)
 	// This comment is left unintentionally blank.
@@ -1057,7 +1217,17 @@ type RepositoryServiceServer interface {
 	RemoveRepository(context.Context, *RemoveRepositoryRequest) (*RemoveRepositoryResponse, error)
 	// This comment is left unintentionally blank.
 	RenameRepository(context.Context, *RenameRepositoryRequest) (*RenameRepositoryResponse, error)
-	// This comment is left unintentionally blank.
+	// ReplicateRepository replicates the source repository into the target
+	// repository. If the target repository does not exist it is created from a
+	// snapshot of the source, otherwise all references are fetched from the
+	// source.
+	//
+	// If the request carries the reference updates which have been performed on
+	// the source since the target was last replicated, then only objects
+	// reachable from the updated references are fetched and the references are
+	// updated in place. The checksums of both repositories are compared
+	// afterwards and the RPC falls back to replicating all references in case
+	// they don't match.
 	ReplicateRepository(context.Context, *ReplicateRepositoryRequest) (*ReplicateRepositoryResponse, error)
 	// OptimizeRepository performs all maintenance tasks in a repository to keep
 	// it in an efficient state. It cleans up stale data, repacks objects,
@@ -1090,6 +1260,20 @@ type RepositoryServiceServer interface {
 	FullPath(context.Context, *FullPathRequest) (*FullPathResponse, error)
 	// RemoveAll deletes all repositories on a specified storage.
 	RemoveAll(context.Context, *RemoveAllRequest) (*RemoveAllResponse, error)
+	// SetPushRules configures the push rules of a repository. Push rules are evaluated by the
+	// pre-receive hook against the quarantined objects of a push before any access checks are
+	// performed via the GitLab API. Setting an empty set of push rules disables evaluation.
+	SetPushRules(context.Context, *SetPushRulesRequest) (*SetPushRulesResponse, error)
+	// GetPushRules returns the push rules configured for a repository. Returns an empty set of
+	// push rules in case none have been configured.
+	GetPushRules(context.Context, *GetPushRulesRequest) (*GetPushRulesResponse, error)
+	// TailLog streams the entries of the repository's write-ahead log, starting at the given log
+	// index. Once all appended log entries have been streamed, the RPC waits for further entries
+	// to be appended until it is canceled. This allows replicas to apply the same reference
+	// updates without replicating the whole repository. Returns FailedPrecondition if the
+	// write-ahead log is not enabled and OutOfRange if a log entry to be streamed has already
+	// been pruned from the log.
+	TailLog(*TailLogRequest, RepositoryService_TailLogServer) error
 	mustEmbedUnimplementedRepositoryServiceServer()
 }
 
@@ -1121,6 +1305,12 @@ func (UnimplementedRepositoryServiceServer) RepositorySize(context.Context, *Rep
 func (UnimplementedRepositoryServiceServer) ObjectFormat(context.Context, *ObjectFormatRequest) (*ObjectFormatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ObjectFormat not implemented")
 }
+func (UnimplementedRepositoryServiceServer) ReferenceBackend(context.Context, *ReferenceBackendRequest) (*ReferenceBackendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceBackend not implemented")
+}
+func (UnimplementedRepositoryServiceServer) ConvertObjectFormat(context.Context, *ConvertObjectFormatRequest) (*ConvertObjectFormatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertObjectFormat not implemented")
+}
 func (UnimplementedRepositoryServiceServer) ApplyGitattributes(context.Context, *ApplyGitattributesRequest) (*ApplyGitattributesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyGitattributes not implemented")
 }
@@ -1184,6 +1374,9 @@ func (UnimplementedRepositoryServiceServer) Cleanup(context.Context, *CleanupReq
 func (UnimplementedRepositoryServiceServer) GetSnapshot(*GetSnapshotRequest, RepositoryService_GetSnapshotServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
 }
+func (UnimplementedRepositoryServiceServer) GetStorageSnapshot(*GetStorageSnapshotRequest, RepositoryService_GetStorageSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetStorageSnapshot not implemented")
+}
 func (UnimplementedRepositoryServiceServer) CreateRepositoryFromSnapshot(context.Context, *CreateRepositoryFromSnapshotRequest) (*CreateRepositoryFromSnapshotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRepositoryFromSnapshot not implemented")
 }
@@ -1235,6 +1428,15 @@ func (UnimplementedRepositoryServiceServer) FullPath(context.Context, *FullPathR
 func (UnimplementedRepositoryServiceServer) RemoveAll(context.Context, *RemoveAllRequest) (*RemoveAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAll not implemented")
 }
+func (UnimplementedRepositoryServiceServer) SetPushRules(context.Context, *SetPushRulesRequest) (*SetPushRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPushRules not implemented")
+}
+func (UnimplementedRepositoryServiceServer) GetPushRules(context.Context, *GetPushRulesRequest) (*GetPushRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPushRules not implemented")
+}
+func (UnimplementedRepositoryServiceServer) TailLog(*TailLogRequest, RepositoryService_TailLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailLog not implemented")
+}
 func (UnimplementedRepositoryServiceServer) mustEmbedUnimplementedRepositoryServiceServer() {}
 
 // UnsafeRepositoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1392,6 +1594,42 @@ func _RepositoryService_ObjectFormat_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_ReferenceBackend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceBackendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ReferenceBackend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.RepositoryService/ReferenceBackend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ReferenceBackend(ctx, req.(*ReferenceBackendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_ConvertObjectFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertObjectFormatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ConvertObjectFormat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.RepositoryService/ConvertObjectFormat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ConvertObjectFormat(ctx, req.(*ConvertObjectFormatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_ApplyGitattributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplyGitattributesRequest)
 	if err := dec(in); err != nil {
@@ -1809,6 +2047,27 @@ func (x *repositoryServiceGetSnapshotServer) Send(m *GetSnapshotResponse) error
 	return x.ServerStream.SendMsg(m)
 }
 
+func _RepositoryService_GetStorageSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetStorageSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServiceServer).GetStorageSnapshot(m, &repositoryServiceGetStorageSnapshotServer{stream})
+}
+
+type RepositoryService_GetStorageSnapshotServer interface {
+	Send(*GetStorageSnapshotResponse) error
+	grpc.ServerStream
+}
+
+type repositoryServiceGetStorageSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryServiceGetStorageSnapshotServer) Send(m *GetStorageSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RepositoryService_CreateRepositoryFromSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRepositoryFromSnapshotRequest)
 	if err := dec(in); err != nil {
@@ -2146,6 +2405,63 @@ func _RepositoryService_RemoveAll_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_SetPushRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPushRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).SetPushRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.RepositoryService/SetPushRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).SetPushRules(ctx, req.(*SetPushRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_GetPushRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).GetPushRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.RepositoryService/GetPushRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).GetPushRules(ctx, req.(*GetPushRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_TailLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServiceServer).TailLog(m, &repositoryServiceTailLogServer{stream})
+}
+
+type RepositoryService_TailLogServer interface {
+	Send(*TailLogResponse) error
+	grpc.ServerStream
+}
+
+type repositoryServiceTailLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryServiceTailLogServer) Send(m *TailLogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // RepositoryService_ServiceDesc is the grpc.ServiceDesc for RepositoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2185,6 +2501,14 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectFormat",
 			Handler:    _RepositoryService_ObjectFormat_Handler,
 		},
+		{
+			MethodName: "ReferenceBackend",
+			Handler:    _RepositoryService_ReferenceBackend_Handler,
+		},
+		{
+			MethodName: "ConvertObjectFormat",
+			Handler:    _RepositoryService_ConvertObjectFormat_Handler,
+		},
 		{
 			MethodName: "ApplyGitattributes",
 			Handler:    _RepositoryService_ApplyGitattributes_Handler,
@@ -2277,6 +2601,14 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveAll",
 			Handler:    _RepositoryService_RemoveAll_Handler,
 		},
+		{
+			MethodName: "SetPushRules",
+			Handler:    _RepositoryService_SetPushRules_Handler,
+		},
+		{
+			MethodName: "GetPushRules",
+			Handler:    _RepositoryService_GetPushRules_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2320,6 +2652,11 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _RepositoryService_GetSnapshot_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetStorageSnapshot",
+			Handler:       _RepositoryService_GetStorageSnapshot_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetRawChanges",
 			Handler:       _RepositoryService_GetRawChanges_Handler,
@@ -2355,6 +2692,11 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _RepositoryService_GetCustomHooks_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TailLog",
+			Handler:       _RepositoryService_TailLog_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "repository.proto",
 }
@@ -31,6 +31,10 @@ type ServerServiceClient interface {
 	ClockSynced(ctx context.Context, in *ClockSyncedRequest, opts ...grpc.CallOption) (*ClockSyncedResponse, error)
 	// ReadinessCheck runs the set of the checks to make sure service is in operational state.
 	ReadinessCheck(ctx context.Context, in *ReadinessCheckRequest, opts ...grpc.CallOption) (*ReadinessCheckResponse, error)
+	// ReloadConfig reloads the server's configuration file. Only a subset of the configuration can
+	// be changed without a restart: changes to any other keys are reported, but not applied. An
+	// invalid configuration is rejected without applying any changes.
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
 }
 
 type serverServiceClient struct {
@@ -77,6 +81,15 @@ func (c *serverServiceClient) ReadinessCheck(ctx context.Context, in *ReadinessC
 	return out, nil
 }
 
+func (c *serverServiceClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, "/gitaly.ServerService/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServerServiceServer is the server API for ServerService service.
 // All implementations must embed UnimplementedServerServiceServer
 // for forward compatibility
@@ -90,6 +103,10 @@ type ServerServiceServer interface {
 	ClockSynced(context.Context, *ClockSyncedRequest) (*ClockSyncedResponse, error)
 	// ReadinessCheck runs the set of the checks to make sure service is in operational state.
 	ReadinessCheck(context.Context, *ReadinessCheckRequest) (*ReadinessCheckResponse, error)
+	// ReloadConfig reloads the server's configuration file. Only a subset of the configuration can
+	// be changed without a restart: changes to any other keys are reported, but not applied. An
+	// invalid configuration is rejected without applying any changes.
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
 	mustEmbedUnimplementedServerServiceServer()
 }
 
@@ -109,6 +126,9 @@ func (UnimplementedServerServiceServer) ClockSynced(context.Context, *ClockSynce
 func (UnimplementedServerServiceServer) ReadinessCheck(context.Context, *ReadinessCheckRequest) (*ReadinessCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadinessCheck not implemented")
 }
+func (UnimplementedServerServiceServer) ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
 func (UnimplementedServerServiceServer) mustEmbedUnimplementedServerServiceServer() {}
 
 // UnsafeServerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -194,6 +214,24 @@ func _ServerService_ReadinessCheck_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServerService_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerServiceServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitaly.ServerService/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerServiceServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ServerService_ServiceDesc is the grpc.ServiceDesc for ServerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -217,6 +255,10 @@ var ServerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadinessCheck",
 			Handler:    _ServerService_ReadinessCheck_Handler,
 		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _ServerService_ReloadConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server.proto",
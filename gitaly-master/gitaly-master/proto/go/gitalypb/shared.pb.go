@@ -136,6 +136,61 @@ func (ObjectFormat) EnumDescriptor() ([]byte, []int) {
 	return file_shared_proto_rawDescGZIP(), []int{1}
 }
 
+// ReferenceBackend is the backend that a Git repository uses to store its references.
+type ReferenceBackend int32
+
+const (
+	// REFERENCE_BACKEND_UNSPECIFIED is the default reference backend when it has not been explicitly
+	// requested by the client. Defaults to REFERENCE_BACKEND_FILES.
+	ReferenceBackend_REFERENCE_BACKEND_UNSPECIFIED ReferenceBackend = 0
+	// REFERENCE_BACKEND_FILES stores references as loose files and in the packed-refs file.
+	ReferenceBackend_REFERENCE_BACKEND_FILES ReferenceBackend = 1
+	// REFERENCE_BACKEND_REFTABLE stores references in a stack of reftables. This requires Git v2.45.0
+	// or newer.
+	ReferenceBackend_REFERENCE_BACKEND_REFTABLE ReferenceBackend = 2
+)
+
+// Enum value maps for ReferenceBackend.
+var (
+	ReferenceBackend_name = map[int32]string{
+		0: "REFERENCE_BACKEND_UNSPECIFIED",
+		1: "REFERENCE_BACKEND_FILES",
+		2: "REFERENCE_BACKEND_REFTABLE",
+	}
+	ReferenceBackend_value = map[string]int32{
+		"REFERENCE_BACKEND_UNSPECIFIED": 0,
+		"REFERENCE_BACKEND_FILES":       1,
+		"REFERENCE_BACKEND_REFTABLE":    2,
+	}
+)
+
+func (x ReferenceBackend) Enum() *ReferenceBackend {
+	p := new(ReferenceBackend)
+	*p = x
+	return p
+}
+
+func (x ReferenceBackend) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReferenceBackend) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_enumTypes[2].Descriptor()
+}
+
+func (ReferenceBackend) Type() protoreflect.EnumType {
+	return &file_shared_proto_enumTypes[2]
+}
+
+func (x ReferenceBackend) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReferenceBackend.Descriptor instead.
+func (ReferenceBackend) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_rawDescGZIP(), []int{2}
+}
+
 // This comment is left unintentionally blank.
 type SignatureType int32
 
@@ -177,11 +232,11 @@ func (x SignatureType) String() string {
 }
 
 func (SignatureType) Descriptor() protoreflect.EnumDescriptor {
-	return file_shared_proto_enumTypes[2].Descriptor()
+	return file_shared_proto_enumTypes[3].Descriptor()
 }
 
 func (SignatureType) Type() protoreflect.EnumType {
-	return &file_shared_proto_enumTypes[2]
+	return &file_shared_proto_enumTypes[3]
 }
 
 func (x SignatureType) Number() protoreflect.EnumNumber {
@@ -190,7 +245,7 @@ func (x SignatureType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SignatureType.Descriptor instead.
 func (SignatureType) EnumDescriptor() ([]byte, []int) {
-	return file_shared_proto_rawDescGZIP(), []int{2}
+	return file_shared_proto_rawDescGZIP(), []int{3}
 }
 
 // SortDirection defines the sort direction.
@@ -226,11 +281,11 @@ func (x SortDirection) String() string {
 }
 
 func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
-	return file_shared_proto_enumTypes[3].Descriptor()
+	return file_shared_proto_enumTypes[4].Descriptor()
 }
 
 func (SortDirection) Type() protoreflect.EnumType {
-	return &file_shared_proto_enumTypes[3]
+	return &file_shared_proto_enumTypes[4]
 }
 
 func (x SortDirection) Number() protoreflect.EnumNumber {
@@ -239,7 +294,7 @@ func (x SortDirection) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortDirection.Descriptor instead.
 func (SortDirection) EnumDescriptor() ([]byte, []int) {
-	return file_shared_proto_rawDescGZIP(), []int{3}
+	return file_shared_proto_rawDescGZIP(), []int{4}
 }
 
 // This comment is left unintentionally blank.
@@ -1350,17 +1405,24 @@ var file_shared_proto_rawDesc = []byte{
 	0x16, 0x0a, 0x12, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
 	0x5f, 0x53, 0x48, 0x41, 0x31, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x42, 0x4a, 0x45, 0x43,
 	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10,
-	0x02, 0x2a, 0x35, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
-	0x50, 0x47, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x58, 0x35, 0x30, 0x39, 0x10, 0x02, 0x12,
-	0x07, 0x0a, 0x03, 0x53, 0x53, 0x48, 0x10, 0x03, 0x2a, 0x2e, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x53, 0x43,
-	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x53, 0x43,
-	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x6c,
-	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2d, 0x6f, 0x72,
-	0x67, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2f, 0x76, 0x31, 0x35, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x2a, 0x72, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e,
+	0x43, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x46, 0x45,
+	0x52, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x46, 0x49,
+	0x4c, 0x45, 0x53, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e,
+	0x43, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x46, 0x54, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x02, 0x2a, 0x35, 0x0a, 0x0d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x50, 0x47, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x58, 0x35, 0x30,
+	0x39, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x53, 0x48, 0x10, 0x03, 0x2a, 0x2e, 0x0a, 0x0d,
+	0x53, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x0a,
+	0x09, 0x41, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x44, 0x45, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x42, 0x34, 0x5a, 0x32,
+	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2d, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79, 0x2f, 0x76, 0x31, 0x35,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x69, 0x74, 0x61, 0x6c, 0x79,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1375,40 +1437,41 @@ func file_shared_proto_rawDescGZIP() []byte {
 	return file_shared_proto_rawDescData
 }
 
-var file_shared_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_shared_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_shared_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_shared_proto_goTypes = []interface{}{
 	(ObjectType)(0),               // 0: gitaly.ObjectType
 	(ObjectFormat)(0),             // 1: gitaly.ObjectFormat
-	(SignatureType)(0),            // 2: gitaly.SignatureType
-	(SortDirection)(0),            // 3: gitaly.SortDirection
-	(*Repository)(nil),            // 4: gitaly.Repository
-	(*CommitTrailer)(nil),         // 5: gitaly.CommitTrailer
-	(*CommitStatInfo)(nil),        // 6: gitaly.CommitStatInfo
-	(*GitCommit)(nil),             // 7: gitaly.GitCommit
-	(*CommitAuthor)(nil),          // 8: gitaly.CommitAuthor
-	(*ExitStatus)(nil),            // 9: gitaly.ExitStatus
-	(*Branch)(nil),                // 10: gitaly.Branch
-	(*Tag)(nil),                   // 11: gitaly.Tag
-	(*User)(nil),                  // 12: gitaly.User
-	(*ObjectPool)(nil),            // 13: gitaly.ObjectPool
-	(*PaginationParameter)(nil),   // 14: gitaly.PaginationParameter
-	(*PaginationCursor)(nil),      // 15: gitaly.PaginationCursor
-	(*GlobalOptions)(nil),         // 16: gitaly.GlobalOptions
-	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(ReferenceBackend)(0),         // 2: gitaly.ReferenceBackend
+	(SignatureType)(0),            // 3: gitaly.SignatureType
+	(SortDirection)(0),            // 4: gitaly.SortDirection
+	(*Repository)(nil),            // 5: gitaly.Repository
+	(*CommitTrailer)(nil),         // 6: gitaly.CommitTrailer
+	(*CommitStatInfo)(nil),        // 7: gitaly.CommitStatInfo
+	(*GitCommit)(nil),             // 8: gitaly.GitCommit
+	(*CommitAuthor)(nil),          // 9: gitaly.CommitAuthor
+	(*ExitStatus)(nil),            // 10: gitaly.ExitStatus
+	(*Branch)(nil),                // 11: gitaly.Branch
+	(*Tag)(nil),                   // 12: gitaly.Tag
+	(*User)(nil),                  // 13: gitaly.User
+	(*ObjectPool)(nil),            // 14: gitaly.ObjectPool
+	(*PaginationParameter)(nil),   // 15: gitaly.PaginationParameter
+	(*PaginationCursor)(nil),      // 16: gitaly.PaginationCursor
+	(*GlobalOptions)(nil),         // 17: gitaly.GlobalOptions
+	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
 }
 var file_shared_proto_depIdxs = []int32{
-	8,  // 0: gitaly.GitCommit.author:type_name -> gitaly.CommitAuthor
-	8,  // 1: gitaly.GitCommit.committer:type_name -> gitaly.CommitAuthor
-	2,  // 2: gitaly.GitCommit.signature_type:type_name -> gitaly.SignatureType
-	5,  // 3: gitaly.GitCommit.trailers:type_name -> gitaly.CommitTrailer
-	6,  // 4: gitaly.GitCommit.short_stats:type_name -> gitaly.CommitStatInfo
-	17, // 5: gitaly.CommitAuthor.date:type_name -> google.protobuf.Timestamp
-	7,  // 6: gitaly.Branch.target_commit:type_name -> gitaly.GitCommit
-	7,  // 7: gitaly.Tag.target_commit:type_name -> gitaly.GitCommit
-	8,  // 8: gitaly.Tag.tagger:type_name -> gitaly.CommitAuthor
-	2,  // 9: gitaly.Tag.signature_type:type_name -> gitaly.SignatureType
-	4,  // 10: gitaly.ObjectPool.repository:type_name -> gitaly.Repository
+	9,  // 0: gitaly.GitCommit.author:type_name -> gitaly.CommitAuthor
+	9,  // 1: gitaly.GitCommit.committer:type_name -> gitaly.CommitAuthor
+	3,  // 2: gitaly.GitCommit.signature_type:type_name -> gitaly.SignatureType
+	6,  // 3: gitaly.GitCommit.trailers:type_name -> gitaly.CommitTrailer
+	7,  // 4: gitaly.GitCommit.short_stats:type_name -> gitaly.CommitStatInfo
+	18, // 5: gitaly.CommitAuthor.date:type_name -> google.protobuf.Timestamp
+	8,  // 6: gitaly.Branch.target_commit:type_name -> gitaly.GitCommit
+	8,  // 7: gitaly.Tag.target_commit:type_name -> gitaly.GitCommit
+	9,  // 8: gitaly.Tag.tagger:type_name -> gitaly.CommitAuthor
+	3,  // 9: gitaly.Tag.signature_type:type_name -> gitaly.SignatureType
+	5,  // 10: gitaly.ObjectPool.repository:type_name -> gitaly.Repository
 	11, // [11:11] is the sub-list for method output_type
 	11, // [11:11] is the sub-list for method input_type
 	11, // [11:11] is the sub-list for extension type_name
@@ -1585,7 +1648,7 @@ func file_shared_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_shared_proto_rawDesc,
-			NumEnums:      4,
+			NumEnums:      5,
 			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,